@@ -107,8 +107,19 @@ type DataScienceClusterStatus struct {
 
 	// Version and release type
 	Release cluster.Release `json:"release,omitempty"`
+
+	// LastReadyDuration is the time it took to become Ready after the last change of the spec, i.e. since ReadyGeneration
+	// has been created, so that reconciliation SLOs can be tracked.
+	// +optional
+	LastReadyDuration *metav1.Duration `json:"lastReadyDuration,omitempty"`
+
+	// ReadyGeneration is the generation of the spec LastReadyDuration has been measured for.
+	// +optional
+	ReadyGeneration int64 `json:"readyGeneration,omitempty"`
 }
 
+//+genclient
+//+genclient:nonNamespaced
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:scope=Cluster,shortName=dsc
@@ -0,0 +1,5 @@
+// +groupName=datasciencecluster.opendatahub.io
+
+// The group name is repeated here, as client-gen reads it from doc.go only (see hack/update-codegen.sh).
+
+package v1
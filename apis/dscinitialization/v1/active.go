@@ -0,0 +1,38 @@
+package v1
+
+// ActiveInstance returns the DSCInitialization which is in effect when more than one instance exists in the cluster.
+// The oldest instance is the active one, instances created at the same time are ordered by name.
+// Nil is returned when the list is empty.
+func (in *DSCInitializationList) ActiveInstance() *DSCInitialization {
+	var active *DSCInitialization
+	for i := range in.Items {
+		candidate := &in.Items[i]
+		if active == nil || isOlder(candidate, active) {
+			active = candidate
+		}
+	}
+
+	return active
+}
+
+// Duplicates returns instances other than the active one.
+func (in *DSCInitializationList) Duplicates() []*DSCInitialization {
+	active := in.ActiveInstance()
+
+	var duplicates []*DSCInitialization
+	for i := range in.Items {
+		if candidate := &in.Items[i]; candidate != active {
+			duplicates = append(duplicates, candidate)
+		}
+	}
+
+	return duplicates
+}
+
+func isOlder(instance, other *DSCInitialization) bool {
+	if !instance.CreationTimestamp.Equal(&other.CreationTimestamp) {
+		return instance.CreationTimestamp.Before(&other.CreationTimestamp)
+	}
+
+	return instance.Name < other.Name
+}
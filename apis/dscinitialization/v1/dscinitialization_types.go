@@ -21,6 +21,7 @@ import (
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
@@ -30,7 +31,8 @@ import (
 
 // DSCInitializationSpec defines the desired state of DSCInitialization.
 type DSCInitializationSpec struct {
-	// Namespace for applications to be installed, non-configurable, default to "opendatahub"
+	// Namespace for applications to be installed, default to "opendatahub".
+	// Changing it migrates applications from the previously used namespace to the new one.
 	// +kubebuilder:default:=opendatahub
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
 	ApplicationsNamespace string `json:"applicationsNamespace"`
@@ -57,6 +59,414 @@ type DSCInitializationSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=5
 	// +optional
 	DevFlags *DevFlags `json:"devFlags,omitempty"`
+	// Guardrails applied to namespaces created by the operator for platform features,
+	// such as the Service Mesh control plane or the authorization provider namespace.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=6
+	// +optional
+	NamespaceDefaults *NamespaceDefaults `json:"namespaceDefaults,omitempty"`
+	// Overrides conditions under which platform features are enabled.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=7
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	FeatureOverrides []FeatureOverride `json:"featureOverrides,omitempty"`
+	// Profile selects a tested set of platform features to be enabled. When not set,
+	// features are enabled according to the rest of the spec. Features listed in
+	// featureOverrides take precedence over the profile.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=8
+	// +optional
+	Profile Profile `json:"profile,omitempty"`
+	// When set, disruptive changes to platform features, such as Service Mesh control plane updates
+	// or gateway reconfiguration, are only applied within the maintenance window.
+	// Outside the window such changes are postponed and reported as pending.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=9
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	// Interval at which capabilities, such as Service Mesh and its authorization provider, re-validate external state
+	// (e.g. health of the control plane or presence of Authorino) in the absence of events, e.g. "15m".
+	// Shorter intervals detect problems sooner at the cost of more API load, "0s" disables periodic re-validation.
+	// When not set, the default of the operator (--capability-resync-period flag) is used.
+	// Intervals shorter than a minute are raised to a minute.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=10
+	// +optional
+	CapabilityResyncPeriod *metav1.Duration `json:"capabilityResyncPeriod,omitempty"`
+	// Labels and annotations added to resources and namespaces created by platform features,
+	// so that they match exemptions of admission policies enforced in the cluster, e.g. by OPA Gatekeeper.
+	// Changes denied by such policies are reported with the PolicyDenied reason.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=11
+	// +optional
+	PolicyExemptions *PolicyExemptions `json:"policyExemptions,omitempty"`
+	// Experimental behaviors of the operator to turn on or off, keyed by the name of the gate, e.g. "ParallelFeatureApply: true".
+	// Alpha gates are off and Beta gates are on unless set otherwise, GA gates cannot be turned off.
+	// Unknown gates are ignored and reported in events.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=12
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// Surfaces the platform in the OpenShift console, with links to the dashboard and documentation
+	// in the application and help menus, and on the Command Line Tools page.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=13
+	// +optional
+	ConsoleIntegration *ConsoleIntegration `json:"consoleIntegration,omitempty"`
+	// Defaults of platform workloads deployed by the capabilities, such as the Service Mesh ingress gateway or Authorino.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=14
+	// +optional
+	WorkloadDefaults *WorkloadDefaults `json:"workloadDefaults,omitempty"`
+	// Metadata added to workloads and namespaces created by platform features, such as labels used by chargeback
+	// tools (e.g. OpenCost or Kubecost) to attribute the overhead of the platform.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=15
+	// +optional
+	MetadataDefaults *MetadataDefaults `json:"metadataDefaults,omitempty"`
+	// Serving certificates of model serving endpoints, issued for the listed Services by a single provider, so that
+	// serving components (KServe, ModelMesh) find them through the serving-cert-refs ConfigMap instead of issuing them on their own.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=16
+	// +optional
+	ServingCertificates *ServingCertificates `json:"servingCertificates,omitempty"`
+	// Canary rollout of changes brought by operator upgrades. Features applied by an older operator version are first
+	// applied to the canary namespace, and rolled out only once they succeed there, including their post-conditions.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=17
+	// +optional
+	CanaryRollout *CanaryRollout `json:"canaryRollout,omitempty"`
+	// Handling of namespaces created by the operator which are no longer used by any capability or feature, e.g. the previous
+	// applications namespace after it has been renamed. Orphaned namespaces are reported in status.orphanedNamespaces.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=18
+	// +optional
+	OrphanedNamespaces *OrphanedNamespaces `json:"orphanedNamespaces,omitempty"`
+	// Mirrors of image repositories referenced by manifests of platform features, e.g. in the registry of a disconnected cluster.
+	// Image references are rewritten when the manifests are rendered, so that no forked templates have to be maintained.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=19
+	// +optional
+	ImageMirrors []ImageMirror `json:"imageMirrors,omitempty"`
+	// Deletion of namespaces created by platform features, such as the namespace of the authorization provider,
+	// when the capability they are part of is removed.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=20
+	// +optional
+	NamespaceDeletion *NamespaceDeletion `json:"namespaceDeletion,omitempty"`
+}
+
+// ImageMirror replaces the repository of image references with its mirror.
+type ImageMirror struct {
+	// Source repository, or its parent, e.g. registry.redhat.io/openshift-service-mesh. It matches references on path boundaries,
+	// i.e. quay.io/org matches quay.io/org/image, but not quay.io/organization/image. The longest matching source is used.
+	// +kubebuilder:validation:MinLength=1
+	Source string `json:"source"`
+	// Mirror replacing the source, e.g. mirror.example.com:5000/openshift-service-mesh.
+	// +kubebuilder:validation:MinLength=1
+	Mirror string `json:"mirror"`
+}
+
+// OrphanedNamespacesPolicy defines what happens to namespaces created by the operator which are no longer used.
+// +kubebuilder:validation:Enum=Report;Delete
+type OrphanedNamespacesPolicy string
+
+const (
+	// ReportOrphanedNamespaces lists orphaned namespaces in status, leaving them intact.
+	ReportOrphanedNamespaces OrphanedNamespacesPolicy = "Report"
+	// DeleteOrphanedNamespaces deletes orphaned namespaces, together with everything they contain.
+	DeleteOrphanedNamespaces OrphanedNamespacesPolicy = "Delete"
+)
+
+// OrphanedNamespaces configures handling of namespaces created by the operator which are no longer used.
+type OrphanedNamespaces struct {
+	// Policy applied to orphaned namespaces once they are detected.
+	// +kubebuilder:default=Report
+	// +optional
+	Policy OrphanedNamespacesPolicy `json:"policy,omitempty"`
+}
+
+// NamespacePropagation defines how namespaces created by platform features are deleted once the capability is removed.
+// +kubebuilder:validation:Enum=Foreground;Background;Orphan
+type NamespacePropagation string
+
+const (
+	// ForegroundNamespacePropagation deletes namespaces and waits until they have terminated, so that enabling the capability
+	// again does not race with the termination.
+	ForegroundNamespacePropagation NamespacePropagation = "Foreground"
+	// BackgroundNamespacePropagation lets the garbage collector delete namespaces without waiting for them to terminate.
+	BackgroundNamespacePropagation NamespacePropagation = "Background"
+	// OrphanNamespacePropagation keeps namespaces, together with everything they contain, and reports them as orphaned.
+	OrphanNamespacePropagation NamespacePropagation = "Orphan"
+)
+
+// NamespaceDeletion configures deletion of namespaces created by platform features.
+type NamespaceDeletion struct {
+	// Propagation applied to namespaces of removed capabilities.
+	// +kubebuilder:default=Background
+	// +optional
+	Propagation NamespacePropagation `json:"propagation,omitempty"`
+	// Timeout for namespaces to terminate with Foreground propagation. Removal of namespaces which are still terminating
+	// is retried by the next reconciliation.
+	// +kubebuilder:default="2m"
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// CanaryRollout defines where changes of the features are verified before they are rolled out.
+type CanaryRollout struct {
+	// Namespace the features are applied to first, as their target namespace. It is created when missing,
+	// and resources of the features are removed from it once the changes have been rolled out.
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// ServingCertificatesProvider issues serving certificates.
+// +kubebuilder:validation:Enum=OpenShift;CertManager;SelfSigned
+type ServingCertificatesProvider string
+
+const (
+	// OpenShiftServingCertificates are issued by the OpenShift service CA for Services annotated with the name of the secret.
+	OpenShiftServingCertificates ServingCertificatesProvider = "OpenShift"
+	// CertManagerServingCertificates are issued by cert-manager Certificates using the configured issuer.
+	CertManagerServingCertificates ServingCertificatesProvider = "CertManager"
+	// SelfSignedServingCertificates are generated by the operator, one per Service.
+	SelfSignedServingCertificates ServingCertificatesProvider = "SelfSigned"
+)
+
+// ServingCertificates configures how TLS certificates of model serving endpoints are issued.
+type ServingCertificates struct {
+	// Set to "Managed" to issue certificates for the listed Services and publish references to them
+	// in the serving-cert-refs ConfigMap of the applications namespace. Set to "Removed" to remove them.
+	// +kubebuilder:validation:Enum=Managed;Removed
+	// +kubebuilder:default=Removed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Provider issuing the certificates.
+	// +kubebuilder:default=OpenShift
+	// +optional
+	Provider ServingCertificatesProvider `json:"provider,omitempty"`
+	// Issuer of cert-manager signing the certificates, required by the CertManager provider.
+	// +optional
+	Issuer *CertManagerIssuer `json:"issuer,omitempty"`
+	// Services the certificates are issued for, covering their cluster-local DNS names.
+	// +optional
+	Services []ServingCertificateService `json:"services,omitempty"`
+}
+
+// CertManagerIssuer references cert-manager Issuer or ClusterIssuer.
+type CertManagerIssuer struct {
+	// Name of the issuer.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Kind of the issuer.
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default=ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+// ServingCertificateService identifies the Service serving model endpoints over TLS.
+type ServingCertificateService struct {
+	// Name of the Service.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace of the Service.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// Name of the secret holding the certificate, defaults to "<name>-serving-cert".
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// MetadataDefaults defines metadata added to workloads and namespaces created by platform features.
+type MetadataDefaults struct {
+	// Cost-attribution labels added to namespaces and workloads, including their pod templates.
+	// +optional
+	CostAttribution *CostAttribution `json:"costAttribution,omitempty"`
+}
+
+// CostAttribution defines labels attributing the cost of platform workloads and namespaces. Keys of the labels
+// can be changed to match the labels configured in the chargeback tool. Labels with empty values are not added,
+// and labels already set by the operator take precedence.
+type CostAttribution struct {
+	// Team the overhead of the platform is attributed to.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$`
+	// +optional
+	Team string `json:"team,omitempty"`
+	// Product the overhead of the platform is attributed to.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$`
+	// +optional
+	Product string `json:"product,omitempty"`
+	// Key of the label holding the team.
+	// +kubebuilder:default=team
+	// +optional
+	TeamLabel string `json:"teamLabel,omitempty"`
+	// Key of the label holding the product.
+	// +kubebuilder:default=product
+	// +optional
+	ProductLabel string `json:"productLabel,omitempty"`
+	// Key of the label holding the platform capability the resource is part of, e.g. "service-mesh".
+	// Set to "-" to leave the capability out.
+	// +kubebuilder:default=capability
+	// +optional
+	CapabilityLabel string `json:"capabilityLabel,omitempty"`
+}
+
+// WorkloadDefaults defines how platform workloads deployed by the capabilities are run.
+type WorkloadDefaults struct {
+	// Autoscaling of platform workloads. Workloads without autoscaling keep replicas set by the operator deploying them.
+	// +optional
+	Autoscaling *WorkloadAutoscaling `json:"autoscaling,omitempty"`
+	// PodDisruptionBudgets of platform workloads, so that voluntary disruptions, such as nodes drained during cluster upgrades,
+	// do not evict all of their pods at once.
+	// +optional
+	PodDisruptionBudgets *WorkloadDisruptionBudgets `json:"podDisruptionBudgets,omitempty"`
+}
+
+// WorkloadAutoscaling configures HorizontalPodAutoscalers of platform workloads.
+type WorkloadAutoscaling struct {
+	// Ingress gateway of the Service Mesh control plane. It has an effect only when Service Mesh is Managed.
+	// +optional
+	Gateway *AutoscalingSpec `json:"gateway,omitempty"`
+	// Authorino authorization provider. It has an effect only when Service Mesh is Managed and Authorino is used for authorization.
+	// +optional
+	Authorino *AutoscalingSpec `json:"authorino,omitempty"`
+}
+
+// AutoscalingSpec defines the HorizontalPodAutoscaler of a workload, scaling it on CPU utilization.
+// +kubebuilder:validation:XValidation:rule="self.minReplicas <= self.maxReplicas",message="minReplicas must not be greater than maxReplicas"
+type AutoscalingSpec struct {
+	// Set to "Managed" to create the HorizontalPodAutoscaler, set to "Removed" to remove it.
+	// +kubebuilder:validation:Enum=Managed;Removed
+	// +kubebuilder:default=Removed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
+	// Average CPU utilization of the pods, relative to their requests, the autoscaler keeps the workload at.
+	// +kubebuilder:default=80
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	TargetCPUUtilizationPercentage int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+}
+
+// WorkloadDisruptionBudgets configures PodDisruptionBudgets of platform workloads.
+type WorkloadDisruptionBudgets struct {
+	// Ingress gateway of the Service Mesh control plane. It has an effect only when Service Mesh is Managed.
+	// +optional
+	Gateway *DisruptionBudgetSpec `json:"gateway,omitempty"`
+	// Authorino authorization provider. It has an effect only when Service Mesh is Managed and Authorino is used for authorization.
+	// +optional
+	Authorino *DisruptionBudgetSpec `json:"authorino,omitempty"`
+}
+
+// DisruptionBudgetSpec defines the PodDisruptionBudget of a workload.
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="only one of minAvailable and maxUnavailable can be set"
+type DisruptionBudgetSpec struct {
+	// Set to "Managed" to create the PodDisruptionBudget, set to "Removed" to remove it.
+	// +kubebuilder:validation:Enum=Managed;Removed
+	// +kubebuilder:default=Removed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Number, e.g. 1, or percentage, e.g. "50%", of the pods of the workload which have to stay available when pods are evicted.
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// Number, e.g. 1, or percentage, e.g. "50%", of the pods of the workload which can be unavailable when pods are evicted.
+	// It is used when minAvailable is not set, and defaults to 1, so that workloads running a single replica do not block node drains.
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// ConsoleIntegration configures entries of the OpenShift console pointing at the platform.
+type ConsoleIntegration struct {
+	// Set to "Managed" to create ConsoleLink and ConsoleCLIDownload resources pointing at the dashboard and documentation.
+	// Set to "Removed" to remove them.
+	// +kubebuilder:validation:Enum=Managed;Removed
+	// +kubebuilder:default=Removed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// URL of the documentation linked from the help menu of the console.
+	// +kubebuilder:default="https://opendatahub.io/docs"
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	DocumentationURL string `json:"documentationURL,omitempty"`
+	// Console dynamic plugin to be registered, served by a Service in the applications namespace.
+	// The plugin has to be enabled in the Console operator configuration to be loaded by the console.
+	// +optional
+	Plugin *ConsolePlugin `json:"plugin,omitempty"`
+}
+
+// ConsolePlugin defines the backend serving a console dynamic plugin.
+type ConsolePlugin struct {
+	// Name of the ConsolePlugin resource, used to enable the plugin in the Console operator configuration.
+	// +kubebuilder:default=odh-console-plugin
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Name of the Service serving the plugin assets over TLS in the applications namespace.
+	// +kubebuilder:validation:MinLength=1
+	ServiceName string `json:"serviceName"`
+	// Port of the Service serving the plugin assets.
+	// +kubebuilder:default=9443
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// Path under which the plugin assets are served.
+	// +kubebuilder:default="/"
+	// +optional
+	BasePath string `json:"basePath,omitempty"`
+}
+
+// MaintenanceWindow defines recurring periods in which disruptive changes can be applied.
+type MaintenanceWindow struct {
+	// Schedule in Cron format (minute hour day-of-month month day-of-week), evaluated in UTC,
+	// defining when the maintenance window opens, e.g. "0 2 * * 6" for every Saturday at 2am.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// Duration for which the maintenance window stays open, e.g. "4h".
+	Duration metav1.Duration `json:"duration"`
+}
+
+// Profile is a predefined set of platform features.
+// +kubebuilder:validation:Enum=Minimal;ServingOnly;Full
+type Profile string
+
+const (
+	// ProfileMinimal disables all optional platform features, such as Service Mesh and its authorization provider.
+	ProfileMinimal Profile = "Minimal"
+	// ProfileServingOnly enables only the features required by model serving.
+	ProfileServingOnly Profile = "ServingOnly"
+	// ProfileFull enables all platform features.
+	ProfileFull Profile = "Full"
+)
+
+// FeatureOverride replaces the built-in condition determining if the feature is enabled.
+type FeatureOverride struct {
+	// Name of the feature, e.g. mesh-metrics-collection.
+	Name string `json:"name"`
+	// CEL expression which has to evaluate to true for the feature to be enabled.
+	// The expression has access to `spec` of the DSCInitialization and `cluster` facts:
+	// `ocpVersion`, `ocpMajor`, `ocpMinor` and `architectures`, e.g.
+	// spec.serviceMesh.controlPlane.metricsCollection == 'Istio' && cluster.ocpMinor >= 14
+	// Expressions which do not compile are rejected.
+	// +kubebuilder:validation:MinLength=1
+	EnabledWhen string `json:"enabledWhen"`
+}
+
+// NamespaceDefaults defines resource guardrails applied to namespaces created by the operator.
+type NamespaceDefaults struct {
+	// ResourceQuota to be created in every namespace created by the operator.
+	// +optional
+	ResourceQuota *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+	// LimitRange to be created in every namespace created by the operator.
+	// +optional
+	LimitRange *corev1.LimitRangeSpec `json:"limitRange,omitempty"`
+}
+
+// PolicyExemptions defines metadata required by admission policies to exempt resources created by the operator.
+type PolicyExemptions struct {
+	// Labels added to the created resources, e.g. "admission.gatekeeper.sh/ignore": "true".
+	// Labels set by the operator itself take precedence.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations added to the created resources. Annotations set by the operator itself take precedence.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 type Monitoring struct {
@@ -70,6 +480,24 @@ type Monitoring struct {
 	// +kubebuilder:default=opendatahub
 	// Namespace for monitoring if it is enabled
 	Namespace string `json:"namespace,omitempty"`
+	// FeatureAlerts configures alerting on features applied by the operator which remain in the Error phase.
+	// It has an effect only when monitoring is Managed.
+	// +optional
+	FeatureAlerts *FeatureAlerts `json:"featureAlerts,omitempty"`
+}
+
+// FeatureAlerts configures the PrometheusRule alerting on features stuck in the Error phase.
+type FeatureAlerts struct {
+	// Set to "Managed" to create the alerting rule together with the ServiceMonitor scraping operator metrics.
+	// Set to "Removed" to remove them.
+	// +kubebuilder:validation:Enum=Managed;Removed
+	// +kubebuilder:default=Removed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// For defines how long a feature has to remain in the Error phase before the alert fires.
+	// +kubebuilder:default="15m"
+	// +kubebuilder:validation:Pattern=`^([0-9]+(ms|s|m|h))+$`
+	// +optional
+	For string `json:"for,omitempty"`
 }
 
 // DevFlags defines list of fields that can be used by developers to test customizations. This is not recommended
@@ -81,6 +509,50 @@ type DevFlags struct {
 	// +kubebuilder:validation:Enum=devel;development;prod;production
 	// +kubebuilder:default="production"
 	LogMode string `json:"logmode,omitempty"`
+	// Custom templates of platform capabilities, used instead of the ones embedded in the operator.
+	// +listType=map
+	// +listMapKey=capability
+	// +optional
+	Templates []CapabilityTemplates `json:"templates,omitempty"`
+}
+
+// TemplatesCapability is the platform capability for which custom templates can be used.
+// +kubebuilder:validation:Enum=servicemesh;authorino
+type TemplatesCapability string
+
+const (
+	ServiceMeshTemplates TemplatesCapability = "servicemesh"
+	AuthorinoTemplates   TemplatesCapability = "authorino"
+)
+
+// CapabilityTemplates points a platform capability at custom templates. The templates directory has to follow
+// the layout of the templates embedded in the operator, i.e. contain "servicemesh", "authorino" etc. folders.
+type CapabilityTemplates struct {
+	// capability which uses the custom templates.
+	Capability TemplatesCapability `json:"capability"`
+	// uri of the templates. Either a local directory, e.g. file:///home/dev/opendatahub-operator/controllers/dscinitialization/resources,
+	// or a tarball of a git repository, e.g. https://github.com/org/repo/tarball/<tag/branch>.
+	// Templates are read again on every reconciliation, so that changes are applied without restarting the operator.
+	// +kubebuilder:validation:Pattern=`^(file|https?)://`
+	URI string `json:"uri"`
+	// contextDir is the relative path to the folder containing templates in the tarball.
+	// +optional
+	// +kubebuilder:default:="controllers/dscinitialization/resources"
+	ContextDir string `json:"contextDir,omitempty"`
+}
+
+// TemplatesFor returns custom templates configured for the given capability, nil if there are none.
+func (d *DevFlags) TemplatesFor(capability TemplatesCapability) *CapabilityTemplates {
+	if d == nil {
+		return nil
+	}
+	for i := range d.Templates {
+		if d.Templates[i].Capability == capability {
+			return &d.Templates[i]
+		}
+	}
+
+	return nil
 }
 
 type TrustedCABundleSpec struct {
@@ -114,8 +586,178 @@ type DSCInitializationStatus struct {
 
 	// Version and release type
 	Release cluster.Release `json:"release,omitempty"`
+
+	// ApplicationsNamespace is the namespace in which applications are currently deployed.
+	// It differs from spec.applicationsNamespace until migration to the new namespace is completed.
+	// +optional
+	ApplicationsNamespace string `json:"applicationsNamespace,omitempty"`
+
+	// Simulation is a report describing the impact of spec changes proposed using
+	// the opendatahub.io/simulate annotation. Proposed changes are never applied.
+	// +optional
+	Simulation *SimulationReport `json:"simulation,omitempty"`
+
+	// Dependencies lists versions of the operators, which the platform capabilities depend on, detected in the cluster,
+	// along with the verdict whether this operator supports them.
+	// +optional
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+
+	// Removal reports the outcome of the last removal of Service Mesh capabilities, i.e. when spec.serviceMesh is set
+	// to Removed or DSCInitialization is deleted, so that automation can verify the teardown is complete.
+	// +optional
+	Removal *RemovalReport `json:"removal,omitempty"`
+
+	// OrphanedNamespaces lists namespaces created by the operator which are no longer used by any capability or feature,
+	// see spec.orphanedNamespaces.
+	// +optional
+	OrphanedNamespaces []string `json:"orphanedNamespaces,omitempty"`
+
+	// LastReadyDuration is the time it took to become Ready after the last change of the spec, i.e. since ReadyGeneration
+	// has been created, so that reconciliation SLOs can be tracked.
+	// +optional
+	LastReadyDuration *metav1.Duration `json:"lastReadyDuration,omitempty"`
+
+	// ReadyGeneration is the generation of the spec LastReadyDuration has been measured for.
+	// +optional
+	ReadyGeneration int64 `json:"readyGeneration,omitempty"`
+
+	// Capabilities lists values published by the features of each capability, such as the hostname of the ingress gateway
+	// or the URL of the authorization provider, so that endpoints created by the platform are discoverable in a single place.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Capabilities []CapabilityStatus `json:"capabilities,omitempty"`
+
+	// Preconditions lists the outcome of evaluating prerequisites of each enabled feature up front, without applying it,
+	// so that all missing prerequisites, such as operators which are not installed, are reported at once.
+	// +listType=map
+	// +listMapKey=feature
+	// +optional
+	Preconditions []PreconditionStatus `json:"preconditions,omitempty"`
+}
+
+// CapabilityStatus holds values published by the features of the capability.
+type CapabilityStatus struct {
+	// Name of the capability, e.g. service-mesh.
+	Name string `json:"name"`
+	// Values published by the features of the capability, keyed by name, e.g. gatewayHostname.
+	// +optional
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// PreconditionStatus is the outcome of evaluating prerequisites of a feature before it is applied.
+type PreconditionStatus struct {
+	// Feature whose prerequisites have been evaluated.
+	Feature string `json:"feature"`
+	// Capability the feature is part of, if any.
+	// +optional
+	Capability string `json:"capability,omitempty"`
+	// Satisfied tells whether all prerequisites of the feature are met.
+	Satisfied bool `json:"satisfied"`
+	// Reason identifies the step whose prerequisites are not met, e.g. PreConditions or LoadTemplateData.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message describes the prerequisites which are not met.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DependencyVerdict tells whether the detected version of the operator is supported.
+// +kubebuilder:validation:Enum=Supported;Unsupported;Unknown
+type DependencyVerdict string
+
+const (
+	DependencySupported   DependencyVerdict = "Supported"
+	DependencyUnsupported DependencyVerdict = "Unsupported"
+	DependencyUnknown     DependencyVerdict = "Unknown"
+)
+
+// Dependency describes an operator installed in the cluster, which the platform capabilities depend on.
+type Dependency struct {
+	// Name of the operator package, e.g. servicemeshoperator.
+	Name string `json:"name"`
+	// Version of the installed ClusterServiceVersion. Empty when it cannot be determined, e.g. during installation.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// SupportedVersions is the range of versions supported by this operator, e.g. ">=2.4.0 <3.0.0".
+	SupportedVersions string `json:"supportedVersions"`
+	// Verdict is Unknown when the version cannot be determined.
+	Verdict DependencyVerdict `json:"verdict"`
+}
+
+// Disruption estimates the impact of proposed changes on running workloads.
+// +kubebuilder:validation:Enum=None;Low;High
+type Disruption string
+
+const (
+	DisruptionNone Disruption = "None"
+	DisruptionLow  Disruption = "Low"
+	DisruptionHigh Disruption = "High"
+)
+
+// SimulationReport describes what would happen if the spec patch proposed using
+// the opendatahub.io/simulate annotation was applied.
+type SimulationReport struct {
+	// Generation of the DSCInitialization the report has been computed for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Patch is the proposed spec patch the report has been computed for.
+	Patch string `json:"patch,omitempty"`
+	// Resources which would be created.
+	// +optional
+	ResourcesToCreate []string `json:"resourcesToCreate,omitempty"`
+	// Resources which would be deleted.
+	// +optional
+	ResourcesToDelete []string `json:"resourcesToDelete,omitempty"`
+	// Operators which have to be installed for the proposed spec to be applied.
+	// +optional
+	RequiredOperators []string `json:"requiredOperators,omitempty"`
+	// Required operators which are currently not installed on the cluster.
+	// +optional
+	MissingOperators []string `json:"missingOperators,omitempty"`
+	// Estimated disruption of running workloads.
+	Disruption Disruption `json:"disruption,omitempty"`
+	// Error describes why the simulation could not be performed.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// RemovalReport describes resources created by the operator for capabilities which have been removed.
+type RemovalReport struct {
+	// Time the removal has finished.
+	Time metav1.Time `json:"time"`
+	// Complete is true when no resource failed to be deleted and all the clean-up tasks succeeded.
+	Complete bool `json:"complete"`
+	// Deleted resources, including the ones being deleted by the garbage collector or waiting for finalizers.
+	// +optional
+	Deleted []RemovedResource `json:"deleted,omitempty"`
+	// Skipped resources have intentionally been left intact, e.g. as they have not been created by the operator.
+	// +optional
+	Skipped []RemovedResource `json:"skipped,omitempty"`
+	// Failed resources are still present, even though they should have been deleted.
+	// +optional
+	Failed []RemovedResource `json:"failed,omitempty"`
+	// Errors of clean-up tasks which cannot be attributed to any of the resources, e.g. reverting patches of existing resources.
+	// +optional
+	Errors []string `json:"errors,omitempty"`
+}
+
+// RemovedResource identifies a resource created by a feature of the operator.
+type RemovedResource struct {
+	// Feature which created the resource.
+	Feature    string `json:"feature"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Namespace of the resource, empty for cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Reason explains how the resource is being deleted, why it has been skipped or why it failed to be deleted.
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
 
+//+genclient
+//+genclient:nonNamespaced
 //+kubebuilder:object:root=true
 //+kubebuilder:resource:scope=Cluster,shortName=dsci
 //+kubebuilder:subresource:status
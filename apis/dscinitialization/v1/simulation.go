@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+)
+
+// ProposedSpec returns a copy of the spec with the given JSON merge patch applied.
+// Unknown fields in the patch are reported as an error.
+func (in *DSCInitialization) ProposedSpec(mergePatch string) (*DSCInitializationSpec, error) {
+	current, err := json.Marshal(in.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := jsonpatch.MergePatch(current, []byte(mergePatch))
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec patch: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+
+	proposed := &DSCInitializationSpec{}
+	if err := decoder.Decode(proposed); err != nil {
+		return nil, fmt.Errorf("invalid spec patch: %w", err)
+	}
+
+	return proposed, nil
+}
@@ -24,9 +24,143 @@ import (
 	infrastructurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingSpec) DeepCopyInto(out *AutoscalingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingSpec.
+func (in *AutoscalingSpec) DeepCopy() *AutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRollout) DeepCopyInto(out *CanaryRollout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRollout.
+func (in *CanaryRollout) DeepCopy() *CanaryRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapabilityStatus) DeepCopyInto(out *CapabilityStatus) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapabilityStatus.
+func (in *CapabilityStatus) DeepCopy() *CapabilityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CapabilityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapabilityTemplates) DeepCopyInto(out *CapabilityTemplates) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapabilityTemplates.
+func (in *CapabilityTemplates) DeepCopy() *CapabilityTemplates {
+	if in == nil {
+		return nil
+	}
+	out := new(CapabilityTemplates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuer) DeepCopyInto(out *CertManagerIssuer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuer.
+func (in *CertManagerIssuer) DeepCopy() *CertManagerIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleIntegration) DeepCopyInto(out *ConsoleIntegration) {
+	*out = *in
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(ConsolePlugin)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleIntegration.
+func (in *ConsoleIntegration) DeepCopy() *ConsoleIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsolePlugin) DeepCopyInto(out *ConsolePlugin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsolePlugin.
+func (in *ConsolePlugin) DeepCopy() *ConsolePlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsolePlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostAttribution) DeepCopyInto(out *CostAttribution) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostAttribution.
+func (in *CostAttribution) DeepCopy() *CostAttribution {
+	if in == nil {
+		return nil
+	}
+	out := new(CostAttribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DSCInitialization) DeepCopyInto(out *DSCInitialization) {
 	*out = *in
@@ -89,7 +223,7 @@ func (in *DSCInitializationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DSCInitializationSpec) DeepCopyInto(out *DSCInitializationSpec) {
 	*out = *in
-	out.Monitoring = in.Monitoring
+	in.Monitoring.DeepCopyInto(&out.Monitoring)
 	if in.ServiceMesh != nil {
 		in, out := &in.ServiceMesh, &out.ServiceMesh
 		*out = new(infrastructurev1.ServiceMeshSpec)
@@ -103,6 +237,78 @@ func (in *DSCInitializationSpec) DeepCopyInto(out *DSCInitializationSpec) {
 	if in.DevFlags != nil {
 		in, out := &in.DevFlags, &out.DevFlags
 		*out = new(DevFlags)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceDefaults != nil {
+		in, out := &in.NamespaceDefaults, &out.NamespaceDefaults
+		*out = new(NamespaceDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FeatureOverrides != nil {
+		in, out := &in.FeatureOverrides, &out.FeatureOverrides
+		*out = make([]FeatureOverride, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	if in.CapabilityResyncPeriod != nil {
+		in, out := &in.CapabilityResyncPeriod, &out.CapabilityResyncPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PolicyExemptions != nil {
+		in, out := &in.PolicyExemptions, &out.PolicyExemptions
+		*out = new(PolicyExemptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConsoleIntegration != nil {
+		in, out := &in.ConsoleIntegration, &out.ConsoleIntegration
+		*out = new(ConsoleIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadDefaults != nil {
+		in, out := &in.WorkloadDefaults, &out.WorkloadDefaults
+		*out = new(WorkloadDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetadataDefaults != nil {
+		in, out := &in.MetadataDefaults, &out.MetadataDefaults
+		*out = new(MetadataDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServingCertificates != nil {
+		in, out := &in.ServingCertificates, &out.ServingCertificates
+		*out = new(ServingCertificates)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CanaryRollout != nil {
+		in, out := &in.CanaryRollout, &out.CanaryRollout
+		*out = new(CanaryRollout)
+		**out = **in
+	}
+	if in.OrphanedNamespaces != nil {
+		in, out := &in.OrphanedNamespaces, &out.OrphanedNamespaces
+		*out = new(OrphanedNamespaces)
+		**out = **in
+	}
+	if in.ImageMirrors != nil {
+		in, out := &in.ImageMirrors, &out.ImageMirrors
+		*out = make([]ImageMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceDeletion != nil {
+		in, out := &in.NamespaceDeletion, &out.NamespaceDeletion
+		*out = new(NamespaceDeletion)
 		**out = **in
 	}
 }
@@ -133,6 +339,43 @@ func (in *DSCInitializationStatus) DeepCopyInto(out *DSCInitializationStatus) {
 		copy(*out, *in)
 	}
 	in.Release.DeepCopyInto(&out.Release)
+	if in.Simulation != nil {
+		in, out := &in.Simulation, &out.Simulation
+		*out = new(SimulationReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]Dependency, len(*in))
+		copy(*out, *in)
+	}
+	if in.Removal != nil {
+		in, out := &in.Removal, &out.Removal
+		*out = new(RemovalReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrphanedNamespaces != nil {
+		in, out := &in.OrphanedNamespaces, &out.OrphanedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastReadyDuration != nil {
+		in, out := &in.LastReadyDuration, &out.LastReadyDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]CapabilityStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Preconditions != nil {
+		in, out := &in.Preconditions, &out.Preconditions
+		*out = make([]PreconditionStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCInitializationStatus.
@@ -145,9 +388,29 @@ func (in *DSCInitializationStatus) DeepCopy() *DSCInitializationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dependency) DeepCopyInto(out *Dependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dependency.
+func (in *Dependency) DeepCopy() *Dependency {
+	if in == nil {
+		return nil
+	}
+	out := new(Dependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DevFlags) DeepCopyInto(out *DevFlags) {
 	*out = *in
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]CapabilityTemplates, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevFlags.
@@ -160,9 +423,120 @@ func (in *DevFlags) DeepCopy() *DevFlags {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisruptionBudgetSpec) DeepCopyInto(out *DisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptionBudgetSpec.
+func (in *DisruptionBudgetSpec) DeepCopy() *DisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureAlerts) DeepCopyInto(out *FeatureAlerts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureAlerts.
+func (in *FeatureAlerts) DeepCopy() *FeatureAlerts {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureAlerts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureOverride) DeepCopyInto(out *FeatureOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureOverride.
+func (in *FeatureOverride) DeepCopy() *FeatureOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageMirror) DeepCopyInto(out *ImageMirror) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageMirror.
+func (in *ImageMirror) DeepCopy() *ImageMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataDefaults) DeepCopyInto(out *MetadataDefaults) {
+	*out = *in
+	if in.CostAttribution != nil {
+		in, out := &in.CostAttribution, &out.CostAttribution
+		*out = new(CostAttribution)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataDefaults.
+func (in *MetadataDefaults) DeepCopy() *MetadataDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Monitoring) DeepCopyInto(out *Monitoring) {
 	*out = *in
+	if in.FeatureAlerts != nil {
+		in, out := &in.FeatureAlerts, &out.FeatureAlerts
+		*out = new(FeatureAlerts)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
@@ -175,6 +549,232 @@ func (in *Monitoring) DeepCopy() *Monitoring {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDefaults) DeepCopyInto(out *NamespaceDefaults) {
+	*out = *in
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(corev1.ResourceQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitRange != nil {
+		in, out := &in.LimitRange, &out.LimitRange
+		*out = new(corev1.LimitRangeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDefaults.
+func (in *NamespaceDefaults) DeepCopy() *NamespaceDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDeletion) DeepCopyInto(out *NamespaceDeletion) {
+	*out = *in
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDeletion.
+func (in *NamespaceDeletion) DeepCopy() *NamespaceDeletion {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDeletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedNamespaces) DeepCopyInto(out *OrphanedNamespaces) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedNamespaces.
+func (in *OrphanedNamespaces) DeepCopy() *OrphanedNamespaces {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedNamespaces)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyExemptions) DeepCopyInto(out *PolicyExemptions) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyExemptions.
+func (in *PolicyExemptions) DeepCopy() *PolicyExemptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyExemptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreconditionStatus) DeepCopyInto(out *PreconditionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreconditionStatus.
+func (in *PreconditionStatus) DeepCopy() *PreconditionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreconditionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemovalReport) DeepCopyInto(out *RemovalReport) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Deleted != nil {
+		in, out := &in.Deleted, &out.Deleted
+		*out = make([]RemovedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Skipped != nil {
+		in, out := &in.Skipped, &out.Skipped
+		*out = make([]RemovedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]RemovedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemovalReport.
+func (in *RemovalReport) DeepCopy() *RemovalReport {
+	if in == nil {
+		return nil
+	}
+	out := new(RemovalReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemovedResource) DeepCopyInto(out *RemovedResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemovedResource.
+func (in *RemovedResource) DeepCopy() *RemovedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(RemovedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServingCertificateService) DeepCopyInto(out *ServingCertificateService) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServingCertificateService.
+func (in *ServingCertificateService) DeepCopy() *ServingCertificateService {
+	if in == nil {
+		return nil
+	}
+	out := new(ServingCertificateService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServingCertificates) DeepCopyInto(out *ServingCertificates) {
+	*out = *in
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(CertManagerIssuer)
+		**out = **in
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ServingCertificateService, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServingCertificates.
+func (in *ServingCertificates) DeepCopy() *ServingCertificates {
+	if in == nil {
+		return nil
+	}
+	out := new(ServingCertificates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulationReport) DeepCopyInto(out *SimulationReport) {
+	*out = *in
+	if in.ResourcesToCreate != nil {
+		in, out := &in.ResourcesToCreate, &out.ResourcesToCreate
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourcesToDelete != nil {
+		in, out := &in.ResourcesToDelete, &out.ResourcesToDelete
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequiredOperators != nil {
+		in, out := &in.RequiredOperators, &out.RequiredOperators
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingOperators != nil {
+		in, out := &in.MissingOperators, &out.MissingOperators
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulationReport.
+func (in *SimulationReport) DeepCopy() *SimulationReport {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulationReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustedCABundleSpec) DeepCopyInto(out *TrustedCABundleSpec) {
 	*out = *in
@@ -189,3 +789,78 @@ func (in *TrustedCABundleSpec) DeepCopy() *TrustedCABundleSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadAutoscaling) DeepCopyInto(out *WorkloadAutoscaling) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(AutoscalingSpec)
+		**out = **in
+	}
+	if in.Authorino != nil {
+		in, out := &in.Authorino, &out.Authorino
+		*out = new(AutoscalingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadAutoscaling.
+func (in *WorkloadAutoscaling) DeepCopy() *WorkloadAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadDefaults) DeepCopyInto(out *WorkloadDefaults) {
+	*out = *in
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(WorkloadAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudgets != nil {
+		in, out := &in.PodDisruptionBudgets, &out.PodDisruptionBudgets
+		*out = new(WorkloadDisruptionBudgets)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadDefaults.
+func (in *WorkloadDefaults) DeepCopy() *WorkloadDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadDisruptionBudgets) DeepCopyInto(out *WorkloadDisruptionBudgets) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(DisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorino != nil {
+		in, out := &in.Authorino, &out.Authorino
+		*out = new(DisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadDisruptionBudgets.
+func (in *WorkloadDisruptionBudgets) DeepCopy() *WorkloadDisruptionBudgets {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadDisruptionBudgets)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -1,8 +1,12 @@
 package v1
 
 import (
+	"strings"
+	"time"
+
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // FeatureTracker represents a cluster-scoped resource in the Data Science Cluster,
@@ -12,9 +16,16 @@ import (
 // is to enable efficient garbage collection by Kubernetes. This is essential for
 // ensuring that resources are automatically cleaned up and reclaimed when they are
 // no longer required.
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=ft
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=.status.phase,description="Current phase of the feature"
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=.status.summary.source,description="Resource defining the feature"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=.metadata.creationTimestamp
+// +kubebuilder:printcolumn:name="Last Transition",type=date,JSONPath=.status.summary.lastTransitionTime,description="Time of the last change of the feature conditions"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=.status.summary.message,description="Message of the last changed condition"
 type FeatureTracker struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -46,15 +57,25 @@ var ConditionReason = struct {
 	LoadTemplateData,
 	ApplyManifests,
 	PostConditions,
+	PendingMaintenanceWindow,
+	PolicyDenied, // admission webhook enforcing cluster policies refused the change, regardless of the step
+	ApplyTimeout, // feature has not been applied within its timeout, regardless of the step
+	UnsupportedClusterVersion, // cluster does not run OpenShift version required by the feature
+	NamespaceRecreated, // namespace holding resources of the feature has been recreated, the feature awaits re-applying by its source
 	FeatureCreated FeatureConditionReason
 }{
-	FailedApplying:   "FailedApplying",
-	PreConditions:    "PreConditions",
-	ResourceCreation: "ResourceCreation",
-	LoadTemplateData: "LoadTemplateData",
-	ApplyManifests:   "ApplyManifests",
-	PostConditions:   "PostConditions",
-	FeatureCreated:   "FeatureCreated",
+	FailedApplying:            "FailedApplying",
+	PreConditions:             "PreConditions",
+	ResourceCreation:          "ResourceCreation",
+	LoadTemplateData:          "LoadTemplateData",
+	ApplyManifests:            "ApplyManifests",
+	PostConditions:            "PostConditions",
+	PendingMaintenanceWindow:  "PendingMaintenanceWindow",
+	PolicyDenied:              "PolicyDenied",
+	ApplyTimeout:              "ApplyTimeout",
+	UnsupportedClusterVersion: "UnsupportedClusterVersion",
+	NamespaceRecreated:        "NamespaceRecreated",
+	FeatureCreated:            "FeatureCreated",
 }
 
 const (
@@ -72,6 +93,11 @@ func (s *FeatureTracker) ToOwnerReference() metav1.OwnerReference {
 	}
 }
 
+// FeatureName returns the name of the feature tracked, i.e. the name of the FeatureTracker without the applications namespace prefix.
+func (s *FeatureTracker) FeatureName() string {
+	return strings.TrimPrefix(s.Name, s.Spec.AppNamespace+"-")
+}
+
 // Source describes the type of object that created the related Feature to this FeatureTracker.
 type Source struct {
 	Type OwnerType `json:"type,omitempty"`
@@ -91,6 +117,161 @@ type FeatureTrackerStatus struct {
 	Phase string `json:"phase,omitempty"`
 	// +optional
 	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+	// OperatorVersion is the version of the operator which applied the feature last.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+	// Summary condenses the status for listing many trackers, e.g. with `kubectl get featuretrackers`.
+	// +optional
+	Summary FeatureTrackerSummary `json:"summary,omitempty"`
+	// History lists the last transitions between outcomes of applying the feature, oldest first,
+	// so that intermittent failures can be diagnosed after the feature recovered.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	History []FeatureTransition `json:"history,omitempty"`
+	// Namespaces lists namespaces holding resources created by the feature when it was applied last,
+	// so that the feature is applied again when any of them is recreated.
+	// +optional
+	Namespaces []TrackedNamespace `json:"namespaces,omitempty"`
+	// Charts lists Helm charts the feature rendered its resources from when it was applied last.
+	// +optional
+	Charts []AppliedChart `json:"charts,omitempty"`
+	// ClusterResources lists cluster-scoped resources created or adopted by the feature, which are deleted explicitly when the feature
+	// is removed, also when they no longer reference the FeatureTracker as their owner. Resources declaring the orphan on-delete policy
+	// and existing resources skipped by the adoption policy are not listed.
+	// +optional
+	ClusterResources []TrackedResource `json:"clusterResources,omitempty"`
+	// Exports holds values published by the feature when it was applied last, such as endpoints it created,
+	// which are reported in the status of DSCInitialization under the capability the feature is part of.
+	// +optional
+	Exports *FeatureExports `json:"exports,omitempty"`
+	// ApplyLease is held by the operator instance applying the feature, so that other instances do not apply it at the same time.
+	// +optional
+	ApplyLease *ApplyLease `json:"applyLease,omitempty"`
+}
+
+// ApplyLease marks the feature as being applied by an operator instance.
+type ApplyLease struct {
+	// Holder identifies the operator instance holding the lease.
+	Holder string `json:"holder"`
+	// ExpiresAt is the time after which the lease can be taken over by another operator instance, unless renewed by the holder.
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// FeatureExports are values published by the feature.
+type FeatureExports struct {
+	// Capability the feature is part of, empty when the feature is not part of any.
+	// +optional
+	Capability string `json:"capability,omitempty"`
+	// Values published by the feature, keyed by name, e.g. gatewayHostname.
+	Values map[string]string `json:"values"`
+}
+
+// AppliedChart identifies the version of the Helm chart rendered by the feature.
+type AppliedChart struct {
+	// Name of the chart, as defined in its Chart.yaml.
+	Name string `json:"name"`
+	// Version of the chart, as defined in its Chart.yaml.
+	Version string `json:"version"`
+	// Source is the repository, OCI reference or path the chart has been loaded from.
+	// +optional
+	Source string `json:"source,omitempty"`
+}
+
+// TrackedNamespace identifies the namespace by its UID, which changes when the namespace is recreated under the same name.
+type TrackedNamespace struct {
+	Name string    `json:"name"`
+	UID  types.UID `json:"uid"`
+}
+
+// TrackedResource identifies the cluster-scoped resource created by the feature.
+type TrackedResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
+// FeatureTransition is a change of the outcome of applying the feature.
+type FeatureTransition struct {
+	// Phase the feature transitioned to, Ready or Error.
+	Phase string `json:"phase"`
+	// Reason of the transition, e.g. the step of applying the feature which failed.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message describing the transition, shortened to its first line of at most 80 characters.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Time of the transition.
+	Time metav1.Time `json:"time"`
+}
+
+// MaxHistory is the number of transitions kept in the FeatureTracker status.
+const MaxHistory = 10
+
+// RecordTransition appends the outcome of applying the feature to Status.History, unless it is the same as the
+// last recorded one. The oldest transitions are dropped to keep at most MaxHistory of them.
+func (s *FeatureTracker) RecordTransition(phase, reason, message string, at time.Time) {
+	if last := len(s.Status.History) - 1; last >= 0 && s.Status.History[last].Phase == phase && s.Status.History[last].Reason == reason {
+		return
+	}
+
+	s.Status.History = append(s.Status.History, FeatureTransition{
+		Phase:   phase,
+		Reason:  reason,
+		Message: shortened(message),
+		Time:    metav1.NewTime(at),
+	})
+	if overflow := len(s.Status.History) - MaxHistory; overflow > 0 {
+		s.Status.History = append([]FeatureTransition{}, s.Status.History[overflow:]...)
+	}
+}
+
+// FeatureTrackerSummary is derived from the rest of the FeatureTracker, see FeatureTracker.Summarize.
+type FeatureTrackerSummary struct {
+	// Source of the feature as type/name, e.g. DSCI/default-dsci.
+	// +optional
+	Source string `json:"source,omitempty"`
+	// LastTransitionTime of the most recently changed condition.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Message of the most recently changed condition, shortened to its first line of at most 80 characters.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+const summaryMessageLength = 80
+
+// Summarize updates Status.Summary from the source of the feature and its conditions. It has to be called
+// whenever any of them changes.
+func (s *FeatureTracker) Summarize() {
+	summary := FeatureTrackerSummary{}
+	if s.Spec.Source.Type != "" || s.Spec.Source.Name != "" {
+		summary.Source = string(s.Spec.Source.Type) + "/" + s.Spec.Source.Name
+	}
+
+	var latest *conditionsv1.Condition
+	for i := range s.Status.Conditions {
+		condition := &s.Status.Conditions[i]
+		if latest == nil || condition.LastTransitionTime.After(latest.LastTransitionTime.Time) {
+			latest = condition
+		}
+	}
+
+	if latest != nil {
+		lastTransition := latest.LastTransitionTime
+		summary.LastTransitionTime = &lastTransition
+		summary.Message = shortened(latest.Message)
+	}
+
+	s.Status.Summary = summary
+}
+
+func shortened(message string) string {
+	message, _, _ = strings.Cut(message, "\n")
+	if runes := []rune(message); len(runes) > summaryMessageLength {
+		return string(runes[:summaryMessageLength-3]) + "..."
+	}
+
+	return message
 }
 
 // +kubebuilder:object:root=true
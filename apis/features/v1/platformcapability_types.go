@@ -0,0 +1,102 @@
+package v1
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PlatformCapability is declared by an external controller, such as odh-platform, to register capabilities it implements
+// on top of the platform. The operator grants the service account of the controller access to the declared resources
+// and to the platform references the capabilities rely on, e.g. the Service Mesh control plane for routing.
+// As the permissions are granted by the operator, creating PlatformCapabilities has to be restricted to cluster administrators.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Capabilities",type=string,JSONPath=.spec.capabilities,description="Capabilities implemented by the controller"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=.metadata.creationTimestamp
+type PlatformCapability struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PlatformCapabilitySpec   `json:"spec,omitempty"`
+	Status PlatformCapabilityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Routing;Authorization
+type CapabilityName string
+
+const (
+	// RoutingCapability exposes workloads through the Service Mesh and OpenShift Routes.
+	RoutingCapability CapabilityName = "Routing"
+	// AuthorizationCapability protects workloads using the authorization provider.
+	AuthorizationCapability CapabilityName = "Authorization"
+)
+
+// PlatformCapabilitySpec defines the desired state of PlatformCapability.
+type PlatformCapabilitySpec struct {
+	// Capabilities implemented by the controller.
+	// +kubebuilder:validation:MinItems=1
+	Capabilities []CapabilityName `json:"capabilities"`
+	// ServiceAccount the controller runs as, which is granted the permissions.
+	ServiceAccount ServiceAccountReference `json:"serviceAccount"`
+	// Resources the controller manages in addition to the ones required by the capabilities.
+	// +optional
+	Resources []ResourceReference `json:"resources,omitempty"`
+}
+
+// ServiceAccountReference identifies the service account of the controller.
+type ServiceAccountReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// ResourceReference identifies the kind of resources the controller needs access to.
+type ResourceReference struct {
+	// Group of the resource, empty for the core API group.
+	// +optional
+	Group   string `json:"group,omitempty"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+	// Verbs granted on the resource, all the verbs needed to manage it when not set.
+	// +optional
+	Verbs []string `json:"verbs,omitempty"`
+}
+
+// PlatformCapabilityStatus defines the observed state of PlatformCapability.
+type PlatformCapabilityStatus struct {
+	// +optional
+	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the generation of the spec for which the permissions have been granted last.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ClusterRole granting the permissions to the service account of the controller.
+	// +optional
+	ClusterRole string `json:"clusterRole,omitempty"`
+	// References to the ConfigMaps publishing the platform configuration the capabilities rely on, listed once
+	// DSCInitialization configures it.
+	// +optional
+	References []PlatformReference `json:"references,omitempty"`
+}
+
+// PlatformReference identifies the ConfigMap publishing the platform configuration a capability relies on.
+type PlatformReference struct {
+	Capability CapabilityName `json:"capability"`
+	Name       string         `json:"name"`
+	Namespace  string         `json:"namespace"`
+}
+
+// +kubebuilder:object:root=true
+
+// PlatformCapabilityList contains a list of PlatformCapability.
+type PlatformCapabilityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PlatformCapability `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&PlatformCapability{},
+		&PlatformCapabilityList{},
+	)
+}
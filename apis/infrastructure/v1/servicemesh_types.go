@@ -4,7 +4,8 @@ import operatorv1 "github.com/openshift/api/operator/v1"
 
 // ServiceMeshSpec configures Service Mesh.
 type ServiceMeshSpec struct {
-	// +kubebuilder:validation:Enum=Managed;Unmanaged;Removed
+	// +kubebuilder:validation:Enum=Managed;Unmanaged;Removed;Observed
+	// +kubebuilder:validation:Pattern=`^(Managed|Unmanaged|Force|Removed|Observed)$`
 	// +kubebuilder:default=Removed
 	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
 	// ControlPlane holds configuration of Service Mesh used by Opendatahub.
@@ -12,6 +13,43 @@ type ServiceMeshSpec struct {
 	// Auth holds configuration of authentication and authorization services
 	// used by Service Mesh in Opendatahub.
 	Auth AuthSpec `json:"auth,omitempty"`
+	// Injection configures how workloads managed by Opendatahub are enrolled
+	// for sidecar proxy injection.
+	Injection InjectionSpec `json:"injection,omitempty"`
+	// EgressTLS configures TLS origination by proxies of meshed workloads to services outside the mesh.
+	// +optional
+	EgressTLS EgressTLSSpec `json:"egressTLS,omitempty"`
+}
+
+type EgressTLSSpec struct {
+	// Hosts are services outside the mesh, e.g. "minio.corp.example.com", which meshed workloads call using plain HTTP on port 80.
+	// Proxies originate TLS to port 443 of these hosts, verifying their certificates using the trusted CA bundle of
+	// DSCInitialization, which therefore has to be Managed, so that services signed by corporate CAs are trusted.
+	// +listType=set
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+}
+
+// InjectionStrategy defines the scheme used to request sidecar proxy injection.
+// +kubebuilder:validation:Enum=Annotation;Revision
+type InjectionStrategy string
+
+const (
+	// AnnotationInjectionStrategy uses the legacy `sidecar.istio.io/inject: "true"` pod annotation.
+	AnnotationInjectionStrategy InjectionStrategy = "Annotation"
+	// RevisionInjectionStrategy uses the `istio.io/rev` label pointing to the control plane revision.
+	RevisionInjectionStrategy InjectionStrategy = "Revision"
+)
+
+type InjectionSpec struct {
+	// Strategy selects the scheme used to enable sidecar injection. "Annotation" relies on
+	// the `sidecar.istio.io/inject` annotation, while "Revision" labels workloads and namespaces
+	// with `istio.io/rev`. Defaults to "Annotation".
+	// +kubebuilder:default=Annotation
+	Strategy InjectionStrategy `json:"strategy,omitempty"`
+	// Revision is the value of the `istio.io/rev` label used when Strategy is set to "Revision".
+	// If not provided, the name of the Service Mesh Control Plane is used.
+	Revision string `json:"revision,omitempty"`
 }
 
 type ControlPlaneSpec struct {
@@ -28,8 +66,38 @@ type ControlPlaneSpec struct {
 	// +kubebuilder:validation:Enum=Istio;None
 	// +kubebuilder:default=Istio
 	MetricsCollection string `json:"metricsCollection,omitempty"`
+	// MetricsFederation specifies if metrics collected from the control plane and proxies
+	// on the Mesh namespace should also be exposed to OpenShift user workload monitoring.
+	// Setting the value to "UserWorkloadMonitoring" creates monitors scraped by it, labeling
+	// the series with the mesh they come from. Requires MetricsCollection to be set to "Istio".
+	// +kubebuilder:validation:Enum=UserWorkloadMonitoring;None
+	// +kubebuilder:default=None
+	MetricsFederation string `json:"metricsFederation,omitempty"`
+	// DriftPolicy defines what happens when fields of the control plane managed by the operator, i.e. its addons, gateways
+	// and the authorization extension provider, are changed outside the operator. Setting the value to "Report" sets the
+	// DriftDetected condition listing the changed fields, "Correct" additionally restores the values set by the operator.
+	// +kubebuilder:default=Report
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
+// DriftPolicy defines what happens with changes made outside the operator to the resources it manages.
+// +kubebuilder:validation:Enum=Report;Correct
+type DriftPolicy string
+
+const (
+	// ReportDrift reports the changes without touching them.
+	ReportDrift DriftPolicy = "Report"
+	// CorrectDrift reports the changes and restores the values set by the operator.
+	CorrectDrift DriftPolicy = "Correct"
+)
+
+// ServiceMeshObserved extends the management states of operatorv1.ManagementState for Service Mesh managed outside the operator,
+// e.g. by GitOps, while the operator keeps tracking its health. The operator then does not apply any resources of the mesh, but
+// checks the control plane, its members and the authorization provider are ready, and publishes references to them to the
+// applications namespace.
+const ServiceMeshObserved operatorv1.ManagementState = "Observed"
+
 // GatewaySpec represents the configuration of the Ingress Gateways.
 type GatewaySpec struct {
 	// Domain specifies the host name for intercepting incoming requests.
@@ -42,7 +110,28 @@ type GatewaySpec struct {
 	Certificate CertificateSpec `json:"certificate,omitempty"`
 }
 
+// AuthMode selects how requests to model serving workloads in the mesh are authorized.
+// +kubebuilder:validation:Enum=Authorino;Lightweight
+type AuthMode string
+
+const (
+	// AuthorinoAuthMode deploys Authorino as the external authorization provider of the mesh.
+	AuthorinoAuthMode AuthMode = "Authorino"
+	// LightweightAuthMode checks tokens in request headers using Envoy extensions deployed to the mesh,
+	// without requiring the Authorino operator.
+	LightweightAuthMode AuthMode = "Lightweight"
+)
+
 type AuthSpec struct {
+	// Mode selects the authorization chain of model serving. "Authorino" deploys Authorino as external
+	// authorization provider, "Lightweight" validates tokens in request headers using RequestAuthentication or WasmPlugin
+	// resources instead. Defaults to "Authorino".
+	// +kubebuilder:default=Authorino
+	// +optional
+	Mode AuthMode `json:"mode,omitempty"`
+	// Lightweight configures token checks used when Mode is "Lightweight".
+	// +optional
+	Lightweight LightweightAuthSpec `json:"lightweight,omitempty"`
 	// Namespace where it is deployed. If not provided, the default is to
 	// use '-auth-provider' suffix on the ApplicationsNamespace of the DSCI.
 	Namespace string `json:"namespace,omitempty"`
@@ -53,4 +142,85 @@ type AuthSpec struct {
 	// Kubernetes apiserver (kubernetes.default.svc).
 	// +kubebuilder:default={"https://kubernetes.default.svc"}
 	Audiences *[]string `json:"audiences,omitempty"`
+	// ComponentAudiences overrides Audiences for tokens consumed by a specific component, keyed by the component name,
+	// e.g. "dashboard" or "kserve". Components which are not listed use Audiences.
+	// +optional
+	ComponentAudiences map[string][]string `json:"componentAudiences,omitempty"`
+	// Authorino configures the Authorino instance managed by the operator.
+	// Changes made directly to the Authorino resource are reverted to match this configuration.
+	Authorino AuthorinoSpec `json:"authorino,omitempty"`
+}
+
+type LightweightAuthSpec struct {
+	// TokenHeader is the request header holding the token of the caller. Defaults to "Authorization",
+	// in which case the token has to be sent as "Bearer <token>".
+	// +optional
+	TokenHeader string `json:"tokenHeader,omitempty"`
+	// PluginImage is the OCI image of a WasmPlugin validating the token, e.g. "oci://quay.io/org/token-check:v1".
+	// When set, the plugin is deployed, otherwise the token is validated by the proxies as a JWT issued by Issuer.
+	// +optional
+	PluginImage string `json:"pluginImage,omitempty"`
+	// Issuer of the tokens validated when PluginImage is not set, whose signing keys are found using its OpenID discovery document.
+	// Defaults to "https://kubernetes.default.svc", the issuer of service account tokens of the cluster unless configured otherwise.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+}
+
+// AuthorinoLogLevel defines verbosity of Authorino logs.
+// +kubebuilder:validation:Enum=debug;info;error
+type AuthorinoLogLevel string
+
+const (
+	AuthorinoLogLevelDebug AuthorinoLogLevel = "debug"
+	AuthorinoLogLevelInfo  AuthorinoLogLevel = "info"
+	AuthorinoLogLevelError AuthorinoLogLevel = "error"
+)
+
+// DefaultAuthConfigSelector is the label selector of AuthConfigs handled by Authorino, unless configured otherwise.
+const DefaultAuthConfigSelector = "security.opendatahub.io/authorization-group=default"
+
+// AuthorinoAdoption selects whether an Authorino instance installed on the cluster by other means is reused.
+// +kubebuilder:validation:Enum=Never;IfPresent
+type AuthorinoAdoption string
+
+const (
+	// NeverAdoptAuthorino always deploys the Authorino instance managed by the operator.
+	NeverAdoptAuthorino AuthorinoAdoption = "Never"
+	// AdoptAuthorinoIfPresent reuses an existing Authorino instance as the external authorization provider of the mesh,
+	// instead of deploying a second one. The operator-managed instance is deployed only when there is none.
+	AdoptAuthorinoIfPresent AuthorinoAdoption = "IfPresent"
+)
+
+type AuthorinoSpec struct {
+	// Adoption controls reuse of an Authorino instance which has not been deployed by the operator. "IfPresent" registers
+	// an existing instance as the external authorization provider instead of deploying a second one, as long as it is
+	// cluster-wide and handles AuthConfigs matching AuthConfigSelector. The adopted instance is never modified, the other
+	// settings of this spec apply to the operator-managed instance only. Defaults to "Never".
+	// +optional
+	Adoption AuthorinoAdoption `json:"adoption,omitempty"`
+	// Replicas is the number of Authorino pods. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// LogLevel defines verbosity of Authorino logs. Defaults to "info".
+	// +optional
+	LogLevel AuthorinoLogLevel `json:"logLevel,omitempty"`
+	// ListenerTLS configures TLS of the authorization listener.
+	// +optional
+	ListenerTLS AuthorinoTLSSpec `json:"listenerTLS,omitempty"`
+	// AuthConfigSelector is the label selector of AuthConfig resources handled by this Authorino instance,
+	// allowing to shard them between multiple instances. Defaults to "security.opendatahub.io/authorization-group=default".
+	// It has to consist of label equalities, e.g. "key=value,other=value", as it is also used to label AuthConfigs.
+	// +optional
+	AuthConfigSelector string `json:"authConfigSelector,omitempty"`
+}
+
+type AuthorinoTLSSpec struct {
+	// Enabled turns TLS on for the listener.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// CertSecretName is the name of the Secret of type kubernetes.io/tls holding the certificate of the listener.
+	// The Secret has to exist in the namespace of the authorization provider. Required when TLS is enabled.
+	// +optional
+	CertSecretName string `json:"certSecretName,omitempty"`
 }
@@ -25,6 +25,7 @@ import ()
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthSpec) DeepCopyInto(out *AuthSpec) {
 	*out = *in
+	out.Lightweight = in.Lightweight
 	if in.Audiences != nil {
 		in, out := &in.Audiences, &out.Audiences
 		*out = new([]string)
@@ -34,6 +35,23 @@ func (in *AuthSpec) DeepCopyInto(out *AuthSpec) {
 			copy(*out, *in)
 		}
 	}
+	if in.ComponentAudiences != nil {
+		in, out := &in.ComponentAudiences, &out.ComponentAudiences
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	in.Authorino.DeepCopyInto(&out.Authorino)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSpec.
@@ -46,6 +64,42 @@ func (in *AuthSpec) DeepCopy() *AuthSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorinoSpec) DeepCopyInto(out *AuthorinoSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	out.ListenerTLS = in.ListenerTLS
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorinoSpec.
+func (in *AuthorinoSpec) DeepCopy() *AuthorinoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorinoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorinoTLSSpec) DeepCopyInto(out *AuthorinoTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorinoTLSSpec.
+func (in *AuthorinoTLSSpec) DeepCopy() *AuthorinoTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorinoTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	*out = *in
@@ -76,6 +130,26 @@ func (in *ControlPlaneSpec) DeepCopy() *ControlPlaneSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressTLSSpec) DeepCopyInto(out *EgressTLSSpec) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressTLSSpec.
+func (in *EgressTLSSpec) DeepCopy() *EgressTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewaySpec) DeepCopyInto(out *GatewaySpec) {
 	*out = *in
@@ -92,11 +166,43 @@ func (in *GatewaySpec) DeepCopy() *GatewaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InjectionSpec) DeepCopyInto(out *InjectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InjectionSpec.
+func (in *InjectionSpec) DeepCopy() *InjectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InjectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightweightAuthSpec) DeepCopyInto(out *LightweightAuthSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightweightAuthSpec.
+func (in *LightweightAuthSpec) DeepCopy() *LightweightAuthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LightweightAuthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceMeshSpec) DeepCopyInto(out *ServiceMeshSpec) {
 	*out = *in
 	out.ControlPlane = in.ControlPlane
 	in.Auth.DeepCopyInto(&out.Auth)
+	out.Injection = in.Injection
+	in.EgressTLS.DeepCopyInto(&out.EgressTLS)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshSpec.
@@ -193,16 +193,10 @@ func updateKustomizeVariable(ctx context.Context, cli client.Client, platform cl
 		cluster.Unknown:          "OpenShift Open Data Hub",
 	}[platform]
 
-	consoleLinkDomain, err := cluster.GetDomain(ctx, cli)
+	consoleURL, err := URL(ctx, cli, platform, dscispec.ApplicationsNamespace)
 	if err != nil {
-		return nil, fmt.Errorf("error getting console route URL %s : %w", consoleLinkDomain, err)
+		return nil, err
 	}
-	consoleURL := map[cluster.Platform]string{
-		cluster.SelfManagedRhods: "https://rhods-dashboard-" + dscispec.ApplicationsNamespace + "." + consoleLinkDomain,
-		cluster.ManagedRhods:     "https://rhods-dashboard-" + dscispec.ApplicationsNamespace + "." + consoleLinkDomain,
-		cluster.OpenDataHub:      "https://odh-dashboard-" + dscispec.ApplicationsNamespace + "." + consoleLinkDomain,
-		cluster.Unknown:          "https://odh-dashboard-" + dscispec.ApplicationsNamespace + "." + consoleLinkDomain,
-	}[platform]
 
 	return map[string]string{
 		"admin_groups":  adminGroups,
@@ -211,6 +205,21 @@ func updateKustomizeVariable(ctx context.Context, cli client.Client, platform cl
 	}, nil
 }
 
+// URL returns the address of the dashboard Route exposed in the applications namespace of the platform.
+func URL(ctx context.Context, cli client.Client, platform cluster.Platform, applicationsNamespace string) (string, error) {
+	consoleLinkDomain, err := cluster.GetDomain(ctx, cli)
+	if err != nil {
+		return "", fmt.Errorf("error getting console route URL %s : %w", consoleLinkDomain, err)
+	}
+
+	return map[cluster.Platform]string{
+		cluster.SelfManagedRhods: "https://rhods-dashboard-" + applicationsNamespace + "." + consoleLinkDomain,
+		cluster.ManagedRhods:     "https://rhods-dashboard-" + applicationsNamespace + "." + consoleLinkDomain,
+		cluster.OpenDataHub:      "https://odh-dashboard-" + applicationsNamespace + "." + consoleLinkDomain,
+		cluster.Unknown:          "https://odh-dashboard-" + applicationsNamespace + "." + consoleLinkDomain,
+	}[platform], nil
+}
+
 func (d *Dashboard) cleanOauthClient(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec, currentComponentExist bool, l logr.Logger) error {
 	// Remove previous oauth-client secrets
 	// Check if component is going from state of `Not Installed --> Installed`
@@ -15,6 +15,7 @@ import (
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregate"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
@@ -137,7 +138,7 @@ func (k *Kserve) configureServerless(ctx context.Context, cli client.Client, log
 
 		switch instance.ServiceMesh.ManagementState {
 		case operatorv1.Unmanaged, operatorv1.Removed:
-			return fmt.Errorf("ServiceMesh is currently set to '%s'. It needs to be set to 'Managed' in DSCI CR, "+
+			return fmt.Errorf("ServiceMesh is currently set to '%s'. It needs to be set to 'Managed' or 'Observed' in DSCI CR, "+
 				"as it is required by the KServe serving field", instance.ServiceMesh.ManagementState)
 		}
 
@@ -147,7 +148,13 @@ func (k *Kserve) configureServerless(ctx context.Context, cli client.Client, log
 			return dependOpsErrors
 		}
 
-		serverlessFeatures := feature.ComponentFeaturesHandler(k.GetComponentName(), instance.ApplicationsNamespace, k.configureServerlessFeatures(instance))
+		gates, _ := featuregate.Resolve(instance.FeatureGates)
+		serverlessFeatures := feature.ComponentFeaturesHandler(k.GetComponentName(), instance.ApplicationsNamespace, k.configureServerlessFeatures(instance)).
+			WithMaintenanceWindow(instance.MaintenanceWindow).
+			WithPolicyExemptions(instance.PolicyExemptions).
+			WithMetadataDefaults(instance.MetadataDefaults).
+			WithImageMirrors(instance.ImageMirrors).
+			WithFeatureGates(gates)
 
 		if err := serverlessFeatures.Apply(ctx); err != nil {
 			return err
@@ -157,7 +164,8 @@ func (k *Kserve) configureServerless(ctx context.Context, cli client.Client, log
 }
 
 func (k *Kserve) removeServerlessFeatures(ctx context.Context, instance *dsciv1.DSCInitializationSpec) error {
-	serverlessFeatures := feature.ComponentFeaturesHandler(k.GetComponentName(), instance.ApplicationsNamespace, k.configureServerlessFeatures(instance))
+	serverlessFeatures := feature.ComponentFeaturesHandler(k.GetComponentName(), instance.ApplicationsNamespace, k.configureServerlessFeatures(instance)).
+		WithNamespaceDeletion(instance.NamespaceDeletion)
 
 	return serverlessFeatures.Delete(ctx)
 }
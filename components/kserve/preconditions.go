@@ -0,0 +1,57 @@
+package kserve
+
+import (
+	"context"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregate"
+)
+
+// EvaluatePreconditions evaluates prerequisites of the features KServe applies with the given DSCInitialization, without applying
+// them, see feature.FeaturesHandler.EvaluatePreconditions. Features are evaluated under the same conditions they are applied:
+// Serverless features when serving is Managed, Service Mesh features when both KServe and Service Mesh are Managed.
+func (k *Kserve) EvaluatePreconditions(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec) ([]dsciv1.PreconditionStatus, error) {
+	if k.GetManagementState() != operatorv1.Managed || dscispec.ServiceMesh == nil {
+		return nil, nil
+	}
+
+	gates, _ := featuregate.Resolve(dscispec.FeatureGates)
+	var evaluated []dsciv1.PreconditionStatus
+
+	meshState := dscispec.ServiceMesh.ManagementState
+	if k.Serving.ManagementState == operatorv1.Managed && (meshState == operatorv1.Managed || meshState == infrav1.ServiceMeshObserved) {
+		serverlessEvaluated, err := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec.ApplicationsNamespace, k.configureServerlessFeatures(dscispec)).
+			WithPolicyExemptions(dscispec.PolicyExemptions).
+			WithMetadataDefaults(dscispec.MetadataDefaults).
+			WithImageMirrors(dscispec.ImageMirrors).
+			WithFeatureGates(gates).
+			EvaluatePreconditions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		evaluated = append(evaluated, serverlessEvaluated...)
+	}
+
+	if meshState == operatorv1.Managed {
+		subscriptions := cluster.NewSubscriptionLookup()
+		meshEvaluated, err := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec.ApplicationsNamespace, k.defineServiceMeshFeatures(ctx, cli, dscispec, subscriptions)).
+			WithSubscriptionLookup(subscriptions).
+			WithPolicyExemptions(dscispec.PolicyExemptions).
+			WithMetadataDefaults(dscispec.MetadataDefaults).
+			WithImageMirrors(dscispec.ImageMirrors).
+			WithFeatureGates(gates).
+			EvaluatePreconditions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		evaluated = append(evaluated, meshEvaluated...)
+	}
+
+	return evaluated, nil
+}
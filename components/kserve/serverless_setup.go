@@ -23,45 +23,52 @@ func (k *Kserve) configureServerlessFeatures(dsciSpec *dsciv1.DSCInitializationS
 				serverless.FeatureData.IngressDomain.Define(&k.Serving).AsAction(),
 				serverless.FeatureData.Serving.Define(&k.Serving).AsAction(),
 				servicemesh.FeatureData.ControlPlane.Define(dsciSpec).AsAction(),
+				servicemesh.FeatureData.Injection.Define(dsciSpec).AsAction(),
 			).
 			PreConditions(
 				serverless.EnsureServerlessOperatorInstalled,
 				serverless.EnsureServerlessAbsent,
 				servicemesh.EnsureServiceMeshInstalled,
 				feature.CreateNamespaceIfNotExists(serverless.KnativeServingNamespace),
+				feature.ApplyNamespaceDefaults(serverless.KnativeServingNamespace, dsciSpec.NamespaceDefaults),
 			).
 			PostConditions(
 				feature.WaitForPodsToBeReady(serverless.KnativeServingNamespace),
 			)
 
 		istioSecretFiltering := feature.Define("serverless-net-istio-secret-filtering").
+			DependsOn("serverless-serving-deployment").
 			Manifests(
 				manifest.Location(Resources.Location).
 					Include(
 						path.Join(Resources.BaseDir, "serving-net-istio-secret-filtering.patch.tmpl.yaml"),
 					),
 			).
-			WithData(serverless.FeatureData.Serving.Define(&k.Serving).AsAction()).
+			WithData(
+				serverless.FeatureData.Serving.Define(&k.Serving).AsAction(),
+				servicemesh.FeatureData.Injection.Define(dsciSpec).AsAction(),
+			).
 			PreConditions(serverless.EnsureServerlessServingDeployed).
 			PostConditions(
 				feature.WaitForPodsToBeReady(serverless.KnativeServingNamespace),
 			)
 
 		servingGateway := feature.Define("serverless-serving-gateways").
+			DependsOn("serverless-serving-deployment", serverless.IngressCertificateFeatureName).
+			Disruptive().
 			Manifests(
 				manifest.Location(Resources.Location).
 					Include(
 						path.Join(Resources.GatewaysDir),
 					),
 			).
-			WithData(
-				serverless.FeatureData.IngressDomain.Define(&k.Serving).AsAction(),
-				serverless.FeatureData.CertificateName.Define(&k.Serving).AsAction(),
-				serverless.FeatureData.Serving.Define(&k.Serving).AsAction(),
-				servicemesh.FeatureData.ControlPlane.Define(dsciSpec).AsAction(),
-			).
-			WithResources(serverless.ServingCertificateResource).
-			PreConditions(serverless.EnsureServerlessServingDeployed)
+			WithData(serverless.IngressCertificateData(&k.Serving, dsciSpec)...).
+			PreConditions(serverless.EnsureServerlessServingDeployed).
+			PostConditions(serverless.CompleteGatewayCertificateSwap)
+
+		if err := serverless.IngressCertificate(&k.Serving, dsciSpec)(registry); err != nil {
+			return err
+		}
 
 		return registry.Add(
 			servingDeployment,
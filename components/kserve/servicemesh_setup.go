@@ -10,19 +10,26 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/serverless"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregate"
 )
 
 func (k *Kserve) configureServiceMesh(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec) error {
 	if dscispec.ServiceMesh != nil {
 		if dscispec.ServiceMesh.ManagementState == operatorv1.Managed && k.GetManagementState() == operatorv1.Managed {
-			serviceMeshInitializer := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec.ApplicationsNamespace, k.defineServiceMeshFeatures(ctx, cli, dscispec))
-			return serviceMeshInitializer.Apply(ctx)
+			subscriptions := cluster.NewSubscriptionLookup()
+			gates, _ := featuregate.Resolve(dscispec.FeatureGates)
+			serviceMeshInitializer := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec.ApplicationsNamespace, k.defineServiceMeshFeatures(ctx, cli, dscispec, subscriptions))
+			return serviceMeshInitializer.WithSubscriptionLookup(subscriptions).WithPolicyExemptions(dscispec.PolicyExemptions).WithMetadataDefaults(dscispec.MetadataDefaults).WithImageMirrors(dscispec.ImageMirrors).WithFeatureGates(gates).Apply(ctx)
 		}
-		if dscispec.ServiceMesh.ManagementState == operatorv1.Unmanaged && k.GetManagementState() == operatorv1.Managed {
+		// Mesh managed outside the operator is expected to be configured for KServe as well, what has been applied is kept.
+		unmanaged := dscispec.ServiceMesh.ManagementState == operatorv1.Unmanaged || dscispec.ServiceMesh.ManagementState == infrav1.ServiceMeshObserved
+		if unmanaged && k.GetManagementState() == operatorv1.Managed {
 			return nil
 		}
 	}
@@ -31,19 +38,26 @@ func (k *Kserve) configureServiceMesh(ctx context.Context, cli client.Client, ds
 }
 
 func (k *Kserve) removeServiceMeshConfigurations(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec) error {
-	serviceMeshInitializer := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec.ApplicationsNamespace, k.defineServiceMeshFeatures(ctx, cli, dscispec))
-	return serviceMeshInitializer.Delete(ctx)
+	subscriptions := cluster.NewSubscriptionLookup()
+	serviceMeshInitializer := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec.ApplicationsNamespace, k.defineServiceMeshFeatures(ctx, cli, dscispec, subscriptions))
+	return serviceMeshInitializer.WithSubscriptionLookup(subscriptions).WithNamespaceDeletion(dscispec.NamespaceDeletion).Delete(ctx)
 }
 
-func (k *Kserve) defineServiceMeshFeatures(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec) feature.FeaturesProvider {
+func (k *Kserve) defineServiceMeshFeatures(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec, subscriptions *cluster.SubscriptionLookup) feature.FeaturesProvider {
 	return func(registry feature.FeaturesRegistry) error {
-		authorinoInstalled, err := cluster.SubscriptionExists(ctx, cli, "authorino-operator")
+		authorinoInstalled, err := subscriptions.Exists(ctx, cli, "authorino-operator")
 		if err != nil {
 			return fmt.Errorf("failed to list subscriptions %w", err)
 		}
 
 		if authorinoInstalled {
-			kserveExtAuthzErr := registry.Add(feature.Define("kserve-external-authz").
+			// With lightweight authorization tokens are checked by the mesh-lightweight-auth features of DSCInitialization.
+			authorinoMode := func(_ context.Context, _ *feature.Feature) (bool, error) {
+				return !servicemesh.UsesLightweightAuth(dscispec.ServiceMesh), nil
+			}
+
+			kserveExtAuthz := feature.Define("kserve-external-authz").
+				EnabledWhen(authorinoMode).
 				Manifests(
 					manifest.Location(Resources.Location).
 						Include(
@@ -60,10 +74,15 @@ func (k *Kserve) defineServiceMeshFeatures(ctx context.Context, cli client.Clien
 				).
 				WithData(
 					servicemesh.FeatureData.Authorization.All(dscispec)...,
-				),
-			)
+				)
+
+			if k.Serving.ManagementState == operatorv1.Managed {
+				kserveExtAuthz.
+					WithData(serverless.IngressCertificateData(&k.Serving, dscispec)...).
+					PreConditions(serverless.EnsureGatewayCertificateValid)
+			}
 
-			if kserveExtAuthzErr != nil {
+			if kserveExtAuthzErr := registry.Add(kserveExtAuthz); kserveExtAuthzErr != nil {
 				return kserveExtAuthzErr
 			}
 		} else {
@@ -124,6 +124,11 @@ func (m *ModelMeshServing) ReconcileComponent(ctx context.Context,
 		return fmt.Errorf("failed to apply manifests from %s : %w", Path, err)
 	}
 	l.WithValues("Path", Path).Info("apply manifests done for modelmesh")
+	if enabled {
+		if err := configureServingCertificate(ctx, cli, dscispec.ApplicationsNamespace); err != nil {
+			return err
+		}
+	}
 	// For odh-model-controller
 	if enabled {
 		if err := cluster.UpdatePodSecurityRolebinding(ctx, cli, dscispec.ApplicationsNamespace,
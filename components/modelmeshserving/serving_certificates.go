@@ -0,0 +1,120 @@
+package modelmeshserving
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+const (
+	// serviceName is the name of the Service ModelMesh creates in every namespace serving models.
+	serviceName = "modelmesh-serving"
+	// userConfigName is the ConfigMap overriding defaults of the ModelMesh controller by its config.yaml key.
+	userConfigName = "model-serving-config"
+	userConfigKey  = "config.yaml"
+)
+
+// configureServingCertificate makes ModelMesh Services serve certificates published by DSCInitialization in the serving-cert-refs
+// ConfigMap, by setting tls.secretName of the ModelMesh configuration. ModelMesh uses the same secret in every namespace, so the
+// certificates have to be issued into secrets of the same name. TLS configured by users is kept, and the secret set by the operator
+// is removed once serving certificates are no longer published.
+func configureServingCertificate(ctx context.Context, cli client.Client, namespace string) error {
+	var secretName string
+	refs, published, err := cluster.GetServingCertificateRefs(ctx, cli, namespace)
+	if err != nil {
+		return err
+	}
+	if published {
+		if secretName, _, err = refs.SharedSecretOf(serviceName); err != nil {
+			return fmt.Errorf("failed configuring serving certificate of ModelMesh: %w", err)
+		}
+	}
+
+	userConfig := &corev1.ConfigMap{}
+	err = cli.Get(ctx, client.ObjectKey{Name: userConfigName, Namespace: namespace}, userConfig)
+	if k8serr.IsNotFound(err) {
+		if secretName == "" {
+			return nil
+		}
+
+		userConfig = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: userConfigName, Namespace: namespace}}
+		if errSet := setServingCertificate(userConfig, secretName); errSet != nil {
+			return errSet
+		}
+
+		return cli.Create(ctx, userConfig)
+	}
+	if err != nil {
+		return fmt.Errorf("failed getting %s/%s: %w", namespace, userConfigName, err)
+	}
+
+	original := userConfig.DeepCopy()
+	if errSet := setServingCertificate(userConfig, secretName); errSet != nil {
+		return errSet
+	}
+	if userConfig.Data[userConfigKey] == original.Data[userConfigKey] &&
+		userConfig.GetAnnotations()[annotations.ServingCertSecretConfigured] == original.GetAnnotations()[annotations.ServingCertSecretConfigured] {
+		return nil
+	}
+
+	return cli.Update(ctx, userConfig)
+}
+
+// setServingCertificate sets tls.secretName in config.yaml unless it has been configured by users, or removes it when the secret is not set.
+func setServingCertificate(userConfig *corev1.ConfigMap, secretName string) error {
+	config := map[string]any{}
+	if err := yaml.Unmarshal([]byte(userConfig.Data[userConfigKey]), &config); err != nil {
+		return fmt.Errorf("invalid %s of %s/%s: %w", userConfigKey, userConfig.Namespace, userConfig.Name, err)
+	}
+
+	configured := userConfig.GetAnnotations()[annotations.ServingCertSecretConfigured]
+	current, _, _ := unstructured.NestedString(config, "tls", "secretName")
+	if (current != "" && current != configured) || (current == secretName && configured == secretName) {
+		return nil
+	}
+
+	userAnnotations := userConfig.GetAnnotations()
+	if userAnnotations == nil {
+		userAnnotations = map[string]string{}
+	}
+
+	if secretName == "" {
+		unstructured.RemoveNestedField(config, "tls", "secretName")
+		if tls, _, _ := unstructured.NestedMap(config, "tls"); len(tls) == 0 {
+			delete(config, "tls")
+		}
+		delete(userAnnotations, annotations.ServingCertSecretConfigured)
+	} else {
+		if err := unstructured.SetNestedField(config, secretName, "tls", "secretName"); err != nil {
+			return err
+		}
+		userAnnotations[annotations.ServingCertSecretConfigured] = secretName
+	}
+	userConfig.SetAnnotations(userAnnotations)
+
+	if userConfig.Data == nil {
+		userConfig.Data = map[string]string{}
+	}
+	if len(config) == 0 {
+		delete(userConfig.Data, userConfigKey)
+
+		return nil
+	}
+
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	userConfig.Data[userConfigKey] = string(content)
+
+	return nil
+}
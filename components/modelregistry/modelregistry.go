@@ -14,6 +14,7 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
@@ -22,6 +23,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/conversion"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
 
 	_ "embed"
 )
@@ -82,8 +84,9 @@ func (m *ModelRegistry) ReconcileComponent(ctx context.Context, cli client.Clien
 
 	if enabled {
 		// return error if ServiceMesh is not enabled, as it's a required feature
-		if dscispec.ServiceMesh == nil || dscispec.ServiceMesh.ManagementState != operatorv1.Managed {
-			return errors.New("ServiceMesh needs to be set to 'Managed' in DSCI CR, it is required by Model Registry")
+		if dscispec.ServiceMesh == nil ||
+			(dscispec.ServiceMesh.ManagementState != operatorv1.Managed && dscispec.ServiceMesh.ManagementState != infrav1.ServiceMeshObserved) {
+			return errors.New("ServiceMesh needs to be set to 'Managed' or 'Observed' in DSCI CR, it is required by Model Registry")
 		}
 
 		if err := m.createDependencies(ctx, cli, dscispec); err != nil {
@@ -187,6 +190,8 @@ func (m *ModelRegistry) removeDependencies(ctx context.Context, cli client.Clien
 //go:embed resources/servicemesh-member.tmpl.yaml
 var smmTemplate string
 
+// enrollToServiceMesh creates ServiceMeshMember of the namespace and labels the namespace for sidecar injection
+// according to the injection strategy of the mesh.
 func enrollToServiceMesh(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec, namespace *corev1.Namespace) error {
 	tmpl, err := template.New("servicemeshmember").Parse(smmTemplate)
 	if err != nil {
@@ -196,16 +201,26 @@ func enrollToServiceMesh(ctx context.Context, cli client.Client, dscispec *dsciv
 	controlPlaneData := struct {
 		Namespace    string
 		ControlPlane *infrav1.ControlPlaneSpec
-	}{Namespace: namespace.Name, ControlPlane: &dscispec.ServiceMesh.ControlPlane}
+		Injection    infrav1.InjectionSpec
+	}{Namespace: namespace.Name, ControlPlane: &dscispec.ServiceMesh.ControlPlane, Injection: servicemesh.ResolveInjection(dscispec.ServiceMesh)}
 
 	if err = tmpl.Execute(&builder, controlPlaneData); err != nil {
 		return fmt.Errorf("error executing servicemeshmember template: %w", err)
 	}
 
 	unstrObj, err := conversion.StrToUnstructured(builder.String())
-	if err != nil || len(unstrObj) != 1 {
+	if err != nil || len(unstrObj) != 2 {
 		return fmt.Errorf("error converting servicemeshmember template: %w", err)
 	}
 
-	return client.IgnoreAlreadyExists(cli.Create(ctx, unstrObj[0]))
+	if err = cli.Create(ctx, unstrObj[0]); client.IgnoreAlreadyExists(err) != nil {
+		return err
+	}
+
+	injectionPatch, err := unstrObj[1].MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("error converting namespace injection patch: %w", err)
+	}
+
+	return cli.Patch(ctx, namespace, client.RawPatch(k8stypes.MergePatchType, injectionPatch))
 }
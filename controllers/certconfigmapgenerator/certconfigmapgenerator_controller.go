@@ -59,12 +59,9 @@ func (r *CertConfigmapGeneratorReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{}, err
 	}
 
-	var dsciInstance *dsciv1.DSCInitialization
-	switch len(dsciInstances.Items) {
-	case 0:
+	dsciInstance := dsciInstances.ActiveInstance()
+	if dsciInstance == nil {
 		return ctrl.Result{}, nil
-	case 1:
-		dsciInstance = &dsciInstances.Items[0]
 	}
 
 	if skipApplyTrustCAConfig(dsciInstance.Spec.TrustedCABundle) {
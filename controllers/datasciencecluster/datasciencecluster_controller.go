@@ -36,6 +36,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -68,6 +69,8 @@ type DataScienceClusterReconciler struct {
 	// Recorder to generate events
 	Recorder           record.EventRecorder
 	DataScienceCluster *DataScienceClusterConfig
+	// ReadyTimer measures the time from a change of the spec until DataScienceCluster is Ready. Nothing is measured when not set.
+	ReadyTimer *status.ReadyTimer
 }
 
 // DataScienceClusterConfig passing Spec of DSCI for reconcile DataScienceCluster.
@@ -155,8 +158,10 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 	}
 
 	// Update phase to error state if DataScienceCluster is created without valid DSCInitialization
-	switch len(dsciInstances.Items) { // only handle number as 0 or 1, others won't be existed since webhook block creation
-	case 0:
+	// When there is more than one DSCInitialization, only the oldest one is active
+	activeDSCI := dsciInstances.ActiveInstance()
+	switch activeDSCI {
+	case nil:
 		reason := status.ReconcileFailed
 		message := "Failed to get a valid DSCInitialization instance, please create a DSCI instance"
 		r.Log.Info(message)
@@ -172,9 +177,8 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, nil
-	case 1:
-		dscInitializationSpec := dsciInstances.Items[0].Spec
-		dscInitializationSpec.DeepCopyInto(r.DataScienceCluster.DSCISpec)
+	default:
+		activeDSCI.Spec.DeepCopyInto(r.DataScienceCluster.DSCISpec)
 	}
 
 	if instance.ObjectMeta.DeletionTimestamp.IsZero() {
@@ -236,6 +240,8 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 		}
 	}
 
+	r.ReadyTimer.Start(instance, instance.Status.ReadyGeneration)
+
 	// Initialize error list, instead of returning errors after every component is deployed
 	var componentErrors *multierror.Error
 
@@ -269,6 +275,10 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 		status.SetCompleteCondition(&saved.Status.Conditions, status.ReconcileCompleted, "DataScienceCluster resource reconciled successfully")
 		saved.Status.Phase = status.PhaseReady
 		saved.Status.Release = currentOperatorReleaseVersion
+		if readyAfter, timed := r.ReadyTimer.Ready(saved, saved.Status.ReadyGeneration); timed {
+			saved.Status.LastReadyDuration = &metav1.Duration{Duration: readyAfter}
+			saved.Status.ReadyGeneration = saved.Generation
+		}
 	})
 
 	if err != nil {
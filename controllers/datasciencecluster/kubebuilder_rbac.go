@@ -19,6 +19,9 @@ package datasciencecluster
 // +kubebuilder:rbac:groups="networking.istio.io",resources=virtualservices,verbs=*
 // +kubebuilder:rbac:groups="networking.istio.io",resources=gateways,verbs=*
 // +kubebuilder:rbac:groups="networking.istio.io",resources=envoyfilters,verbs=*
+// +kubebuilder:rbac:groups="networking.istio.io",resources=destinationrules,verbs=*
+// +kubebuilder:rbac:groups="networking.istio.io",resources=serviceentries,verbs=*
+// +kubebuilder:rbac:groups="extensions.istio.io",resources=wasmplugins,verbs=*
 // +kubebuilder:rbac:groups="security.istio.io",resources=authorizationpolicies,verbs=*
 // +kubebuilder:rbac:groups="authorino.kuadrant.io",resources=authconfigs,verbs=*
 // +kubebuilder:rbac:groups="operator.authorino.kuadrant.io",resources=authorinos,verbs=*
@@ -172,6 +175,7 @@ package datasciencecluster
 // +kubebuilder:rbac:groups="core",resources=pods/log,verbs=*
 // +kubebuilder:rbac:groups="core",resources=pods/exec,verbs=*
 // +kubebuilder:rbac:groups="core",resources=pods,verbs=*
+// +kubebuilder:rbac:groups="metrics.k8s.io",resources=pods,verbs=get;list
 
 // +kubebuilder:rbac:groups="core",resources=persistentvolumes,verbs=*
 // +kubebuilder:rbac:groups="core",resources=persistentvolumeclaims,verbs=*
@@ -207,6 +211,7 @@ package datasciencecluster
 // +kubebuilder:rbac:groups="batch",resources=cronjobs,verbs=create;get;patch
 
 // +kubebuilder:rbac:groups="autoscaling",resources=horizontalpodautoscalers,verbs=watch;create;update;delete;list;patch;get
+// +kubebuilder:rbac:groups="policy",resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling.openshift.io",resources=machinesets,verbs=list;patch;delete;get
 // +kubebuilder:rbac:groups="autoscaling.openshift.io",resources=machineautoscalers,verbs=list;patch;delete;get
 
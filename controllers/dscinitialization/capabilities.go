@@ -2,6 +2,7 @@ package dscinitialization
 
 import (
 	"errors"
+	"fmt"
 
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -30,14 +31,27 @@ func authorizationCondition(reason, message string) *conditionsv1.Condition {
 	}
 }
 
-func createCapabilityReporter(cli client.Client, object *dsciv1.DSCInitialization, successfulCondition *conditionsv1.Condition) *status.Reporter[*dsciv1.DSCInitialization] {
+func servingCertificatesCondition(reason, message string) *conditionsv1.Condition {
+	return &conditionsv1.Condition{
+		Type:    status.CapabilityServingCertificates,
+		Status:  corev1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func createCapabilityReporter(cli client.Client, updater *status.Updater, object *dsciv1.DSCInitialization, successfulCondition *conditionsv1.Condition) *status.Reporter[*dsciv1.DSCInitialization] { //nolint:lll // Reason: generics are long
 	return status.NewStatusReporter[*dsciv1.DSCInitialization](
 		cli,
 		object,
 		func(err error) status.SaveStatusFunc[*dsciv1.DSCInitialization] {
 			return func(saved *dsciv1.DSCInitialization) {
 				actualCondition := successfulCondition.DeepCopy()
-				if err != nil {
+				if pendingErr, pending := feature.PendingMaintenanceWindowOnly(err); pending {
+					// Capability keeps working with previously applied configuration until the maintenance window opens.
+					actualCondition.Reason = status.PendingMaintenanceWindow
+					actualCondition.Message = pendingErr.Error()
+				} else if err != nil {
 					actualCondition.Status = corev1.ConditionFalse
 					actualCondition.Message = err.Error()
 					actualCondition.Reason = status.CapabilityFailed
@@ -45,9 +59,71 @@ func createCapabilityReporter(cli client.Client, object *dsciv1.DSCInitializatio
 					if errors.As(err, &missingOperatorErr) {
 						actualCondition.Reason = status.MissingOperatorReason
 					}
+					var unsupportedArchErr *feature.UnsupportedArchitectureError
+					if errors.As(err, &unsupportedArchErr) {
+						actualCondition.Reason = status.UnsupportedArchitectureReason
+					}
+					var unsupportedInstallModeErr *feature.UnsupportedInstallModeError
+					if errors.As(err, &unsupportedInstallModeErr) {
+						actualCondition.Reason = status.UnsupportedInstallModeReason
+					}
+					if feature.IsUnsupportedClusterVersion(err) {
+						actualCondition.Reason = status.UnsupportedClusterVersionReason
+					}
+					if feature.IsVersionSkew(err) {
+						actualCondition.Reason = status.VersionSkewReason
+					}
+					if feature.IsApplyTimeout(err) {
+						actualCondition.Reason = status.ApplyTimeoutReason
+					}
+					if feature.IsCanaryFailed(err) {
+						actualCondition.Reason = status.CanaryFailedReason
+					}
+					if denial, denied := feature.AsPolicyDenial(err); denied {
+						actualCondition.Reason = status.PolicyDeniedReason
+						actualCondition.Message = fmt.Sprintf("%s: %s", denial, err.Error())
+					}
+				}
+				if err != nil {
+					actualCondition.Message = RemediationMessage(actualCondition.Reason, actualCondition.Message, err)
 				}
 				conditionsv1.SetStatusCondition(&saved.Status.Conditions, *actualCondition)
 			}
 		},
-	)
+	).WithUpdater(updater)
+}
+
+// featureGroupCondition reports the features of the group as one condition of DSCInitialization, so that users do not have to
+// look into FeatureTrackers of each feature. The condition is removed when the group is disabled.
+func featureGroupCondition(result feature.GroupResult) status.SaveStatusFunc[*dsciv1.DSCInitialization] {
+	return func(saved *dsciv1.DSCInitialization) {
+		conditionType := status.FeatureGroupConditionType(result.Group)
+		if result.Disabled {
+			conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, conditionType)
+
+			return
+		}
+
+		condition := conditionsv1.Condition{
+			Type:    conditionType,
+			Status:  corev1.ConditionTrue,
+			Reason:  status.ConfiguredReason,
+			Message: fmt.Sprintf("Features of group %s applied", result.Group),
+		}
+		if result.Err == nil {
+			conditionsv1.SetStatusCondition(&saved.Status.Conditions, condition)
+
+			return
+		}
+
+		if pendingErr, pending := feature.PendingMaintenanceWindowOnly(result.Err); pending {
+			condition.Reason = status.PendingMaintenanceWindow
+			condition.Message = pendingErr.Error()
+		} else {
+			condition.Status = corev1.ConditionFalse
+			condition.Reason = status.FeatureGroupFailedReason
+			condition.Message = result.Err.Error()
+		}
+		conditionsv1.SetStatusCondition(&saved.Status.Conditions, condition)
+	}
 }
@@ -0,0 +1,38 @@
+package dscinitialization
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// reportCapabilities stores values exported by the features of DSCInitialization, such as hostnames of the endpoints they created,
+// in status.capabilities when they differ from the reported ones. Failures are only logged, as the report does not affect reconciliation.
+func (r *DSCInitializationReconciler) reportCapabilities(ctx context.Context, instance *dsciv1.DSCInitialization) *dsciv1.DSCInitialization {
+	capabilities, err := feature.CapabilityExports(ctx, r.Client, featurev1.Source{Type: featurev1.DSCIType, Name: instance.Name})
+	if err != nil {
+		r.Log.Error(err, "failed collecting values exported by capabilities")
+
+		return instance
+	}
+
+	if equality.Semantic.DeepEqual(capabilities, instance.Status.Capabilities) {
+		return instance
+	}
+
+	updated, err := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		saved.Status.Capabilities = capabilities
+	})
+	if err != nil {
+		r.Log.Error(err, "failed reporting values exported by capabilities")
+
+		return instance
+	}
+
+	return updated
+}
@@ -0,0 +1,131 @@
+package dscinitialization
+
+import (
+	"context"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/dashboard"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+)
+
+const (
+	defaultDocumentationURL  = "https://opendatahub.io/docs"
+	defaultConsolePluginName = "odh-console-plugin"
+	defaultConsolePluginPort = 9443
+)
+
+// +kubebuilder:rbac:groups="console.openshift.io",resources=consolelinks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="console.openshift.io",resources=consoleclidownloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="console.openshift.io",resources=consoleplugins,verbs=get;list;watch;create;update;patch;delete
+
+// configureConsoleIntegration creates entries of the OpenShift console pointing at the dashboard and documentation, and registers
+// the console plugin when configured. They are removed when the integration is no longer enabled in the DSCI.
+func (r *DSCInitializationReconciler) configureConsoleIntegration(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	return feature.ClusterFeaturesHandler(instance, consoleIntegrationFeatures(instance)).Apply(ctx)
+}
+
+func (r *DSCInitializationReconciler) removeConsoleIntegration(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	return feature.ClusterFeaturesHandler(instance, consoleIntegrationFeatures(instance)).Delete(ctx)
+}
+
+func consoleIntegrationFeatures(instance *dsciv1.DSCInitialization) feature.FeaturesProvider {
+	return feature.ForCapability(consoleIntegrationCapabilityName, func(registry feature.FeaturesRegistry) error {
+		integration := instance.Spec.ConsoleIntegration
+
+		integrationEnabled := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return integration != nil && integration.ManagementState == operatorv1.Managed, nil
+		}
+
+		pluginEnabled := func(ctx context.Context, f *feature.Feature) (bool, error) {
+			enabled, err := integrationEnabled(ctx, f)
+
+			return enabled && integration.Plugin != nil, err
+		}
+
+		documentationURL := defaultDocumentationURL
+		if integration != nil && integration.DocumentationURL != "" {
+			documentationURL = integration.DocumentationURL
+		}
+
+		dashboardURL := func(ctx context.Context, cli client.Client) (string, error) {
+			platform, err := cluster.GetPlatform(ctx, cli)
+			if err != nil {
+				return "", err
+			}
+
+			return dashboard.URL(ctx, cli, platform, instance.Spec.ApplicationsNamespace)
+		}
+
+		return registry.Add(
+			feature.Define("console-links").
+				RequiresPermissions(
+					feature.ClusterPermission("console.openshift.io", "consolelinks", feature.ApplyVerbs...),
+					feature.ClusterPermission("console.openshift.io", "consoleclidownloads", feature.ApplyVerbs...),
+				).
+				EnabledWhen(integrationEnabled).
+				Manifests(
+					manifest.Location(Templates.Location).
+						Include(Templates.ConsoleDir),
+				).
+				WithData(
+					feature.Entry("DisplayName", consoleDisplayName),
+					feature.Entry("DashboardURL", dashboardURL),
+					feature.Entry("DocumentationURL", provider.ValueOf(documentationURL).Get),
+				),
+			feature.Define("console-plugin").
+				// ConsolePlugin is served as v1 since OpenShift 4.12
+				RequiresOpenShift(">=4.12").
+				RequiresPermissions(
+					feature.ClusterPermission("console.openshift.io", "consoleplugins", feature.ApplyVerbs...),
+				).
+				EnabledWhen(pluginEnabled).
+				Manifests(
+					manifest.Location(Templates.Location).
+						Include(Templates.ConsolePluginDir),
+				).
+				WithData(
+					feature.Entry("DisplayName", consoleDisplayName),
+					feature.Entry("Plugin", provider.ValueOf(resolveConsolePlugin(integration)).Get),
+				),
+		)
+	})
+}
+
+// resolveConsolePlugin fills the defaults of the plugin, which are not set when the DSCI has been created before they were introduced.
+func resolveConsolePlugin(integration *dsciv1.ConsoleIntegration) dsciv1.ConsolePlugin {
+	if integration == nil || integration.Plugin == nil {
+		return dsciv1.ConsolePlugin{}
+	}
+
+	plugin := *integration.Plugin
+	if plugin.Name == "" {
+		plugin.Name = defaultConsolePluginName
+	}
+	if plugin.Port == 0 {
+		plugin.Port = defaultConsolePluginPort
+	}
+	if plugin.BasePath == "" {
+		plugin.BasePath = "/"
+	}
+
+	return plugin
+}
+
+func consoleDisplayName(ctx context.Context, cli client.Client) (string, error) {
+	platform, err := cluster.GetPlatform(ctx, cli)
+	if err != nil {
+		return "", err
+	}
+
+	if platform == cluster.SelfManagedRhods || platform == cluster.ManagedRhods {
+		return "Red Hat OpenShift AI", nil
+	}
+
+	return "Open Data Hub", nil
+}
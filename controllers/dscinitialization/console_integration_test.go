@@ -0,0 +1,134 @@
+package dscinitialization_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Console integration templates", func() {
+
+	fakeClient := func() client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+	}
+
+	consoleResource := func(ctx context.Context, cli client.Client, kind, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("console.openshift.io/v1")
+		obj.SetKind(kind)
+		Expect(cli.Get(ctx, client.ObjectKey{Name: name}, obj)).To(Succeed())
+
+		return obj
+	}
+
+	It("should link the documentation and list the dashboard among command line tools in the console", func(ctx context.Context) {
+		// given
+		cli := fakeClient()
+
+		f, err := feature.Define("console-links").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(dscictrl.Templates.Location).Include(dscictrl.Templates.ConsoleDir)).
+			WithData(
+				feature.Entry("DisplayName", provider.ValueOf("Open Data Hub: Lab").Get),
+				feature.Entry("DashboardURL", provider.ValueOf("https://odh-dashboard-opendatahub.apps.example.com").Get),
+				feature.Entry("DocumentationURL", provider.ValueOf("https://opendatahub.io/docs").Get),
+			).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		documentationLink := consoleResource(ctx, cli, "ConsoleLink", "opendatahub-documentation")
+		Expect(documentationLink.Object).To(HaveKeyWithValue("spec", And(
+			HaveKeyWithValue("href", "https://opendatahub.io/docs"),
+			HaveKeyWithValue("location", "HelpMenu"),
+			HaveKeyWithValue("text", "Open Data Hub: Lab documentation"),
+		)))
+		downloads := consoleResource(ctx, cli, "ConsoleCLIDownload", "opendatahub-cli-downloads")
+		links, _, _ := unstructured.NestedSlice(downloads.Object, "spec", "links")
+		Expect(links).To(ContainElement(HaveKeyWithValue("href", "https://odh-dashboard-opendatahub.apps.example.com")))
+		Expect(links).To(HaveLen(2))
+	})
+
+	It("should leave linking the dashboard from the application menu to the dashboard component", func(ctx context.Context) {
+		// given
+		cli := fakeClient()
+
+		f, err := feature.Define("console-links").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(dscictrl.Templates.Location).Include(dscictrl.Templates.ConsoleDir)).
+			WithData(
+				feature.Entry("DisplayName", provider.ValueOf("Open Data Hub").Get),
+				feature.Entry("DashboardURL", provider.ValueOf("https://odh-dashboard-opendatahub.apps.example.com").Get),
+				feature.Entry("DocumentationURL", provider.ValueOf("https://opendatahub.io/docs").Get),
+			).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		links := &unstructured.UnstructuredList{}
+		links.SetAPIVersion("console.openshift.io/v1")
+		links.SetKind("ConsoleLinkList")
+		Expect(cli.List(ctx, links)).To(Succeed())
+		Expect(links.Items).To(HaveEach(WithTransform(func(link unstructured.Unstructured) string {
+			location, _, _ := unstructured.NestedString(link.Object, "spec", "location")
+
+			return location
+		}, Not(Equal("ApplicationMenu")))))
+	})
+
+	It("should register the console plugin served in the applications namespace", func(ctx context.Context) {
+		// given
+		cli := fakeClient()
+
+		f, err := feature.Define("console-plugin").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(dscictrl.Templates.Location).Include(dscictrl.Templates.ConsolePluginDir)).
+			WithData(
+				feature.Entry("DisplayName", provider.ValueOf("Open Data Hub").Get),
+				feature.Entry("Plugin", provider.ValueOf(dsciv1.ConsolePlugin{
+					Name: "odh-console-plugin", ServiceName: "odh-console-plugin", Port: 9443, BasePath: "/",
+				}).Get),
+			).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		plugin := consoleResource(ctx, cli, "ConsolePlugin", "odh-console-plugin")
+		service, _, _ := unstructured.NestedMap(plugin.Object, "spec", "backend", "service")
+		Expect(service).To(HaveKeyWithValue("namespace", "opendatahub"))
+		Expect(service).To(HaveKeyWithValue("port", BeNumerically("==", 9443)))
+	})
+})
@@ -0,0 +1,132 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh/smcp"
+)
+
+// detectControlPlaneDrift finds fields of the SMCP managed by the operator which have been changed outside of it since it last
+// configured the control plane, reports them using the DriftDetected condition and restores them when the drift policy says so.
+// It returns the changes which have been kept, so that they are still reported once the control plane is configured again.
+func (r *DSCInitializationReconciler) detectControlPlaneDrift(ctx context.Context, instance *dsciv1.DSCInitialization) ([]smcp.FieldDrift, error) {
+	controlPlane := instance.Spec.ServiceMesh.ControlPlane
+
+	controlPlaneSMCP := &unstructured.Unstructured{}
+	controlPlaneSMCP.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: controlPlane.Namespace, Name: controlPlane.Name}, controlPlaneSMCP); err != nil {
+		if client.IgnoreNotFound(err) == nil || meta.IsNoMatchError(err) {
+			return nil, r.reportControlPlaneDrift(ctx, instance, nil, false)
+		}
+
+		return nil, fmt.Errorf("failed getting ServiceMeshControlPlane %s/%s: %w", controlPlane.Namespace, controlPlane.Name, err)
+	}
+
+	drifts, err := smcp.DetectDrift(controlPlaneSMCP)
+	if err != nil {
+		return nil, err
+	}
+
+	corrected := len(drifts) > 0 && controlPlane.DriftPolicy == infrav1.CorrectDrift
+	if corrected {
+		if errCorrect := smcp.Mutate(ctx, r.Client, controlPlane, smcp.CorrectDrift(drifts)); errCorrect != nil {
+			return drifts, fmt.Errorf("failed correcting drift of ServiceMeshControlPlane %s/%s: %w", controlPlane.Namespace, controlPlane.Name, errCorrect)
+		}
+	}
+
+	if errReport := r.reportControlPlaneDrift(ctx, instance, drifts, corrected); errReport != nil {
+		return drifts, errReport
+	}
+
+	if corrected {
+		return nil, nil
+	}
+
+	return drifts, nil
+}
+
+// recordControlPlaneFields records the fields of the SMCP managed by the operator once it configured the control plane, so that
+// later changes made outside the operator are detected. Changes which have been kept keep their recorded values. The authorization
+// extension provider is recorded only when the operator registers one, see servicemesh.AuthExtensionProvider.
+func (r *DSCInitializationReconciler) recordControlPlaneFields(ctx context.Context, instance *dsciv1.DSCInitialization,
+	authProvider servicemesh.ExtensionProvider, registered bool, unresolved []smcp.FieldDrift) error {
+	controlPlane := instance.Spec.ServiceMesh.ControlPlane
+	var providers []string
+	if registered {
+		providers = append(providers, authProvider.Name)
+	}
+
+	if err := smcp.Mutate(ctx, r.Client, controlPlane, smcp.RecordRenderedFields(providers, unresolved)); err != nil {
+		if client.IgnoreNotFound(err) == nil || meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed recording managed fields of ServiceMeshControlPlane %s/%s: %w", controlPlane.Namespace, controlPlane.Name, err)
+	}
+
+	return nil
+}
+
+// reportControlPlaneDrift sets the DriftDetected condition listing the changed fields, or removes it when there are none.
+func (r *DSCInitializationReconciler) reportControlPlaneDrift(ctx context.Context, instance *dsciv1.DSCInitialization, drifts []smcp.FieldDrift, corrected bool) error {
+	if len(drifts) == 0 && conditionsv1.FindStatusCondition(instance.Status.Conditions, status.ConditionDriftDetected) == nil {
+		return nil
+	}
+
+	var condition conditionsv1.Condition
+	if len(drifts) > 0 {
+		condition = controlPlaneDriftCondition(instance.Spec.ServiceMesh.ControlPlane, drifts, corrected)
+		r.Log.Info("detected drift of service mesh control plane", "fields", len(drifts), "corrected", corrected)
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, "ControlPlaneDriftDetected", "%s", condition.Message)
+	}
+
+	_, err := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		if len(drifts) == 0 {
+			conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, status.ConditionDriftDetected)
+
+			return
+		}
+
+		conditionsv1.SetStatusCondition(&saved.Status.Conditions, condition)
+	})
+
+	return err
+}
+
+func controlPlaneDriftCondition(controlPlane infrav1.ControlPlaneSpec, drifts []smcp.FieldDrift, corrected bool) conditionsv1.Condition {
+	changes := make([]string, 0, len(drifts))
+	for _, drift := range drifts {
+		changes = append(changes, drift.String())
+	}
+
+	if corrected {
+		return conditionsv1.Condition{
+			Type:   status.ConditionDriftDetected,
+			Status: corev1.ConditionTrue,
+			Reason: status.DriftCorrectedReason,
+			Message: fmt.Sprintf("Restored fields of ServiceMeshControlPlane %s/%s changed outside the operator: %s",
+				controlPlane.Namespace, controlPlane.Name, strings.Join(changes, "; ")),
+		}
+	}
+
+	return conditionsv1.Condition{
+		Type:   status.ConditionDriftDetected,
+		Status: corev1.ConditionTrue,
+		Reason: status.ControlPlaneModifiedReason,
+		Message: fmt.Sprintf("Fields of ServiceMeshControlPlane %s/%s managed by the operator have been changed outside of it: %s",
+			controlPlane.Namespace, controlPlane.Name, strings.Join(changes, "; ")),
+	}
+}
@@ -0,0 +1,174 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// supportedDependency is an entry of the compatibility matrix, giving the range of versions of the operator package which
+// have been tested with this operator.
+type supportedDependency struct {
+	name     string
+	versions string
+}
+
+// compatibilityMatrix lists operators the platform capabilities depend on. Ranges use github.com/blang/semver/v4 syntax.
+var compatibilityMatrix = []supportedDependency{
+	{name: "servicemeshoperator", versions: ">=2.4.0 <3.0.0"},
+	{name: "authorino-operator", versions: ">=0.11.0"},
+	{name: "serverless-operator", versions: ">=1.31.0"},
+}
+
+// DetectDependencies reports versions of the operators from the compatibility matrix which are installed in the cluster,
+// as given by ClusterServiceVersions installed by their Subscriptions. Operators which are not installed are left out.
+// Subscriptions are read using the given reader, which should be backed by the cache of the manager, while versions
+// of ClusterServiceVersions are resolved by csvs.
+func DetectDependencies(ctx context.Context, cli client.Reader, csvs *CSVVersions) ([]dsciv1.Dependency, error) {
+	subscriptions := &ofapiv1alpha1.SubscriptionList{}
+	if err := cli.List(ctx, subscriptions); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed listing subscriptions: %w", err)
+	}
+
+	var dependencies []dsciv1.Dependency
+	for _, supported := range compatibilityMatrix {
+		subscription := findSubscription(subscriptions.Items, supported.name)
+		if subscription == nil {
+			continue
+		}
+
+		version, err := csvs.installedVersion(ctx, subscription)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencies = append(dependencies, dsciv1.Dependency{
+			Name:              supported.name,
+			Version:           version,
+			SupportedVersions: supported.versions,
+			Verdict:           verdictOf(version, supported.versions),
+		})
+	}
+
+	return dependencies, nil
+}
+
+// CSVVersions resolves versions of installed ClusterServiceVersions. ClusterServiceVersions of other operators are not cached
+// by the manager, so the reader has to hit the API directly. Each of them is read only once, as OLM installs a new
+// ClusterServiceVersion, under a different name, for every version of the operator.
+type CSVVersions struct {
+	reader   client.Reader
+	mu       sync.Mutex
+	versions map[types.NamespacedName]string
+}
+
+func NewCSVVersions(reader client.Reader) *CSVVersions {
+	return &CSVVersions{reader: reader, versions: map[types.NamespacedName]string{}}
+}
+
+func findSubscription(subscriptions []ofapiv1alpha1.Subscription, name string) *ofapiv1alpha1.Subscription {
+	for i := range subscriptions {
+		if subscriptions[i].Spec != nil && subscriptions[i].Spec.Package == name {
+			return &subscriptions[i]
+		}
+	}
+
+	return nil
+}
+
+// installedVersion returns empty version when the ClusterServiceVersion is not installed yet.
+func (c *CSVVersions) installedVersion(ctx context.Context, subscription *ofapiv1alpha1.Subscription) (string, error) {
+	if subscription.Status.InstalledCSV == "" {
+		return "", nil
+	}
+
+	key := types.NamespacedName{Namespace: subscription.Namespace, Name: subscription.Status.InstalledCSV}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if version, found := c.versions[key]; found {
+		return version, nil
+	}
+
+	csv := &ofapiv1alpha1.ClusterServiceVersion{}
+	if err := c.reader.Get(ctx, key, csv); err != nil {
+		if k8serr.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("failed getting ClusterServiceVersion %s: %w", subscription.Status.InstalledCSV, err)
+	}
+
+	// version is not remembered until it is set, e.g. while OLM is still creating the ClusterServiceVersion
+	if csv.Spec.Version.Equals(semver.Version{}) {
+		return "", nil
+	}
+
+	c.versions[key] = csv.Spec.Version.String()
+
+	return c.versions[key], nil
+}
+
+func verdictOf(version, versions string) dsciv1.DependencyVerdict {
+	parsed, errVersion := semver.ParseTolerant(version)
+	supported, errRange := semver.ParseRange(versions)
+	if errVersion != nil || errRange != nil {
+		return dsciv1.DependencyUnknown
+	}
+
+	if supported(parsed) {
+		return dsciv1.DependencySupported
+	}
+
+	return dsciv1.DependencyUnsupported
+}
+
+// reportDependencies stores detected dependencies in the status when they differ from the reported ones. Failures are only logged,
+// as the report is meant for support triage and does not affect reconciliation.
+func (r *DSCInitializationReconciler) reportDependencies(ctx context.Context, instance *dsciv1.DSCInitialization) *dsciv1.DSCInitialization {
+	if r.csvVersions == nil {
+		var reader client.Reader = r.Client
+		if r.APIReader != nil {
+			reader = r.APIReader
+		}
+		r.csvVersions = NewCSVVersions(reader)
+	}
+
+	dependencies, err := DetectDependencies(ctx, r.Client, r.csvVersions)
+	if err != nil {
+		r.Log.Error(err, "failed detecting versions of dependent operators")
+
+		return instance
+	}
+
+	if equality.Semantic.DeepEqual(dependencies, instance.Status.Dependencies) {
+		return instance
+	}
+
+	updated, err := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		saved.Status.Dependencies = dependencies
+	})
+	if err != nil {
+		r.Log.Error(err, "failed reporting versions of dependent operators")
+
+		return instance
+	}
+
+	return updated
+}
@@ -0,0 +1,104 @@
+package dscinitialization_test
+
+import (
+	"context"
+
+	"github.com/blang/semver/v4"
+	"github.com/operator-framework/api/pkg/lib/version"
+	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dependent operators", func() {
+
+	installed := func(pkg, csvName, csvVersion string) []client.Object {
+		subscription := &ofapiv1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: pkg, Namespace: "openshift-operators"},
+			Spec:       &ofapiv1alpha1.SubscriptionSpec{Package: pkg},
+			Status:     ofapiv1alpha1.SubscriptionStatus{InstalledCSV: csvName},
+		}
+		csv := &ofapiv1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: csvName, Namespace: "openshift-operators"},
+			Spec:       ofapiv1alpha1.ClusterServiceVersionSpec{Version: version.OperatorVersion{Version: semver.MustParse(csvVersion)}},
+		}
+
+		return []client.Object{subscription, csv}
+	}
+
+	clientWith := func(objects ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(ofapiv1alpha1.AddToScheme(scheme))
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(objects...).Build()
+	}
+
+	It("should report versions of installed operators against the compatibility matrix", func(ctx context.Context) {
+		// given
+		var objects []client.Object
+		objects = append(objects, installed("servicemeshoperator", "servicemeshoperator.v2.5.2", "2.5.2")...)
+		objects = append(objects, installed("serverless-operator", "serverless-operator.v1.28.0", "1.28.0")...)
+		cli := clientWith(objects...)
+
+		// when
+		dependencies, err := dscictrl.DetectDependencies(ctx, cli, dscictrl.NewCSVVersions(cli))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dependencies).To(ConsistOf(
+			dsciv1.Dependency{Name: "servicemeshoperator", Version: "2.5.2", SupportedVersions: ">=2.4.0 <3.0.0", Verdict: dsciv1.DependencySupported},
+			dsciv1.Dependency{Name: "serverless-operator", Version: "1.28.0", SupportedVersions: ">=1.31.0", Verdict: dsciv1.DependencyUnsupported},
+		))
+	})
+
+	It("should report unknown version while the operator is being installed", func(ctx context.Context) {
+		// given
+		cli := clientWith(&ofapiv1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: "authorino", Namespace: "openshift-operators"},
+			Spec:       &ofapiv1alpha1.SubscriptionSpec{Package: "authorino-operator"},
+		})
+
+		// when
+		dependencies, err := dscictrl.DetectDependencies(ctx, cli, dscictrl.NewCSVVersions(cli))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dependencies).To(ConsistOf(
+			dsciv1.Dependency{Name: "authorino-operator", SupportedVersions: ">=0.11.0", Verdict: dsciv1.DependencyUnknown},
+		))
+	})
+
+	It("should read each ClusterServiceVersion only once", func(ctx context.Context) {
+		// given
+		cli := clientWith(installed("servicemeshoperator", "servicemeshoperator.v2.5.2", "2.5.2")...)
+		csvReads := 0
+		csvReader := interceptor.NewClient(cli.(client.WithWatch), interceptor.Funcs{
+			Get: func(ctx context.Context, cli client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				csvReads++
+
+				return cli.Get(ctx, key, obj, opts...)
+			},
+		})
+		csvs := dscictrl.NewCSVVersions(csvReader)
+
+		// when
+		_, errFirst := dscictrl.DetectDependencies(ctx, cli, csvs)
+		dependencies, errSecond := dscictrl.DetectDependencies(ctx, cli, csvs)
+
+		// then
+		Expect(errFirst).ToNot(HaveOccurred())
+		Expect(errSecond).ToNot(HaveOccurred())
+		Expect(dependencies).To(ConsistOf(HaveField("Version", "2.5.2")))
+		Expect(csvReads).To(Equal(1))
+	})
+})
@@ -21,6 +21,7 @@ import (
 	"context"
 	"path/filepath"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -47,12 +48,18 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregate"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/trustedcabundle"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 )
 
 const (
 	finalizerName = "dscinitialization.opendatahub.io/finalizer"
+	// customTemplatesResync is how often templates configured in devFlags are applied again.
+	customTemplatesResync = 30 * time.Second
 )
 
 // This ar is required by the .spec.TrustedCABundle field on Reconcile Update Event. When a user goes from Unmanaged to Managed, update all
@@ -66,6 +73,27 @@ type DSCInitializationReconciler struct {
 	Log                   logr.Logger
 	Recorder              record.EventRecorder
 	ApplicationsNamespace string
+	// CapabilityResyncPeriod is the default interval at which capabilities re-validate external state,
+	// used unless DSCInitialization configures its own. Zero disables periodic re-validation.
+	CapabilityResyncPeriod time.Duration
+	// APIReader reads resources which are not cached by the manager, such as ClusterServiceVersions of other operators.
+	// Client is used when not set.
+	APIReader client.Reader
+	// RemovalConfirmationAnnotation is the annotation of DSCInitialization which has to list capabilities set to Removed,
+	// e.g. Service Mesh, before their resources are removed. Capabilities are removed without confirmation when it is empty.
+	RemovalConfirmationAnnotation string
+	// StatusUpdater writes conditions of capabilities in the background, so that reconcile does not wait for status writes.
+	// Conditions are written right away when not set.
+	StatusUpdater *status.Updater
+	// ReadyTimer measures the time from a change of the spec until DSCInitialization is Ready. Nothing is measured when not set.
+	ReadyTimer *status.ReadyTimer
+	// InstallMode tells which namespaces the operator has been installed for, capabilities configuring other namespaces are
+	// reported as unsupported. The zero value stands for all namespaces.
+	InstallMode cluster.InstallMode
+
+	externalWatches       *externalWatches
+	csvVersions           *CSVVersions
+	preconditionsSchedule *PreconditionsSchedule
 }
 
 // +kubebuilder:rbac:groups="dscinitialization.opendatahub.io",resources=dscinitializations/status,verbs=get;update;patch;delete
@@ -92,12 +120,20 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
-	var instance *dsciv1.DSCInitialization
-	switch { // only handle number as 0 or 1, others won't be existed since webhook block creation
-	case len(instances.Items) == 0:
+	// Webhook blocks creation of more than one instance, but it can be bypassed (e.g. when the operator is not running).
+	// Only the oldest instance is reconciled, the others are marked as duplicates.
+	instance := instances.ActiveInstance()
+	if instance == nil {
 		return ctrl.Result{}, nil
-	case len(instances.Items) == 1:
-		instance = &instances.Items[0]
+	}
+
+	if err := r.markDuplicates(ctx, instance, instances.Duplicates()); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	instance, err = r.clearDuplicate(ctx, instance)
+	if err != nil {
+		return ctrl.Result{}, err
 	}
 
 	if instance.ObjectMeta.DeletionTimestamp.IsZero() {
@@ -111,6 +147,19 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	} else {
 		r.Log.Info("Finalization DSCInitialization start deleting instance", "name", instance.Name, "finalizer", finalizerName)
 		if err := r.removeServiceMesh(ctx, instance); err != nil {
+			if terminating, isTerminating := feature.NamespacesTerminatingOnly(err); isTerminating {
+				return reconcile.Result{RequeueAfter: terminating.RequeueAfter}, nil
+			}
+
+			return reconcile.Result{}, err
+		}
+		if err := r.removeFeatureAlerts(ctx, instance); err != nil {
+			return reconcile.Result{}, err
+		}
+		if err := r.removeConsoleIntegration(ctx, instance); err != nil {
+			return reconcile.Result{}, err
+		}
+		if err := r.removeServingCertificates(ctx, instance); err != nil {
 			return reconcile.Result{}, err
 		}
 
@@ -152,6 +201,37 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 	}
 
+	r.ReadyTimer.Start(instance, instance.Status.ReadyGeneration)
+
+	// Report feature gates which are ignored, e.g. because of a typo
+	gates, gateWarnings := featuregate.Resolve(instance.Spec.FeatureGates)
+	for _, warning := range gateWarnings {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, "FeatureGateIgnored", "%s", warning)
+	}
+	if enabledGates := gates.EnabledGates(); len(enabledGates) > 0 {
+		r.Log.V(1).Info("experimental behaviors enabled", "featureGates", enabledGates)
+	}
+
+	// Report impact of proposed spec changes, if requested
+	instance, err = r.simulate(ctx, instance)
+	if err != nil {
+		r.Log.Error(err, "failed to compute simulation report")
+
+		return reconcile.Result{}, err
+	}
+
+	// Report versions of the operators the capabilities depend on
+	instance = r.reportDependencies(ctx, instance)
+
+	// Report prerequisites of all enabled features before any of them is applied
+	instance = r.reportPreconditions(ctx, instance)
+
+	// Stop before derived names, e.g. of the authorization namespace, overwrite resources they collide with
+	instance, err = r.reportNameCollisions(ctx, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Check namespace is not exist, then create
 	namespace := instance.Spec.ApplicationsNamespace
 	err = r.createOdhNamespace(ctx, instance, namespace)
@@ -160,6 +240,14 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return reconcile.Result{}, err
 	}
 
+	// Move applications to the new namespace if spec.applicationsNamespace has changed
+	instance, err = r.migrateApplicationsNamespace(ctx, instance)
+	if err != nil {
+		r.Log.Error(err, "failed to migrate applications namespace")
+
+		return reconcile.Result{}, err
+	}
+
 	// Check ManagementState to verify if odh-trusted-ca-bundle Configmap should be configured for namespaces
 	if err := trustedcabundle.ConfigureTrustedCABundle(ctx, r.Client, r.Log, instance, managementStateChangeTrustedCA); err != nil {
 		return reconcile.Result{}, err
@@ -249,8 +337,50 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			}
 		}
 
-		// Apply Service Mesh configurations
-		if errServiceMesh := r.configureServiceMesh(ctx, instance); errServiceMesh != nil {
+		if err := r.configureFeatureAlerts(ctx, instance); err != nil {
+			r.Log.Error(err, "failed applying feature alerts")
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "failed applying feature alerts")
+
+			return reconcile.Result{}, err
+		}
+
+		if err := r.configureConsoleIntegration(ctx, instance); err != nil {
+			r.Log.Error(err, "failed applying console integration")
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "failed applying console integration")
+
+			return reconcile.Result{}, err
+		}
+
+		if err := r.configureServingCertificates(ctx, instance); err != nil {
+			r.Log.Error(err, "failed applying serving certificates")
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "failed applying serving certificates")
+
+			return reconcile.Result{}, err
+		}
+
+		// Apply Service Mesh configurations, disruptive changes might be postponed until the maintenance window opens
+		var requeueAfter time.Duration
+		errServiceMesh := r.configureServiceMesh(ctx, instance)
+
+		// Publish endpoints created by the capabilities, including the ones applied before a failure
+		instance = r.reportCapabilities(ctx, instance)
+
+		if pending, isPending := feature.PendingMaintenanceWindowOnly(errServiceMesh); isPending {
+			if !pending.OpensAt.IsZero() {
+				requeueAfter = time.Until(pending.OpensAt)
+			}
+			errServiceMesh = nil
+		}
+		// Namespaces of removed capabilities are checked again instead of waiting for them to terminate
+		if terminating, isTerminating := feature.NamespacesTerminatingOnly(errServiceMesh); isTerminating {
+			requeueAfter = terminating.RequeueAfter
+			errServiceMesh = nil
+		}
+		if errServiceMesh != nil {
+			if errReady := r.updateReadyCondition(ctx, instance); errReady != nil {
+				r.Log.Error(errReady, "failed to update Ready condition of DSCInitialization")
+			}
+
 			return reconcile.Result{}, errServiceMesh
 		}
 
@@ -265,18 +395,33 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "Failed to update DSCInitialization status")
 		}
 
-		return ctrl.Result{}, nil
+		if errReady := r.updateReadyCondition(ctx, instance); errReady != nil {
+			r.Log.Error(errReady, "failed to update Ready condition of DSCInitialization")
+		}
+
+		// Custom templates are read again periodically, so that changes made while developing them are applied
+		if instance.Spec.DevFlags != nil && len(instance.Spec.DevFlags.Templates) > 0 {
+			requeueAfter = earliestRequeue(requeueAfter, customTemplatesResync)
+		}
+
+		// Capabilities re-validate external state, such as control plane health, which does not trigger any event
+		requeueAfter = earliestRequeue(requeueAfter, r.capabilityResyncPeriod(instance))
+
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	dsciController, err := ctrl.NewControllerManagedBy(mgr).
 		// add predicates prevents meaningless reconciliations from being triggered
 		// not use WithEventFilter() because it conflict with secret and configmap predicate
 		For(
 			&dsciv1.DSCInitialization{},
-			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}), dsciPredicateStateChangeTrustedCA),
+			builder.WithPredicates(
+				predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}, simulateAnnotationChangedPredicate),
+				dsciPredicateStateChangeTrustedCA,
+			),
 		).
 		Owns(
 			&corev1.Namespace{},
@@ -321,6 +466,21 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 			}),
 			builder.WithPredicates(DSCDeletionPredicate),
 		).
+		Watches(
+			&dscv1.DataScienceCluster{},
+			handler.EnqueueRequestsFromMapFunc(r.watchDSCIInstances),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&corev1.Service{},
+			handler.EnqueueRequestsFromMapFunc(r.watchDSCIInstances),
+			builder.WithPredicates(authorizationPolicyAnnotationsChangedPredicate),
+		).
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.watchDSCIInstances),
+			builder.WithPredicates(meshOptOutChangedPredicate),
+		).
 		Watches(
 			&corev1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(r.watchMonitoringSecretResource),
@@ -331,7 +491,15 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 			handler.EnqueueRequestsFromMapFunc(r.watchMonitoringConfigMapResource),
 			builder.WithPredicates(CMContentChangedPredicate),
 		).
-		Complete(r)
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	// Resources of other operators the capabilities depend on are watched once these operators are installed, see readinessWatches.
+	r.externalWatches = newExternalWatches(dsciController, mgr.GetCache(), mgr.GetRESTMapper())
+
+	return nil
 }
 
 var SecretContentChangedPredicate = predicate.Funcs{
@@ -343,6 +511,59 @@ var SecretContentChangedPredicate = predicate.Funcs{
 	},
 }
 
+// meshOptOutChangedPredicate passes events of namespaces which opted out of the Service Mesh, or opted in again,
+// so that resources enrolling them in the mesh are removed or applied.
+var meshOptOutChangedPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return e.Object.GetLabels()[labels.ODH.ServiceMesh] == labels.ServiceMeshDisabled
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectOld.GetLabels()[labels.ODH.ServiceMesh] != e.ObjectNew.GetLabels()[labels.ODH.ServiceMesh]
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(event.GenericEvent) bool {
+		return false
+	},
+}
+
+// authorizationPolicyAnnotationsChangedPredicate passes events of Services which request authorization policies,
+// or stopped requesting them, as well as changes of the policies or workloads they apply to.
+var authorizationPolicyAnnotationsChangedPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return requestsAuthorizationPolicy(e.Object)
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return requestsAuthorizationPolicy(e.Object)
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if !requestsAuthorizationPolicy(e.ObjectOld) && !requestsAuthorizationPolicy(e.ObjectNew) {
+			return false
+		}
+
+		for _, annotation := range []string{annotations.AuthorizationPolicy, annotations.AllowedServiceAccounts, annotations.AllowedGroups} {
+			if e.ObjectOld.GetAnnotations()[annotation] != e.ObjectNew.GetAnnotations()[annotation] {
+				return true
+			}
+		}
+
+		oldService, _ := e.ObjectOld.(*corev1.Service)
+		newService, _ := e.ObjectNew.(*corev1.Service)
+
+		return oldService != nil && newService != nil && !reflect.DeepEqual(oldService.Spec.Selector, newService.Spec.Selector)
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+func requestsAuthorizationPolicy(obj client.Object) bool {
+	_, found := obj.GetAnnotations()[annotations.AuthorizationPolicy]
+
+	return found
+}
+
 var CMContentChangedPredicate = predicate.Funcs{
 	UpdateFunc: func(e event.UpdateEvent) bool {
 		oldCM, _ := e.ObjectOld.(*corev1.ConfigMap)
@@ -407,3 +628,21 @@ func (r *DSCInitializationReconciler) watchDSCResource(ctx context.Context) []re
 	}
 	return nil
 }
+
+// watchDSCIInstances enqueues DSCInitialization when DataScienceCluster spec changes, as it affects which capabilities
+// are required for DSCInitialization to be Ready. It is also used for Services requesting authorization policies,
+// which are generated by the authorization-policies feature of DSCInitialization.
+func (r *DSCInitializationReconciler) watchDSCIInstances(ctx context.Context, _ client.Object) []reconcile.Request {
+	instanceList := &dsciv1.DSCInitializationList{}
+	if err := r.Client.List(ctx, instanceList); err != nil {
+		r.Log.Error(err, "Failed to get DSCInitializationList")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(instanceList.Items))
+	for _, instance := range instanceList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.Name}})
+	}
+
+	return requests
+}
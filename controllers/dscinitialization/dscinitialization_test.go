@@ -4,6 +4,7 @@ import (
 	"context"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -12,6 +13,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -288,6 +291,127 @@ var _ = Describe("DataScienceCluster initialization", func() {
 			Expect(foundApplicationNamespace.UID).To(Equal(createdNamespace.UID))
 		})
 	})
+
+	Context("Changing applications namespace", func() {
+		const (
+			sourceNamespace = "test-migration-source-ns"
+			targetNamespace = "test-migration-target-ns"
+			sharedConfigMap = "test-shared-config"
+		)
+
+		AfterEach(func(ctx context.Context) {
+			Expect(k8sClient.DeleteAllOf(ctx, &corev1.ConfigMap{}, client.InNamespace(targetNamespace))).To(Succeed())
+			cleanupResources(ctx)
+		})
+
+		It("Should migrate shared resources to the new namespace", func(ctx context.Context) {
+			// given
+			desiredDsci := createDSCI(operatorv1.Removed, operatorv1.Removed, monitoringNamespace)
+			desiredDsci.Spec.ApplicationsNamespace = sourceNamespace
+			Expect(k8sClient.Create(ctx, desiredDsci)).Should(Succeed())
+			foundDsci := &dsciv1.DSCInitialization{}
+			Eventually(dscInitializationIsReady(applicationName, workingNamespace, foundDsci)).
+				WithContext(ctx).
+				WithTimeout(timeout).
+				WithPolling(interval).
+				Should(BeTrue())
+
+			shared := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      sharedConfigMap,
+					Namespace: sourceNamespace,
+					Labels:    map[string]string{labels.ODH.SharedResource: "true"},
+				},
+				Data: map[string]string{"key": "value"},
+			}
+			Expect(k8sClient.Create(ctx, shared)).Should(Succeed())
+
+			// when
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(foundDsci), foundDsci)).To(Succeed())
+			foundDsci.Spec.ApplicationsNamespace = targetNamespace
+			Expect(k8sClient.Update(ctx, foundDsci)).To(Succeed())
+
+			// then
+			Eventually(func(ctx context.Context) string {
+				_ = k8sClient.Get(ctx, client.ObjectKeyFromObject(foundDsci), foundDsci)
+
+				return foundDsci.Status.ApplicationsNamespace
+			}).
+				WithContext(ctx).
+				WithTimeout(timeout).
+				WithPolling(interval).
+				Should(Equal(targetNamespace))
+			Expect(conditionsv1.IsStatusConditionTrue(foundDsci.Status.Conditions, status.ConditionApplicationsNamespaceMigrated)).To(BeTrue())
+
+			copied := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Name: sharedConfigMap, Namespace: targetNamespace}, copied)).To(Succeed())
+			Expect(copied.Data).To(Equal(shared.Data))
+		})
+	})
+
+	Context("Duplicate instances", func() {
+		AfterEach(cleanupResources)
+
+		It("Should mark newer instance as duplicate and keep reconciling the oldest", func(ctx context.Context) {
+			// given
+			activeDsci := createDSCI(operatorv1.Removed, operatorv1.Removed, monitoringNamespace)
+			Expect(k8sClient.Create(ctx, activeDsci)).Should(Succeed())
+			foundActive := &dsciv1.DSCInitialization{}
+			Eventually(dscInitializationIsReady(applicationName, workingNamespace, foundActive)).
+				WithContext(ctx).
+				WithTimeout(timeout).
+				WithPolling(interval).
+				Should(BeTrue())
+
+			// when
+			duplicateDsci := createDSCI(operatorv1.Removed, operatorv1.Removed, monitoringNamespace)
+			duplicateDsci.Name = applicationName + "-duplicate"
+			Expect(k8sClient.Create(ctx, duplicateDsci)).Should(Succeed())
+
+			// then
+			foundDuplicate := &dsciv1.DSCInitialization{}
+			Eventually(func(ctx context.Context) bool {
+				_ = k8sClient.Get(ctx, client.ObjectKeyFromObject(duplicateDsci), foundDuplicate)
+
+				return conditionsv1.IsStatusConditionTrue(foundDuplicate.Status.Conditions, status.ConditionDuplicate)
+			}).
+				WithContext(ctx).
+				WithTimeout(timeout).
+				WithPolling(interval).
+				Should(BeTrue())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(activeDsci), foundActive)).To(Succeed())
+			Expect(conditionsv1.FindStatusCondition(foundActive.Status.Conditions, status.ConditionDuplicate)).To(BeNil())
+			Expect(foundActive.Status.Phase).To(Equal(readyPhase))
+		})
+
+		It("Should clear duplicate condition once the older instance is deleted", func(ctx context.Context) {
+			// given
+			activeDsci := createDSCI(operatorv1.Removed, operatorv1.Removed, monitoringNamespace)
+			Expect(k8sClient.Create(ctx, activeDsci)).Should(Succeed())
+			Eventually(dscInitializationIsReady(applicationName, workingNamespace, &dsciv1.DSCInitialization{})).
+				WithContext(ctx).
+				WithTimeout(timeout).
+				WithPolling(interval).
+				Should(BeTrue())
+
+			duplicateDsci := createDSCI(operatorv1.Removed, operatorv1.Removed, monitoringNamespace)
+			duplicateDsci.Name = applicationName + "-duplicate"
+			Expect(k8sClient.Create(ctx, duplicateDsci)).Should(Succeed())
+			foundDuplicate := &dsciv1.DSCInitialization{}
+			isDuplicate := func(ctx context.Context) bool {
+				_ = k8sClient.Get(ctx, client.ObjectKeyFromObject(duplicateDsci), foundDuplicate)
+
+				return conditionsv1.FindStatusCondition(foundDuplicate.Status.Conditions, status.ConditionDuplicate) != nil
+			}
+			Eventually(isDuplicate).WithContext(ctx).WithTimeout(timeout).WithPolling(interval).Should(BeTrue())
+
+			// when
+			Expect(k8sClient.Delete(ctx, activeDsci)).Should(Succeed())
+
+			// then
+			Eventually(isDuplicate).WithContext(ctx).WithTimeout(timeout).WithPolling(interval).Should(BeFalse())
+		})
+	})
 })
 
 func cleanupResources(ctx context.Context) {
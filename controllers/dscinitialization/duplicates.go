@@ -0,0 +1,65 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// markDuplicates reports DSCInitialization instances other than the active one as duplicates, so that it is visible
+// that they are not reconciled. Duplicates being deleted are released without clean-up, as they never owned any resources.
+func (r *DSCInitializationReconciler) markDuplicates(ctx context.Context, active *dsciv1.DSCInitialization, duplicates []*dsciv1.DSCInitialization) error {
+	for _, duplicate := range duplicates {
+		if !duplicate.DeletionTimestamp.IsZero() {
+			if controllerutil.RemoveFinalizer(duplicate, finalizerName) {
+				if err := r.Update(ctx, duplicate); err != nil {
+					return fmt.Errorf("failed removing finalizer from duplicate DSCInitialization %s: %w", duplicate.Name, err)
+				}
+			}
+
+			continue
+		}
+
+		message := fmt.Sprintf("Only one instance of DSCInitialization is allowed, %s is active and this instance is not reconciled. Delete this instance.", active.Name)
+		if condition := conditionsv1.FindStatusCondition(duplicate.Status.Conditions, status.ConditionDuplicate); condition != nil && condition.Message == message {
+			continue
+		}
+
+		r.Log.Info("Found duplicate DSCInitialization, it will not be reconciled", "name", duplicate.Name, "active", active.Name)
+		r.Recorder.Eventf(duplicate, corev1.EventTypeWarning, status.DuplicateReason, message)
+
+		if _, err := status.UpdateWithRetry(ctx, r.Client, duplicate, func(saved *dsciv1.DSCInitialization) {
+			status.SetCondition(&saved.Status.Conditions, string(status.ConditionDuplicate), status.DuplicateReason, message, corev1.ConditionTrue)
+			saved.Status.Phase = status.PhaseError
+		}); err != nil {
+			return fmt.Errorf("failed marking DSCInitialization %s as duplicate: %w", duplicate.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// clearDuplicate removes the Duplicate condition from the active instance, which has been reported as a duplicate
+// while an older instance existed, so that it does not stay marked once it is reconciled.
+func (r *DSCInitializationReconciler) clearDuplicate(ctx context.Context, active *dsciv1.DSCInitialization) (*dsciv1.DSCInitialization, error) {
+	if conditionsv1.FindStatusCondition(active.Status.Conditions, status.ConditionDuplicate) == nil {
+		return active, nil
+	}
+
+	r.Log.Info("DSCInitialization is no longer a duplicate, it will be reconciled", "name", active.Name)
+
+	updated, err := status.UpdateWithRetry(ctx, r.Client, active, func(saved *dsciv1.DSCInitialization) {
+		conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, status.ConditionDuplicate)
+	})
+	if err != nil {
+		return active, fmt.Errorf("failed clearing duplicate condition of DSCInitialization %s: %w", active.Name, err)
+	}
+
+	return updated, nil
+}
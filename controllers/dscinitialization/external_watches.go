@@ -0,0 +1,173 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+)
+
+// readinessWatch enqueues DSCInitialization when readiness of a resource managed by another operator changes,
+// so that capabilities depending on it converge as soon as it is ready, instead of polling for it.
+type readinessWatch struct {
+	kind schema.GroupVersionKind
+	// readiness extracts the status fields telling whether the resource is ready, changes of other fields are ignored.
+	readiness func(obj *unstructured.Unstructured) any
+}
+
+var readinessWatches = []readinessWatch{
+	{kind: gvk.ServiceMeshControlPlane, readiness: controlPlaneReadiness},
+	{kind: gvk.ServiceMeshMember, readiness: conditionStatuses},
+	{kind: gvk.Authorino, readiness: conditionStatuses},
+}
+
+// externalWatches registers readinessWatches with the controller once their kinds are served by the cluster,
+// as operators providing them can be installed after the operator has started.
+type externalWatches struct {
+	controller controller.Controller
+	cache      cache.Cache
+	mapper     meta.RESTMapper
+
+	mu         sync.Mutex
+	registered sets.Set[schema.GroupVersionKind]
+}
+
+func newExternalWatches(ctrl controller.Controller, watchCache cache.Cache, mapper meta.RESTMapper) *externalWatches {
+	return &externalWatches{
+		controller: ctrl,
+		cache:      watchCache,
+		mapper:     mapper,
+		registered: sets.New[schema.GroupVersionKind](),
+	}
+}
+
+// ensure registers watches of kinds which became available since the last call.
+func (w *externalWatches) ensure(mapFn handler.MapFunc) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var multiErr *multierror.Error
+	for _, watch := range readinessWatches {
+		if w.registered.Has(watch.kind) {
+			continue
+		}
+
+		if _, err := w.mapper.RESTMapping(watch.kind.GroupKind(), watch.kind.Version); err != nil {
+			if !meta.IsNoMatchError(err) {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("failed resolving %s: %w", watch.kind.Kind, err))
+			}
+
+			continue
+		}
+
+		watched := &unstructured.Unstructured{}
+		watched.SetGroupVersionKind(watch.kind)
+		if err := w.controller.Watch(source.Kind(w.cache, watched), handler.EnqueueRequestsFromMapFunc(mapFn), ReadinessChangedPredicate(watch.kind)); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("failed watching %s: %w", watch.kind.Kind, err))
+
+			continue
+		}
+		w.registered.Insert(watch.kind)
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+// ReadinessChangedPredicate passes changes of readiness of the watched kind and deletions. Creations are ignored,
+// as resources are not ready right away. Kinds which are not watched do not pass at all.
+func ReadinessChangedPredicate(kind schema.GroupVersionKind) predicate.Funcs {
+	var readiness func(obj *unstructured.Unstructured) any
+	for _, watch := range readinessWatches {
+		if watch.kind == kind {
+			readiness = watch.readiness
+		}
+	}
+	if readiness == nil {
+		return predicate.NewPredicateFuncs(func(client.Object) bool { return false })
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldObj, okOld := e.ObjectOld.(*unstructured.Unstructured)
+			newObj, okNew := e.ObjectNew.(*unstructured.Unstructured)
+			if !okOld || !okNew {
+				return false
+			}
+
+			return !reflect.DeepEqual(readiness(oldObj), readiness(newObj))
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// conditionStatuses maps types of the status conditions to their status, leaving out timestamps and messages.
+func conditionStatuses(obj *unstructured.Unstructured) any {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	statuses := make(map[string]any, len(conditions))
+	for _, condition := range conditions {
+		if conditionMap, ok := condition.(map[string]any); ok {
+			statuses[fmt.Sprint(conditionMap["type"])] = conditionMap["status"]
+		}
+	}
+
+	return statuses
+}
+
+// controlPlaneReadiness tracks readiness of the control plane components, which EnsureServiceMeshInstalled checks, along with its conditions.
+func controlPlaneReadiness(obj *unstructured.Unstructured) any {
+	components, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "status", "readiness", "components")
+
+	return []any{components, conditionStatuses(obj)}
+}
+
+// watchServiceMeshResources enqueues DSCInitialization managing or observing Service Mesh when the resource lives in its control plane
+// or authorization provider namespace.
+func (r *DSCInitializationReconciler) watchServiceMeshResources(ctx context.Context, obj client.Object) []reconcile.Request {
+	instanceList := &dsciv1.DSCInitializationList{}
+	if err := r.Client.List(ctx, instanceList); err != nil {
+		r.Log.Error(err, "Failed to get DSCInitializationList")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range instanceList.Items {
+		spec := &instanceList.Items[i].Spec
+		if !serviceMeshTracked(spec) {
+			continue
+		}
+
+		if obj.GetNamespace() == spec.ServiceMesh.ControlPlane.Namespace || obj.GetNamespace() == servicemesh.AuthNamespace(spec) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: instanceList.Items[i].Name}})
+		}
+	}
+
+	return requests
+}
@@ -0,0 +1,92 @@
+package dscinitialization_test
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Readiness of external resources", func() {
+
+	withStatus := func(status map[string]any) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{"status": status}}
+		obj.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		obj.SetName("data-science-smcp")
+		obj.SetNamespace("istio-system")
+
+		return obj
+	}
+
+	condition := func(conditionType, status, lastTransitionTime string) map[string]any {
+		return map[string]any{"type": conditionType, "status": status, "lastTransitionTime": lastTransitionTime}
+	}
+
+	updated := func(oldStatus, newStatus map[string]any) event.UpdateEvent {
+		return event.UpdateEvent{ObjectOld: withStatus(oldStatus), ObjectNew: withStatus(newStatus)}
+	}
+
+	It("should requeue when control plane components become ready", func() {
+		// given
+		predicate := dscictrl.ReadinessChangedPredicate(gvk.ServiceMeshControlPlane)
+
+		// when
+		passed := predicate.Update(updated(
+			map[string]any{"readiness": map[string]any{"components": map[string]any{"pending": []any{"istiod"}}}},
+			map[string]any{"readiness": map[string]any{"components": map[string]any{"ready": []any{"istiod"}}}},
+		))
+
+		// then
+		Expect(passed).To(BeTrue())
+	})
+
+	It("should requeue when status of a condition changes", func() {
+		// given
+		predicate := dscictrl.ReadinessChangedPredicate(gvk.Authorino)
+
+		// when
+		passed := predicate.Update(updated(
+			map[string]any{"conditions": []any{condition("Ready", "False", "2024-01-01T00:00:00Z")}},
+			map[string]any{"conditions": []any{condition("Ready", "True", "2024-01-01T00:01:00Z")}},
+		))
+
+		// then
+		Expect(passed).To(BeTrue())
+	})
+
+	It("should ignore changes which do not affect readiness", func() {
+		// given
+		predicate := dscictrl.ReadinessChangedPredicate(gvk.ServiceMeshMember)
+
+		// when
+		passed := predicate.Update(updated(
+			map[string]any{"conditions": []any{condition("Ready", "True", "2024-01-01T00:00:00Z")}, "observedGeneration": int64(1)},
+			map[string]any{"conditions": []any{condition("Ready", "True", "2024-01-01T00:01:00Z")}, "observedGeneration": int64(2)},
+		))
+
+		// then
+		Expect(passed).To(BeFalse())
+	})
+
+	It("should requeue on deletion but not on creation", func() {
+		// given
+		predicate := dscictrl.ReadinessChangedPredicate(gvk.ServiceMeshControlPlane)
+		obj := withStatus(nil)
+
+		// then
+		Expect(predicate.Delete(event.DeleteEvent{Object: obj})).To(BeTrue())
+		Expect(predicate.Create(event.CreateEvent{Object: obj})).To(BeFalse())
+	})
+
+	It("should ignore kinds which are not watched", func() {
+		// given
+		predicate := dscictrl.ReadinessChangedPredicate(gvk.KnativeServing)
+
+		// then
+		Expect(predicate.Delete(event.DeleteEvent{Object: withStatus(nil)})).To(BeFalse())
+	})
+})
@@ -0,0 +1,66 @@
+package dscinitialization
+
+import (
+	"context"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+)
+
+const defaultFeatureAlertFor = "15m"
+
+// configureFeatureAlerts creates the ServiceMonitor scraping feature_phase metric of the operator and the PrometheusRule
+// alerting on features stuck in the Error phase. Both are removed when alerts are no longer enabled in the DSCI.
+func (r *DSCInitializationReconciler) configureFeatureAlerts(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	return feature.ClusterFeaturesHandler(instance, featureAlertsFeatures(instance)).Apply(ctx)
+}
+
+func (r *DSCInitializationReconciler) removeFeatureAlerts(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	return feature.ClusterFeaturesHandler(instance, featureAlertsFeatures(instance)).Delete(ctx)
+}
+
+func featureAlertsFeatures(instance *dsciv1.DSCInitialization) feature.FeaturesProvider {
+	return feature.ForCapability(featureAlertsCapabilityName, func(registry feature.FeaturesRegistry) error {
+		alerts := instance.Spec.Monitoring.FeatureAlerts
+
+		alertsEnabled := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return instance.Spec.Monitoring.ManagementState == operatorv1.Managed &&
+				alerts != nil && alerts.ManagementState == operatorv1.Managed, nil
+		}
+
+		// Namespace is not known when the operator runs outside of the cluster, permissions are then checked in all namespaces.
+		alertsNamespace, _ := cluster.GetOperatorNamespace()
+
+		alertFor := defaultFeatureAlertFor
+		if alerts != nil && alerts.For != "" {
+			alertFor = alerts.For
+		}
+
+		return registry.Add(
+			feature.Define("feature-alerts").
+				RequiresPermissions(
+					feature.NamespacedPermission(alertsNamespace, "monitoring.coreos.com", "prometheusrules", feature.ApplyVerbs...),
+					feature.NamespacedPermission(alertsNamespace, "monitoring.coreos.com", "servicemonitors", feature.ApplyVerbs...),
+				).
+				EnabledWhen(alertsEnabled).
+				Manifests(
+					manifest.Location(Templates.Location).
+						Include(Templates.FeatureAlertsDir),
+				).
+				WithData(
+					feature.Entry("OperatorNamespace", operatorNamespace),
+					feature.Entry("AlertFor", provider.ValueOf(alertFor).Get),
+				),
+		)
+	})
+}
+
+func operatorNamespace(_ context.Context, _ client.Client) (string, error) {
+	return cluster.GetOperatorNamespace()
+}
@@ -1,9 +1,19 @@
 package dscinitialization
 
 import (
+	"context"
 	"embed"
+	"fmt"
 	"io/fs"
+	"net/url"
+	"os"
 	"path"
+	"path/filepath"
+	"strings"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 )
 
 //go:embed resources
@@ -11,21 +21,107 @@ var dsciEmbeddedFS embed.FS
 
 const baseDir = "resources"
 
+// Capabilities the features of DSCInitialization are part of, used in their cost-attribution labels.
+const (
+	serviceMeshCapabilityName        = "service-mesh"
+	authorizationCapabilityName      = "service-mesh-authorization"
+	featureAlertsCapabilityName      = "feature-alerts"
+	consoleIntegrationCapabilityName = "console-integration"
+	servingCertsCapabilityName       = "serving-certificates"
+)
+
 var Templates = struct {
 	// ServiceMeshDir is the path to the Service Mesh templates.
 	ServiceMeshDir string
 	// AuthorinoDir is the path to the Authorino templates.
 	AuthorinoDir string
+	// LightweightAuthDir is the path to the templates checking tokens of model serving requests without Authorino.
+	LightweightAuthDir string
 	// MetricsDir is the path to the Metrics Collection templates.
 	MetricsDir string
+	// MetricsFederationDir is the path to the templates exposing mesh metrics to user workload monitoring.
+	MetricsFederationDir string
+	// FeatureAlertsDir is the path to the templates alerting on features stuck in the Error phase.
+	FeatureAlertsDir string
+	// ConsoleDir is the path to the templates of OpenShift console entries pointing at the platform.
+	ConsoleDir string
+	// ConsolePluginDir is the path to the templates registering the console dynamic plugin.
+	ConsolePluginDir string
+	// AutoscalingDir is the path to the templates autoscaling platform workloads.
+	AutoscalingDir string
+	// DisruptionBudgetsDir is the path to the PodDisruptionBudget templates of platform workloads.
+	DisruptionBudgetsDir string
+	// EgressTLSDir is the path to the templates originating TLS to services outside the mesh.
+	EgressTLSDir string
+	// ServingCertRefsDir is the path to the template publishing references to serving certificates.
+	ServingCertRefsDir string
+	// ServingCertManagerDir is the path to the templates issuing serving certificates by cert-manager.
+	ServingCertManagerDir string
 	// Location specifies the file system that contains the templates to be used.
 	Location fs.FS
 	// BaseDir is the path to the base of the embedded FS
 	BaseDir string
 }{
-	ServiceMeshDir: path.Join(baseDir, "servicemesh"),
-	AuthorinoDir:   path.Join(baseDir, "authorino"),
-	MetricsDir:     path.Join(baseDir, "metrics-collection"),
-	Location:       dsciEmbeddedFS,
-	BaseDir:        baseDir,
+	ServiceMeshDir:        path.Join(baseDir, "servicemesh"),
+	AuthorinoDir:          path.Join(baseDir, "authorino"),
+	LightweightAuthDir:    path.Join(baseDir, "lightweight-auth"),
+	MetricsDir:            path.Join(baseDir, "metrics-collection"),
+	MetricsFederationDir:  path.Join(baseDir, "metrics-federation"),
+	FeatureAlertsDir:      path.Join(baseDir, "feature-alerts"),
+	ConsoleDir:            path.Join(baseDir, "console"),
+	ConsolePluginDir:      path.Join(baseDir, "console-plugin"),
+	AutoscalingDir:        path.Join(baseDir, "autoscaling"),
+	DisruptionBudgetsDir:  path.Join(baseDir, "disruption-budgets"),
+	EgressTLSDir:          path.Join(baseDir, "egress-tls"),
+	ServingCertRefsDir:    path.Join(baseDir, "serving-certificates"),
+	ServingCertManagerDir: path.Join(baseDir, "serving-certificates-cert-manager"),
+	Location:              dsciEmbeddedFS,
+	BaseDir:               baseDir,
+}
+
+// TemplatesLocation returns the file system holding templates of the given capability. It is the one embedded
+// in the operator, unless custom templates are configured for the capability in DSCI devFlags. Custom templates
+// are served under BaseDir, so that paths defined in Templates can be used regardless of the location.
+func TemplatesLocation(ctx context.Context, devFlags *dsciv1.DevFlags, capability dsciv1.TemplatesCapability) (fs.FS, error) {
+	custom := devFlags.TemplatesFor(capability)
+	if custom == nil {
+		return Templates.Location, nil
+	}
+
+	uri, err := url.Parse(custom.URI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid templates uri %q of %s capability: %w", custom.URI, capability, err)
+	}
+
+	if uri.Scheme == "file" {
+		return rebasedFS{fsys: os.DirFS(uri.Path)}, nil
+	}
+
+	// Templates are downloaded again on every call, stale ones have to go first as the download only adds files.
+	componentName := filepath.Join("templates", string(capability))
+	if err := os.RemoveAll(filepath.Join(deploy.DefaultManifestPath, componentName)); err != nil {
+		return nil, fmt.Errorf("failed removing previously downloaded templates of %s capability: %w", capability, err)
+	}
+	if err := deploy.DownloadManifests(ctx, componentName, components.ManifestsConfig{URI: custom.URI, ContextDir: custom.ContextDir}); err != nil {
+		return nil, fmt.Errorf("failed downloading templates of %s capability: %w", capability, err)
+	}
+
+	return rebasedFS{fsys: os.DirFS(filepath.Join(deploy.DefaultManifestPath, componentName))}, nil
+}
+
+// rebasedFS serves files of the underlying file system under BaseDir.
+type rebasedFS struct {
+	fsys fs.FS
+}
+
+func (r rebasedFS) Open(name string) (fs.File, error) {
+	if name == baseDir {
+		return r.fsys.Open(".")
+	}
+
+	if rel, found := strings.CutPrefix(name, baseDir+"/"); found {
+		return r.fsys.Open(rel)
+	}
+
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
 }
@@ -0,0 +1,68 @@
+package dscinitialization_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Capability templates", func() {
+
+	const smcpTemplate = "smcp.tmpl.yaml"
+
+	It("should use embedded templates when custom ones are not configured", func(ctx context.Context) {
+		// when
+		templates, err := dscictrl.TemplatesLocation(ctx, &dsciv1.DevFlags{}, dsciv1.ServiceMeshTemplates)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(templates).To(Equal(dscictrl.Templates.Location))
+	})
+
+	It("should read custom templates from local directory using paths of embedded templates", func(ctx context.Context) {
+		// given
+		dir := GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(dir, "servicemesh"), os.ModePerm)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "servicemesh", smcpTemplate), []byte("kind: ServiceMeshControlPlane"), 0o600)).To(Succeed())
+		devFlags := &dsciv1.DevFlags{
+			Templates: []dsciv1.CapabilityTemplates{{Capability: dsciv1.ServiceMeshTemplates, URI: "file://" + dir}},
+		}
+
+		// when
+		templates, err := dscictrl.TemplatesLocation(ctx, devFlags, dsciv1.ServiceMeshTemplates)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		content, err := fs.ReadFile(templates, path.Join(dscictrl.Templates.ServiceMeshDir, smcpTemplate))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("kind: ServiceMeshControlPlane"))
+
+		By("picking up changes without restarting the operator")
+		Expect(os.WriteFile(filepath.Join(dir, "servicemesh", smcpTemplate), []byte("kind: ServiceMeshMemberRoll"), 0o600)).To(Succeed())
+		content, err = fs.ReadFile(templates, path.Join(dscictrl.Templates.ServiceMeshDir, smcpTemplate))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("kind: ServiceMeshMemberRoll"))
+	})
+
+	It("should keep using embedded templates for capabilities without custom ones", func(ctx context.Context) {
+		// given
+		devFlags := &dsciv1.DevFlags{
+			Templates: []dsciv1.CapabilityTemplates{{Capability: dsciv1.ServiceMeshTemplates, URI: "file://" + GinkgoT().TempDir()}},
+		}
+
+		// when
+		templates, err := dscictrl.TemplatesLocation(ctx, devFlags, dsciv1.AuthorinoTemplates)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(templates).To(Equal(dscictrl.Templates.Location))
+	})
+})
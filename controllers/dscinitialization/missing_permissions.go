@@ -0,0 +1,64 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// ReportMissingPermissions sets the MissingPermissions condition of the active DSCInitialization when the operator has not been
+// granted permissions declared by its enabled features, and removes it once they are granted. It is meant to be run on operator startup,
+// so that missing permissions are reported upfront rather than as Forbidden errors of the features being applied.
+func (r *DSCInitializationReconciler) ReportMissingPermissions(ctx context.Context) error {
+	instances := &dsciv1.DSCInitializationList{}
+	if err := r.Client.List(ctx, instances); err != nil {
+		return fmt.Errorf("failed listing DSCInitialization instances: %w", err)
+	}
+
+	instance := instances.ActiveInstance()
+	if instance == nil {
+		return nil
+	}
+
+	providers, err := r.featuresProviders(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	missing, err := feature.ClusterFeaturesHandler(instance, append(providers, consoleIntegrationFeatures(instance), servingCertificatesFeatures(instance))...).MissingPermissions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(missing) == 0 && conditionsv1.FindStatusCondition(instance.Status.Conditions, status.ConditionMissingPermissions) == nil {
+		return nil
+	}
+
+	_, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		if len(missing) == 0 {
+			conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, status.ConditionMissingPermissions)
+
+			return
+		}
+
+		permissions := make([]string, 0, len(missing))
+		for _, permission := range missing {
+			permissions = append(permissions, permission.String())
+		}
+		conditionsv1.SetStatusCondition(&saved.Status.Conditions, conditionsv1.Condition{
+			Type:    status.ConditionMissingPermissions,
+			Status:  corev1.ConditionTrue,
+			Reason:  status.MissingPermissionsReason,
+			Message: "Operator has not been granted permissions required by enabled features: " + strings.Join(permissions, "; "),
+		})
+	})
+
+	return err
+}
@@ -0,0 +1,90 @@
+package dscinitialization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/naming"
+)
+
+// DetectNameCollisions checks names of the namespaces configured by DSCInitialization against each other and against
+// namespaces existing in the cluster. A derived namespace which exists, but has been derived from a different full name,
+// e.g. from another applications namespace shortened alike, is reported as a collision instead of being taken over.
+func DetectNameCollisions(ctx context.Context, cli client.Client, spec *dsciv1.DSCInitializationSpec) ([]naming.Collision, error) {
+	claims := []naming.Claim{{Name: spec.ApplicationsNamespace, Owner: "applications namespace"}}
+
+	if spec.Monitoring.ManagementState == operatorv1.Managed {
+		claims = append(claims, naming.Claim{Name: spec.Monitoring.Namespace, Owner: "monitoring namespace"})
+	}
+
+	if spec.ServiceMesh != nil && spec.ServiceMesh.ManagementState == operatorv1.Managed {
+		claims = append(claims, naming.Claim{Name: spec.ServiceMesh.ControlPlane.Namespace, Owner: "service mesh control plane namespace"})
+
+		if strings.TrimSpace(spec.ServiceMesh.Auth.Namespace) == "" && !servicemesh.UsesLightweightAuth(spec.ServiceMesh) {
+			authNs := servicemesh.AuthNamespace(spec)
+			fullName := naming.Join(spec.ApplicationsNamespace, servicemesh.AuthNamespaceSuffix)
+			claims = append(claims, naming.Claim{Name: authNs, Owner: "authorization namespace derived from " + fullName, Derived: true})
+
+			existing := &corev1.Namespace{}
+			if err := cli.Get(ctx, client.ObjectKey{Name: authNs}, existing); client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("failed getting namespace %s: %w", authNs, err)
+			}
+			if generatedFrom, found := existing.GetAnnotations()[annotations.GeneratedFrom]; found && generatedFrom != fullName {
+				claims = append(claims, naming.Claim{Name: authNs, Owner: "existing namespace derived from " + generatedFrom})
+			}
+		}
+	}
+
+	return naming.Collisions(claims...), nil
+}
+
+// reportNameCollisions sets the NameCollision condition when names derived by the operator collide and fails the reconciliation,
+// so that colliding resources are not overwritten. The condition is removed once the names no longer collide.
+func (r *DSCInitializationReconciler) reportNameCollisions(ctx context.Context, instance *dsciv1.DSCInitialization) (*dsciv1.DSCInitialization, error) {
+	collisions, err := DetectNameCollisions(ctx, r.Client, &instance.Spec)
+	if err != nil {
+		return instance, err
+	}
+
+	if len(collisions) == 0 {
+		if conditionsv1.FindStatusCondition(instance.Status.Conditions, status.ConditionNameCollision) == nil {
+			return instance, nil
+		}
+
+		return status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+			conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, status.ConditionNameCollision)
+		})
+	}
+
+	described := make([]string, 0, len(collisions))
+	for _, collision := range collisions {
+		described = append(described, collision.String())
+	}
+	message := "Names derived by the operator collide, change the namespaces in the spec: " + strings.Join(described, "; ")
+
+	if condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, status.ConditionNameCollision); condition != nil && condition.Message == message {
+		return instance, errors.New(message)
+	}
+
+	r.Recorder.Eventf(instance, corev1.EventTypeWarning, status.NameCollisionReason, "%s", message)
+	updated, errUpdate := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		status.SetCondition(&saved.Status.Conditions, string(status.ConditionNameCollision), status.NameCollisionReason, message, corev1.ConditionTrue)
+		saved.Status.Phase = status.PhaseError
+	})
+	if errUpdate != nil {
+		return instance, errUpdate
+	}
+
+	return updated, errors.New(message)
+}
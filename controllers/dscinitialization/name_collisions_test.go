@@ -0,0 +1,83 @@
+package dscinitialization_test
+
+import (
+	"context"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/naming"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Name collisions", func() {
+
+	clientWith := func(objects ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	}
+
+	specWithMesh := func(applicationsNamespace string) *dsciv1.DSCInitializationSpec {
+		return &dsciv1.DSCInitializationSpec{
+			ApplicationsNamespace: applicationsNamespace,
+			Monitoring:            dsciv1.Monitoring{ManagementState: operatorv1.Managed, Namespace: applicationsNamespace},
+			ServiceMesh: &infrav1.ServiceMeshSpec{
+				ManagementState: operatorv1.Managed,
+				ControlPlane:    infrav1.ControlPlaneSpec{Namespace: "istio-system"},
+			},
+		}
+	}
+
+	It("should allow applications and monitoring namespace to be shared", func(ctx context.Context) {
+		// when
+		collisions, err := dscictrl.DetectNameCollisions(ctx, clientWith(), specWithMesh("opendatahub"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(collisions).To(BeEmpty())
+	})
+
+	It("should report derived authorization namespace chosen for another purpose", func(ctx context.Context) {
+		// given
+		spec := specWithMesh("opendatahub")
+		spec.Monitoring.Namespace = "opendatahub-auth-provider"
+
+		// when
+		collisions, err := dscictrl.DetectNameCollisions(ctx, clientWith(), spec)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(collisions).To(ConsistOf(HaveField("Name", "opendatahub-auth-provider")))
+	})
+
+	It("should report existing namespace derived from a different name shortened alike", func(ctx context.Context) {
+		// given
+		spec := specWithMesh("team-" + strings.Repeat("a", 55))
+		authNs := naming.Namespace(spec.ApplicationsNamespace, "auth-provider")
+		existing := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        authNs,
+			Annotations: map[string]string{annotations.GeneratedFrom: "team-other-auth-provider"},
+		}}
+
+		// when
+		collisions, err := dscictrl.DetectNameCollisions(ctx, clientWith(existing), spec)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(collisions).To(ConsistOf(HaveField("Owners", ContainElement("existing namespace derived from team-other-auth-provider"))))
+	})
+})
@@ -0,0 +1,196 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// migrateApplicationsNamespace moves applications to the namespace set in spec.applicationsNamespace when it has changed
+// since the last reconciliation. The migration:
+//   - copies ConfigMaps and Secrets labeled as shared resources to the new namespace,
+//   - removes Service Mesh features rendered for the previous namespace (including `<ns>-auth-provider` extension provider),
+//     so that they are rendered again for the new namespace later in the reconciliation,
+//   - deletes the previous namespaces if they have been created by the operator and are not used for anything else.
+//
+// Progress is reported using ApplicationsNamespaceMigrated condition.
+func (r *DSCInitializationReconciler) migrateApplicationsNamespace(ctx context.Context, instance *dsciv1.DSCInitialization) (*dsciv1.DSCInitialization, error) {
+	previous := instance.Status.ApplicationsNamespace
+	target := instance.Spec.ApplicationsNamespace
+
+	if previous == target {
+		return instance, nil
+	}
+
+	if previous == "" {
+		// Nothing to migrate, applications are deployed to the namespace for the first time.
+		return status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+			saved.Status.ApplicationsNamespace = target
+		})
+	}
+
+	r.Log.Info("Migrating applications namespace", "from", previous, "to", target)
+	instance, err := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		setMigrationCondition(&saved.Status.Conditions, corev1.ConditionUnknown, status.MigrationInProgressReason,
+			fmt.Sprintf("Migrating applications from namespace %s to %s", previous, target))
+	})
+	if err != nil {
+		return instance, err
+	}
+
+	if errMigrate := r.migrate(ctx, instance, previous); errMigrate != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError",
+			"Failed migrating applications from namespace %s to %s", previous, target)
+		_, errUpdate := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+			setMigrationCondition(&saved.Status.Conditions, corev1.ConditionFalse, status.MigrationFailedReason, errMigrate.Error())
+		})
+		if errUpdate != nil {
+			r.Log.Error(errUpdate, "failed to update DSCInitialization status after failed migration")
+		}
+
+		return instance, errMigrate
+	}
+
+	return status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		saved.Status.ApplicationsNamespace = target
+		setMigrationCondition(&saved.Status.Conditions, corev1.ConditionTrue, status.MigrationCompletedReason,
+			fmt.Sprintf("Applications migrated from namespace %s to %s", previous, target))
+	})
+}
+
+func (r *DSCInitializationReconciler) migrate(ctx context.Context, instance *dsciv1.DSCInitialization, previous string) error {
+	target := instance.Spec.ApplicationsNamespace
+
+	if err := r.copySharedResources(ctx, previous, target); err != nil {
+		return err
+	}
+
+	// Features are tracked per applications namespace, so the ones created for the previous namespace
+	// have to be removed using the spec they have been created with.
+	previousInstance := instance.DeepCopy()
+	previousInstance.Spec.ApplicationsNamespace = previous
+	if err := r.removeServiceMesh(ctx, previousInstance); err != nil {
+		return fmt.Errorf("failed removing service mesh features for namespace %s: %w", previous, err)
+	}
+
+	obsolete := []string{previous}
+	if instance.Spec.ServiceMesh != nil && instance.Spec.ServiceMesh.Auth.Namespace == "" {
+		obsolete = append(obsolete, servicemesh.AuthNamespace(&previousInstance.Spec))
+	}
+
+	for _, namespace := range obsolete {
+		if namespaceInUse(&instance.Spec, namespace) {
+			r.Log.Info("Keeping previous namespace as it is still in use", "name", namespace)
+			continue
+		}
+
+		if err := r.deleteGeneratedNamespace(ctx, namespace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copySharedResources copies ConfigMaps and Secrets labeled as shared resources from one namespace to another.
+// Resources which already exist in the target namespace are left untouched.
+func (r *DSCInitializationReconciler) copySharedResources(ctx context.Context, from, to string) error {
+	sharedOnly := []client.ListOption{client.InNamespace(from), client.MatchingLabels{labels.ODH.SharedResource: "true"}}
+
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.Client.List(ctx, configMaps, sharedOnly...); err != nil {
+		return fmt.Errorf("failed listing shared configmaps in namespace %s: %w", from, err)
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := r.Client.List(ctx, secrets, sharedOnly...); err != nil {
+		return fmt.Errorf("failed listing shared secrets in namespace %s: %w", from, err)
+	}
+
+	copies := make([]client.Object, 0, len(configMaps.Items)+len(secrets.Items))
+	for i := range configMaps.Items {
+		source := &configMaps.Items[i]
+		copies = append(copies, &corev1.ConfigMap{
+			ObjectMeta: copyMeta(source.ObjectMeta, to),
+			Data:       source.Data,
+			BinaryData: source.BinaryData,
+		})
+	}
+	for i := range secrets.Items {
+		source := &secrets.Items[i]
+		copies = append(copies, &corev1.Secret{
+			ObjectMeta: copyMeta(source.ObjectMeta, to),
+			Type:       source.Type,
+			Data:       source.Data,
+		})
+	}
+
+	for _, obj := range copies {
+		if err := r.Client.Create(ctx, obj); client.IgnoreAlreadyExists(err) != nil {
+			return fmt.Errorf("failed copying %s to namespace %s: %w", obj.GetName(), to, err)
+		}
+	}
+
+	return nil
+}
+
+func copyMeta(source metav1.ObjectMeta, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        source.Name,
+		Namespace:   namespace,
+		Labels:      source.Labels,
+		Annotations: source.Annotations,
+	}
+}
+
+// deleteGeneratedNamespace deletes the namespace, and everything it contains, only if it has been created by the operator.
+func (r *DSCInitializationReconciler) deleteGeneratedNamespace(ctx context.Context, name string) error {
+	namespace := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: name}, namespace); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if namespace.GetLabels()[labels.ODH.OwnedNamespace] != "true" {
+		r.Log.Info("Keeping previous namespace as it has not been created by the operator", "name", name)
+		return nil
+	}
+
+	r.Log.Info("Deleting previous namespace", "name", name)
+	if err := r.Client.Delete(ctx, namespace); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed deleting namespace %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// namespaceInUse checks if the namespace is still referenced by the current configuration.
+func namespaceInUse(spec *dsciv1.DSCInitializationSpec, namespace string) bool {
+	if namespace == spec.ApplicationsNamespace || namespace == spec.Monitoring.Namespace {
+		return true
+	}
+
+	if spec.ServiceMesh != nil {
+		return namespace == spec.ServiceMesh.ControlPlane.Namespace || namespace == servicemesh.AuthNamespace(spec)
+	}
+
+	return false
+}
+
+func setMigrationCondition(conditions *[]conditionsv1.Condition, conditionStatus corev1.ConditionStatus, reason, message string) {
+	conditionsv1.SetStatusCondition(conditions, conditionsv1.Condition{
+		Type:    status.ConditionApplicationsNamespaceMigrated,
+		Status:  conditionStatus,
+		Reason:  reason,
+		Message: message,
+	})
+}
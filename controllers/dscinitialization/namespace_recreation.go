@@ -0,0 +1,143 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// NamespaceRecreationReconciler requests re-applying features which created resources in a namespace that has been deleted
+// and created again under the same name, e.g. a namespace enrolled in the mesh losing its ServiceMeshMember, network policies
+// and labels. Features of DSCInitialization are re-applied right away through FeatureReapplyReconciler, without waiting
+// for the full reconciliation.
+type NamespaceRecreationReconciler struct {
+	Client   client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+}
+
+func (r *NamespaceRecreationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	namespace := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, req.NamespacedName, namespace); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !namespace.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	trackers := &featurev1.FeatureTrackerList{}
+	if err := r.Client.List(ctx, trackers); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed listing feature trackers: %w", err)
+	}
+
+	var multiErr *multierror.Error
+	for i := range trackers.Items {
+		tracker := &trackers.Items[i]
+		if !feature.RecreatedNamespaceOf(tracker, namespace) || reapplyRequested(tracker) {
+			continue
+		}
+
+		if tracker.Spec.Source.Type != featurev1.DSCIType {
+			r.Log.Info("Namespace recreated, feature is re-applied when its source is reconciled",
+				"namespace", namespace.Name, "feature", tracker.FeatureName(), "source", tracker.Spec.Source)
+			if err := r.reportPendingReapply(ctx, tracker, namespace); err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("failed reporting recreated namespace of feature %s: %w", tracker.FeatureName(), err))
+
+				continue
+			}
+			r.Recorder.Eventf(tracker, corev1.EventTypeNormal, "NamespaceRecreated",
+				"Namespace %s has been recreated, the feature is re-applied when %s %s is reconciled",
+				namespace.Name, tracker.Spec.Source.Type, tracker.Spec.Source.Name)
+
+			continue
+		}
+
+		r.Log.Info("Namespace recreated, re-applying feature", "namespace", namespace.Name, "feature", tracker.FeatureName())
+		if err := r.requestReapply(ctx, tracker); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("failed requesting re-apply of feature %s: %w", tracker.FeatureName(), err))
+
+			continue
+		}
+		r.Recorder.Eventf(tracker, corev1.EventTypeNormal, "NamespaceRecreated",
+			"Namespace %s has been recreated, re-applying the feature", namespace.Name)
+	}
+
+	return ctrl.Result{}, multiErr.ErrorOrNil()
+}
+
+func (r *NamespaceRecreationReconciler) requestReapply(ctx context.Context, tracker *featurev1.FeatureTracker) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &featurev1.FeatureTracker{}
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(tracker), current); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		trackerAnnotations := current.GetAnnotations()
+		if trackerAnnotations == nil {
+			trackerAnnotations = map[string]string{}
+		}
+		trackerAnnotations[annotations.Reapply] = "true"
+		current.SetAnnotations(trackerAnnotations)
+
+		return r.Client.Update(ctx, current)
+	})
+}
+
+// reportPendingReapply marks the feature of other sources than DSCInitialization, e.g. of a component, as degraded in the status
+// of its FeatureTracker until the source is reconciled and the feature is applied again, which resets the conditions.
+func (r *NamespaceRecreationReconciler) reportPendingReapply(ctx context.Context, tracker *featurev1.FeatureTracker, namespace *corev1.Namespace) error {
+	_, err := status.UpdateWithRetry(ctx, r.Client, tracker, func(saved *featurev1.FeatureTracker) {
+		message := fmt.Sprintf("Namespace %s has been recreated, the feature is re-applied when %s %s is reconciled",
+			namespace.Name, saved.Spec.Source.Type, saved.Spec.Source.Name)
+		status.SetCondition(&saved.Status.Conditions, string(conditionsv1.ConditionDegraded),
+			string(featurev1.ConditionReason.NamespaceRecreated), message, corev1.ConditionTrue)
+		saved.Summarize()
+	})
+
+	return client.IgnoreNotFound(err)
+}
+
+// namespaceCreatedPredicate passes creations only, which includes namespaces listed when the operator starts,
+// so that namespaces recreated while it was not running are caught as well.
+var namespaceCreatedPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return true
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NamespaceRecreationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("namespace-recreation").
+		For(
+			&corev1.Namespace{},
+			builder.WithPredicates(namespaceCreatedPredicate),
+		).
+		Complete(r)
+}
@@ -0,0 +1,86 @@
+package dscinitialization_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recreated namespaces", func() {
+
+	var (
+		cli        client.Client
+		recorder   *record.FakeRecorder
+		reconciler *dscictrl.NamespaceRecreationReconciler
+	)
+
+	trackerOf := func(sourceType featurev1.OwnerType, featureName string) *featurev1.FeatureTracker {
+		tracker := featurev1.NewFeatureTracker(featureName, "opendatahub")
+		tracker.Spec.Source = featurev1.Source{Type: sourceType, Name: "default"}
+		tracker.Status.Namespaces = []featurev1.TrackedNamespace{{Name: "ds-project", UID: types.UID("deleted")}}
+
+		return tracker
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ds-project", UID: types.UID("recreated")}}
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(namespace, trackerOf(featurev1.DSCIType, "mesh-shared-configmap"), trackerOf(featurev1.ComponentType, "kserve-external-authz")).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			Build()
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &dscictrl.NamespaceRecreationReconciler{Client: cli, Log: logr.Discard(), Recorder: recorder}
+	})
+
+	It("should request re-applying features of DSCInitialization", func(ctx context.Context) {
+		// when
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "ds-project"}})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		tracker := &featurev1.FeatureTracker{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "opendatahub-mesh-shared-configmap"}, tracker)).To(Succeed())
+		Expect(tracker.GetAnnotations()).To(HaveKeyWithValue(annotations.Reapply, "true"))
+	})
+
+	It("should report features of components in the status of their trackers until the component is reconciled", func(ctx context.Context) {
+		// when
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "ds-project"}})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		tracker := &featurev1.FeatureTracker{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "opendatahub-kserve-external-authz"}, tracker)).To(Succeed())
+		Expect(tracker.GetAnnotations()).ToNot(HaveKey(annotations.Reapply))
+		Expect(tracker.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", conditionsv1.ConditionDegraded),
+			HaveField("Status", corev1.ConditionTrue),
+			HaveField("Reason", string(featurev1.ConditionReason.NamespaceRecreated)),
+			HaveField("Message", ContainSubstring("Component default")),
+		)))
+		Expect(tracker.Status.Summary.Message).To(ContainSubstring("Namespace ds-project has been recreated"))
+		Expect(recorder.Events).To(HaveLen(2))
+	})
+})
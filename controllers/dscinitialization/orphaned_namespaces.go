@@ -0,0 +1,156 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/workbenches"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// OrphanedNamespacesDetector periodically reports namespaces which are orphaned, see FindOrphanedNamespaces,
+// in status.orphanedNamespaces of DSCInitialization, and deletes them when spec.orphanedNamespaces.policy is Delete.
+// It is meant to be added to the manager.
+type OrphanedNamespacesDetector struct {
+	Client client.Client
+	Log    logr.Logger
+	Period time.Duration
+}
+
+// Start reports orphaned namespaces right away and then every Period, until the context is done. Failures are logged and retried in the next period.
+func (d *OrphanedNamespacesDetector) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := ReportOrphanedNamespaces(ctx, d.Client, d.Log); err != nil {
+			d.Log.Error(err, "failed reporting orphaned namespaces")
+		}
+	}, d.Period)
+
+	return nil
+}
+
+// NeedLeaderElection makes only the leader report, and possibly delete, orphaned namespaces.
+func (d *OrphanedNamespacesDetector) NeedLeaderElection() bool {
+	return true
+}
+
+// ReportOrphanedNamespaces sets orphaned namespaces in status of the active DSCInitialization, after deleting them
+// if its policy says so.
+func ReportOrphanedNamespaces(ctx context.Context, cli client.Client, log logr.Logger) error {
+	instances := &dsciv1.DSCInitializationList{}
+	if err := cli.List(ctx, instances); err != nil {
+		return fmt.Errorf("failed listing DSCInitialization instances: %w", err)
+	}
+
+	instance := instances.ActiveInstance()
+	if instance == nil || !instance.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+
+	orphaned, err := FindOrphanedNamespaces(ctx, cli, instance)
+	if err != nil {
+		return err
+	}
+
+	if instance.Spec.OrphanedNamespaces != nil && instance.Spec.OrphanedNamespaces.Policy == dsciv1.DeleteOrphanedNamespaces {
+		for _, namespace := range orphaned {
+			log.Info("Deleting orphaned namespace", "name", namespace)
+			if errDelete := cli.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}); client.IgnoreNotFound(errDelete) != nil {
+				return fmt.Errorf("failed deleting orphaned namespace %s: %w", namespace, errDelete)
+			}
+		}
+		orphaned = nil
+	} else if len(orphaned) > 0 {
+		log.Info("Found orphaned namespaces", "names", orphaned)
+	}
+
+	if slices.Equal(instance.Status.OrphanedNamespaces, orphaned) {
+		return nil
+	}
+
+	_, err = status.UpdateWithRetry(ctx, cli, instance, func(saved *dsciv1.DSCInitialization) {
+		saved.Status.OrphanedNamespaces = orphaned
+	})
+
+	return err
+}
+
+// FindOrphanedNamespaces lists namespaces created by the operator, i.e. labeled with labels.ODH.OwnedNamespace, which are no longer
+// used by any capability or feature, e.g. the previous applications namespace after it has been renamed. Namespaces created by
+// features are orphaned when the FeatureTracker of the feature is gone. Namespaces being deleted are not listed.
+func FindOrphanedNamespaces(ctx context.Context, cli client.Client, instance *dsciv1.DSCInitialization) ([]string, error) {
+	namespaces := &corev1.NamespaceList{}
+	if err := cli.List(ctx, namespaces, client.MatchingLabels{labels.ODH.OwnedNamespace: "true"}); err != nil {
+		return nil, fmt.Errorf("failed listing namespaces created by the operator: %w", err)
+	}
+
+	trackers := &featurev1.FeatureTrackerList{}
+	if err := cli.List(ctx, trackers); err != nil {
+		return nil, fmt.Errorf("failed listing feature trackers: %w", err)
+	}
+	trackedFeatures := make(map[string]bool, len(trackers.Items))
+	for i := range trackers.Items {
+		trackedFeatures[trackers.Items[i].FeatureName()] = true
+	}
+
+	notebooksInUse, err := workbenchesManaged(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	inUse := func(namespace *corev1.Namespace) bool {
+		if featureName, createdByFeature := namespace.GetLabels()[labels.ODH.Feature]; createdByFeature {
+			return trackedFeatures[featureName]
+		}
+
+		name := namespace.GetName()
+		if namespaceInUse(&instance.Spec, name) || name == instance.Status.ApplicationsNamespace {
+			return true
+		}
+
+		if instance.Spec.CanaryRollout != nil && name == instance.Spec.CanaryRollout.Namespace {
+			return true
+		}
+
+		return notebooksInUse && name == workbenches.NotebooksNamespace
+	}
+
+	var orphaned []string
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		if namespace.GetDeletionTimestamp().IsZero() && !inUse(namespace) {
+			orphaned = append(orphaned, namespace.GetName())
+		}
+	}
+	slices.Sort(orphaned)
+
+	return orphaned, nil
+}
+
+// workbenchesManaged checks if workbenches are Managed in any DataScienceCluster, which then use the notebooks namespace.
+func workbenchesManaged(ctx context.Context, cli client.Client) (bool, error) {
+	dscList := &dscv1.DataScienceClusterList{}
+	if err := cli.List(ctx, dscList); err != nil {
+		return false, fmt.Errorf("failed listing DataScienceCluster instances: %w", err)
+	}
+
+	for i := range dscList.Items {
+		if dscList.Items[i].Spec.Components.Workbenches.GetManagementState() == operatorv1.Managed {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,124 @@
+package dscinitialization_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Orphaned namespaces", func() {
+
+	clientWith := func(objects ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		utilruntime.Must(dsciv1.AddToScheme(scheme))
+		utilruntime.Must(dscv1.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(&dsciv1.DSCInitialization{}).Build()
+	}
+
+	generatedNamespace := func(name string, extraLabels ...string) *corev1.Namespace {
+		namespaceLabels := map[string]string{labels.ODH.OwnedNamespace: "true"}
+		for i := 0; i+1 < len(extraLabels); i += 2 {
+			namespaceLabels[extraLabels[i]] = extraLabels[i+1]
+		}
+
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: namespaceLabels}}
+	}
+
+	dsci := func(policy dsciv1.OrphanedNamespacesPolicy) *dsciv1.DSCInitialization {
+		return &dsciv1.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec: dsciv1.DSCInitializationSpec{
+				ApplicationsNamespace: "opendatahub",
+				Monitoring:            dsciv1.Monitoring{ManagementState: operatorv1.Managed, Namespace: "opendatahub"},
+				OrphanedNamespaces:    &dsciv1.OrphanedNamespaces{Policy: policy},
+			},
+		}
+	}
+
+	It("should find namespaces no longer used by capabilities or features", func(ctx context.Context) {
+		// given
+		tracker := featurev1.NewFeatureTracker("mesh-control-plane-external-authz", "opendatahub")
+		tracker.Spec.AppNamespace = "opendatahub"
+		instance := dsci(dsciv1.ReportOrphanedNamespaces)
+		cli := clientWith(
+			instance,
+			tracker,
+			generatedNamespace("opendatahub"),
+			generatedNamespace("renamed-applications"),
+			generatedNamespace("rhods-notebooks"),
+			generatedNamespace("opendatahub-auth-provider", labels.ODH.Feature, "mesh-control-plane-external-authz"),
+			generatedNamespace("removed-feature-namespace", labels.ODH.Feature, "removed-feature"),
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "user-namespace"}},
+		)
+
+		// when
+		orphaned, err := dscictrl.FindOrphanedNamespaces(ctx, cli, instance)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orphaned).To(Equal([]string{"removed-feature-namespace", "renamed-applications", "rhods-notebooks"}))
+	})
+
+	It("should keep notebooks namespace while workbenches are managed", func(ctx context.Context) {
+		// given
+		instance := dsci(dsciv1.ReportOrphanedNamespaces)
+		dsc := &dscv1.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"}}
+		dsc.Spec.Components.Workbenches.ManagementState = operatorv1.Managed
+		cli := clientWith(instance, dsc, generatedNamespace("rhods-notebooks"))
+
+		// when
+		orphaned, err := dscictrl.FindOrphanedNamespaces(ctx, cli, instance)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orphaned).To(BeEmpty())
+	})
+
+	It("should report orphaned namespaces in status", func(ctx context.Context) {
+		// given
+		instance := dsci(dsciv1.ReportOrphanedNamespaces)
+		cli := clientWith(instance, generatedNamespace("renamed-applications"))
+
+		// when
+		Expect(dscictrl.ReportOrphanedNamespaces(ctx, cli, logr.Discard())).To(Succeed())
+
+		// then
+		reported := &dsciv1.DSCInitialization{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(instance), reported)).To(Succeed())
+		Expect(reported.Status.OrphanedNamespaces).To(ConsistOf("renamed-applications"))
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "renamed-applications"}, &corev1.Namespace{})).To(Succeed())
+	})
+
+	It("should delete orphaned namespaces when the policy says so", func(ctx context.Context) {
+		// given
+		instance := dsci(dsciv1.DeleteOrphanedNamespaces)
+		cli := clientWith(instance, generatedNamespace("renamed-applications"))
+
+		// when
+		Expect(dscictrl.ReportOrphanedNamespaces(ctx, cli, logr.Discard())).To(Succeed())
+
+		// then
+		err := cli.Get(ctx, client.ObjectKey{Name: "renamed-applications"}, &corev1.Namespace{})
+		Expect(client.IgnoreNotFound(err)).To(Succeed())
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,137 @@
+package dscinitialization
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/kserve"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// PreconditionsSchedule remembers which specs preconditions of each DSCInitialization have been evaluated for and when.
+// Evaluation sends dry-run requests, e.g. namespace creates, to the API server, so it is only repeated when the specs
+// change or the resync period elapses, rather than on every reconcile.
+type PreconditionsSchedule struct {
+	mu        sync.Mutex
+	evaluated map[string]preconditionsEvaluation
+}
+
+type preconditionsEvaluation struct {
+	specs string
+	at    time.Time
+}
+
+func NewPreconditionsSchedule() *PreconditionsSchedule {
+	return &PreconditionsSchedule{evaluated: map[string]preconditionsEvaluation{}}
+}
+
+// Due tells whether preconditions of the DSCInitialization have to be evaluated, that is when they have not been evaluated
+// for the specs yet, or the period has elapsed since the last evaluation. Zero period disables re-evaluation of unchanged specs.
+func (s *PreconditionsSchedule) Due(name, specs string, period time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last, found := s.evaluated[name]
+	if !found || last.specs != specs {
+		return true
+	}
+
+	return period > 0 && time.Since(last.at) >= period
+}
+
+// Evaluated records that preconditions of the DSCInitialization have been evaluated for the specs.
+func (s *PreconditionsSchedule) Evaluated(name, specs string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evaluated[name] = preconditionsEvaluation{specs: specs, at: time.Now()}
+}
+
+// reportPreconditions evaluates prerequisites of all enabled features of DSCInitialization and of the KServe component before
+// applying any of them and stores the outcome in the status when it differs from the reported one, so that all missing
+// prerequisites are listed at once rather than surfacing one failed feature per reconcile. Evaluation is repeated only when
+// DSCInitialization or DataScienceCluster spec changes, or the capability resync period elapses.
+// Failures are only logged, as the report is informational.
+func (r *DSCInitializationReconciler) reportPreconditions(ctx context.Context, instance *dsciv1.DSCInitialization) *dsciv1.DSCInitialization {
+	dscList := &dscv1.DataScienceClusterList{}
+	if err := r.Client.List(ctx, dscList); err != nil {
+		r.Log.Error(err, "failed listing DataScienceClusters to evaluate preconditions of")
+
+		return instance
+	}
+
+	specs := fmt.Sprintf("%s/%d", instance.Name, instance.Generation)
+	var kserveComponent *kserve.Kserve
+	for i := range dscList.Items {
+		dsc := &dscList.Items[i]
+		specs += fmt.Sprintf(",%s/%d", dsc.Name, dsc.Generation)
+		if kserveComponent == nil && dsc.Spec.Components.Kserve.GetManagementState() == operatorv1.Managed {
+			kserveComponent = &dsc.Spec.Components.Kserve
+		}
+	}
+
+	if r.preconditionsSchedule == nil {
+		r.preconditionsSchedule = NewPreconditionsSchedule()
+	}
+	if !r.preconditionsSchedule.Due(instance.Name, specs, r.capabilityResyncPeriod(instance)) {
+		return instance
+	}
+
+	providers, err := r.featuresProviders(ctx, instance)
+	if err != nil {
+		r.Log.Error(err, "failed resolving features to evaluate preconditions of")
+
+		return instance
+	}
+
+	evaluated, err := feature.ClusterFeaturesHandler(instance, append(providers, consoleIntegrationFeatures(instance), servingCertificatesFeatures(instance))...).
+		EvaluatePreconditions(ctx)
+	if err != nil {
+		r.Log.Error(err, "failed evaluating preconditions of features")
+
+		return instance
+	}
+
+	if kserveComponent != nil {
+		kserveEvaluated, errKserve := kserveComponent.EvaluatePreconditions(ctx, r.Client, &instance.Spec)
+		if errKserve != nil {
+			r.Log.Error(errKserve, "failed evaluating preconditions of features", "component", kserveComponent.GetComponentName())
+
+			return instance
+		}
+		evaluated = append(evaluated, kserveEvaluated...)
+		slices.SortStableFunc(evaluated, func(a, b dsciv1.PreconditionStatus) int {
+			if a.Capability != b.Capability {
+				return cmp.Compare(a.Capability, b.Capability)
+			}
+
+			return cmp.Compare(a.Feature, b.Feature)
+		})
+	}
+
+	if !equality.Semantic.DeepEqual(evaluated, instance.Status.Preconditions) {
+		updated, errUpdate := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+			saved.Status.Preconditions = evaluated
+		})
+		if errUpdate != nil {
+			r.Log.Error(errUpdate, "failed reporting preconditions of features")
+
+			return instance
+		}
+		instance = updated
+	}
+
+	r.preconditionsSchedule.Evaluated(instance.Name, specs)
+
+	return instance
+}
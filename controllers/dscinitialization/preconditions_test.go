@@ -0,0 +1,72 @@
+package dscinitialization_test
+
+import (
+	"time"
+
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Preconditions schedule", func() {
+
+	var schedule *dscictrl.PreconditionsSchedule
+
+	BeforeEach(func() {
+		schedule = dscictrl.NewPreconditionsSchedule()
+	})
+
+	It("should evaluate preconditions which have not been evaluated yet", func() {
+		// when
+		due := schedule.Due("default-dsci", "default-dsci/1", time.Hour)
+
+		// then
+		Expect(due).To(BeTrue())
+	})
+
+	It("should not evaluate preconditions again for unchanged specs within the resync period", func() {
+		// given
+		schedule.Evaluated("default-dsci", "default-dsci/1,default-dsc/3")
+
+		// when
+		due := schedule.Due("default-dsci", "default-dsci/1,default-dsc/3", time.Hour)
+
+		// then
+		Expect(due).To(BeFalse())
+	})
+
+	It("should evaluate preconditions again when the specs change", func() {
+		// given
+		schedule.Evaluated("default-dsci", "default-dsci/1,default-dsc/3")
+
+		// when
+		due := schedule.Due("default-dsci", "default-dsci/1,default-dsc/4", time.Hour)
+
+		// then
+		Expect(due).To(BeTrue())
+	})
+
+	It("should evaluate preconditions again once the resync period elapses", func() {
+		// given
+		schedule.Evaluated("default-dsci", "default-dsci/1")
+		time.Sleep(10 * time.Millisecond)
+
+		// when
+		due := schedule.Due("default-dsci", "default-dsci/1", 5*time.Millisecond)
+
+		// then
+		Expect(due).To(BeTrue())
+	})
+
+	It("should not re-evaluate unchanged specs when resync is disabled", func() {
+		// given
+		schedule.Evaluated("default-dsci", "default-dsci/1")
+
+		// when
+		due := schedule.Due("default-dsci", "default-dsci/1", 0)
+
+		// then
+		Expect(due).To(BeFalse())
+	})
+})
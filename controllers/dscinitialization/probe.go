@@ -0,0 +1,69 @@
+package dscinitialization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+const cacheSyncTimeout = time.Second
+
+// CacheSyncWaiter is implemented by the manager cache, which informers of all the controllers are started from.
+type CacheSyncWaiter interface {
+	WaitForCacheSync(ctx context.Context) bool
+}
+
+// ReadinessCheck reports the operator as ready once caches of its controllers are synced.
+func ReadinessCheck(cache CacheSyncWaiter) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), cacheSyncTimeout)
+		defer cancel()
+
+		if !cache.WaitForCacheSync(ctx) {
+			return errors.New("caches of controllers are not synced yet")
+		}
+
+		return nil
+	}
+}
+
+// CapabilitiesCheck reports the operator as not ready when any of the fatal capabilities of the active DSCInitialization failed,
+// so that a broken upgrade stops the rollout. Capabilities which are missing an operator or are postponed until the maintenance
+// window opens are not considered failed, as they are caused by the environment rather than a broken operator.
+// Webhooks are served by pods which are not ready as well, so the change of DSCInitialization fixing the capability is admitted.
+func CapabilitiesCheck(cli client.Reader, fatalCapabilities ...conditionsv1.ConditionType) healthz.Checker {
+	return func(req *http.Request) error {
+		if len(fatalCapabilities) == 0 {
+			return nil
+		}
+
+		instances := &dsciv1.DSCInitializationList{}
+		if err := cli.List(req.Context(), instances); err != nil {
+			return fmt.Errorf("failed listing DSCInitialization instances: %w", err)
+		}
+
+		instance := instances.ActiveInstance()
+		if instance == nil {
+			return nil
+		}
+
+		for _, capability := range fatalCapabilities {
+			condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, capability)
+			if condition != nil && condition.Status == corev1.ConditionFalse && condition.Reason == status.CapabilityFailed {
+				return fmt.Errorf("capability %s of DSCInitialization %s failed: %s", capability, instance.Name, condition.Message)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,88 @@
+package dscinitialization_test
+
+import (
+	"context"
+	"net/http"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type syncedCache bool
+
+func (s syncedCache) WaitForCacheSync(_ context.Context) bool {
+	return bool(s)
+}
+
+var _ = Describe("Readiness and capabilities checks", func() {
+
+	dsciWithCapability := func(reason string) *dsciv1.DSCInitialization {
+		return &dsciv1.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Status: dsciv1.DSCInitializationStatus{
+				Conditions: []conditionsv1.Condition{{
+					Type:    status.CapabilityServiceMesh,
+					Status:  corev1.ConditionFalse,
+					Reason:  reason,
+					Message: "failed applying service mesh resources",
+				}},
+			},
+		}
+	}
+
+	request := func(ctx context.Context) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/readyz", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		return req
+	}
+
+	check := func(ctx context.Context, dsci *dsciv1.DSCInitialization, fatal ...conditionsv1.ConditionType) error {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(dsciv1.AddToScheme(scheme))
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dsci).Build()
+
+		return dscictrl.CapabilitiesCheck(cli, fatal...)(request(ctx))
+	}
+
+	It("should not be ready until caches are synced", func(ctx context.Context) {
+		Expect(dscictrl.ReadinessCheck(syncedCache(false))(request(ctx))).ToNot(Succeed())
+	})
+
+	It("should be ready once caches are synced", func(ctx context.Context) {
+		Expect(dscictrl.ReadinessCheck(syncedCache(true))(request(ctx))).To(Succeed())
+	})
+
+	DescribeTable("capability state",
+		func(ctx context.Context, reason string, fatal []conditionsv1.ConditionType, healthy bool) {
+			// when
+			err := check(ctx, dsciWithCapability(reason), fatal...)
+
+			// then
+			if healthy {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring("capability CapabilityServiceMesh of DSCInitialization default-dsci failed")))
+			}
+		},
+		Entry("should fail when fatal capability failed",
+			status.CapabilityFailed, []conditionsv1.ConditionType{status.CapabilityServiceMesh}, false),
+		Entry("should pass when failed capability is not fatal",
+			status.CapabilityFailed, []conditionsv1.ConditionType{status.CapabilityServiceMeshAuthorization}, true),
+		Entry("should pass when no capability is fatal",
+			status.CapabilityFailed, nil, true),
+		Entry("should pass when fatal capability is missing operator",
+			status.MissingOperatorReason, []conditionsv1.ConditionType{status.CapabilityServiceMesh}, true),
+	)
+})
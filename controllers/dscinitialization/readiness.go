@@ -0,0 +1,74 @@
+package dscinitialization
+
+import (
+	"context"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// readinessAggregator defines which conditions have to be satisfied for DSCInitialization to be Ready.
+// Service Mesh capabilities are only required when there is a serving component relying on them.
+func readinessAggregator(instance *dsciv1.DSCInitialization, servingManaged bool) *status.ConditionAggregator {
+	meshRequired := func() bool {
+		return servingManaged && serviceMeshTracked(&instance.Spec)
+	}
+
+	return status.NewConditionAggregator(
+		status.Always(status.ConditionReconcileComplete),
+		status.RequiredWhen(status.CapabilityServiceMesh, meshRequired),
+		status.RequiredWhen(status.CapabilityServiceMeshAuthorization, meshRequired),
+	)
+}
+
+// isServingManaged checks if any of the model serving components is Managed in the DataScienceCluster.
+func (r *DSCInitializationReconciler) isServingManaged(ctx context.Context) (bool, error) {
+	dscList := &dscv1.DataScienceClusterList{}
+	if err := r.Client.List(ctx, dscList); err != nil {
+		return false, err
+	}
+
+	for _, dsc := range dscList.Items {
+		components := dsc.Spec.Components
+		if components.Kserve.GetManagementState() == operatorv1.Managed || components.ModelMeshServing.GetManagementState() == operatorv1.Managed {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// updateReadyCondition rolls up conditions reported so far into the top-level Ready condition. Conditions of capabilities are
+// written by the StatusUpdater in the background, so Ready is then queued to it as well, and computed when they are written.
+func (r *DSCInitializationReconciler) updateReadyCondition(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	servingManaged, err := r.isServingManaged(ctx)
+	if err != nil {
+		return err
+	}
+
+	setReady := func(saved *dsciv1.DSCInitialization) {
+		status.SetReadyCondition(&saved.Status.Conditions, readinessAggregator(saved, servingManaged))
+		if !conditionsv1.IsStatusConditionTrue(saved.Status.Conditions, status.ConditionReady) {
+			return
+		}
+		if readyAfter, timed := r.ReadyTimer.Ready(saved, saved.Status.ReadyGeneration); timed {
+			saved.Status.LastReadyDuration = &metav1.Duration{Duration: readyAfter}
+			saved.Status.ReadyGeneration = saved.Generation
+		}
+	}
+
+	if r.StatusUpdater != nil {
+		status.Enqueue(r.StatusUpdater, instance, setReady)
+
+		return nil
+	}
+
+	_, err = status.UpdateWithRetry(ctx, r.Client, instance, setReady)
+
+	return err
+}
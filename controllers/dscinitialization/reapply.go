@@ -0,0 +1,150 @@
+package dscinitialization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+const reapplyInProgressRequeue = 30 * time.Second
+
+// FeatureReapplyReconciler re-applies the feature of a FeatureTracker annotated with annotations.Reapply, without
+// reconciling all the features of the DSCInitialization. It is useful when a single feature drifted.
+type FeatureReapplyReconciler struct {
+	Client   client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+	// DSCInitialization reconciler is used to define the features of DSCInitialization capabilities.
+	DSCInitialization *DSCInitializationReconciler
+}
+
+func (r *FeatureReapplyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	tracker := &featurev1.FeatureTracker{}
+	if err := r.Client.Get(ctx, req.NamespacedName, tracker); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !reapplyRequested(tracker) {
+		return ctrl.Result{}, nil
+	}
+
+	r.Log.Info("Re-applying feature", "feature", tracker.FeatureName(), "source", tracker.Spec.Source)
+
+	var reapplyErr error
+	switch tracker.Spec.Source.Type {
+	case featurev1.DSCIType:
+		reapplyErr = r.reapplyDSCIFeature(ctx, tracker)
+	default:
+		reapplyErr = fmt.Errorf("re-applying features of %s %s is not supported, they are applied when it is reconciled",
+			tracker.Spec.Source.Type, tracker.Spec.Source.Name)
+	}
+
+	if feature.IsApplyInProgress(reapplyErr) {
+		r.Log.Info("Feature is being applied, re-apply postponed", "feature", tracker.FeatureName(), "reason", reapplyErr.Error())
+
+		return ctrl.Result{RequeueAfter: reapplyInProgressRequeue}, nil
+	}
+
+	// Result is reported in the FeatureTracker status, re-apply is not retried until requested again.
+	if reapplyErr != nil {
+		r.Log.Error(reapplyErr, "failed re-applying feature", "feature", tracker.FeatureName())
+		r.Recorder.Eventf(tracker, corev1.EventTypeWarning, "FeatureReapplyError", "Failed re-applying feature: %v", reapplyErr)
+	}
+
+	return ctrl.Result{}, r.removeReapplyAnnotation(ctx, tracker)
+}
+
+func (r *FeatureReapplyReconciler) reapplyDSCIFeature(ctx context.Context, tracker *featurev1.FeatureTracker) error {
+	instance := &dsciv1.DSCInitialization{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: tracker.Spec.Source.Name}, instance); err != nil {
+		return fmt.Errorf("failed getting DSCInitialization %s defining the feature: %w", tracker.Spec.Source.Name, err)
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return fmt.Errorf("DSCInitialization %s defining the feature is being deleted", instance.Name)
+	}
+
+	providers, err := r.DSCInitialization.featuresProviders(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	err = feature.ClusterFeaturesHandler(instance, providers...).ApplyOnly(ctx, tracker.FeatureName())
+	if errors.Is(err, feature.ErrFeatureNotDefined) {
+		return fmt.Errorf("feature %s is not configured by DSCInitialization %s: %w", tracker.FeatureName(), instance.Name, err)
+	}
+
+	return err
+}
+
+// featuresProviders returns the providers of all the features of DSCInitialization capabilities which are Managed.
+func (r *DSCInitializationReconciler) featuresProviders(ctx context.Context, instance *dsciv1.DSCInitialization) ([]feature.FeaturesProvider, error) {
+	providers := []feature.FeaturesProvider{featureAlertsFeatures(instance)}
+
+	if instance.Spec.ServiceMesh == nil || instance.Spec.ServiceMesh.ManagementState != operatorv1.Managed {
+		return providers, nil
+	}
+
+	meshTemplates, err := TemplatesLocation(ctx, instance.Spec.DevFlags, dsciv1.ServiceMeshTemplates)
+	if err != nil {
+		return nil, err
+	}
+	authzTemplates, err := TemplatesLocation(ctx, instance.Spec.DevFlags, dsciv1.AuthorinoTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(providers, r.serviceMeshCapabilityFeatures(instance, meshTemplates), r.authorizationFeatures(instance, authzTemplates, servicemesh.NewAuthorinoLookup(instance.Spec.ServiceMesh))), nil
+}
+
+func (r *FeatureReapplyReconciler) removeReapplyAnnotation(ctx context.Context, tracker *featurev1.FeatureTracker) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &featurev1.FeatureTracker{}
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(tracker), current); err != nil {
+			// Tracker is gone when re-applying disabled feature, which cleans it up.
+			return client.IgnoreNotFound(err)
+		}
+
+		if _, found := current.GetAnnotations()[annotations.Reapply]; !found {
+			return nil
+		}
+
+		trackerAnnotations := current.GetAnnotations()
+		delete(trackerAnnotations, annotations.Reapply)
+		current.SetAnnotations(trackerAnnotations)
+
+		return r.Client.Update(ctx, current)
+	})
+}
+
+func reapplyRequested(obj client.Object) bool {
+	return obj.GetAnnotations()[annotations.Reapply] == "true"
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FeatureReapplyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("feature-reapply").
+		For(
+			&featurev1.FeatureTracker{},
+			builder.WithPredicates(predicate.NewPredicateFuncs(reapplyRequested)),
+		).
+		Complete(r)
+}
@@ -0,0 +1,70 @@
+package dscinitialization_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature re-apply", func() {
+
+	const appNamespace = "opendatahub"
+
+	var recorder *record.FakeRecorder
+
+	reapplyTracker := func(featureName string, source featurev1.Source) *featurev1.FeatureTracker {
+		tracker := featurev1.NewFeatureTracker(featureName, appNamespace)
+		tracker.Spec = featurev1.FeatureTrackerSpec{Source: source, AppNamespace: appNamespace}
+		tracker.SetAnnotations(map[string]string{annotations.Reapply: "true"})
+
+		return tracker
+	}
+
+	reconcile := func(ctx context.Context, tracker *featurev1.FeatureTracker, objs ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(dsciv1.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, tracker)...).Build()
+
+		reconciler := &dscictrl.FeatureReapplyReconciler{
+			Client:            cli,
+			Log:               ctrl.Log.WithName("controllers").WithName("FeatureReapply"),
+			Recorder:          recorder,
+			DSCInitialization: &dscictrl.DSCInitializationReconciler{Client: cli},
+		}
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tracker)})
+		Expect(err).ToNot(HaveOccurred())
+
+		return cli
+	}
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should report that features of components are not re-applied out of band", func(ctx context.Context) {
+		// given
+		tracker := reapplyTracker("kserve-external-authz", featurev1.Source{Type: featurev1.ComponentType, Name: "kserve"})
+
+		// when
+		cli := reconcile(ctx, tracker)
+
+		// then
+		Expect(recorder.Events).To(Receive(ContainSubstring("re-applying features of Component kserve is not supported")))
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).To(Succeed())
+		Expect(tracker.GetAnnotations()).ToNot(HaveKey(annotations.Reapply))
+	})
+})
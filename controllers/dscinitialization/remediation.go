@@ -0,0 +1,112 @@
+package dscinitialization
+
+import (
+	"errors"
+	"strings"
+	"text/template"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// DocsURL is the base of the documentation links included in condition messages of the capabilities.
+const DocsURL = "https://github.com/opendatahub-io/opendatahub-operator/blob/main/README.md"
+
+// operatorInstallation tells where to find the operator a capability depends on in OperatorHub.
+type operatorInstallation struct {
+	Package     string
+	DisplayName string
+	Channel     string
+}
+
+// knownOperators lists operators from the compatibility matrix along with the channel tested with this operator.
+var knownOperators = map[string]operatorInstallation{
+	"servicemeshoperator": {Package: "servicemeshoperator", DisplayName: "Red Hat OpenShift Service Mesh", Channel: "stable"},
+	"authorino-operator":  {Package: "authorino-operator", DisplayName: "Authorino Operator", Channel: "stable"},
+	"serverless-operator": {Package: "serverless-operator", DisplayName: "Red Hat OpenShift Serverless", Channel: "stable"},
+}
+
+// remediation holds the template of the condition message for a reason of the failure, and the section of the documentation
+// describing it. Templates are executed with remediationData.
+type remediation struct {
+	message *template.Template
+	docs    string
+}
+
+// remediationData is available to the templates of condition messages.
+type remediationData struct {
+	// Message describes the failure, e.g. the error returned by the feature.
+	Message string
+	// Operator is set for MissingOperator failures.
+	Operator operatorInstallation
+	// TrackerVersion is the operator version which applied the feature, set for NewerOperatorVersion failures.
+	TrackerVersion string
+}
+
+var remediations = map[string]remediation{
+	status.MissingOperatorReason: newRemediation("dependent-operators",
+		`{{ .Message }}. Install {{ .Operator.DisplayName }} ({{ .Operator.Package }}) from OperatorHub`+
+			`{{ if .Operator.Channel }} using the {{ .Operator.Channel }} channel{{ end }}, or set the capability to Removed.`),
+	status.UnsupportedArchitectureReason: newRemediation("",
+		`{{ .Message }}. Add nodes of a supported architecture to the cluster, or set the capability to Removed.`),
+	status.UnsupportedInstallModeReason: newRemediation("install-modes",
+		`{{ .Message }}. Install the operator for all namespaces, or set the capability to Removed.`),
+	status.UnsupportedClusterVersionReason: newRemediation("cluster-version-requirements",
+		`{{ .Message }}. Upgrade the cluster to a supported OpenShift version, or set the capability to Removed.`),
+	status.VersionSkewReason: newRemediation("",
+		`{{ .Message }}. Install operator version {{ .TrackerVersion }} or newer again, capabilities are kept as applied until then.`),
+	status.ApplyTimeoutReason: newRemediation("",
+		`{{ .Message }}. Check that pods of the capability, e.g. of the control plane, become ready. It is applied again in the next reconciliation.`),
+	status.PolicyDeniedReason: newRemediation("admission-policies",
+		`{{ .Message }}. Exempt resources of the capability from the policy, e.g. using spec.policyExemptions.`),
+	status.CanaryFailedReason: newRemediation("canary-rollout",
+		`{{ .Message }}. Inspect resources in the canary namespace, capabilities are kept as applied by the previous operator version until the canary succeeds.`),
+	status.PendingMaintenanceWindow: newRemediation("maintenance-window",
+		`{{ .Message }}. Previously applied configuration is kept until then.`),
+}
+
+func newRemediation(docsSection, message string) remediation {
+	return remediation{
+		message: template.Must(template.New("remediation").Option("missingkey=error").Parse(message)),
+		docs:    docsSection,
+	}
+}
+
+// RemediationMessage builds the condition message for the failure of a capability reported with the given reason. The message
+// describing the failure is extended with actionable steps and a link to the documentation, when they are known for the reason.
+func RemediationMessage(reason, message string, err error) string {
+	fix, found := remediations[reason]
+	if !found {
+		return message
+	}
+
+	data := remediationData{Message: strings.TrimSuffix(message, ".")}
+
+	var missingOperatorErr *feature.MissingOperatorError
+	if errors.As(err, &missingOperatorErr) {
+		data.Operator = operatorInstallationOf(missingOperatorErr.OperatorName())
+	}
+	var skewErr *feature.VersionSkewError
+	if errors.As(err, &skewErr) {
+		data.TrackerVersion = skewErr.TrackerVersion.String()
+	}
+
+	var rendered strings.Builder
+	if errRender := fix.message.Execute(&rendered, data); errRender != nil {
+		return message
+	}
+
+	if fix.docs != "" {
+		rendered.WriteString(" See " + DocsURL + "#" + fix.docs)
+	}
+
+	return rendered.String()
+}
+
+func operatorInstallationOf(packageName string) operatorInstallation {
+	if known, found := knownOperators[packageName]; found {
+		return known
+	}
+
+	return operatorInstallation{Package: packageName, DisplayName: packageName}
+}
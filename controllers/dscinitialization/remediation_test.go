@@ -0,0 +1,77 @@
+package dscinitialization_test
+
+import (
+	"errors"
+	"fmt"
+
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Remediation of failing capabilities", func() {
+
+	It("should tell where to install the missing operator from", func() {
+		// given
+		err := fmt.Errorf("failed to find the pre-requisite operator subscription %q, please ensure operator is installed. %w",
+			"servicemeshoperator", feature.NewMissingOperatorError("servicemeshoperator", nil))
+
+		// when
+		message := dscictrl.RemediationMessage(status.MissingOperatorReason, err.Error(), err)
+
+		// then
+		Expect(message).To(HavePrefix(err.Error()))
+		Expect(message).To(ContainSubstring("Install Red Hat OpenShift Service Mesh (servicemeshoperator) from OperatorHub using the stable channel"))
+		Expect(message).To(HaveSuffix(dscictrl.DocsURL + "#dependent-operators"))
+	})
+
+	It("should name the package of operators without known channel", func() {
+		// given
+		err := feature.NewMissingOperatorError("custom-operator", nil)
+
+		// when
+		message := dscictrl.RemediationMessage(status.MissingOperatorReason, err.Error(), err)
+
+		// then
+		Expect(message).To(ContainSubstring("Install custom-operator (custom-operator) from OperatorHub, or set the capability to Removed."))
+	})
+
+	It("should suggest installing the operator for all namespaces when capability configures unwatched namespaces", func() {
+		// given
+		err := feature.NewUnsupportedInstallModeError([]string{"opendatahub"}, []string{"istio-system"})
+
+		// when
+		message := dscictrl.RemediationMessage(status.UnsupportedInstallModeReason, err.Error(), err)
+
+		// then
+		Expect(message).To(ContainSubstring("namespaces [istio-system] are not watched by the operator installed for namespaces [opendatahub]"))
+		Expect(message).To(ContainSubstring("Install the operator for all namespaces, or set the capability to Removed."))
+		Expect(message).To(HaveSuffix(dscictrl.DocsURL + "#install-modes"))
+	})
+
+	It("should suggest upgrading the cluster when feature requires newer OpenShift", func() {
+		// given
+		err := errors.New(`feature "mesh-gateway-api" requires OpenShift >=4.14, but the cluster runs 4.12.40`)
+
+		// when
+		message := dscictrl.RemediationMessage(status.UnsupportedClusterVersionReason, err.Error(), err)
+
+		// then
+		Expect(message).To(ContainSubstring("Upgrade the cluster to a supported OpenShift version, or set the capability to Removed."))
+		Expect(message).To(HaveSuffix(dscictrl.DocsURL + "#cluster-version-requirements"))
+	})
+
+	It("should keep the message of failures without known remediation", func() {
+		// given
+		err := errors.New("failed applying feature")
+
+		// when
+		message := dscictrl.RemediationMessage(status.CapabilityFailed, err.Error(), err)
+
+		// then
+		Expect(message).To(Equal("failed applying feature"))
+	})
+})
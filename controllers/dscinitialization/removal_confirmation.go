@@ -0,0 +1,142 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// DefaultRemovalConfirmationAnnotation is the annotation of DSCInitialization confirming removal of capabilities,
+// used unless another one is configured for the operator (--removal-confirmation-annotation flag).
+const DefaultRemovalConfirmationAnnotation = "opendatahub.io/confirm-removal"
+
+// ServiceMeshRemoval confirms removal of Service Mesh and its authorization when listed in the confirmation annotation.
+const ServiceMeshRemoval = "servicemesh"
+
+// RemovalConfirmed checks if the removal of the capability is listed in the comma-separated value of the annotation,
+// e.g. `opendatahub.io/confirm-removal: servicemesh`. Removal is always confirmed when no annotation is configured.
+func RemovalConfirmed(instance *dsciv1.DSCInitialization, annotation, capability string) bool {
+	if annotation == "" {
+		return true
+	}
+
+	return slices.Contains(confirmedRemovals(instance, annotation), capability)
+}
+
+func confirmedRemovals(instance *dsciv1.DSCInitialization, annotation string) []string {
+	var confirmed []string
+	for _, capability := range strings.Split(instance.GetAnnotations()[annotation], ",") {
+		if trimmed := strings.TrimSpace(capability); trimmed != "" {
+			confirmed = append(confirmed, trimmed)
+		}
+	}
+
+	return confirmed
+}
+
+// capabilityConfigured checks if the capability has been configured, and not removed since, based on its condition.
+func capabilityConfigured(instance *dsciv1.DSCInitialization, conditionType conditionsv1.ConditionType) bool {
+	condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionType)
+
+	return condition != nil && condition.Reason != status.RemovedReason
+}
+
+// removeConfirmedServiceMesh removes Service Mesh capabilities which have been configured and are now set to Removed, but only once
+// the removal is confirmed. Until then the PendingRemovalConfirmation condition is reported, and the control plane, namespaces and
+// other resources of the capabilities are kept, so that an accidental change of the spec does not destroy a mesh in use.
+func (r *DSCInitializationReconciler) removeConfirmedServiceMesh(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	if instance.Spec.ServiceMesh == nil ||
+		!capabilityConfigured(instance, status.CapabilityServiceMesh) && !capabilityConfigured(instance, status.CapabilityServiceMeshAuthorization) {
+		return r.clearPendingRemoval(ctx, instance)
+	}
+
+	if !RemovalConfirmed(instance, r.RemovalConfirmationAnnotation, ServiceMeshRemoval) {
+		return r.reportPendingRemoval(ctx, instance, ServiceMeshRemoval)
+	}
+
+	r.Log.Info("removal of Service Mesh confirmed, removing its resources")
+	// Features are only removed for Managed capabilities, the ones which have been applied, or for Observed ones, which only
+	// check the mesh managed outside the operator.
+	observed, err := r.observedBefore(ctx, instance)
+	if err != nil {
+		return err
+	}
+	previous := instance.DeepCopy()
+	previous.Spec.ServiceMesh.ManagementState = operatorv1.Managed
+	if observed {
+		previous.Spec.ServiceMesh.ManagementState = infrav1.ServiceMeshObserved
+	}
+	if err := r.removeServiceMesh(ctx, previous); err != nil {
+		return err
+	}
+
+	if err := r.clearPendingRemoval(ctx, instance); err != nil {
+		return err
+	}
+
+	return r.consumeRemovalConfirmation(ctx, instance, ServiceMeshRemoval)
+}
+
+func (r *DSCInitializationReconciler) reportPendingRemoval(ctx context.Context, instance *dsciv1.DSCInitialization, capability string) error {
+	message := fmt.Sprintf("Removal of %s is pending, annotate DSCInitialization with %s: %s to confirm it",
+		capability, r.RemovalConfirmationAnnotation, capability)
+
+	if condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, status.ConditionPendingRemovalConfirmation); condition != nil && condition.Message == message {
+		return nil
+	}
+
+	r.Log.Info("capability set to Removed, waiting for confirmation", "capability", capability, "annotation", r.RemovalConfirmationAnnotation)
+	r.Recorder.Eventf(instance, corev1.EventTypeWarning, status.RemovalNotConfirmedReason, "%s", message)
+	_, err := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		status.SetCondition(&saved.Status.Conditions, string(status.ConditionPendingRemovalConfirmation), status.RemovalNotConfirmedReason, message, corev1.ConditionTrue)
+	})
+
+	return err
+}
+
+func (r *DSCInitializationReconciler) clearPendingRemoval(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	if conditionsv1.FindStatusCondition(instance.Status.Conditions, status.ConditionPendingRemovalConfirmation) == nil {
+		return nil
+	}
+
+	_, err := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, status.ConditionPendingRemovalConfirmation)
+	})
+
+	return err
+}
+
+// consumeRemovalConfirmation removes the capability from the confirmation annotation once it has been removed, so that
+// the confirmation does not apply to the next time the capability is set to Removed.
+func (r *DSCInitializationReconciler) consumeRemovalConfirmation(ctx context.Context, instance *dsciv1.DSCInitialization, capability string) error {
+	confirmed := confirmedRemovals(instance, r.RemovalConfirmationAnnotation)
+	if !slices.Contains(confirmed, capability) {
+		return nil
+	}
+
+	original := instance.DeepCopy()
+	remaining := slices.DeleteFunc(confirmed, func(confirmedCapability string) bool { return confirmedCapability == capability })
+	instanceAnnotations := instance.GetAnnotations()
+	if len(remaining) == 0 {
+		delete(instanceAnnotations, r.RemovalConfirmationAnnotation)
+	} else {
+		instanceAnnotations[r.RemovalConfirmationAnnotation] = strings.Join(remaining, ",")
+	}
+	instance.SetAnnotations(instanceAnnotations)
+
+	if err := r.Client.Patch(ctx, instance, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed removing %s from annotation %s: %w", capability, r.RemovalConfirmationAnnotation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package dscinitialization_test
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Confirmation of capability removal", func() {
+
+	annotated := func(value string) *dsciv1.DSCInitialization {
+		return &dsciv1.DSCInitialization{ObjectMeta: metav1.ObjectMeta{
+			Name:        "default-dsci",
+			Annotations: map[string]string{dscictrl.DefaultRemovalConfirmationAnnotation: value},
+		}}
+	}
+
+	It("should confirm removal of the capability listed in the annotation", func() {
+		Expect(dscictrl.RemovalConfirmed(annotated("kueue, servicemesh"), dscictrl.DefaultRemovalConfirmationAnnotation, dscictrl.ServiceMeshRemoval)).To(BeTrue())
+	})
+
+	It("should not confirm removal of the capability missing in the annotation", func() {
+		Expect(dscictrl.RemovalConfirmed(annotated("kueue"), dscictrl.DefaultRemovalConfirmationAnnotation, dscictrl.ServiceMeshRemoval)).To(BeFalse())
+	})
+
+	It("should not confirm removal when not annotated", func() {
+		Expect(dscictrl.RemovalConfirmed(&dsciv1.DSCInitialization{}, dscictrl.DefaultRemovalConfirmationAnnotation, dscictrl.ServiceMeshRemoval)).To(BeFalse())
+	})
+
+	It("should confirm removal when confirmation is not required", func() {
+		Expect(dscictrl.RemovalConfirmed(&dsciv1.DSCInitialization{}, "", dscictrl.ServiceMeshRemoval)).To(BeTrue())
+	})
+})
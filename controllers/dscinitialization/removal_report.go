@@ -0,0 +1,65 @@
+package dscinitialization
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// reportRemoval stores the report of removed Service Mesh resources in the status and emits an event summarizing it.
+func (r *DSCInitializationReconciler) reportRemoval(ctx context.Context, instance *dsciv1.DSCInitialization, removal feature.RemovalReport) error {
+	report := RemovalStatus(removal, metav1.Now())
+
+	r.Log.Info("removed service mesh resources", "complete", report.Complete,
+		"deleted", len(report.Deleted), "skipped", len(report.Skipped), "failed", len(report.Failed), "errors", len(report.Errors))
+	if report.Complete {
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, "ServiceMeshRemoved",
+			"removed service mesh resources: %d deleted, %d skipped", len(report.Deleted), len(report.Skipped))
+	} else {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, "ServiceMeshRemovalIncomplete",
+			"service mesh resources have not been completely removed: %d deleted, %d skipped, %d failed, %d failed clean-up tasks, see status.removal",
+			len(report.Deleted), len(report.Skipped), len(report.Failed), len(report.Errors))
+	}
+
+	_, err := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		saved.Status.Removal = report
+	})
+
+	return err
+}
+
+// RemovalStatus converts the report of removed feature resources to its representation in the status of DSCInitialization.
+func RemovalStatus(removal feature.RemovalReport, now metav1.Time) *dsciv1.RemovalReport {
+	report := &dsciv1.RemovalReport{
+		Time:     now,
+		Complete: removal.Complete(),
+		Errors:   removal.Errors,
+	}
+
+	for _, removed := range removal.Resources {
+		resource := dsciv1.RemovedResource{
+			Feature:    removed.Feature,
+			APIVersion: removed.APIVersion,
+			Kind:       removed.Kind,
+			Namespace:  removed.Namespace,
+			Name:       removed.Name,
+			Reason:     removed.Reason,
+		}
+
+		switch removed.Outcome {
+		case feature.ResourceDeleted:
+			report.Deleted = append(report.Deleted, resource)
+		case feature.ResourceSkipped:
+			report.Skipped = append(report.Skipped, resource)
+		case feature.ResourceFailed:
+			report.Failed = append(report.Failed, resource)
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,72 @@
+package dscinitialization_test
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Removal report in the status", func() {
+
+	removed := func(name string, outcome feature.RemovalOutcome, reason string) feature.RemovedResource {
+		return feature.RemovedResource{
+			Feature:   "mesh-shared-configmap",
+			Reference: resource.Reference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "opendatahub", Name: name},
+			Outcome:   outcome,
+			Reason:    reason,
+		}
+	}
+
+	It("should group resources by the outcome of their removal", func() {
+		// given
+		now := metav1.Now()
+		removal := feature.RemovalReport{Resources: []feature.RemovedResource{
+			removed("service-mesh-refs", feature.ResourceDeleted, ""),
+			removed("custom", feature.ResourceSkipped, "not owned"),
+		}}
+
+		// when
+		report := dscictrl.RemovalStatus(removal, now)
+
+		// then
+		Expect(report.Time).To(Equal(now))
+		Expect(report.Complete).To(BeTrue())
+		Expect(report.Deleted).To(ConsistOf(dsciv1.RemovedResource{
+			Feature: "mesh-shared-configmap", APIVersion: "v1", Kind: "ConfigMap", Namespace: "opendatahub", Name: "service-mesh-refs",
+		}))
+		Expect(report.Skipped).To(ConsistOf(HaveField("Reason", "not owned")))
+		Expect(report.Failed).To(BeEmpty())
+	})
+
+	It("should not be complete when resources failed to be deleted", func() {
+		// given
+		removal := feature.RemovalReport{Resources: []feature.RemovedResource{
+			removed("auth-refs", feature.ResourceFailed, "forbidden"),
+		}}
+
+		// when
+		report := dscictrl.RemovalStatus(removal, metav1.Now())
+
+		// then
+		Expect(report.Complete).To(BeFalse())
+		Expect(report.Failed).To(ConsistOf(HaveField("Name", "auth-refs")))
+	})
+
+	It("should not be complete when clean-up tasks failed", func() {
+		// given
+		removal := feature.RemovalReport{Errors: []string{"mesh-control-plane-external-authz: failed reverting patch"}}
+
+		// when
+		report := dscictrl.RemovalStatus(removal, metav1.Now())
+
+		// then
+		Expect(report.Complete).To(BeFalse())
+		Expect(report.Errors).To(ConsistOf("mesh-control-plane-external-authz: failed reverting patch"))
+	})
+})
@@ -0,0 +1,84 @@
+package dscinitialization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+)
+
+// ServiceMeshRenderTarget renders features of both Service Mesh and Service Mesh Authorization capabilities.
+const ServiceMeshRenderTarget = "servicemesh"
+
+var errUnknownCapability = errors.New("unknown capability")
+
+// RenderTargets returns names of the capabilities which can be rendered using RenderCapability.
+func RenderTargets() []string {
+	targets := []string{
+		ServiceMeshRenderTarget,
+		serviceMeshCapabilityName,
+		authorizationCapabilityName,
+		featureAlertsCapabilityName,
+		consoleIntegrationCapabilityName,
+		servingCertsCapabilityName,
+	}
+	sort.Strings(targets)
+
+	return targets
+}
+
+// RenderCapability renders manifests of the features of the capability configured by the DSCInitialization, without applying
+// anything to the cluster, so that they can be reviewed upfront. Data providers of the features read the cluster using the client
+// of the reconciler, which can be backed by fixtures to render the manifests offline. Management state of the capability is not
+// taken into account, features are rendered as if it was Managed.
+func (r *DSCInitializationReconciler) RenderCapability(ctx context.Context, instance *dsciv1.DSCInitialization,
+	capability string) ([]feature.RenderedFeature, error) {
+	providers, err := r.renderedProviders(ctx, instance, capability)
+	if err != nil {
+		return nil, err
+	}
+
+	return feature.ClusterFeaturesHandler(instance, providers...).UsingClient(r.Client).Render(ctx)
+}
+
+func (r *DSCInitializationReconciler) renderedProviders(ctx context.Context, instance *dsciv1.DSCInitialization,
+	capability string) ([]feature.FeaturesProvider, error) {
+	switch capability {
+	case featureAlertsCapabilityName:
+		return []feature.FeaturesProvider{featureAlertsFeatures(instance)}, nil
+	case consoleIntegrationCapabilityName:
+		return []feature.FeaturesProvider{consoleIntegrationFeatures(instance)}, nil
+	case servingCertsCapabilityName:
+		return []feature.FeaturesProvider{servingCertificatesFeatures(instance)}, nil
+	case ServiceMeshRenderTarget, serviceMeshCapabilityName, authorizationCapabilityName:
+	default:
+		return nil, fmt.Errorf("%w %q, expected one of: %s", errUnknownCapability, capability, strings.Join(RenderTargets(), ", "))
+	}
+
+	if instance.Spec.ServiceMesh == nil {
+		return nil, fmt.Errorf("DSCInitialization %s does not configure Service Mesh", instance.Name)
+	}
+
+	var providers []feature.FeaturesProvider
+	if capability != authorizationCapabilityName {
+		meshTemplates, err := TemplatesLocation(ctx, instance.Spec.DevFlags, dsciv1.ServiceMeshTemplates)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, r.serviceMeshCapabilityFeatures(instance, meshTemplates))
+	}
+	if capability != serviceMeshCapabilityName {
+		authzTemplates, err := TemplatesLocation(ctx, instance.Spec.DevFlags, dsciv1.AuthorinoTemplates)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, r.authorizationFeatures(instance, authzTemplates, servicemesh.NewAuthorinoLookup(instance.Spec.ServiceMesh)))
+	}
+
+	return providers, nil
+}
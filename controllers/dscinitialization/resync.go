@@ -0,0 +1,42 @@
+package dscinitialization
+
+import (
+	"time"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+)
+
+// minCapabilityResyncPeriod protects the API server from re-validating capabilities too often.
+const minCapabilityResyncPeriod = time.Minute
+
+func (r *DSCInitializationReconciler) capabilityResyncPeriod(instance *dsciv1.DSCInitialization) time.Duration {
+	return CapabilityResyncPeriod(instance, r.CapabilityResyncPeriod)
+}
+
+// CapabilityResyncPeriod is the interval after which capabilities re-validate external state in the absence of events.
+// The period configured in DSCInitialization takes precedence over the operator default, zero disables re-validation.
+func CapabilityResyncPeriod(instance *dsciv1.DSCInitialization, defaultPeriod time.Duration) time.Duration {
+	if !serviceMeshTracked(&instance.Spec) {
+		return 0
+	}
+
+	period := defaultPeriod
+	if instance.Spec.CapabilityResyncPeriod != nil {
+		period = instance.Spec.CapabilityResyncPeriod.Duration
+	}
+
+	if period <= 0 {
+		return 0
+	}
+
+	return max(period, minCapabilityResyncPeriod)
+}
+
+// earliestRequeue returns the shorter of the requeue intervals, where zero means no requeue.
+func earliestRequeue(current, next time.Duration) time.Duration {
+	if current == 0 || (next > 0 && next < current) {
+		return next
+	}
+
+	return current
+}
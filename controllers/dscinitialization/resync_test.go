@@ -0,0 +1,45 @@
+package dscinitialization_test
+
+import (
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Capability resync period", func() {
+
+	dsciWithMesh := func(state operatorv1.ManagementState, period *metav1.Duration) *dsciv1.DSCInitialization {
+		return &dsciv1.DSCInitialization{
+			Spec: dsciv1.DSCInitializationSpec{
+				ServiceMesh:            &infrav1.ServiceMeshSpec{ManagementState: state},
+				CapabilityResyncPeriod: period,
+			},
+		}
+	}
+
+	DescribeTable("resolving the period",
+		func(instance *dsciv1.DSCInitialization, defaultPeriod, expected time.Duration) {
+			Expect(dscictrl.CapabilityResyncPeriod(instance, defaultPeriod)).To(Equal(expected))
+		},
+		Entry("should use the operator default",
+			dsciWithMesh(operatorv1.Managed, nil), 10*time.Minute, 10*time.Minute),
+		Entry("should prefer the period configured in DSCInitialization",
+			dsciWithMesh(operatorv1.Managed, &metav1.Duration{Duration: 5 * time.Minute}), 10*time.Minute, 5*time.Minute),
+		Entry("should let DSCInitialization disable re-validation",
+			dsciWithMesh(infrav1.ServiceMeshObserved, &metav1.Duration{}), 10*time.Minute, time.Duration(0)),
+		Entry("should not re-validate more often than once a minute",
+			dsciWithMesh(operatorv1.Managed, &metav1.Duration{Duration: time.Second}), time.Duration(0), time.Minute),
+		Entry("should not re-validate when Service Mesh is not tracked",
+			dsciWithMesh(operatorv1.Removed, nil), 10*time.Minute, time.Duration(0)),
+		Entry("should not re-validate when Service Mesh is not configured",
+			&dsciv1.DSCInitialization{}, 10*time.Minute, time.Duration(0)),
+	)
+})
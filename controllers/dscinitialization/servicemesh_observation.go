@@ -0,0 +1,190 @@
+package dscinitialization
+
+import (
+	"context"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+)
+
+// controlPlaneObservation is the feature checking readiness of the control plane managed outside the operator.
+const controlPlaneObservation = "mesh-control-plane-observation"
+
+// serviceMeshTracked checks if the operator tracks health of Service Mesh, which is the case when it is either Managed, or Observed.
+func serviceMeshTracked(spec *dsciv1.DSCInitializationSpec) bool {
+	if spec.ServiceMesh == nil {
+		return false
+	}
+
+	return spec.ServiceMesh.ManagementState == operatorv1.Managed || spec.ServiceMesh.ManagementState == infrav1.ServiceMeshObserved
+}
+
+// observeServiceMesh tracks health of Service Mesh managed outside the operator, e.g. by GitOps. Observed capabilities do not
+// apply any resources of the mesh, they check the control plane, the authorization provider and its membership in the mesh
+// are ready, and publish references to them, so that components rely on the mesh the same way as when it is Managed.
+// Resources applied while the mesh was Managed are kept as they are, so that the mesh can be handed over without downtime.
+func (r *DSCInitializationReconciler) observeServiceMesh(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	if err := r.reportControlPlaneDrift(ctx, instance, nil, false); err != nil {
+		return err
+	}
+
+	if r.externalWatches != nil {
+		if errWatch := r.externalWatches.ensure(r.watchServiceMeshResources); errWatch != nil {
+			r.Log.Error(errWatch, "failed watching readiness of Service Mesh resources, changes are detected on periodic re-validation only")
+		}
+	}
+
+	subscriptions := cluster.NewSubscriptionLookup()
+	for _, capability := range r.observedCapabilities(instance, subscriptions) {
+		if err := capability.Apply(ctx); err != nil {
+			r.Log.Info("Service Mesh managed outside the operator is not ready", "reason", err.Error())
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeServiceMeshObservation removes the features of the observed capabilities, along with the references they published.
+func (r *DSCInitializationReconciler) removeServiceMeshObservation(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	removal := feature.RemovalReport{}
+	for _, capability := range r.observedCapabilities(instance, cluster.NewSubscriptionLookup()) {
+		capabilityRemoval, err := capability.DeleteWithReport(ctx)
+		removal.Merge(capabilityRemoval)
+		if err != nil {
+			r.Log.Error(err, "failed removing observation of service mesh")
+
+			return err
+		}
+	}
+
+	return r.reportRemoval(ctx, instance, removal)
+}
+
+// stopServiceMeshObservation removes the readiness checks of Service Mesh once it is Managed again. References published
+// by the observation are kept, as the capabilities of the operator publish the same.
+func (r *DSCInitializationReconciler) stopServiceMeshObservation(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	observed, err := r.observedBefore(ctx, instance)
+	if err != nil || !observed {
+		return err
+	}
+
+	r.Log.Info("Service Mesh is managed by the operator again, removing readiness checks of the observed mesh")
+
+	return feature.ClusterFeaturesHandler(instance,
+		feature.ForCapability(serviceMeshCapabilityName, serviceMeshObservationFeatures(instance)),
+		feature.ForCapability(authorizationCapabilityName, authorizationObservationFeatures(instance)),
+	).Delete(ctx)
+}
+
+// stopObservingUnmanagedServiceMesh removes the readiness checks of Service Mesh which is no longer Observed, but Unmanaged,
+// along with the references they published, as the operator no longer tracks the mesh. The mesh itself is left intact.
+func (r *DSCInitializationReconciler) stopObservingUnmanagedServiceMesh(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	observed, err := r.observedBefore(ctx, instance)
+	if err != nil || !observed {
+		return err
+	}
+
+	r.Log.Info("Service Mesh is not managed by the operator anymore, removing observation of the mesh")
+
+	return r.removeServiceMeshObservation(ctx, instance)
+}
+
+// observedBefore checks if Service Mesh has been Observed, based on the FeatureTracker of the control plane observation,
+// so that leaving the Observed state does not touch resources of the mesh managed outside the operator.
+func (r *DSCInitializationReconciler) observedBefore(ctx context.Context, instance *dsciv1.DSCInitialization) (bool, error) {
+	tracker := featurev1.NewFeatureTracker(controlPlaneObservation, instance.Spec.ApplicationsNamespace)
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(tracker), tracker); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return true, nil
+}
+
+func (r *DSCInitializationReconciler) observedCapabilities(instance *dsciv1.DSCInitialization, subscriptions *cluster.SubscriptionLookup) []*feature.HandlerWithReporter[*dsciv1.DSCInitialization] { //nolint:lll // Reason: generics are long
+	return []*feature.HandlerWithReporter[*dsciv1.DSCInitialization]{
+		r.observedCapability(instance, subscriptions,
+			feature.ForCapability(serviceMeshCapabilityName, func(registry feature.FeaturesRegistry) error {
+				if err := serviceMeshObservationFeatures(instance)(registry); err != nil {
+					return err
+				}
+
+				return meshRefsFeatures(instance)(registry)
+			}),
+			serviceMeshCondition(status.ObservedReason, "Service Mesh managed outside the operator is ready")),
+		r.observedCapability(instance, subscriptions,
+			feature.ForCapability(authorizationCapabilityName, authorizationObservationFeatures(instance)),
+			authorizationCondition(status.ObservedReason, "Service Mesh Authorization managed outside the operator is ready")),
+	}
+}
+
+func (r *DSCInitializationReconciler) observedCapability(instance *dsciv1.DSCInitialization, subscriptions *cluster.SubscriptionLookup, features feature.FeaturesProvider, condition *conditionsv1.Condition) *feature.HandlerWithReporter[*dsciv1.DSCInitialization] { //nolint:lll // Reason: generics are long
+	return feature.NewHandlerWithReporter(
+		feature.ClusterFeaturesHandler(instance, features).WithSubscriptionLookup(subscriptions),
+		createCapabilityReporter(r.Client, r.StatusUpdater, instance, condition),
+	)
+}
+
+// serviceMeshObservationFeatures checks the control plane is ready, without changing it.
+func serviceMeshObservationFeatures(instance *dsciv1.DSCInitialization) feature.FeaturesProvider {
+	return func(registry feature.FeaturesRegistry) error {
+		controlPlaneSpec := instance.Spec.ServiceMesh.ControlPlane
+
+		return registry.Add(
+			feature.Define(controlPlaneObservation).
+				RequiresPermissions(
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "maistra.io", "servicemeshcontrolplanes", "get"),
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "route.openshift.io", "routes", "get"),
+				).
+				WithData(
+					servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
+				).
+				PreConditions(
+					servicemesh.EnsureServiceMeshInstalled,
+				).
+				Exports(servicemesh.GatewayHostnameExport, servicemesh.GatewayHostname).
+				Exports(servicemesh.ControlPlaneVersionExport, servicemesh.ControlPlaneVersion),
+		)
+	}
+}
+
+// authorizationObservationFeatures checks the Authorino instance is ready and its namespace is a member of the mesh, without
+// changing either of them. Lightweight authorization does not rely on any provider, so there is nothing to check then.
+func authorizationObservationFeatures(instance *dsciv1.DSCInitialization) feature.FeaturesProvider {
+	return func(registry feature.FeaturesRegistry) error {
+		serviceMeshSpec := instance.Spec.ServiceMesh
+		authNamespace := servicemesh.AuthNamespace(&instance.Spec)
+
+		authorinoMode := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return !servicemesh.UsesLightweightAuth(serviceMeshSpec), nil
+		}
+
+		return registry.Add(
+			feature.Define("mesh-authorization-observation").
+				RequiresPermissions(
+					feature.NamespacedPermission(authNamespace, "maistra.io", "servicemeshmembers", "get"),
+					feature.NamespacedPermission(authNamespace, "operator.authorino.kuadrant.io", "authorinos", "get"),
+				).
+				EnabledWhen(authorinoMode).
+				WithData(
+					servicemesh.FeatureData.Authorization.All(&instance.Spec)...,
+				).
+				PreConditions(
+					feature.EnsureOperatorIsInstalled("authorino-operator"),
+					servicemesh.EnsureServiceMeshMemberReady,
+					servicemesh.EnsureAuthorizationProviderReady,
+				).
+				Exports(servicemesh.AuthProviderURLExport, servicemesh.AuthProviderURL),
+		)
+	}
+}
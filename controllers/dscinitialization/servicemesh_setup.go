@@ -3,18 +3,24 @@ package dscinitialization
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"path"
 
+	"github.com/hashicorp/go-multierror"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/refs"
 )
 
 func (r *DSCInitializationReconciler) configureServiceMesh(ctx context.Context, instance *dsciv1.DSCInitialization) error {
@@ -27,19 +33,70 @@ func (r *DSCInitializationReconciler) configureServiceMesh(ctx context.Context,
 
 	switch serviceMeshManagementState {
 	case operatorv1.Managed:
+		if unsupported := r.unsupportedInstallMode(instance); unsupported != nil {
+			r.Log.Info("service mesh is not configured in the namespaces the operator has been installed for", "reason", unsupported.Error())
+			for _, condition := range []*conditionsv1.Condition{
+				serviceMeshCondition(status.ConfiguredReason, "Service Mesh configured"),
+				authorizationCondition(status.ConfiguredReason, "Service Mesh Authorization configured"),
+			} {
+				if _, errReport := createCapabilityReporter(r.Client, r.StatusUpdater, instance, condition).ReportCondition(ctx, unsupported); errReport != nil {
+					return errReport
+				}
+			}
+
+			return nil
+		}
+
+		if r.externalWatches != nil {
+			if errWatch := r.externalWatches.ensure(r.watchServiceMeshResources); errWatch != nil {
+				r.Log.Error(errWatch, "failed watching readiness of Service Mesh resources, changes are detected on periodic re-validation only")
+			}
+		}
+
+		if err := r.stopServiceMeshObservation(ctx, instance); err != nil {
+			return err
+		}
+
+		meshTemplates, err := TemplatesLocation(ctx, instance.Spec.DevFlags, dsciv1.ServiceMeshTemplates)
+		if err != nil {
+			return err
+		}
+		authzTemplates, err := TemplatesLocation(ctx, instance.Spec.DevFlags, dsciv1.AuthorinoTemplates)
+		if err != nil {
+			return err
+		}
+
+		// Changes made outside the operator are detected before it configures the control plane again, as that may overwrite them.
+		unresolvedDrifts, err := r.detectControlPlaneDrift(ctx, instance)
+		if err != nil {
+			return err
+		}
+
+		// Operators required by the capabilities are checked by several features, Subscriptions are listed once per reconcile.
+		subscriptions := cluster.NewSubscriptionLookup()
 
 		capabilities := []*feature.HandlerWithReporter[*dsciv1.DSCInitialization]{
-			r.serviceMeshCapability(instance, serviceMeshCondition(status.ConfiguredReason, "Service Mesh configured")),
+			r.serviceMeshCapability(instance, meshTemplates, subscriptions, serviceMeshCondition(status.ConfiguredReason, "Service Mesh configured")),
 		}
 
-		authzCapability, err := r.authorizationCapability(ctx, instance, authorizationCondition(status.ConfiguredReason, "Service Mesh Authorization configured"))
+		// Authorino to adopt is looked up once, for the features as well as for the extension provider they register.
+		authorinoLookup := servicemesh.NewAuthorinoLookup(instance.Spec.ServiceMesh)
+
+		authzCapability, err := r.authorizationCapability(ctx, instance, authzTemplates, subscriptions, authorinoLookup,
+			authorizationCondition(status.ConfiguredReason, "Service Mesh Authorization configured"))
 		if err != nil {
 			return err
 		}
 		capabilities = append(capabilities, authzCapability)
 
+		var pendingErr *feature.PendingMaintenanceWindowError
 		for _, capability := range capabilities {
 			capabilityErr := capability.Apply(ctx)
+			if pending, isPending := feature.PendingMaintenanceWindowOnly(capabilityErr); isPending {
+				r.Log.Info("service mesh changes postponed", "reason", pending.Error())
+				pendingErr = pending
+				continue
+			}
 			if capabilityErr != nil {
 				r.Log.Error(capabilityErr, "failed applying service mesh resources")
 				r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "failed applying service mesh resources")
@@ -47,11 +104,41 @@ func (r *DSCInitializationReconciler) configureServiceMesh(ctx context.Context,
 			}
 		}
 
+		if pendingErr != nil {
+			return pendingErr
+		}
+
+		authProvider, registered, err := servicemesh.AuthExtensionProvider(ctx, r.Client, &instance.Spec, authorinoLookup)
+		if err != nil {
+			return err
+		}
+
+		if err := r.pruneStaleExtensionProviders(ctx, instance, authProvider); err != nil {
+			return err
+		}
+
+		if err := r.recordControlPlaneFields(ctx, instance, authProvider, registered, unresolvedDrifts); err != nil {
+			return err
+		}
+
+	case infrav1.ServiceMeshObserved:
+		if err := r.observeServiceMesh(ctx, instance); err != nil {
+			return err
+		}
 	case operatorv1.Unmanaged:
 		r.Log.Info("ServiceMesh CR is not configured by the operator, we won't do anything")
+		if err := r.reportControlPlaneDrift(ctx, instance, nil, false); err != nil {
+			return err
+		}
+		if err := r.stopObservingUnmanagedServiceMesh(ctx, instance); err != nil {
+			return err
+		}
 	case operatorv1.Removed:
 		r.Log.Info("existing ServiceMesh CR (owned by operator) will be removed")
-		if err := r.removeServiceMesh(ctx, instance); err != nil {
+		if err := r.reportControlPlaneDrift(ctx, instance, nil, false); err != nil {
+			return err
+		}
+		if err := r.removeConfirmedServiceMesh(ctx, instance); err != nil {
 			return err
 		}
 	}
@@ -59,50 +146,92 @@ func (r *DSCInitializationReconciler) configureServiceMesh(ctx context.Context,
 	return nil
 }
 
+// pruneStaleExtensionProviders removes authorization extension providers left in the SMCP by the operator for previous
+// applications namespaces, e.g. after upgrades or when the namespace has been renamed. Every removal is recorded as an event.
+func (r *DSCInitializationReconciler) pruneStaleExtensionProviders(ctx context.Context, instance *dsciv1.DSCInitialization, current servicemesh.ExtensionProvider) error {
+	controlPlane := instance.Spec.ServiceMesh.ControlPlane
+	pruned, err := servicemesh.PruneStaleExtensionProviders(ctx, r.Client, controlPlane, current)
+	if err != nil {
+		r.Log.Error(err, "failed pruning stale extension providers", "smcp", controlPlane.Namespace+"/"+controlPlane.Name)
+
+		return err
+	}
+
+	for _, provider := range pruned {
+		r.Log.Info("removed stale extension provider", "name", provider.Name, "service", provider.Service, "smcp", controlPlane.Namespace+"/"+controlPlane.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, "StaleExtensionProviderRemoved",
+			"removed stale extension provider %s (service %s) from ServiceMeshControlPlane %s/%s", provider.Name, provider.Service, controlPlane.Namespace, controlPlane.Name)
+	}
+
+	return nil
+}
+
 func (r *DSCInitializationReconciler) removeServiceMesh(ctx context.Context, instance *dsciv1.DSCInitialization) error {
 	// on condition of Managed, do not handle Removed when set to Removed it trigger DSCI reconcile to clean up
 	if instance.Spec.ServiceMesh == nil {
 		return nil
 	}
+	// Mesh managed outside the operator is left intact, only its observation is removed.
+	if instance.Spec.ServiceMesh.ManagementState == infrav1.ServiceMeshObserved {
+		return r.removeServiceMeshObservation(ctx, instance)
+	}
 	if instance.Spec.ServiceMesh.ManagementState == operatorv1.Managed {
+		// Templates are not rendered when features are removed, embedded ones are used even if custom templates are configured.
+		subscriptions := cluster.NewSubscriptionLookup()
+
 		capabilities := []*feature.HandlerWithReporter[*dsciv1.DSCInitialization]{
-			r.serviceMeshCapability(instance, serviceMeshCondition(status.RemovedReason, "Service Mesh removed")),
+			r.serviceMeshCapability(instance, Templates.Location, subscriptions, serviceMeshCondition(status.RemovedReason, "Service Mesh removed")),
 		}
 
-		authzCapability, err := r.authorizationCapability(ctx, instance, authorizationCondition(status.RemovedReason, "Service Mesh Authorization removed"))
+		authzCapability, err := r.authorizationCapability(ctx, instance, Templates.Location, subscriptions,
+			servicemesh.NewAuthorinoLookup(instance.Spec.ServiceMesh), authorizationCondition(status.RemovedReason, "Service Mesh Authorization removed"))
 		if err != nil {
 			return err
 		}
 
 		capabilities = append(capabilities, authzCapability)
 
+		removal := feature.RemovalReport{}
+		var terminatingErr error
 		for _, capability := range capabilities {
-			capabilityErr := capability.Delete(ctx)
+			capabilityRemoval, capabilityErr := capability.DeleteWithReport(ctx)
+			removal.Merge(capabilityRemoval)
+			// Removal of the capability is retried until its namespaces have terminated, without holding back the other ones
+			if _, isTerminating := feature.NamespacesTerminatingOnly(capabilityErr); isTerminating {
+				r.Log.Info("waiting for namespaces of service mesh to terminate", "reason", capabilityErr.Error())
+				terminatingErr = multierror.Append(terminatingErr, capabilityErr)
+
+				continue
+			}
 			if capabilityErr != nil {
 				r.Log.Error(capabilityErr, "failed deleting service mesh resources")
 				r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "failed deleting service mesh resources")
 
-				return capabilityErr
+				return multierror.Append(capabilityErr, r.reportRemoval(ctx, instance, removal)).ErrorOrNil()
 			}
 		}
+
+		return multierror.Append(terminatingErr, r.reportRemoval(ctx, instance, removal)).ErrorOrNil()
 	}
 	return nil
 }
 
-func (r *DSCInitializationReconciler) serviceMeshCapability(instance *dsciv1.DSCInitialization, initialCondition *conditionsv1.Condition) *feature.HandlerWithReporter[*dsciv1.DSCInitialization] { //nolint:lll // Reason: generics are long
+func (r *DSCInitializationReconciler) serviceMeshCapability(instance *dsciv1.DSCInitialization, templates fs.FS, subscriptions *cluster.SubscriptionLookup, initialCondition *conditionsv1.Condition) *feature.HandlerWithReporter[*dsciv1.DSCInitialization] { //nolint:lll // Reason: generics are long
 	return feature.NewHandlerWithReporter(
-		feature.ClusterFeaturesHandler(instance, r.serviceMeshCapabilityFeatures(instance)),
-		createCapabilityReporter(r.Client, instance, initialCondition),
-	)
+		feature.ClusterFeaturesHandler(instance, r.serviceMeshCapabilityFeatures(instance, templates)).WithSubscriptionLookup(subscriptions),
+		createCapabilityReporter(r.Client, r.StatusUpdater, instance, initialCondition),
+	).WithGroupConditions(featureGroupCondition)
 }
 
-func (r *DSCInitializationReconciler) authorizationCapability(ctx context.Context, instance *dsciv1.DSCInitialization, condition *conditionsv1.Condition) (*feature.HandlerWithReporter[*dsciv1.DSCInitialization], error) { //nolint:lll // Reason: generics are long
-	authorinoInstalled, err := cluster.SubscriptionExists(ctx, r.Client, "authorino-operator")
+func (r *DSCInitializationReconciler) authorizationCapability(ctx context.Context, instance *dsciv1.DSCInitialization, templates fs.FS, subscriptions *cluster.SubscriptionLookup,
+	authorinoLookup *servicemesh.AuthorinoLookup, condition *conditionsv1.Condition) (*feature.HandlerWithReporter[*dsciv1.DSCInitialization], error) { //nolint:lll // Reason: generics are long
+	authorinoInstalled, err := subscriptions.Exists(ctx, r.Client, "authorino-operator")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list subscriptions %w", err)
 	}
 
-	if !authorinoInstalled {
+	// Lightweight authorization does not deploy Authorino, its features are applied regardless of the operator.
+	if !authorinoInstalled && !servicemesh.UsesLightweightAuth(instance.Spec.ServiceMesh) {
 		authzMissingOperatorCondition := &conditionsv1.Condition{
 			Type:    status.CapabilityServiceMeshAuthorization,
 			Status:  corev1.ConditionFalse,
@@ -114,79 +243,216 @@ func (r *DSCInitializationReconciler) authorizationCapability(ctx context.Contex
 			// EmptyFeaturesHandler acts as all the authorization features are disabled (calling Apply/Delete has no actual effect on the cluster)
 			// but it's going to be reported as CapabilityServiceMeshAuthorization/MissingOperator condition/reason
 			feature.EmptyFeaturesHandler,
-			createCapabilityReporter(r.Client, instance, authzMissingOperatorCondition),
+			createCapabilityReporter(r.Client, r.StatusUpdater, instance, authzMissingOperatorCondition),
 		), nil
 	}
 
+	// Adoption is reflected in the condition, as well as existing instances rejected in favor of the operator-managed one.
+	if condition.Reason == status.ConfiguredReason {
+		adopted, errAdopt := authorinoLookup.Find(ctx, r.Client)
+		if errAdopt != nil {
+			return nil, errAdopt
+		}
+		if !adopted.IsZero() {
+			condition = authorizationCondition(status.AuthorinoAdoptedReason, fmt.Sprintf("Service Mesh Authorization configured using adopted Authorino %s", adopted))
+		}
+		if rejected := authorinoLookup.Rejected(); rejected != nil {
+			condition = authorizationCondition(status.AuthorinoAdoptionFailedReason,
+				fmt.Sprintf("Service Mesh Authorization configured using operator-managed Authorino, as %s", rejected))
+		}
+	}
+
 	return feature.NewHandlerWithReporter(
-		feature.ClusterFeaturesHandler(instance, r.authorizationFeatures(instance)),
-		createCapabilityReporter(r.Client, instance, condition),
-	), nil
+		feature.ClusterFeaturesHandler(instance, r.authorizationFeatures(instance, templates, authorinoLookup)).WithSubscriptionLookup(subscriptions),
+		createCapabilityReporter(r.Client, r.StatusUpdater, instance, condition),
+	).WithGroupConditions(featureGroupCondition), nil
 }
 
-func (r *DSCInitializationReconciler) serviceMeshCapabilityFeatures(instance *dsciv1.DSCInitialization) feature.FeaturesProvider {
-	return func(registry feature.FeaturesRegistry) error {
+func (r *DSCInitializationReconciler) serviceMeshCapabilityFeatures(instance *dsciv1.DSCInitialization, templates fs.FS) feature.FeaturesProvider {
+	return feature.ForCapability(serviceMeshCapabilityName, func(registry feature.FeaturesRegistry) error {
 		controlPlaneSpec := instance.Spec.ServiceMesh.ControlPlane
 
 		meshMetricsCollection := func(_ context.Context, _ *feature.Feature) (bool, error) {
 			return controlPlaneSpec.MetricsCollection == "Istio", nil
 		}
 
-		return registry.Add(
+		meshMetricsFederation := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return controlPlaneSpec.MetricsFederation == "UserWorkloadMonitoring", nil
+		}
+
+		// TLS origination is disabled unless the trusted CA bundle is managed, as proxies would not find CA certificates to verify the hosts with.
+		egressTLSEnabled := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			trustedCABundle := instance.Spec.TrustedCABundle
+
+			return len(instance.Spec.ServiceMesh.EgressTLS.Hosts) > 0 && trustedCABundle != nil && trustedCABundle.ManagementState == operatorv1.Managed, nil
+		}
+
+		metrics := feature.Group("mesh-metrics").
+			EnabledWhen(meshMetricsCollection).
+			WithData(
+				servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
+			).
+			Add(
+				feature.Define("mesh-metrics-collection").
+					RequiresPermissions(
+						feature.NamespacedPermission(controlPlaneSpec.Namespace, "monitoring.coreos.com", "podmonitors", feature.ApplyVerbs...),
+						feature.NamespacedPermission(controlPlaneSpec.Namespace, "monitoring.coreos.com", "servicemonitors", feature.ApplyVerbs...),
+					).
+					DependsOn("mesh-control-plane-creation").
+					Manifests(
+						manifest.Location(templates).
+							Include(
+								path.Join(Templates.MetricsDir),
+							),
+					).
+					PreConditions(
+						servicemesh.EnsureServiceMeshInstalled,
+					),
+				feature.Define("mesh-metrics-federation").
+					RequiresPermissions(
+						feature.NamespacedPermission(controlPlaneSpec.Namespace, "monitoring.coreos.com", "podmonitors", feature.ApplyVerbs...),
+						feature.NamespacedPermission(controlPlaneSpec.Namespace, "monitoring.coreos.com", "servicemonitors", feature.ApplyVerbs...),
+					).
+					DependsOn("mesh-metrics-collection").
+					EnabledWhen(meshMetricsFederation).
+					Manifests(
+						manifest.Location(templates).
+							Include(
+								path.Join(Templates.MetricsFederationDir),
+							),
+					).
+					PreConditions(
+						servicemesh.EnsureServiceMeshInstalled,
+					),
+			)
+
+		if err := registry.AddGroup(metrics); err != nil {
+			return err
+		}
+
+		if err := registry.Add(
 			feature.Define("mesh-control-plane-creation").
+				RequiresPermissions(
+					feature.ClusterPermission("", "namespaces", "get", "create", "patch"),
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "maistra.io", "servicemeshcontrolplanes", feature.ApplyVerbs...),
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "", "pods", "list"),
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "route.openshift.io", "routes", "get"),
+				).
+				Disruptive().
 				Manifests(
-					manifest.Location(Templates.Location).
+					manifest.Location(templates).
 						Include(
 							path.Join(Templates.ServiceMeshDir),
 						),
 				).
-				WithData(servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction()).
+				WithData(
+					servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
+					feature.Entry(feature.ArchitecturesKey, feature.SchedulableArchitectures(servicemesh.SupportedArchitectures...)),
+				).
 				PreConditions(
 					servicemesh.EnsureServiceMeshOperatorInstalled,
+					feature.EnsureArchitectureSupported(servicemesh.SupportedArchitectures...),
 					feature.CreateNamespaceIfNotExists(controlPlaneSpec.Namespace),
+					feature.ApplyNamespaceDefaults(controlPlaneSpec.Namespace, instance.Spec.NamespaceDefaults),
 				).
 				PostConditions(
 					feature.WaitForPodsToBeReady(controlPlaneSpec.Namespace),
-				),
-			feature.Define("mesh-metrics-collection").
-				EnabledWhen(meshMetricsCollection).
+				).
+				Exports(servicemesh.GatewayHostnameExport, servicemesh.GatewayHostname).
+				Exports(servicemesh.ControlPlaneVersionExport, servicemesh.ControlPlaneVersion),
+			// The gateway is deployed by Maistra, which owns its replicas, so it is scaled through the control plane.
+			feature.Define("mesh-gateway-autoscaling").
+				RequiresPermissions(
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "maistra.io", "servicemeshcontrolplanes", feature.PatchVerbs...),
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "autoscaling", "horizontalpodautoscalers", "get"),
+				).
+				DependsOn("mesh-control-plane-creation").
+				EnabledWhen(autoscalingEnabled(gatewayAutoscaling(instance))).
+				Managed().
 				Manifests(
-					manifest.Location(Templates.Location).
+					manifest.Location(templates).
 						Include(
-							path.Join(Templates.MetricsDir),
+							path.Join(Templates.AutoscalingDir, "gateway-autoscaling.patch.tmpl.yaml"),
 						),
 				).
 				WithData(
 					servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
+					feature.Entry(autoscalingKey, provider.ValueOf(resolveAutoscaling(gatewayAutoscaling(instance))).Get),
+				).
+				PreConditions(
+					servicemesh.EnsureServiceMeshInstalled,
+				).
+				// Maistra creates the autoscaler of the gateway named after its deployment.
+				PostConditions(
+					feature.WaitForHPAActive(controlPlaneSpec.Namespace, "istio-ingressgateway"),
+				),
+			feature.Define("mesh-gateway-disruption-budget").
+				RequiresPermissions(
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "policy", "poddisruptionbudgets", feature.ApplyVerbs...),
 				).
+				DependsOn("mesh-control-plane-creation").
+				EnabledWhen(disruptionBudgetEnabled(gatewayDisruptionBudget(instance))).
+				Managed().
+				Manifests(
+					manifest.Location(templates).
+						Include(
+							path.Join(Templates.DisruptionBudgetsDir, "gateway-pdb.tmpl.yaml"),
+						),
+				).
+				WithData(servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction()).
+				WithData(disruptionBudgetData(gatewayDisruptionBudget(instance))...).
 				PreConditions(
 					servicemesh.EnsureServiceMeshInstalled,
 				),
-			feature.Define("mesh-shared-configmap").
-				WithResources(servicemesh.MeshRefs, servicemesh.AuthRefs).
+			// Proxies verify the hosts using the trusted CA bundle synced into the namespaces of the mesh by the mesh CA bundle controller,
+			// which also creates the DestinationRules originating TLS there. DestinationRules are still listed to prune ones created
+			// in the control plane namespace by previous versions.
+			feature.Define("mesh-egress-tls").
+				RequiresPermissions(
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "networking.istio.io", "serviceentries", append(feature.ApplyVerbs, "list")...),
+					feature.NamespacedPermission(controlPlaneSpec.Namespace, "networking.istio.io", "destinationrules", append(feature.ApplyVerbs, "list")...),
+				).
+				DependsOn("mesh-control-plane-creation").
+				EnabledWhen(egressTLSEnabled).
+				Managed().
+				Manifests(
+					manifest.Location(templates).
+						Include(
+							path.Join(Templates.EgressTLSDir),
+						),
+				).
 				WithData(
 					servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
+					servicemesh.FeatureData.EgressTLS.Define(&instance.Spec).AsAction(),
 				).
-				WithData(
-					servicemesh.FeatureData.Authorization.All(&instance.Spec)...,
-				),
-		)
-	}
+				PreConditions(
+					servicemesh.EnsureServiceMeshInstalled,
+				).
+				WithResources(servicemesh.PruneEgressTLS),
+		); err != nil {
+			return err
+		}
+
+		return meshRefsFeatures(instance)(registry)
+	})
 }
 
-func (r *DSCInitializationReconciler) authorizationFeatures(instance *dsciv1.DSCInitialization) feature.FeaturesProvider {
+// meshRefsFeatures publishes references to the control plane and the authorization provider to the applications namespace,
+// both when Service Mesh is Managed and Observed.
+func meshRefsFeatures(instance *dsciv1.DSCInitialization) feature.FeaturesProvider {
 	return func(registry feature.FeaturesRegistry) error {
-		serviceMeshSpec := instance.Spec.ServiceMesh
-
 		return registry.Add(
-			feature.Define("mesh-control-plane-external-authz").
-				Manifests(
-					manifest.Location(Templates.Location).
-						Include(
-							path.Join(Templates.AuthorinoDir, "auth-smm.tmpl.yaml"),
-							path.Join(Templates.AuthorinoDir, "base"),
-							path.Join(Templates.AuthorinoDir, "mesh-authz-ext-provider.patch.tmpl.yaml"),
-						),
+			feature.Define("mesh-shared-configmap").
+				RequiresPermissions(
+					feature.NamespacedPermission(instance.Spec.ApplicationsNamespace, "", "configmaps", feature.ApplyVerbs...),
+					// AuthConfigs labeled for the previous selector are relabeled in all namespaces.
+					feature.ClusterPermission("authorino.kuadrant.io", "authconfigs", "list", "patch"),
+				).
+				DependsOn("mesh-control-plane-creation").
+				WithResources(servicemesh.MeshRefs, servicemesh.MigrateAuthConfigSelector, servicemesh.AuthRefs).
+				CleanupResources(
+					resource.Reference{APIVersion: "v1", Kind: "ConfigMap", Namespace: instance.Spec.ApplicationsNamespace, Name: refs.MeshRefsName},
+					resource.Reference{APIVersion: "v1", Kind: "ConfigMap", Namespace: instance.Spec.ApplicationsNamespace, Name: refs.AuthRefsName},
 				).
 				WithData(
 					servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
@@ -194,44 +460,300 @@ func (r *DSCInitializationReconciler) authorizationFeatures(instance *dsciv1.DSC
 				WithData(
 					servicemesh.FeatureData.Authorization.All(&instance.Spec)...,
 				).
-				PreConditions(
-					feature.EnsureOperatorIsInstalled("authorino-operator"),
-					servicemesh.EnsureServiceMeshInstalled,
-					servicemesh.EnsureAuthNamespaceExists,
-				).
-				PostConditions(
-					feature.WaitForPodsToBeReady(serviceMeshSpec.ControlPlane.Namespace),
-				).
-				OnDelete(
-					servicemesh.RemoveExtensionProvider(
-						instance.Spec.ServiceMesh.ControlPlane,
-						instance.Spec.ApplicationsNamespace+"-auth-provider",
-					),
+				WithData(
+					servicemesh.FeatureData.Authorization.Adopted.Define(servicemesh.NewAuthorinoLookup(instance.Spec.ServiceMesh)).AsAction(),
 				),
+		)
+	}
+}
 
-			// We do not have the control over deployment resource creation.
-			// It is created by Authorino operator using Authorino CR and labels are not propagated from Authorino CR to spec.template
-			// See https://issues.redhat.com/browse/RHOAIENG-5494
-			//
-			// To make it part of Service Mesh we have to patch it with injection
-			// enabled instead, otherwise it will not have proxy pod injected.
-			feature.Define("enable-proxy-injection-in-authorino-deployment").
-				Manifests(
-					manifest.Location(Templates.Location).
-						Include(path.Join(Templates.AuthorinoDir, "deployment.injection.patch.tmpl.yaml")),
-				).
-				PreConditions(
-					func(ctx context.Context, f *feature.Feature) error {
-						namespace, err := servicemesh.FeatureData.Authorization.Namespace.Extract(f)
-						if err != nil {
-							return fmt.Errorf("failed trying to resolve authorization provider namespace for feature '%s': %w", f.Name, err)
-						}
+func (r *DSCInitializationReconciler) authorizationFeatures(instance *dsciv1.DSCInitialization, templates fs.FS,
+	authorinoLookup *servicemesh.AuthorinoLookup) feature.FeaturesProvider {
+	return feature.ForCapability(authorizationCapabilityName, func(registry feature.FeaturesRegistry) error {
+		serviceMeshSpec := instance.Spec.ServiceMesh
+		authNamespace := servicemesh.AuthNamespace(&instance.Spec)
 
-						return feature.WaitForPodsToBeReady(namespace)(ctx, f)
-					},
-				).
-				WithData(servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction()).
-				WithData(servicemesh.FeatureData.Authorization.All(&instance.Spec)...),
-		)
+		// Feature groups of the other mode are disabled, so that switching the mode cleans up what they created.
+		authorinoMode := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return !servicemesh.UsesLightweightAuth(serviceMeshSpec), nil
+		}
+
+		lightweightMode := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return servicemesh.UsesLightweightAuth(serviceMeshSpec), nil
+		}
+
+		withoutPlugin := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return serviceMeshSpec.Auth.Lightweight.PluginImage == "", nil
+		}
+
+		withPlugin := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return serviceMeshSpec.Auth.Lightweight.PluginImage != "", nil
+		}
+
+		// Features deploying and configuring the operator-managed Authorino are disabled when an existing instance is adopted,
+		// see servicemesh.FindAdoptableAuthorino.
+		authorinoAdopted := servicemesh.AuthorinoAdopted(authorinoLookup)
+		authorinoNotAdopted := servicemesh.AuthorinoNotAdopted(authorinoLookup)
+		authorinoAutoscaled := func(ctx context.Context, f *feature.Feature) (bool, error) {
+			if notAdopted, err := authorinoNotAdopted(ctx, f); !notAdopted || err != nil {
+				return false, err
+			}
+
+			return autoscalingEnabled(authorinoAutoscaling(instance))(ctx, f)
+		}
+		authorinoDisruptionBudgeted := func(ctx context.Context, f *feature.Feature) (bool, error) {
+			if notAdopted, err := authorinoNotAdopted(ctx, f); !notAdopted || err != nil {
+				return false, err
+			}
+
+			return disruptionBudgetEnabled(authorinoDisruptionBudget(instance))(ctx, f)
+		}
+
+		authorino := feature.Group("authorino").
+			EnabledWhen(authorinoMode).
+			WithData(
+				servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
+				servicemesh.FeatureData.Injection.Define(&instance.Spec).AsAction(),
+			).
+			WithData(
+				servicemesh.FeatureData.Authorization.All(&instance.Spec)...,
+			).
+			Add(
+				feature.Define("mesh-control-plane-external-authz").
+					RequiresPermissions(
+						feature.ClusterPermission("", "namespaces", feature.PatchVerbs...),
+						feature.NamespacedPermission(authNamespace, "maistra.io", "servicemeshmembers", feature.ApplyVerbs...),
+						feature.NamespacedPermission(authNamespace, "operator.authorino.kuadrant.io", "authorinos", feature.ApplyVerbs...),
+						feature.NamespacedPermission(serviceMeshSpec.ControlPlane.Namespace, "maistra.io", "servicemeshcontrolplanes", feature.PatchVerbs...),
+					).
+					DependsOn("mesh-control-plane-creation").
+					EnabledWhen(authorinoNotAdopted).
+					Disruptive().
+					Manifests(
+						manifest.Location(templates).
+							Include(
+								path.Join(Templates.AuthorinoDir, "auth-namespace-injection.patch.tmpl.yaml"),
+							).
+							IncludeInNamespace("{{ .AuthNamespace }}",
+								path.Join(Templates.AuthorinoDir, "auth-smm.tmpl.yaml"),
+								path.Join(Templates.AuthorinoDir, "base"),
+							).
+							IncludeInNamespace("{{ .ControlPlane.Namespace }}",
+								path.Join(Templates.AuthorinoDir, "mesh-authz-ext-provider.patch.tmpl.yaml"),
+							),
+					).
+					WithData(
+						feature.Entry(authorinoAutoscaledKey, provider.ValueOf(autoscalingManaged(authorinoAutoscaling(instance))).Get),
+					).
+					PreConditions(
+						feature.EnsureOperatorIsInstalled("authorino-operator"),
+						servicemesh.EnsureServiceMeshInstalled,
+						servicemesh.EnsureAuthNamespaceExists,
+						func(ctx context.Context, f *feature.Feature) error {
+							namespace, err := servicemesh.FeatureData.Authorization.Namespace.Extract(f)
+							if err != nil {
+								return fmt.Errorf("failed trying to resolve authorization provider namespace for feature '%s': %w", f.Name, err)
+							}
+
+							return feature.ApplyNamespaceDefaults(namespace, instance.Spec.NamespaceDefaults)(ctx, f)
+						},
+					).
+					PostConditions(
+						feature.WaitForPodsToBeReady(serviceMeshSpec.ControlPlane.Namespace),
+					).
+					Exports(servicemesh.AuthProviderURLExport, servicemesh.AuthProviderURL).
+					OnDelete(
+						servicemesh.RemoveAuthExtensionProvider(&instance.Spec),
+					),
+
+				// We do not have the control over deployment resource creation.
+				// It is created by Authorino operator using Authorino CR and labels are not propagated from Authorino CR to spec.template
+				// See https://issues.redhat.com/browse/RHOAIENG-5494
+				//
+				// To make it part of Service Mesh we have to patch it with injection
+				// enabled instead, otherwise it will not have proxy pod injected.
+				// The patch is reverted when the feature is removed, as the deployment is owned by Authorino operator.
+				feature.Define("enable-proxy-injection-in-authorino-deployment").
+					RequiresPermissions(
+						feature.NamespacedPermission(authNamespace, "apps", "deployments", feature.PatchVerbs...),
+					).
+					DependsOn("mesh-control-plane-external-authz").
+					EnabledWhen(authorinoNotAdopted).
+					Patches(
+						feature.PatchFromManifest(templates, path.Join(Templates.AuthorinoDir, "deployment.injection.patch.tmpl.yaml")),
+					).
+					PreConditions(
+						feature.EnsureArchitectureSupported(servicemesh.SupportedArchitectures...),
+						func(ctx context.Context, f *feature.Feature) error {
+							namespace, err := servicemesh.FeatureData.Authorization.Namespace.Extract(f)
+							if err != nil {
+								return fmt.Errorf("failed trying to resolve authorization provider namespace for feature '%s': %w", f.Name, err)
+							}
+
+							return feature.WaitForPodsToBeReady(namespace)(ctx, f)
+						},
+					).
+					WithData(feature.Entry(feature.ArchitecturesKey, feature.SchedulableArchitectures(servicemesh.SupportedArchitectures...))),
+
+				// Replicas of the Authorino deployment are owned by the autoscaler, they are not set in the Authorino CR then.
+				feature.Define("authorino-autoscaling").
+					RequiresPermissions(
+						feature.NamespacedPermission(authNamespace, "autoscaling", "horizontalpodautoscalers", feature.ApplyVerbs...),
+					).
+					DependsOn("mesh-control-plane-external-authz").
+					EnabledWhen(authorinoAutoscaled).
+					Managed().
+					Manifests(
+						manifest.Location(templates).
+							Include(
+								path.Join(Templates.AutoscalingDir, "authorino-hpa.tmpl.yaml"),
+							),
+					).
+					WithData(feature.Entry(autoscalingKey, provider.ValueOf(resolveAutoscaling(authorinoAutoscaling(instance))).Get)).
+					PostConditions(
+						func(ctx context.Context, f *feature.Feature) error {
+							namespace, err := servicemesh.FeatureData.Authorization.Namespace.Extract(f)
+							if err != nil {
+								return fmt.Errorf("failed trying to resolve authorization provider namespace for feature '%s': %w", f.Name, err)
+							}
+							name, err := servicemesh.FeatureData.Authorization.Provider.Extract(f)
+							if err != nil {
+								return fmt.Errorf("failed trying to resolve authorization provider name for feature '%s': %w", f.Name, err)
+							}
+
+							return feature.WaitForHPAActive(namespace, name)(ctx, f)
+						},
+					),
+
+				// Voluntary disruptions, such as node drains, keep the configured number of Authorino pods running.
+				feature.Define("authorino-disruption-budget").
+					RequiresPermissions(
+						feature.NamespacedPermission(authNamespace, "policy", "poddisruptionbudgets", feature.ApplyVerbs...),
+					).
+					DependsOn("mesh-control-plane-external-authz").
+					EnabledWhen(authorinoDisruptionBudgeted).
+					Managed().
+					Manifests(
+						manifest.Location(templates).
+							Include(
+								path.Join(Templates.DisruptionBudgetsDir, "authorino-pdb.tmpl.yaml"),
+							),
+					).
+					WithData(disruptionBudgetData(authorinoDisruptionBudget(instance))...),
+
+				// Existing Authorino instance is registered as the extension provider as it is. It is neither enrolled
+				// in the mesh nor patched, which is up to its owner.
+				feature.Define("mesh-control-plane-adopted-authz").
+					RequiresPermissions(
+						feature.ClusterPermission("operator.authorino.kuadrant.io", "authorinos", "list"),
+						feature.NamespacedPermission(serviceMeshSpec.ControlPlane.Namespace, "maistra.io", "servicemeshcontrolplanes", feature.PatchVerbs...),
+					).
+					DependsOn("mesh-control-plane-external-authz").
+					EnabledWhen(authorinoAdopted).
+					Disruptive().
+					Manifests(
+						manifest.Location(templates).
+							Include(
+								path.Join(Templates.AuthorinoDir, "adopted-authz-ext-provider.patch.tmpl.yaml"),
+							),
+					).
+					WithData(
+						servicemesh.FeatureData.Authorization.Adopted.Define(authorinoLookup).AsAction(),
+					).
+					PreConditions(
+						feature.EnsureOperatorIsInstalled("authorino-operator"),
+						servicemesh.EnsureServiceMeshInstalled,
+					).
+					PostConditions(
+						feature.WaitForPodsToBeReady(serviceMeshSpec.ControlPlane.Namespace),
+					).
+					Exports(servicemesh.AuthProviderURLExport, servicemesh.AdoptedAuthProviderURL).
+					OnDelete(
+						servicemesh.RemoveAuthExtensionProvider(&instance.Spec),
+					),
+
+				// Services opt into the policies through annotations, see servicemesh.AuthorizationPolicies.
+				// Policies of Services which no longer request them are pruned before the current ones are applied,
+				// Services requesting policies which cannot be generated get a warning event instead.
+				feature.Define("authorization-policies").
+					RequiresPermissions(
+						feature.ClusterPermission("", "services", "list"),
+						feature.ClusterPermission("security.istio.io", "authorizationpolicies", append(feature.ApplyVerbs, "list")...),
+						feature.ClusterPermission("authorino.kuadrant.io", "authconfigs", feature.ApplyVerbs...),
+					).
+					DependsOn("mesh-control-plane-external-authz", "mesh-control-plane-adopted-authz").
+					Manifests(
+						manifest.Location(templates).
+							Include(
+								path.Join(Templates.AuthorinoDir, "policies"),
+							),
+					).
+					WithData(servicemesh.FeatureData.Authorization.Policies.Define(&instance.Spec).AsAction()).
+					PreConditions(
+						servicemesh.EnsureServiceMeshInstalled,
+					).
+					WithResources(servicemesh.PruneAuthorizationPolicies, servicemesh.ReportRejectedServices(r.Recorder)),
+			)
+
+		lightweight := feature.Group("lightweight-auth").
+			EnabledWhen(lightweightMode).
+			WithData(
+				servicemesh.FeatureData.ControlPlane.Define(&instance.Spec).AsAction(),
+			).
+			WithData(
+				servicemesh.FeatureData.Authorization.All(&instance.Spec)...,
+			).
+			Add(
+				// Lightweight alternative to the external authorization chain, validating tokens of requests to predictors
+				// directly in their proxies, either as JWTs of the configured issuer or by the WasmPlugin.
+				feature.Define("mesh-lightweight-auth").
+					RequiresPermissions(
+						feature.NamespacedPermission(serviceMeshSpec.ControlPlane.Namespace, "security.istio.io", "requestauthentications", feature.ApplyVerbs...),
+						feature.NamespacedPermission(serviceMeshSpec.ControlPlane.Namespace, "security.istio.io", "authorizationpolicies", feature.ApplyVerbs...),
+					).
+					EnabledWhen(withoutPlugin).
+					Managed().
+					Manifests(
+						manifest.Location(templates).
+							Include(
+								path.Join(Templates.LightweightAuthDir, "token-check-jwt.tmpl.yaml"),
+							),
+					).
+					PreConditions(
+						servicemesh.EnsureServiceMeshInstalled,
+					),
+				feature.Define("mesh-lightweight-auth-plugin").
+					RequiresPermissions(
+						feature.NamespacedPermission(serviceMeshSpec.ControlPlane.Namespace, "extensions.istio.io", "wasmplugins", feature.ApplyVerbs...),
+					).
+					EnabledWhen(withPlugin).
+					Managed().
+					Manifests(
+						manifest.Location(templates).
+							Include(
+								path.Join(Templates.LightweightAuthDir, "token-check-wasmplugin.tmpl.yaml"),
+							),
+					).
+					PreConditions(
+						servicemesh.EnsureServiceMeshInstalled,
+					),
+			)
+
+		return registry.AddGroup(authorino, lightweight)
+	})
+}
+
+// unsupportedInstallMode returns UnsupportedInstallModeError when the service mesh capabilities configure namespaces which
+// the operator has not been installed for.
+func (r *DSCInitializationReconciler) unsupportedInstallMode(instance *dsciv1.DSCInitialization) error {
+	unwatched := r.InstallMode.Unwatched(
+		instance.Spec.ApplicationsNamespace,
+		instance.Spec.ServiceMesh.ControlPlane.Namespace,
+		servicemesh.AuthNamespace(&instance.Spec),
+	)
+	if len(unwatched) == 0 {
+		return nil
 	}
+
+	return feature.NewUnsupportedInstallModeError(r.InstallMode.Namespaces, unwatched)
 }
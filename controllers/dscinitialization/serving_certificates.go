@@ -0,0 +1,384 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	defaultCertManagerIssuerKind = "ClusterIssuer"
+	// caCertKey holds the CA certificate in secrets issued by cert-manager and by the operator.
+	caCertKey = "ca.crt"
+	// certificateNameSuffix is appended to the name of the Service to name its cert-manager Certificate.
+	certificateNameSuffix = "-serving-cert"
+)
+
+// +kubebuilder:rbac:groups="cert-manager.io",resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// configureServingCertificates issues serving certificates of the Services listed in the DSCI using the configured provider,
+// and publishes references to them in the serving-cert-refs ConfigMap of the applications namespace.
+func (r *DSCInitializationReconciler) configureServingCertificates(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	// DSCInitializations which have never configured serving certificates do not report the capability.
+	if instance.Spec.ServingCertificates == nil && conditionsv1.FindStatusCondition(instance.Status.Conditions, status.CapabilityServingCertificates) == nil {
+		return feature.ClusterFeaturesHandler(instance, servingCertificatesFeatures(instance)).Apply(ctx)
+	}
+
+	certs := resolveServingCertificates(instance.Spec.ServingCertificates)
+	condition := servingCertificatesCondition(status.RemovedReason, "Serving certificates removed")
+	if certs.ManagementState == operatorv1.Managed {
+		condition = servingCertificatesCondition(status.ConfiguredReason,
+			fmt.Sprintf("Serving certificates of %d services issued by %s provider", len(certs.Services), certs.Provider))
+	}
+
+	return r.servingCertificatesCapability(instance, condition).Apply(ctx)
+}
+
+func (r *DSCInitializationReconciler) removeServingCertificates(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	return r.servingCertificatesCapability(instance, servingCertificatesCondition(status.RemovedReason, "Serving certificates removed")).Delete(ctx)
+}
+
+func (r *DSCInitializationReconciler) servingCertificatesCapability(instance *dsciv1.DSCInitialization, condition *conditionsv1.Condition) *feature.HandlerWithReporter[*dsciv1.DSCInitialization] { //nolint:lll // Reason: generics are long
+	return feature.NewHandlerWithReporter(
+		feature.ClusterFeaturesHandler(instance, servingCertificatesFeatures(instance)),
+		createCapabilityReporter(r.Client, r.StatusUpdater, instance, condition),
+	)
+}
+
+func servingCertificatesFeatures(instance *dsciv1.DSCInitialization) feature.FeaturesProvider {
+	return feature.ForCapability(servingCertsCapabilityName, func(registry feature.FeaturesRegistry) error {
+		certs := resolveServingCertificates(instance.Spec.ServingCertificates)
+
+		certsEnabled := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return certs.ManagementState == operatorv1.Managed, nil
+		}
+
+		providerEnabled := func(certProvider dsciv1.ServingCertificatesProvider) feature.EnabledFunc {
+			return func(ctx context.Context, f *feature.Feature) (bool, error) {
+				enabled, err := certsEnabled(ctx, f)
+
+				return enabled && certs.Provider == certProvider, err
+			}
+		}
+
+		return registry.Add(
+			feature.Define("serving-cert-refs").
+				EnabledWhen(certsEnabled).
+				Manifests(
+					manifest.Location(Templates.Location).
+						Include(Templates.ServingCertRefsDir),
+				).
+				WithData(feature.Entry("ServingCertificates", provider.ValueOf(certs).Get)),
+			feature.Define("serving-certificates-openshift").
+				RequiresPermissions(
+					feature.ClusterPermission("", "services", "get", "list", "patch"),
+					feature.ClusterPermission("", "secrets", "get", "delete"),
+				).
+				EnabledWhen(providerEnabled(dsciv1.OpenShiftServingCertificates)).
+				WithResources(
+					withdrawServingCertificateRequests(certs.Services),
+					annotateServingServices(certs.Services),
+				).
+				OnDelete(removeServingCertificateRequests),
+			feature.Define("serving-certificates-cert-manager").
+				RequiresPermissions(
+					feature.ClusterPermission("cert-manager.io", "certificates", append(feature.ApplyVerbs, "list", "delete")...),
+					feature.ClusterPermission("", "secrets", "get", "delete"),
+				).
+				EnabledWhen(providerEnabled(dsciv1.CertManagerServingCertificates)).
+				PreConditions(
+					feature.EnsureOperatorIsInstalled("openshift-cert-manager-operator"),
+					ensureCertManagerIssuer(certs.Issuer),
+				).
+				WithResources(pruneCertManagerCertificates(certs.Services)).
+				Manifests(
+					manifest.Location(Templates.Location).
+						Include(Templates.ServingCertManagerDir),
+				).
+				WithData(feature.Entry("ServingCertificates", provider.ValueOf(certs).Get)),
+			feature.Define("serving-certificates-self-signed").
+				RequiresPermissions(
+					feature.ClusterPermission("", "secrets", append(feature.ApplyVerbs, "list", "delete")...),
+				).
+				EnabledWhen(providerEnabled(dsciv1.SelfSignedServingCertificates)).
+				WithResources(
+					pruneSelfSignedServingCertificates(certs.Services),
+					createSelfSignedServingCertificates(certs.Services),
+				),
+		)
+	})
+}
+
+// resolveServingCertificates fills the defaults of serving certificates, which are not set when the DSCI has been created
+// before they were introduced.
+func resolveServingCertificates(spec *dsciv1.ServingCertificates) dsciv1.ServingCertificates {
+	if spec == nil {
+		return dsciv1.ServingCertificates{ManagementState: operatorv1.Removed}
+	}
+
+	certs := *spec.DeepCopy()
+	if certs.Provider == "" {
+		certs.Provider = dsciv1.OpenShiftServingCertificates
+	}
+	if certs.Issuer != nil && certs.Issuer.Kind == "" {
+		certs.Issuer.Kind = defaultCertManagerIssuerKind
+	}
+	for i := range certs.Services {
+		if certs.Services[i].SecretName == "" {
+			certs.Services[i].SecretName = certs.Services[i].Name + "-serving-cert"
+		}
+	}
+
+	return certs
+}
+
+func ensureCertManagerIssuer(issuer *dsciv1.CertManagerIssuer) feature.Action {
+	return func(_ context.Context, _ *feature.Feature) error {
+		if issuer == nil {
+			return fmt.Errorf("servingCertificates.issuer is required by the %s provider", dsciv1.CertManagerServingCertificates)
+		}
+
+		return nil
+	}
+}
+
+// annotateServingServices requests serving certificates from the OpenShift service CA. Services which do not exist yet,
+// as serving components have not created them, are annotated when the capability is re-validated.
+func annotateServingServices(services []dsciv1.ServingCertificateService) feature.Action {
+	return func(ctx context.Context, f *feature.Feature) error {
+		for _, svc := range services {
+			service := &corev1.Service{}
+			if err := f.Client.Get(ctx, client.ObjectKey{Name: svc.Name, Namespace: svc.Namespace}, service); err != nil {
+				if k8serr.IsNotFound(err) {
+					continue
+				}
+
+				return fmt.Errorf("failed getting service %s/%s: %w", svc.Namespace, svc.Name, err)
+			}
+
+			if service.GetAnnotations()[annotations.ServingCertSecretName] == svc.SecretName && service.GetLabels()[labels.ServingCertRequested] == "true" {
+				continue
+			}
+
+			original := service.DeepCopy()
+			if service.Annotations == nil {
+				service.Annotations = map[string]string{}
+			}
+			service.Annotations[annotations.ServingCertSecretName] = svc.SecretName
+			if service.Labels == nil {
+				service.Labels = map[string]string{}
+			}
+			service.Labels[labels.ServingCertRequested] = "true"
+			if err := f.Client.Patch(ctx, service, client.MergeFrom(original)); err != nil {
+				return fmt.Errorf("failed requesting serving certificate of service %s/%s: %w", svc.Namespace, svc.Name, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// withdrawServingCertificateRequests removes requests of Services annotated by the operator which are no longer listed, and deletes
+// certificates issued for them, as well as certificates of listed Services issued into a secret which is no longer requested.
+// Requests of Services still listed are updated by annotateServingServices afterwards.
+func withdrawServingCertificateRequests(services []dsciv1.ServingCertificateService) feature.Action {
+	return func(ctx context.Context, f *feature.Feature) error {
+		return withdrawRequests(ctx, f.Client, servingSecrets(services))
+	}
+}
+
+// removeServingCertificateRequests stops the OpenShift service CA from issuing certificates for all Services annotated by the operator.
+func removeServingCertificateRequests(ctx context.Context, cli client.Client) error {
+	return withdrawRequests(ctx, cli, nil)
+}
+
+func withdrawRequests(ctx context.Context, cli client.Client, keep map[types.NamespacedName]string) error {
+	requested := &corev1.ServiceList{}
+	if err := cli.List(ctx, requested, client.MatchingLabels{labels.ServingCertRequested: "true"}); err != nil {
+		return fmt.Errorf("failed listing services with requested serving certificates: %w", err)
+	}
+
+	for i := range requested.Items {
+		service := &requested.Items[i]
+		requestedSecret := service.GetAnnotations()[annotations.ServingCertSecretName]
+		secretName, listed := keep[client.ObjectKeyFromObject(service)]
+		if listed && secretName == requestedSecret {
+			continue
+		}
+
+		if err := deleteIssuedSecret(ctx, cli, service.Namespace, requestedSecret, annotations.ServingCertOriginatingService, service.Name); err != nil {
+			return err
+		}
+		if listed {
+			continue
+		}
+
+		original := service.DeepCopy()
+		delete(service.Annotations, annotations.ServingCertSecretName)
+		delete(service.Labels, labels.ServingCertRequested)
+		if err := cli.Patch(ctx, service, client.MergeFrom(original)); err != nil {
+			return fmt.Errorf("failed removing serving certificate request of service %s/%s: %w", service.Namespace, service.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// pruneCertManagerCertificates deletes Certificates of Services which are no longer listed, together with secrets issued by them,
+// which cert-manager keeps unless configured otherwise. Secrets of listed Services which are no longer requested are deleted as well.
+func pruneCertManagerCertificates(services []dsciv1.ServingCertificateService) feature.Action {
+	return func(ctx context.Context, f *feature.Feature) error {
+		keep := servingSecrets(services)
+
+		certificates := &unstructured.UnstructuredList{}
+		certificates.SetGroupVersionKind(gvk.CertManagerCertificate)
+		if err := f.Client.List(ctx, certificates, client.MatchingLabels{labels.ODH.Feature: f.Name}); err != nil {
+			if meta.IsNoMatchError(err) {
+				return nil
+			}
+
+			return fmt.Errorf("failed listing serving certificates: %w", err)
+		}
+
+		for i := range certificates.Items {
+			certificate := &certificates.Items[i]
+			serviceName := strings.TrimSuffix(certificate.GetName(), certificateNameSuffix)
+			issuedSecret, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+			secretName, listed := keep[types.NamespacedName{Namespace: certificate.GetNamespace(), Name: serviceName}]
+			if listed && secretName == issuedSecret {
+				continue
+			}
+
+			if err := deleteIssuedSecret(ctx, f.Client, certificate.GetNamespace(), issuedSecret, annotations.CertManagerCertificateName, certificate.GetName()); err != nil {
+				return err
+			}
+			if listed {
+				continue
+			}
+
+			if err := f.Client.Delete(ctx, certificate); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed deleting serving certificate %s/%s: %w", certificate.GetNamespace(), certificate.GetName(), err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// pruneSelfSignedServingCertificates deletes secrets generated for Services which are no longer listed, or under a name
+// which is no longer requested.
+func pruneSelfSignedServingCertificates(services []dsciv1.ServingCertificateService) feature.Action {
+	return func(ctx context.Context, f *feature.Feature) error {
+		keep := sets.New[types.NamespacedName]()
+		for _, svc := range services {
+			keep.Insert(types.NamespacedName{Namespace: svc.Namespace, Name: svc.SecretName})
+		}
+
+		generated := &corev1.SecretList{}
+		if err := f.Client.List(ctx, generated, client.MatchingLabels{labels.ODH.Feature: f.Name}); err != nil {
+			return fmt.Errorf("failed listing serving certificates: %w", err)
+		}
+
+		for i := range generated.Items {
+			secret := &generated.Items[i]
+			if keep.Has(client.ObjectKeyFromObject(secret)) {
+				continue
+			}
+
+			if err := f.Client.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed deleting serving certificate %s/%s: %w", secret.Namespace, secret.Name, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// servingSecrets maps listed Services to the names of the secrets holding their certificates.
+func servingSecrets(services []dsciv1.ServingCertificateService) map[types.NamespacedName]string {
+	secrets := make(map[types.NamespacedName]string, len(services))
+	for _, svc := range services {
+		secrets[types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}] = svc.SecretName
+	}
+
+	return secrets
+}
+
+// deleteIssuedSecret deletes the secret only if it has been issued for the given issuer, so that secrets created by users
+// under the same name are kept.
+func deleteIssuedSecret(ctx context.Context, cli client.Client, namespace, name, issuerAnnotation, issuer string) error {
+	if name == "" {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed getting serving certificate %s/%s: %w", namespace, name, err)
+	}
+
+	if secret.GetAnnotations()[issuerAnnotation] != issuer {
+		return nil
+	}
+
+	if err := cli.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting serving certificate %s/%s: %w", namespace, name, err)
+	}
+
+	return nil
+}
+
+// createSelfSignedServingCertificates generates a certificate for each Service, which is also its own CA. Existing secrets are
+// kept, so that certificates are not rotated on every reconcile and clients trusting them keep working.
+func createSelfSignedServingCertificates(services []dsciv1.ServingCertificateService) feature.Action {
+	return func(ctx context.Context, f *feature.Feature) error {
+		for _, svc := range services {
+			existing := &corev1.Secret{}
+			err := f.Client.Get(ctx, client.ObjectKey{Name: svc.SecretName, Namespace: svc.Namespace}, existing)
+			if err == nil {
+				continue
+			}
+			if !k8serr.IsNotFound(err) {
+				return fmt.Errorf("failed getting serving certificate %s/%s: %w", svc.Namespace, svc.SecretName, err)
+			}
+
+			secret, errGenerate := cluster.GenerateSelfSignedCertificateAsSecret(svc.SecretName, fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace), svc.Namespace)
+			if errGenerate != nil {
+				return fmt.Errorf("failed generating serving certificate of service %s/%s: %w", svc.Namespace, svc.Name, errGenerate)
+			}
+			secret.Data[caCertKey] = secret.Data[corev1.TLSCertKey]
+
+			if errMeta := cluster.ApplyMetaOptions(secret, feature.DefaultMetaOptions(f)...); errMeta != nil {
+				return errMeta
+			}
+			if errCreate := f.Client.Create(ctx, secret); errCreate != nil {
+				return fmt.Errorf("failed creating serving certificate %s/%s: %w", svc.Namespace, svc.SecretName, errCreate)
+			}
+		}
+
+		return nil
+	}
+}
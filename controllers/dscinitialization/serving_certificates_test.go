@@ -0,0 +1,97 @@
+package dscinitialization_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Serving certificates templates", func() {
+
+	fakeClient := func() client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+	}
+
+	applyTemplates := func(ctx context.Context, cli client.Client, dir string, certs dsciv1.ServingCertificates) {
+		f, err := feature.Define("serving-certificates").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(dscictrl.Templates.Location).Include(dir)).
+			WithData(feature.Entry("ServingCertificates", provider.ValueOf(certs).Get)).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		Expect(f.Apply(ctx)).To(Succeed())
+	}
+
+	services := []dsciv1.ServingCertificateService{
+		{Name: "modelmesh-serving", Namespace: "models", SecretName: "modelmesh-serving-cert"},
+		{Name: "sklearn-predictor", Namespace: "kserve-demo", SecretName: "sklearn-tls"},
+	}
+
+	It("should publish references to certificates issued by the OpenShift service CA", func(ctx context.Context) {
+		// given
+		cli := fakeClient()
+
+		// when
+		applyTemplates(ctx, cli, dscictrl.Templates.ServingCertRefsDir, dsciv1.ServingCertificates{
+			Provider: dsciv1.OpenShiftServingCertificates,
+			Services: services,
+		})
+
+		// then
+		refs := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "serving-cert-refs", Namespace: "opendatahub"}, refs)).To(Succeed())
+		Expect(refs.Data).To(Equal(map[string]string{
+			"provider":                      "OpenShift",
+			"caBundleConfigMap":             "openshift-service-ca.crt",
+			"models.modelmesh-serving":      "modelmesh-serving-cert",
+			"kserve-demo.sklearn-predictor": "sklearn-tls",
+		}))
+	})
+
+	It("should request certificates of all services from cert-manager issuer", func(ctx context.Context) {
+		// given
+		cli := fakeClient()
+
+		// when
+		applyTemplates(ctx, cli, dscictrl.Templates.ServingCertManagerDir, dsciv1.ServingCertificates{
+			Provider: dsciv1.CertManagerServingCertificates,
+			Issuer:   &dsciv1.CertManagerIssuer{Name: "serving-ca", Kind: "ClusterIssuer"},
+			Services: services,
+		})
+
+		// then
+		certificate := &unstructured.Unstructured{}
+		certificate.SetAPIVersion("cert-manager.io/v1")
+		certificate.SetKind("Certificate")
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "sklearn-predictor-serving-cert", Namespace: "kserve-demo"}, certificate)).To(Succeed())
+		Expect(certificate.Object).To(HaveKeyWithValue("spec", And(
+			HaveKeyWithValue("secretName", "sklearn-tls"),
+			HaveKeyWithValue("dnsNames", ConsistOf("sklearn-predictor.kserve-demo.svc", "sklearn-predictor.kserve-demo.svc.cluster.local")),
+			HaveKeyWithValue("issuerRef", HaveKeyWithValue("name", "serving-ca")),
+		)))
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "modelmesh-serving-serving-cert", Namespace: "models"}, certificate)).To(Succeed())
+	})
+})
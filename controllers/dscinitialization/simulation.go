@@ -0,0 +1,178 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/trustedcabundle"
+)
+
+// simulate computes the impact of the spec patch proposed using opendatahub.io/simulate annotation
+// and stores it in the status. Proposed changes are never applied to the cluster.
+func (r *DSCInitializationReconciler) simulate(ctx context.Context, instance *dsciv1.DSCInitialization) (*dsciv1.DSCInitialization, error) {
+	patch, requested := instance.GetAnnotations()[annotations.Simulate]
+	if !requested {
+		if instance.Status.Simulation == nil {
+			return instance, nil
+		}
+
+		return status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+			saved.Status.Simulation = nil
+		})
+	}
+
+	if simulation := instance.Status.Simulation; simulation != nil &&
+		simulation.Patch == patch && simulation.ObservedGeneration == instance.Generation {
+		return instance, nil
+	}
+
+	report := &dsciv1.SimulationReport{
+		ObservedGeneration: instance.Generation,
+		Patch:              patch,
+	}
+
+	proposed, err := instance.ProposedSpec(patch)
+	if err != nil {
+		report.Error = err.Error()
+	} else if errSimulation := r.computeSimulation(ctx, report, instance, proposed); errSimulation != nil {
+		report.Error = errSimulation.Error()
+	} else {
+		subscriptions := cluster.NewSubscriptionLookup()
+		for _, operator := range report.RequiredOperators {
+			installed, errSub := subscriptions.Exists(ctx, r.Client, operator)
+			if errSub != nil {
+				return instance, fmt.Errorf("failed checking if operator %s is installed: %w", operator, errSub)
+			}
+			if !installed {
+				report.MissingOperators = append(report.MissingOperators, operator)
+			}
+		}
+	}
+
+	r.Log.Info("computed simulation report", "patch", patch, "disruption", report.Disruption, "error", report.Error)
+
+	return status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+		saved.Status.Simulation = report
+	})
+}
+
+// computeSimulation compares resources managed for the current and the proposed spec.
+func (r *DSCInitializationReconciler) computeSimulation(ctx context.Context, report *dsciv1.SimulationReport,
+	instance *dsciv1.DSCInitialization, proposed *dsciv1.DSCInitializationSpec) error {
+	currentResources, err := r.managedResources(ctx, instance)
+	if err != nil {
+		return fmt.Errorf("failed computing resources of the current spec: %w", err)
+	}
+
+	simulated := instance.DeepCopy()
+	simulated.Spec = *proposed
+	proposedResources, err := r.managedResources(ctx, simulated)
+	if err != nil {
+		return fmt.Errorf("failed computing resources of the proposed spec: %w", err)
+	}
+
+	report.ResourcesToCreate = difference(proposedResources, currentResources)
+	report.ResourcesToDelete = difference(currentResources, proposedResources)
+	report.RequiredOperators = requiredOperators(proposed)
+
+	switch {
+	case len(report.ResourcesToDelete) > 0:
+		report.Disruption = dsciv1.DisruptionHigh
+	case len(report.ResourcesToCreate) > 0:
+		report.Disruption = dsciv1.DisruptionLow
+	default:
+		report.Disruption = dsciv1.DisruptionNone
+	}
+
+	return nil
+}
+
+// managedResources lists resources managed for the DSCInitialization, sorted and without duplicates. Resources of features
+// are those the features would write, see feature.FeaturesHandler.DryRun, so the report follows their definitions.
+// Namespaces and the trusted CA bundle are created by the reconciler itself, and thus listed here.
+func (r *DSCInitializationReconciler) managedResources(ctx context.Context, instance *dsciv1.DSCInitialization) ([]string, error) {
+	spec := &instance.Spec
+	references := []resource.Reference{{APIVersion: "v1", Kind: "Namespace", Name: spec.ApplicationsNamespace}}
+
+	if spec.Monitoring.ManagementState == operatorv1.Managed {
+		references = append(references, resource.Reference{APIVersion: "v1", Kind: "Namespace", Name: spec.Monitoring.Namespace})
+	}
+
+	if spec.TrustedCABundle != nil && spec.TrustedCABundle.ManagementState == operatorv1.Managed {
+		references = append(references, resource.Reference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "*", Name: trustedcabundle.CAConfigMapName})
+	}
+
+	// events of the features, e.g. about rejected authorization policies, are dropped, as nothing is applied
+	simulator := *r
+	simulator.Recorder = &record.FakeRecorder{}
+	providers, err := simulator.featuresProviders(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	featureReferences, err := feature.ClusterFeaturesHandler(instance, append(providers, consoleIntegrationFeatures(instance), servingCertificatesFeatures(instance))...).
+		UsingClient(r.Client).
+		DryRun(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := sets.New[string]()
+	for _, reference := range append(references, featureReferences...) {
+		resources.Insert(reference.String())
+	}
+
+	return sets.List(resources), nil
+}
+
+func requiredOperators(spec *dsciv1.DSCInitializationSpec) []string {
+	if spec.ServiceMesh != nil && spec.ServiceMesh.ManagementState == operatorv1.Managed {
+		if servicemesh.UsesLightweightAuth(spec.ServiceMesh) {
+			return []string{"servicemeshoperator"}
+		}
+
+		return []string{"servicemeshoperator", "authorino-operator"}
+	}
+
+	return nil
+}
+
+func difference(left, right []string) []string {
+	var result []string
+	for _, l := range left {
+		found := false
+		for _, r := range right {
+			if l == r {
+				found = true
+
+				break
+			}
+		}
+		if !found {
+			result = append(result, l)
+		}
+	}
+
+	return result
+}
+
+// simulateAnnotationChangedPredicate triggers reconciliation when opendatahub.io/simulate annotation changes,
+// as annotation updates do not bump the generation of the resource.
+var simulateAnnotationChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectOld.GetAnnotations()[annotations.Simulate] != e.ObjectNew.GetAnnotations()[annotations.Simulate]
+	},
+}
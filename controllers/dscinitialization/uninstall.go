@@ -0,0 +1,159 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
+)
+
+const uninstallFinalizerName = "dscinitialization.opendatahub.io/uninstall-cleanup"
+
+// UninstallReconciler removes cluster-scoped resources created by the operator when its ClusterServiceVersion is deleted,
+// as OLM does not garbage collect them and they pile up across reinstall cycles. It holds a finalizer on the CSV
+// of the running operator, which is released without clean-up when the CSV is being replaced by a newer version
+// or reinstalled by the Subscription.
+type UninstallReconciler struct {
+	Client client.Client
+	Log    logr.Logger
+	// DSCInitialization reconciler is used to remove Service Mesh configuration, such as extension providers.
+	DSCInitialization *DSCInitializationReconciler
+	// CSVName is the name of the ClusterServiceVersion of the running operator.
+	CSVName           string
+	OperatorNamespace string
+}
+
+// +kubebuilder:rbac:groups="operators.coreos.com",resources=clusterserviceversions,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="operators.coreos.com",resources=subscriptions,verbs=get;list;watch
+
+func (r *UninstallReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	csv := &ofapiv1alpha1.ClusterServiceVersion{}
+	if err := r.Client.Get(ctx, req.NamespacedName, csv); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Finalizer left by the previous version of the operator which has been replaced by this one.
+	if csv.Name != r.CSVName {
+		if csv.DeletionTimestamp.IsZero() || !controllerutil.ContainsFinalizer(csv, uninstallFinalizerName) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, r.removeFinalizer(ctx, csv)
+	}
+
+	if csv.DeletionTimestamp.IsZero() {
+		if controllerutil.AddFinalizer(csv, uninstallFinalizerName) {
+			r.Log.Info("Adding finalizer for ClusterServiceVersion", "name", csv.Name, "finalizer", uninstallFinalizerName)
+			if err := r.Client.Update(ctx, csv); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(csv, uninstallFinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	uninstalling, err := r.isUninstalled(ctx, csv)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if uninstalling {
+		r.Log.Info("Operator is being uninstalled, removing cluster-scoped resources", "csv", csv.Name)
+		if err := r.removeClusterResources(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed removing cluster-scoped resources on uninstall: %w", err)
+		}
+	}
+
+	return ctrl.Result{}, r.removeFinalizer(ctx, csv)
+}
+
+// isUninstalled checks if the CSV is deleted for good, and not because of being replaced by a newer version
+// or deleted while the Subscription installing it still exists, in which case OLM installs it again.
+func (r *UninstallReconciler) isUninstalled(ctx context.Context, csv *ofapiv1alpha1.ClusterServiceVersion) (bool, error) {
+	csvs := &ofapiv1alpha1.ClusterServiceVersionList{}
+	if err := r.Client.List(ctx, csvs, client.InNamespace(csv.Namespace)); err != nil {
+		return false, fmt.Errorf("failed listing cluster service versions: %w", err)
+	}
+	for _, other := range csvs.Items {
+		if other.Spec.Replaces == csv.Name && other.DeletionTimestamp.IsZero() {
+			return false, nil
+		}
+	}
+
+	subscriptions := &ofapiv1alpha1.SubscriptionList{}
+	if err := r.Client.List(ctx, subscriptions, client.InNamespace(csv.Namespace)); err != nil {
+		return false, fmt.Errorf("failed listing subscriptions: %w", err)
+	}
+	for _, sub := range subscriptions.Items {
+		if sub.DeletionTimestamp.IsZero() && (sub.Status.InstalledCSV == csv.Name || sub.Status.CurrentCSV == csv.Name) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (r *UninstallReconciler) removeClusterResources(ctx context.Context) error {
+	instances := &dsciv1.DSCInitializationList{}
+	if err := r.Client.List(ctx, instances); err != nil {
+		return err
+	}
+
+	// Extension providers are configured in the Service Mesh control plane, which is not owned by the operator.
+	for i := range instances.Items {
+		if err := r.DSCInitialization.removeServiceMesh(ctx, &instances.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	// The namespace webhook rejects deletion of namespaces owned by an active DSCInitialization,
+	// it has to be marked for deletion before namespaces are removed.
+	for i := range instances.Items {
+		if err := r.Client.Delete(ctx, &instances.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting DSCInitialization %s: %w", instances.Items[i].Name, err)
+		}
+	}
+
+	return upgrade.RemoveClusterScopedResources(ctx, r.Client)
+}
+
+func (r *UninstallReconciler) removeFinalizer(ctx context.Context, csv *ofapiv1alpha1.ClusterServiceVersion) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &ofapiv1alpha1.ClusterServiceVersion{}
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(csv), current); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if controllerutil.RemoveFinalizer(current, uninstallFinalizerName) {
+			return r.Client.Update(ctx, current)
+		}
+
+		return nil
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *UninstallReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("uninstall").
+		For(
+			&ofapiv1alpha1.ClusterServiceVersion{},
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetNamespace() == r.OperatorNamespace
+			})),
+		).
+		Complete(r)
+}
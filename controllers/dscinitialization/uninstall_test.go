@@ -0,0 +1,143 @@
+package dscinitialization_test
+
+import (
+	"context"
+
+	ofapi "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Uninstall safeguard", func() {
+
+	const (
+		operatorNamespace = "uninstall-operator-ns"
+		csvName           = "opendatahub-operator.v2.1.0"
+		finalizer         = "dscinitialization.opendatahub.io/uninstall-cleanup"
+	)
+
+	var (
+		featureRole    *rbacv1.ClusterRole
+		componentRole  *rbacv1.ClusterRole
+		unrelatedRole  *rbacv1.ClusterRole
+		tracker        *featurev1.FeatureTracker
+		ownedNamespace *corev1.Namespace
+		dsci           *dsciv1.DSCInitialization
+	)
+
+	newScheme := func() *runtime.Scheme {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+		utilruntime.Must(dsciv1.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(ofapi.AddToScheme(scheme))
+
+		return scheme
+	}
+
+	deletedCSV := func() *ofapi.ClusterServiceVersion {
+		return &ofapi.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              csvName,
+				Namespace:         operatorNamespace,
+				Finalizers:        []string{finalizer},
+				DeletionTimestamp: &metav1.Time{Time: metav1.Now().Time},
+			},
+		}
+	}
+
+	reconcile := func(ctx context.Context, cli client.Client) error {
+		reconciler := &dscictrl.UninstallReconciler{
+			Client:            cli,
+			Log:               ctrl.Log.WithName("controllers").WithName("Uninstall"),
+			DSCInitialization: &dscictrl.DSCInitializationReconciler{Client: cli},
+			CSVName:           csvName,
+			OperatorNamespace: operatorNamespace,
+		}
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: csvName, Namespace: operatorNamespace}})
+
+		return err
+	}
+
+	BeforeEach(func() {
+		featureRole = &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{
+			Name:   "feature-role",
+			Labels: map[string]string{labels.K8SCommon.ManagedBy: cluster.OperatorName},
+		}}
+		componentRole = &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{
+			Name:   "component-role",
+			Labels: map[string]string{labels.ODH.Component("dashboard"): "true"},
+		}}
+		unrelatedRole = &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{
+			Name: "unrelated-role",
+		}}
+		tracker = featurev1.NewFeatureTracker("mesh-control-plane-creation", "opendatahub")
+		ownedNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "opendatahub",
+				Labels: map[string]string{labels.ODH.OwnedNamespace: "true"},
+			},
+			Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		}
+		dsci = &dsciv1.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv1.DSCInitializationSpec{ApplicationsNamespace: "opendatahub"},
+		}
+	})
+
+	It("should remove cluster-scoped resources created by the operator when it is uninstalled", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().
+			WithScheme(newScheme()).
+			WithObjects(deletedCSV(), featureRole, componentRole, unrelatedRole, tracker, ownedNamespace, dsci).
+			Build()
+
+		// when
+		Expect(reconcile(ctx, cli)).To(Succeed())
+
+		// then
+		for _, removed := range []client.Object{dsci, featureRole, componentRole, tracker, ownedNamespace} {
+			Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKeyFromObject(removed), removed))).To(BeTrue(), "%s should be removed", removed.GetName())
+		}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(unrelatedRole), unrelatedRole)).To(Succeed())
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: csvName, Namespace: operatorNamespace}, &ofapi.ClusterServiceVersion{}))).To(BeTrue())
+	})
+
+	It("should keep cluster-scoped resources when the operator is upgraded", func(ctx context.Context) {
+		// given
+		newerCSV := &ofapi.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-operator.v2.2.0", Namespace: operatorNamespace},
+			Spec:       ofapi.ClusterServiceVersionSpec{Replaces: csvName},
+		}
+		cli := fake.NewClientBuilder().
+			WithScheme(newScheme()).
+			WithObjects(deletedCSV(), newerCSV, featureRole, componentRole, tracker, ownedNamespace).
+			Build()
+
+		// when
+		Expect(reconcile(ctx, cli)).To(Succeed())
+
+		// then
+		for _, kept := range []client.Object{featureRole, componentRole, tracker, ownedNamespace} {
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(kept), kept)).To(Succeed(), "%s should be kept", kept.GetName())
+		}
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: csvName, Namespace: operatorNamespace}, &ofapi.ClusterServiceVersion{}))).To(BeTrue())
+	})
+})
@@ -215,6 +215,16 @@ func (r *DSCInitializationReconciler) reconcileDefaultNetworkPolicy(ctx context.
 			return err
 		}
 	} else { // Expected namespace for the given name in ODH
+		optedOut, errOptOut := cluster.MeshOptedOut(ctx, r.Client, name)
+		if errOptOut != nil {
+			return errOptOut
+		}
+		if optedOut {
+			r.Log.Info("namespace opted out of Service Mesh, skipping its default NetworkPolicy", "name", name)
+
+			return nil
+		}
+
 		desiredNetworkPolicy := &networkingv1.NetworkPolicy{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       "NetworkPolicy",
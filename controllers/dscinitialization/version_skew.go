@@ -0,0 +1,60 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// ReportVersionSkew sets the VersionSkew condition of the active DSCInitialization when features have been applied by a newer
+// version of the operator than the running one, and removes it once they are not. It is meant to be run on operator startup.
+func ReportVersionSkew(ctx context.Context, cli client.Client) error {
+	ahead, err := feature.TrackersAheadOfOperator(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	instances := &dsciv1.DSCInitializationList{}
+	if err := cli.List(ctx, instances); err != nil {
+		return fmt.Errorf("failed listing DSCInitialization instances: %w", err)
+	}
+
+	instance := instances.ActiveInstance()
+	if instance == nil {
+		return nil
+	}
+
+	if len(ahead) == 0 && conditionsv1.FindStatusCondition(instance.Status.Conditions, status.ConditionVersionSkew) == nil {
+		return nil
+	}
+
+	_, err = status.UpdateWithRetry(ctx, cli, instance, func(saved *dsciv1.DSCInitialization) {
+		if len(ahead) == 0 {
+			conditionsv1.RemoveStatusCondition(&saved.Status.Conditions, status.ConditionVersionSkew)
+
+			return
+		}
+
+		features := make([]string, 0, len(ahead))
+		for i := range ahead {
+			features = append(features, fmt.Sprintf("%s (%s)", ahead[i].FeatureName(), ahead[i].Status.OperatorVersion))
+		}
+		conditionsv1.SetStatusCondition(&saved.Status.Conditions, conditionsv1.Condition{
+			Type:   status.ConditionVersionSkew,
+			Status: corev1.ConditionTrue,
+			Reason: status.VersionSkewReason,
+			Message: "Features have been applied by a newer operator version, changes to them are refused until it is installed again: " +
+				strings.Join(features, ", "),
+		})
+	})
+
+	return err
+}
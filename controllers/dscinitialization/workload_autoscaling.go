@@ -0,0 +1,67 @@
+package dscinitialization
+
+import (
+	"context"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+const (
+	autoscalingKey         = "Autoscaling"
+	authorinoAutoscaledKey = "AuthorinoAutoscaled"
+
+	defaultMinReplicas          = int32(1)
+	defaultMaxReplicas          = int32(3)
+	defaultTargetCPUUtilization = int32(80)
+)
+
+// gatewayAutoscaling returns autoscaling of the Service Mesh ingress gateway configured in DSCI, nil when not set.
+func gatewayAutoscaling(instance *dsciv1.DSCInitialization) *dsciv1.AutoscalingSpec {
+	if instance.Spec.WorkloadDefaults == nil || instance.Spec.WorkloadDefaults.Autoscaling == nil {
+		return nil
+	}
+
+	return instance.Spec.WorkloadDefaults.Autoscaling.Gateway
+}
+
+// authorinoAutoscaling returns autoscaling of Authorino configured in DSCI, nil when not set.
+func authorinoAutoscaling(instance *dsciv1.DSCInitialization) *dsciv1.AutoscalingSpec {
+	if instance.Spec.WorkloadDefaults == nil || instance.Spec.WorkloadDefaults.Autoscaling == nil {
+		return nil
+	}
+
+	return instance.Spec.WorkloadDefaults.Autoscaling.Authorino
+}
+
+func autoscalingManaged(spec *dsciv1.AutoscalingSpec) bool {
+	return spec != nil && spec.ManagementState == operatorv1.Managed
+}
+
+// resolveAutoscaling returns autoscaling with defaults applied, so that templates can rely on all the values being set.
+func resolveAutoscaling(spec *dsciv1.AutoscalingSpec) dsciv1.AutoscalingSpec {
+	resolved := dsciv1.AutoscalingSpec{}
+	if spec != nil {
+		resolved = *spec
+	}
+
+	if resolved.MinReplicas < 1 {
+		resolved.MinReplicas = defaultMinReplicas
+	}
+	if resolved.MaxReplicas < resolved.MinReplicas {
+		resolved.MaxReplicas = max(defaultMaxReplicas, resolved.MinReplicas)
+	}
+	if resolved.TargetCPUUtilizationPercentage < 1 {
+		resolved.TargetCPUUtilizationPercentage = defaultTargetCPUUtilization
+	}
+
+	return resolved
+}
+
+func autoscalingEnabled(spec *dsciv1.AutoscalingSpec) feature.EnabledFunc {
+	return func(_ context.Context, _ *feature.Feature) (bool, error) {
+		return autoscalingManaged(spec), nil
+	}
+}
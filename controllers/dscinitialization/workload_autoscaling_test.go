@@ -0,0 +1,67 @@
+package dscinitialization_test
+
+import (
+	"context"
+	"path"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Workload autoscaling templates", func() {
+
+	It("should autoscale the ingress gateway through the control plane", func(ctx context.Context) {
+		// given
+		scheme := runtime.NewScheme()
+		utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+
+		smcp := &unstructured.Unstructured{}
+		smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		smcp.SetNamespace("istio-system")
+		smcp.SetName("data-science-smcp")
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(smcp).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+
+		f, err := feature.Define("mesh-gateway-autoscaling").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(dscictrl.Templates.Location).Include(path.Join(dscictrl.Templates.AutoscalingDir, "gateway-autoscaling.patch.tmpl.yaml"))).
+			WithData(
+				feature.Entry("ControlPlane", provider.ValueOf(infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"}).Get),
+				feature.Entry("Autoscaling", provider.ValueOf(dsciv1.AutoscalingSpec{MinReplicas: 2, MaxReplicas: 5, TargetCPUUtilizationPercentage: 70}).Get),
+			).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(smcp), smcp)).To(Succeed())
+		autoScaling, _, _ := unstructured.NestedMap(smcp.Object, "spec", "gateways", "ingress", "runtime", "deployment", "autoScaling")
+		Expect(autoScaling).To(And(
+			HaveKeyWithValue("enabled", true),
+			HaveKeyWithValue("minReplicas", BeNumerically("==", 2)),
+			HaveKeyWithValue("maxReplicas", BeNumerically("==", 5)),
+			HaveKeyWithValue("targetCPUUtilizationPercentage", BeNumerically("==", 70)),
+		))
+	})
+})
@@ -0,0 +1,61 @@
+package dscinitialization
+
+import (
+	"context"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+)
+
+const (
+	minAvailableKey   = "MinAvailable"
+	maxUnavailableKey = "MaxUnavailable"
+)
+
+// defaultMaxUnavailable lets a pod be evicted even when the workload runs a single replica, as Authorino and the gateway do by default,
+// so that the budget does not block node drains.
+var defaultMaxUnavailable = intstr.FromInt32(1)
+
+// gatewayDisruptionBudget returns the PodDisruptionBudget of the Service Mesh ingress gateway configured in DSCI, nil when not set.
+func gatewayDisruptionBudget(instance *dsciv1.DSCInitialization) *dsciv1.DisruptionBudgetSpec {
+	if instance.Spec.WorkloadDefaults == nil || instance.Spec.WorkloadDefaults.PodDisruptionBudgets == nil {
+		return nil
+	}
+
+	return instance.Spec.WorkloadDefaults.PodDisruptionBudgets.Gateway
+}
+
+// authorinoDisruptionBudget returns the PodDisruptionBudget of Authorino configured in DSCI, nil when not set.
+func authorinoDisruptionBudget(instance *dsciv1.DSCInitialization) *dsciv1.DisruptionBudgetSpec {
+	if instance.Spec.WorkloadDefaults == nil || instance.Spec.WorkloadDefaults.PodDisruptionBudgets == nil {
+		return nil
+	}
+
+	return instance.Spec.WorkloadDefaults.PodDisruptionBudgets.Authorino
+}
+
+// disruptionBudgetData provides minAvailable of the budget, formatted to be used in templates, or maxUnavailable with the default
+// applied when minAvailable is not set.
+func disruptionBudgetData(spec *dsciv1.DisruptionBudgetSpec) []feature.Action {
+	minAvailable, maxUnavailable := "", defaultMaxUnavailable.String()
+	if spec != nil && spec.MinAvailable != nil {
+		minAvailable, maxUnavailable = spec.MinAvailable.String(), ""
+	} else if spec != nil && spec.MaxUnavailable != nil {
+		maxUnavailable = spec.MaxUnavailable.String()
+	}
+
+	return []feature.Action{
+		feature.Entry(minAvailableKey, provider.ValueOf(minAvailable).Get),
+		feature.Entry(maxUnavailableKey, provider.ValueOf(maxUnavailable).Get),
+	}
+}
+
+func disruptionBudgetEnabled(spec *dsciv1.DisruptionBudgetSpec) feature.EnabledFunc {
+	return func(_ context.Context, _ *feature.Feature) (bool, error) {
+		return spec != nil && spec.ManagementState == operatorv1.Managed, nil
+	}
+}
@@ -0,0 +1,89 @@
+package dscinitialization_test
+
+import (
+	"context"
+	"path"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Workload disruption budget templates", func() {
+
+	It("should keep the configured share of ingress gateway pods available", func(ctx context.Context) {
+		// given
+		scheme := runtime.NewScheme()
+		utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+
+		f, err := feature.Define("mesh-gateway-disruption-budget").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(dscictrl.Templates.Location).Include(path.Join(dscictrl.Templates.DisruptionBudgetsDir, "gateway-pdb.tmpl.yaml"))).
+			WithData(
+				feature.Entry("ControlPlane", provider.ValueOf(infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"}).Get),
+				feature.Entry("MinAvailable", provider.ValueOf("50%").Get),
+			).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		pdb := &policyv1.PodDisruptionBudget{}
+		Expect(cli.Get(ctx, client.ObjectKey{Namespace: "istio-system", Name: "istio-ingressgateway"}, pdb)).To(Succeed())
+		Expect(pdb.Spec.MinAvailable).To(HaveValue(Equal(intstr.FromString("50%"))))
+		Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue("istio", "ingressgateway"))
+	})
+
+	It("should allow evicting a pod of Authorino when minimum of available pods is not set", func(ctx context.Context) {
+		// given
+		scheme := runtime.NewScheme()
+		utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+
+		f, err := feature.Define("authorino-disruption-budget").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(dscictrl.Templates.Location).Include(path.Join(dscictrl.Templates.DisruptionBudgetsDir, "authorino-pdb.tmpl.yaml"))).
+			WithData(
+				feature.Entry("AuthNamespace", provider.ValueOf("opendatahub-auth-provider").Get),
+				feature.Entry("AuthProviderName", provider.ValueOf("authorino").Get),
+				feature.Entry("MinAvailable", provider.ValueOf("").Get),
+				feature.Entry("MaxUnavailable", provider.ValueOf("1").Get),
+			).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		pdbs := &policyv1.PodDisruptionBudgetList{}
+		Expect(cli.List(ctx, pdbs)).To(Succeed())
+		Expect(pdbs.Items).To(HaveLen(1))
+		Expect(pdbs.Items[0].Spec.MinAvailable).To(BeNil())
+		Expect(pdbs.Items[0].Spec.MaxUnavailable).To(HaveValue(Equal(intstr.FromInt32(1))))
+	})
+})
@@ -0,0 +1,57 @@
+// Package logging contains the controller applying logging configuration of the operator at runtime
+package logging
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+)
+
+// ConfigReconciler applies logging configuration from logger.ConfigMapName ConfigMap in the operator namespace, so that
+// format and levels of the operator logs can be changed without restarting it and losing the state of the failure being debugged.
+type ConfigReconciler struct {
+	Client client.Client
+	Log    logr.Logger
+	// Namespace of the operator, in which the ConfigMap is looked up.
+	Namespace string
+	Logging   *logger.Dynamic
+}
+
+func (r *ConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := r.Client.Get(ctx, req.NamespacedName, configMap); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Defaults of the log mode are restored when the ConfigMap is removed, as its data is empty then.
+	config := logger.ConfigFromData(configMap.Data)
+	if err := r.Logging.Update(config); err != nil {
+		// Retrying does not help until the ConfigMap is fixed, which triggers the reconcile again.
+		r.Log.Error(err, "invalid logging configuration, keeping the current one", "configmap", req.NamespacedName)
+
+		return ctrl.Result{}, nil
+	}
+
+	r.Log.Info("Logging configuration applied", "format", config.Format, "level", config.Level, "levels", config.Levels)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	isLoggingConfig := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetName() == logger.ConfigMapName && obj.GetNamespace() == r.Namespace
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("logging-config").
+		For(&corev1.ConfigMap{}, builder.WithPredicates(isLoggingConfig)).
+		Complete(r)
+}
@@ -0,0 +1,322 @@
+// Package meshcabundle contains the controller syncing the trusted CA bundle into Secrets read by proxies of meshed workloads
+package meshcabundle
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/trustedcabundle"
+)
+
+const (
+	// egressTLSComponent labels DestinationRules created by the controller, so that the ones of hosts no longer configured are found.
+	egressTLSComponent = "egress-tls"
+	// istioTLSModeLabel is set by the sidecar injector on pods of meshed workloads.
+	istioTLSModeLabel = "security.istio.io/tlsMode"
+)
+
+// MeshCABundleReconciler keeps the trusted CA bundle, i.e. the cluster and custom CA bundles of the odh-trusted-ca-bundle ConfigMap,
+// in the odh-trusted-ca-bundle-mesh Secret of every namespace enrolled in the mesh of DSCInitialization, together with a DestinationRule
+// originating TLS for each of spec.serviceMesh.egressTLS.hosts. Proxies resolve the Secret referenced by credentialName only for
+// DestinationRules of their own namespace selecting their workload, so the rules cannot be shared from the control plane namespace.
+type MeshCABundleReconciler struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MeshCABundleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Log.Info("Adding controller for mesh CA bundle synchronization.")
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("mesh-ca-bundle-controller").
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.watchTrustedCABundleConfigMap), builder.WithPredicates(trustedCABundleChangedPredicate)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.watchMeshCASecret)).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.watchNamespace), builder.WithPredicates(meshMembershipChangedPredicate)).
+		Watches(&dsciv1.DSCInitialization{}, handler.EnqueueRequestsFromMapFunc(r.watchDSCInitialization), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// Reconcile creates, updates or deletes the odh-trusted-ca-bundle-mesh Secret in the namespace of the request.
+func (r *MeshCABundleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	dsciInstances := &dsciv1.DSCInitializationList{}
+	if err := r.Client.List(ctx, dsciInstances); err != nil {
+		return ctrl.Result{}, errors.WithMessage(err, "error getting DSCInitialization to sync mesh CA bundle")
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: req.Namespace}, namespace); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	caBundle := &corev1.ConfigMap{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: trustedcabundle.CAConfigMapName, Namespace: req.Namespace}, caBundle); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, err
+	} else if k8serr.IsNotFound(err) {
+		caBundle = nil
+	}
+
+	dsci := dsciInstances.ActiveInstance()
+	desired := DesiredSecret(dsci, namespace, caBundle)
+	if desired == nil {
+		if err := r.syncDestinationRules(ctx, req.Namespace, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, r.deleteSecret(ctx, req.Namespace)
+	}
+
+	r.Log.Info("Syncing trusted CA bundle for mesh proxies", "namespace", req.Namespace, "secret", trustedcabundle.MeshCASecretName)
+
+	if err := r.syncSecret(ctx, desired); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, r.syncDestinationRules(ctx, req.Namespace, DesiredDestinationRules(dsci, namespace))
+}
+
+func (r *MeshCABundleReconciler) syncSecret(ctx context.Context, desired *corev1.Secret) error {
+	existing := &corev1.Secret{}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		if !k8serr.IsNotFound(err) {
+			return err
+		}
+
+		return client.IgnoreAlreadyExists(r.Client.Create(ctx, desired))
+	}
+
+	if reflect.DeepEqual(existing.Data, desired.Data) {
+		return nil
+	}
+	existing.Data = desired.Data
+
+	return r.Client.Update(ctx, existing)
+}
+
+// syncDestinationRules creates or updates the desired DestinationRules of the namespace and deletes the ones created by the controller
+// for hosts which are no longer configured.
+func (r *MeshCABundleReconciler) syncDestinationRules(ctx context.Context, namespace string, desired []*unstructured.Unstructured) error {
+	existing := &unstructured.UnstructuredList{}
+	existing.SetGroupVersionKind(gvk.DestinationRule.GroupVersion().WithKind(gvk.DestinationRule.Kind + "List"))
+	if err := r.Client.List(ctx, existing, client.InNamespace(namespace), client.MatchingLabels(egressTLSLabels())); err != nil {
+		if meta.IsNoMatchError(err) && len(desired) == 0 {
+			return nil
+		}
+
+		return errors.WithMessage(err, "error listing DestinationRules originating TLS")
+	}
+
+	current := make(map[string]*unstructured.Unstructured, len(existing.Items))
+	for i := range existing.Items {
+		current[existing.Items[i].GetName()] = &existing.Items[i]
+	}
+
+	for _, rule := range desired {
+		found, exists := current[rule.GetName()]
+		delete(current, rule.GetName())
+		if !exists {
+			if err := r.Client.Create(ctx, rule); client.IgnoreAlreadyExists(err) != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if reflect.DeepEqual(found.Object["spec"], rule.Object["spec"]) {
+			continue
+		}
+		found.Object["spec"] = rule.Object["spec"]
+		if err := r.Client.Update(ctx, found); err != nil {
+			return err
+		}
+	}
+
+	for _, stale := range current {
+		if err := r.Client.Delete(ctx, stale); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DesiredSecret returns the Secret holding the trusted CA bundle for proxies in the namespace, nil when there should be none, i.e. when
+// the namespace is not enrolled in the mesh of DSCInitialization, TLS origination is not configured or the CA bundle is not managed.
+func DesiredSecret(dsci *dsciv1.DSCInitialization, namespace *corev1.Namespace, caBundle *corev1.ConfigMap) *corev1.Secret {
+	if dsci == nil || caBundle == nil || !syncsMeshCABundle(dsci) {
+		return nil
+	}
+
+	if namespace.GetLabels()[labels.MeshMemberOf] != dsci.Spec.ServiceMesh.ControlPlane.Namespace {
+		return nil
+	}
+
+	bundle := trustedcabundle.CombinedCABundle(caBundle)
+	if bundle == "" {
+		return nil
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      trustedcabundle.MeshCASecretName,
+			Namespace: namespace.Name,
+			Labels:    map[string]string{labels.K8SCommon.PartOf: "opendatahub-operator"},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{trustedcabundle.MeshCADataFieldName: []byte(bundle)},
+	}
+}
+
+// DesiredDestinationRules returns DestinationRules originating TLS to the configured hosts from workloads of the namespace, using the
+// Secret returned by DesiredSecret to verify them. None are returned when there should be no Secret.
+func DesiredDestinationRules(dsci *dsciv1.DSCInitialization, namespace *corev1.Namespace) []*unstructured.Unstructured {
+	if dsci == nil || !syncsMeshCABundle(dsci) || namespace.GetLabels()[labels.MeshMemberOf] != dsci.Spec.ServiceMesh.ControlPlane.Namespace {
+		return nil
+	}
+
+	egress := servicemesh.ResolveEgressTLS(dsci.Spec.ServiceMesh)
+	rules := make([]*unstructured.Unstructured, 0, len(egress.Destinations))
+	for _, destination := range egress.Destinations {
+		rule := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"host": destination.Host,
+				// Injected proxies label their pods with the TLS mode, so all the meshed workloads of the namespace are selected.
+				"workloadSelector": map[string]any{
+					"matchLabels": map[string]any{istioTLSModeLabel: "istio"},
+				},
+				"exportTo": []any{"."},
+				"trafficPolicy": map[string]any{
+					"portLevelSettings": []any{
+						map[string]any{
+							"port": map[string]any{"number": int64(80)},
+							"tls": map[string]any{
+								"mode":           "SIMPLE",
+								"credentialName": egress.CASecretName,
+								"sni":            destination.Host,
+							},
+						},
+					},
+				},
+			},
+		}}
+		rule.SetGroupVersionKind(gvk.DestinationRule)
+		rule.SetName(destination.Name)
+		rule.SetNamespace(namespace.Name)
+		rule.SetLabels(egressTLSLabels())
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func egressTLSLabels() map[string]string {
+	return map[string]string{labels.K8SCommon.PartOf: "opendatahub-operator", labels.ODH.Component(egressTLSComponent): "true"}
+}
+
+func syncsMeshCABundle(dsci *dsciv1.DSCInitialization) bool {
+	serviceMesh := dsci.Spec.ServiceMesh
+	trustedCABundle := dsci.Spec.TrustedCABundle
+
+	return serviceMesh != nil && serviceMesh.ManagementState == operatorv1.Managed && len(serviceMesh.EgressTLS.Hosts) > 0 &&
+		trustedCABundle != nil && trustedCABundle.ManagementState == operatorv1.Managed
+}
+
+// deleteSecret removes the Secret created by the controller. Secrets of the same name without its label are left intact.
+func (r *MeshCABundleReconciler) deleteSecret(ctx context.Context, namespace string) error {
+	existing := &corev1.Secret{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: trustedcabundle.MeshCASecretName, Namespace: namespace}, existing); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if existing.GetLabels()[labels.K8SCommon.PartOf] != "opendatahub-operator" {
+		return nil
+	}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx, existing))
+}
+
+func (r *MeshCABundleReconciler) watchTrustedCABundleConfigMap(_ context.Context, a client.Object) []reconcile.Request {
+	if a.GetName() != trustedcabundle.CAConfigMapName {
+		return nil
+	}
+
+	return []reconcile.Request{requestFor(a.GetNamespace())}
+}
+
+// watchMeshCASecret reconciles the namespace when the Secret is changed or deleted by others.
+func (r *MeshCABundleReconciler) watchMeshCASecret(_ context.Context, a client.Object) []reconcile.Request {
+	if a.GetName() != trustedcabundle.MeshCASecretName {
+		return nil
+	}
+
+	return []reconcile.Request{requestFor(a.GetNamespace())}
+}
+
+func (r *MeshCABundleReconciler) watchNamespace(_ context.Context, a client.Object) []reconcile.Request {
+	return []reconcile.Request{requestFor(a.GetName())}
+}
+
+// watchDSCInitialization reconciles all the namespaces enrolled in a mesh, as changes of the configuration may add or remove their Secrets.
+func (r *MeshCABundleReconciler) watchDSCInitialization(ctx context.Context, _ client.Object) []reconcile.Request {
+	namespaces := &corev1.NamespaceList{}
+	if err := r.Client.List(ctx, namespaces, client.HasLabels{labels.MeshMemberOf}); err != nil {
+		r.Log.Error(err, "failed listing namespaces enrolled in the mesh")
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(namespaces.Items))
+	for i := range namespaces.Items {
+		requests = append(requests, requestFor(namespaces.Items[i].Name))
+	}
+
+	return requests
+}
+
+func requestFor(namespace string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Name: trustedcabundle.MeshCASecretName, Namespace: namespace}}
+}
+
+var trustedCABundleChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldCM, _ := e.ObjectOld.(*corev1.ConfigMap)
+		newCM, _ := e.ObjectNew.(*corev1.ConfigMap)
+		return !reflect.DeepEqual(oldCM.Data, newCM.Data)
+	},
+}
+
+var meshMembershipChangedPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		_, isMember := e.Object.GetLabels()[labels.MeshMemberOf]
+		return isMember
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectOld.GetLabels()[labels.MeshMemberOf] != e.ObjectNew.GetLabels()[labels.MeshMemberOf]
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+}
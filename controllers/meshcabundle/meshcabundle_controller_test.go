@@ -0,0 +1,180 @@
+package meshcabundle_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/meshcabundle"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/trustedcabundle"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Mesh CA bundle", func() {
+
+	const namespace = "data-science-project"
+
+	createDSCI := func(hosts ...string) *dsciv1.DSCInitialization {
+		return &dsciv1.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec: dsciv1.DSCInitializationSpec{
+				ApplicationsNamespace: "opendatahub",
+				TrustedCABundle:       &dsciv1.TrustedCABundleSpec{ManagementState: operatorv1.Managed},
+				ServiceMesh: &infrav1.ServiceMeshSpec{
+					ManagementState: operatorv1.Managed,
+					ControlPlane:    infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"},
+					EgressTLS:       infrav1.EgressTLSSpec{Hosts: hosts},
+				},
+			},
+		}
+	}
+
+	createNamespace := func(memberOf string) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+		if memberOf != "" {
+			ns.SetLabels(map[string]string{labels.MeshMemberOf: memberOf})
+		}
+
+		return ns
+	}
+
+	caBundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: trustedcabundle.CAConfigMapName, Namespace: namespace},
+		Data: map[string]string{
+			trustedcabundle.ClusterCADataFieldName: "cluster-ca\n",
+			trustedcabundle.CADataFieldName:        "corporate-ca",
+		},
+	}
+
+	newReconciler := func(objects ...client.Object) (*meshcabundle.MeshCABundleReconciler, client.Client) {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(dsciv1.AddToScheme(scheme)).To(Succeed())
+		scheme.AddKnownTypeWithName(gvk.DestinationRule, &unstructured.Unstructured{})
+		scheme.AddKnownTypeWithName(gvk.DestinationRule.GroupVersion().WithKind(gvk.DestinationRule.Kind+"List"), &unstructured.UnstructuredList{})
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+		return &meshcabundle.MeshCABundleReconciler{Client: cli, Scheme: scheme, Log: logr.Discard()}, cli
+	}
+
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: trustedcabundle.MeshCASecretName}}
+
+	It("should combine cluster and custom CA bundles for namespaces of the mesh", func() {
+		// when
+		secret := meshcabundle.DesiredSecret(createDSCI("minio.corp.example.com"), createNamespace("istio-system"), caBundle)
+
+		// then
+		Expect(secret).ToNot(BeNil())
+		Expect(secret.Namespace).To(Equal(namespace))
+		Expect(string(secret.Data[trustedcabundle.MeshCADataFieldName])).To(Equal("cluster-ca\ncorporate-ca\n"))
+	})
+
+	DescribeTable("should not sync CA bundle",
+		func(dsci *dsciv1.DSCInitialization, ns *corev1.Namespace) {
+			Expect(meshcabundle.DesiredSecret(dsci, ns, caBundle)).To(BeNil())
+		},
+		Entry("into namespaces outside of the mesh", createDSCI("minio.corp.example.com"), createNamespace("")),
+		Entry("into namespaces of another mesh", createDSCI("minio.corp.example.com"), createNamespace("other-mesh-system")),
+		Entry("when TLS origination is not configured", createDSCI(), createNamespace("istio-system")),
+	)
+
+	It("should create and update the Secret", func(ctx context.Context) {
+		// given
+		reconciler, cli := newReconciler(createDSCI("minio.corp.example.com"), createNamespace("istio-system"), caBundle.DeepCopy())
+
+		// when
+		_, err := reconciler.Reconcile(ctx, request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		secret := &corev1.Secret{}
+		Expect(cli.Get(ctx, request.NamespacedName, secret)).To(Succeed())
+		Expect(string(secret.Data[trustedcabundle.MeshCADataFieldName])).To(Equal("cluster-ca\ncorporate-ca\n"))
+
+		// when
+		updated := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(caBundle), updated)).To(Succeed())
+		updated.Data[trustedcabundle.CADataFieldName] = "rotated-corporate-ca"
+		Expect(cli.Update(ctx, updated)).To(Succeed())
+		_, err = reconciler.Reconcile(ctx, request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cli.Get(ctx, request.NamespacedName, secret)).To(Succeed())
+		Expect(string(secret.Data[trustedcabundle.MeshCADataFieldName])).To(Equal("cluster-ca\nrotated-corporate-ca\n"))
+	})
+
+	It("should delete the Secret when namespace leaves the mesh", func(ctx context.Context) {
+		// given
+		existing := meshcabundle.DesiredSecret(createDSCI("minio.corp.example.com"), createNamespace("istio-system"), caBundle)
+		reconciler, cli := newReconciler(createDSCI("minio.corp.example.com"), createNamespace(""), caBundle.DeepCopy(), existing)
+
+		// when
+		_, err := reconciler.Reconcile(ctx, request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8serr.IsNotFound(cli.Get(ctx, request.NamespacedName, &corev1.Secret{}))).To(BeTrue())
+	})
+
+	It("should keep Secret of the same name not created by the operator", func(ctx context.Context) {
+		// given
+		foreign := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: trustedcabundle.MeshCASecretName, Namespace: namespace}}
+		reconciler, cli := newReconciler(createDSCI(), createNamespace("istio-system"), caBundle.DeepCopy(), foreign)
+
+		// when
+		_, err := reconciler.Reconcile(ctx, request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cli.Get(ctx, request.NamespacedName, &corev1.Secret{})).To(Succeed())
+	})
+
+	It("should originate TLS from workloads of the namespace using its Secret", func() {
+		// when
+		rules := meshcabundle.DesiredDestinationRules(createDSCI("minio.corp.example.com"), createNamespace("istio-system"))
+
+		// then
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].GetNamespace()).To(Equal(namespace))
+		Expect(rules[0].GetName()).To(Equal("minio.corp.example.com-egress-tls"))
+		selector, _, _ := unstructured.NestedStringMap(rules[0].Object, "spec", "workloadSelector", "matchLabels")
+		Expect(selector).To(Equal(map[string]string{"security.istio.io/tlsMode": "istio"}))
+		portSettings, _, _ := unstructured.NestedSlice(rules[0].Object, "spec", "trafficPolicy", "portLevelSettings")
+		Expect(portSettings).To(ConsistOf(HaveKeyWithValue("tls", HaveKeyWithValue("credentialName", trustedcabundle.MeshCASecretName))))
+	})
+
+	It("should delete DestinationRules of hosts which are no longer configured", func(ctx context.Context) {
+		// given
+		existing := meshcabundle.DesiredDestinationRules(createDSCI("minio.corp.example.com"), createNamespace("istio-system"))
+		reconciler, cli := newReconciler(createDSCI("s3.corp.example.com"), createNamespace("istio-system"), caBundle.DeepCopy(), existing[0])
+
+		// when
+		_, err := reconciler.Reconcile(ctx, request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		rules := &unstructured.UnstructuredList{}
+		rules.SetGroupVersionKind(gvk.DestinationRule.GroupVersion().WithKind(gvk.DestinationRule.Kind + "List"))
+		Expect(cli.List(ctx, rules, client.InNamespace(namespace))).To(Succeed())
+		Expect(rules.Items).To(HaveLen(1))
+		Expect(rules.Items[0].GetName()).To(Equal("s3.corp.example.com-egress-tls"))
+	})
+})
@@ -0,0 +1,13 @@
+package meshcabundle_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMeshCABundle(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Mesh CA Bundle Controller Suite")
+}
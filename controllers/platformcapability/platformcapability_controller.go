@@ -0,0 +1,269 @@
+// Package platformcapability contains the controller granting external controllers, such as odh-platform, the permissions
+// needed for the capabilities they declare using PlatformCapability resources.
+package platformcapability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/permissions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/refs"
+)
+
+// +kubebuilder:rbac:groups="features.opendatahub.io",resources=platformcapabilities,verbs=get;list;watch
+// +kubebuilder:rbac:groups="features.opendatahub.io",resources=platformcapabilities/status,verbs=get;update;patch
+
+// ErrSensitiveResource is returned when PlatformCapability declares a resource, or a verb, which would let its controller
+// escalate its privileges, see DesiredRules.
+var ErrSensitiveResource = errors.New("granting access to sensitive resources is not allowed")
+
+const (
+	// RolePrefix is prepended to the name of PlatformCapability to name the ClusterRole and ClusterRoleBinding granting its permissions.
+	RolePrefix = "odh-platform-capability-"
+
+	PermissionsGrantedReason = "PermissionsGranted"
+	UnknownResourceReason    = "UnknownResource"
+	ForbiddenResourceReason  = "ForbiddenResource"
+	FailedGrantingReason     = "FailedGranting"
+
+	// unknownResourceRetryPeriod is the period after which PlatformCapability declaring resources not served by the cluster is
+	// reconciled again, as installing their CRDs does not change it.
+	unknownResourceRetryPeriod = time.Minute
+)
+
+// readingVerbs are granted on the ConfigMaps referencing the platform configuration the capabilities rely on.
+var readingVerbs = []string{"get", "list", "watch"} //nolint:gochecknoglobals // Reason: read-only list of verbs
+
+// sensitiveGroups are API groups of resources which let their managers grant themselves further permissions, e.g. by binding
+// roles, admitting or rewriting requests, or registering PlatformCapabilities.
+var sensitiveGroups = []string{ //nolint:gochecknoglobals // Reason: read-only list of groups
+	"rbac.authorization.k8s.io",
+	"admissionregistration.k8s.io",
+	"apiextensions.k8s.io",
+	"certificates.k8s.io",
+	"authentication.k8s.io",
+	"authorization.k8s.io",
+	featurev1.GroupVersion.Group,
+}
+
+// sensitiveCoreResources are resources of the core API group which expose credentials, or let their managers run workloads
+// as any service account of the namespace.
+var sensitiveCoreResources = []string{"secrets", "serviceaccounts", "pods", "nodes"} //nolint:gochecknoglobals // Reason: read-only list of resources
+
+// sensitiveVerbs let holders of the permissions act beyond them.
+var sensitiveVerbs = []string{"*", "escalate", "bind", "impersonate"} //nolint:gochecknoglobals // Reason: read-only list of verbs
+
+// capabilityRules lists the rules needed by each capability: managing the resources wiring workloads into the platform,
+// and reading the references to the platform configuration published by DSCInitialization.
+var capabilityRules = map[featurev1.CapabilityName][]rbacv1.PolicyRule{ //nolint:gochecknoglobals // Reason: read-only rules
+	featurev1.RoutingCapability: {
+		{APIGroups: []string{"route.openshift.io"}, Resources: []string{"routes"}, Verbs: permissions.ManagingVerbs},
+		{APIGroups: []string{"networking.istio.io"}, Resources: []string{"destinationrules", "gateways", "virtualservices"}, Verbs: permissions.ManagingVerbs},
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{refs.MeshRefsName}, Verbs: readingVerbs},
+	},
+	featurev1.AuthorizationCapability: {
+		{APIGroups: []string{"authorino.kuadrant.io"}, Resources: []string{"authconfigs"}, Verbs: permissions.ManagingVerbs},
+		{APIGroups: []string{"security.istio.io"}, Resources: []string{"authorizationpolicies"}, Verbs: permissions.ManagingVerbs},
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{refs.AuthRefsName}, Verbs: readingVerbs},
+	},
+}
+
+// PlatformCapabilityReconciler grants the service account declared in PlatformCapability the permissions needed by the declared
+// capabilities and resources, so that controllers extending the platform are wired into it without changes to the operator.
+type PlatformCapabilityReconciler struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PlatformCapabilityReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Log.Info("Adding controller for platform capabilities.")
+	ownedBy := handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &featurev1.PlatformCapability{})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("platform-capability-controller").
+		For(&featurev1.PlatformCapability{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&rbacv1.ClusterRole{}, ownedBy).
+		Watches(&rbacv1.ClusterRoleBinding{}, ownedBy).
+		Watches(&dsciv1.DSCInitialization{}, handler.EnqueueRequestsFromMapFunc(r.watchDSCInitialization), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// Reconcile applies the ClusterRole and ClusterRoleBinding of PlatformCapability and reports the outcome in its status, together
+// with the references to the platform configuration its capabilities rely on. Both are owned by the PlatformCapability, so that
+// the permissions are revoked once it is deleted.
+func (r *PlatformCapabilityReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	capability := &featurev1.PlatformCapability{}
+	if err := r.Client.Get(ctx, req.NamespacedName, capability); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	rules, err := DesiredRules(r.Client.RESTMapper(), capability)
+	if errors.Is(err, ErrSensitiveResource) {
+		return ctrl.Result{}, r.updateStatus(ctx, capability, corev1.ConditionFalse, ForbiddenResourceReason, err.Error())
+	}
+	if err != nil {
+		// declared resources may be served once their CRDs are installed
+		return ctrl.Result{RequeueAfter: unknownResourceRetryPeriod},
+			r.updateStatus(ctx, capability, corev1.ConditionFalse, UnknownResourceReason, err.Error())
+	}
+
+	dsciInstances := &dsciv1.DSCInitializationList{}
+	if errList := r.Client.List(ctx, dsciInstances); errList != nil {
+		return ctrl.Result{}, fmt.Errorf("error getting DSCInitialization to reference platform configuration: %w", errList)
+	}
+
+	name := RolePrefix + capability.Name
+	subject := rbacv1.Subject{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      capability.Spec.ServiceAccount.Name,
+		Namespace: capability.Spec.ServiceAccount.Namespace,
+	}
+
+	r.Log.Info("Granting permissions for platform capabilities", "name", capability.Name, "serviceAccount", subject.Namespace+"/"+subject.Name)
+	if errApply := cluster.CreateOrUpdatePlatformRBAC(ctx, r.Client, name, subject, rules,
+		cluster.OwnedBy(capability, r.Scheme), cluster.WithLabels(labels.K8SCommon.PartOf, "opendatahub-operator")); errApply != nil {
+		return ctrl.Result{}, errors.Join(errApply, r.updateStatus(ctx, capability, corev1.ConditionFalse, FailedGrantingReason, errApply.Error()))
+	}
+
+	capability.Status.ClusterRole = name
+	capability.Status.ObservedGeneration = capability.Generation
+	capability.Status.References = PlatformReferences(dsciInstances.ActiveInstance(), capability)
+
+	return ctrl.Result{}, r.updateStatus(ctx, capability, corev1.ConditionTrue, PermissionsGrantedReason,
+		fmt.Sprintf("Granted permissions for %s to %s/%s", joined(capability.Spec.Capabilities), subject.Namespace, subject.Name))
+}
+
+// DesiredRules returns the rules needed by the capabilities and the resources declared in PlatformCapability. The kinds of the
+// declared resources are resolved using the mapper, so it fails when any of them is not served by the cluster. It fails with
+// ErrSensitiveResource when any of them is sensitive, e.g. RBAC resources or Secrets, or is declared with verbs such as escalate.
+func DesiredRules(mapper meta.RESTMapper, capability *featurev1.PlatformCapability) ([]rbacv1.PolicyRule, error) {
+	var rules []rbacv1.PolicyRule
+	for _, name := range capability.Spec.Capabilities {
+		rules = append(rules, capabilityRules[name]...)
+	}
+
+	for _, resource := range capability.Spec.Resources {
+		gvk := schema.GroupVersionKind{Group: resource.Group, Version: resource.Version, Kind: resource.Kind}
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed resolving resource of kind %s: %w", gvk, err)
+		}
+
+		if sensitive(mapping.Resource) {
+			return nil, fmt.Errorf("%w: %s", ErrSensitiveResource, mapping.Resource.GroupResource())
+		}
+
+		verbs := resource.Verbs
+		if len(verbs) == 0 {
+			verbs = permissions.ManagingVerbs
+		}
+		if i := slices.IndexFunc(verbs, func(verb string) bool { return slices.Contains(sensitiveVerbs, verb) }); i >= 0 {
+			return nil, fmt.Errorf("%w: verb %q of %s", ErrSensitiveResource, verbs[i], mapping.Resource.GroupResource())
+		}
+
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{mapping.Resource.Group},
+			Resources: []string{mapping.Resource.Resource},
+			Verbs:     verbs,
+		})
+	}
+
+	return rules, nil
+}
+
+// PlatformReferences returns the references to the ConfigMaps publishing the platform configuration the capabilities rely on,
+// which DSCInitialization publishes in the applications namespace once it manages Service Mesh.
+func PlatformReferences(dsci *dsciv1.DSCInitialization, capability *featurev1.PlatformCapability) []featurev1.PlatformReference {
+	if dsci == nil || dsci.Spec.ServiceMesh == nil || dsci.Spec.ServiceMesh.ManagementState != operatorv1.Managed {
+		return nil
+	}
+
+	var references []featurev1.PlatformReference
+	for _, name := range capability.Spec.Capabilities {
+		var configMap string
+		switch name {
+		case featurev1.RoutingCapability:
+			configMap = refs.MeshRefsName
+		case featurev1.AuthorizationCapability:
+			configMap = refs.AuthRefsName
+		default:
+			continue
+		}
+
+		references = append(references, featurev1.PlatformReference{Capability: name, Name: configMap, Namespace: dsci.Spec.ApplicationsNamespace})
+	}
+
+	return references
+}
+
+func sensitive(resource schema.GroupVersionResource) bool {
+	if resource.Group == "" {
+		return slices.Contains(sensitiveCoreResources, resource.Resource)
+	}
+
+	return slices.Contains(sensitiveGroups, resource.Group)
+}
+
+// watchDSCInitialization reconciles all the PlatformCapabilities, as the platform configuration they reference is configured
+// in DSCInitialization.
+func (r *PlatformCapabilityReconciler) watchDSCInitialization(ctx context.Context, _ client.Object) []reconcile.Request {
+	capabilities := &featurev1.PlatformCapabilityList{}
+	if err := r.Client.List(ctx, capabilities); err != nil {
+		r.Log.Error(err, "failed listing platform capabilities")
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(capabilities.Items))
+	for i := range capabilities.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&capabilities.Items[i])})
+	}
+
+	return requests
+}
+
+func (r *PlatformCapabilityReconciler) updateStatus(ctx context.Context, capability *featurev1.PlatformCapability,
+	status corev1.ConditionStatus, reason, message string) error {
+	conditionsv1.SetStatusCondition(&capability.Status.Conditions, conditionsv1.Condition{
+		Type:    conditionsv1.ConditionAvailable,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+
+	return r.Client.Status().Update(ctx, capability)
+}
+
+func joined(capabilities []featurev1.CapabilityName) string {
+	names := make([]string, 0, len(capabilities))
+	for _, name := range capabilities {
+		names = append(names, string(name))
+	}
+
+	return strings.Join(names, ", ")
+}
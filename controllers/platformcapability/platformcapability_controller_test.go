@@ -0,0 +1,159 @@
+package platformcapability_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/platformcapability"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/permissions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/refs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Platform capability", func() {
+
+	createCapability := func(resources ...featurev1.ResourceReference) *featurev1.PlatformCapability {
+		return &featurev1.PlatformCapability{
+			ObjectMeta: metav1.ObjectMeta{Name: "odh-platform"},
+			Spec: featurev1.PlatformCapabilitySpec{
+				Capabilities:   []featurev1.CapabilityName{featurev1.RoutingCapability},
+				ServiceAccount: featurev1.ServiceAccountReference{Name: "odh-platform-controller", Namespace: "opendatahub"},
+				Resources:      resources,
+			},
+		}
+	}
+
+	servicesMapper := func() meta.RESTMapper {
+		mapper := meta.NewDefaultRESTMapper(nil)
+		mapper.Add(schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService"}, meta.RESTScopeNamespace)
+		mapper.Add(schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Secret"}, meta.RESTScopeNamespace)
+		mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"}, meta.RESTScopeRoot)
+
+		return mapper
+	}
+
+	It("should grant managing resources of the capability and reading its platform references", func() {
+		// when
+		rules, err := platformcapability.DesiredRules(servicesMapper(), createCapability())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rules).To(ContainElements(
+			rbacv1.PolicyRule{APIGroups: []string{"route.openshift.io"}, Resources: []string{"routes"}, Verbs: permissions.ManagingVerbs},
+			rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{refs.MeshRefsName}, Verbs: []string{"get", "list", "watch"}},
+		))
+		Expect(rules).ToNot(ContainElement(HaveField("ResourceNames", ContainElement(refs.AuthRefsName))))
+	})
+
+	It("should grant declared resources with the declared verbs, or all the verbs needed to manage them", func() {
+		// given
+		capability := createCapability(
+			featurev1.ResourceReference{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService", Verbs: []string{"get", "watch"}},
+			featurev1.ResourceReference{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService"},
+		)
+
+		// when
+		rules, err := platformcapability.DesiredRules(servicesMapper(), capability)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rules).To(ContainElements(
+			rbacv1.PolicyRule{APIGroups: []string{"serving.kserve.io"}, Resources: []string{"inferenceservices"}, Verbs: []string{"get", "watch"}},
+			rbacv1.PolicyRule{APIGroups: []string{"serving.kserve.io"}, Resources: []string{"inferenceservices"}, Verbs: permissions.ManagingVerbs},
+		))
+	})
+
+	It("should fail when declared resource is not served by the cluster", func() {
+		// given
+		capability := createCapability(featurev1.ResourceReference{Group: "serving.knative.dev", Version: "v1", Kind: "Service"})
+
+		// when
+		_, err := platformcapability.DesiredRules(servicesMapper(), capability)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("serving.knative.dev/v1, Kind=Service")))
+	})
+
+	DescribeTable("should refuse granting access to sensitive resources",
+		func(resource featurev1.ResourceReference, expected string) {
+			// when
+			_, err := platformcapability.DesiredRules(servicesMapper(), createCapability(resource))
+
+			// then
+			Expect(err).To(MatchError(platformcapability.ErrSensitiveResource))
+			Expect(err).To(MatchError(ContainSubstring(expected)))
+		},
+		Entry("secrets", featurev1.ResourceReference{Version: "v1", Kind: "Secret", Verbs: []string{"get"}}, "secrets"),
+		Entry("RBAC resources", featurev1.ResourceReference{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"}, "clusterrolebindings"),
+		Entry("escalating verbs", featurev1.ResourceReference{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService", Verbs: []string{"get", "*"}}, `verb "*"`),
+	)
+
+	It("should reference platform configuration of the capabilities once Service Mesh is managed", func() {
+		// given
+		capability := createCapability()
+		capability.Spec.Capabilities = []featurev1.CapabilityName{featurev1.RoutingCapability, featurev1.AuthorizationCapability}
+		dsci := &dsciv1.DSCInitialization{Spec: dsciv1.DSCInitializationSpec{
+			ApplicationsNamespace: "opendatahub",
+			ServiceMesh:           &infrav1.ServiceMeshSpec{ManagementState: operatorv1.Managed},
+		}}
+
+		// when
+		references := platformcapability.PlatformReferences(dsci, capability)
+
+		// then
+		Expect(references).To(ConsistOf(
+			featurev1.PlatformReference{Capability: featurev1.RoutingCapability, Name: refs.MeshRefsName, Namespace: "opendatahub"},
+			featurev1.PlatformReference{Capability: featurev1.AuthorizationCapability, Name: refs.AuthRefsName, Namespace: "opendatahub"},
+		))
+		Expect(platformcapability.PlatformReferences(nil, capability)).To(BeEmpty())
+	})
+
+	It("should report unknown resources in status without granting any permissions", func(ctx context.Context) {
+		// given
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(featurev1.AddToScheme(scheme)).To(Succeed())
+		capability := createCapability(featurev1.ResourceReference{Group: "serving.knative.dev", Version: "v1", Kind: "Service"})
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(servicesMapper()).
+			WithObjects(capability).WithStatusSubresource(capability).Build()
+		reconciler := &platformcapability.PlatformCapabilityReconciler{Client: cli, Scheme: scheme, Log: logr.Discard()}
+
+		// when
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: capability.Name}})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		reconciled := &featurev1.PlatformCapability{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(capability), reconciled)).To(Succeed())
+		available := conditionsv1.FindStatusCondition(reconciled.Status.Conditions, conditionsv1.ConditionAvailable)
+		Expect(available).ToNot(BeNil())
+		Expect(available.Status).To(Equal(corev1.ConditionFalse))
+		Expect(available.Reason).To(Equal(platformcapability.UnknownResourceReason))
+		Expect(reconciled.Status.ClusterRole).To(BeEmpty())
+
+		roles := &rbacv1.ClusterRoleList{}
+		Expect(cli.List(ctx, roles)).To(Succeed())
+		Expect(roles.Items).To(BeEmpty())
+	})
+})
@@ -0,0 +1,13 @@
+package platformcapability_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPlatformCapability(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Platform Capability Controller Suite")
+}
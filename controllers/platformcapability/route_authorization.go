@@ -0,0 +1,459 @@
+package platformcapability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// ExposingKinds are kinds of resources which opt into authorization of the workloads they expose through annotations.Authorization.
+var ExposingKinds = []schema.GroupVersionKind{gvk.Route, gvk.VirtualService} //nolint:gochecknoglobals // Reason: read-only list of kinds
+
+// exposingResources are the resources of ExposingKinds, which users have to be allowed to get to access the exposed workloads.
+var exposingResources = map[string]string{ //nolint:gochecknoglobals // Reason: read-only mapping of kinds
+	gvk.Route.Kind:          "routes",
+	gvk.VirtualService.Kind: "virtualservices",
+}
+
+// generatedKinds are kinds of resources generated to protect the exposed workloads.
+var generatedKinds = []schema.GroupVersionKind{gvk.AuthorizationPolicy, gvk.AuthConfig} //nolint:gochecknoglobals // Reason: read-only list of kinds
+
+// NotMeshMemberReason is the reason of the event reported on the resource requesting authorization of workloads which are not
+// part of the mesh, as the AuthorizationPolicy is enforced by their sidecars.
+const NotMeshMemberReason = "NotMeshMember"
+
+// RouteAuthorizationReconciler protects workloads exposed by Routes and VirtualServices annotated with annotations.Authorization,
+// using the authorization provider configured in DSCInitialization. For each such resource it applies an AuthorizationPolicy
+// delegating requests to the workloads of the exposed Service to Authorino, and an AuthConfig requiring a token of a user allowed
+// to get the annotated resource for its hosts. Both are owned by the annotated resource, and removed once the annotation is removed.
+// Workloads are protected only in namespaces which are members of the mesh, as the policy is enforced by their sidecars.
+type RouteAuthorizationReconciler struct {
+	Client   client.Client
+	Scheme   *runtime.Scheme
+	Log      logr.Logger
+	Recorder record.EventRecorder
+}
+
+// SetupWithManager sets up a controller for each of the ExposingKinds served by the cluster.
+func (r *RouteAuthorizationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	for _, kind := range ExposingKinds {
+		if _, err := mgr.GetRESTMapper().RESTMapping(kind.GroupKind(), kind.Version); err != nil {
+			if !meta.IsNoMatchError(err) {
+				return fmt.Errorf("failed resolving %s: %w", kind.Kind, err)
+			}
+			r.Log.Info("Kind is not served by the cluster, its authorization annotations are not handled", "kind", kind.Kind)
+
+			continue
+		}
+
+		r.Log.Info("Adding controller for authorization of exposed workloads.", "kind", kind.Kind)
+		exposing := &unstructured.Unstructured{}
+		exposing.SetGroupVersionKind(kind)
+		controller := ctrl.NewControllerManagedBy(mgr).
+			Named("authorization-"+strings.ToLower(kind.Kind)+"-controller").
+			For(exposing, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+			Watches(&dsciv1.DSCInitialization{}, handler.EnqueueRequestsFromMapFunc(r.watchDSCInitialization(kind)), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+			Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.watchNamespace(kind)), builder.WithPredicates(meshMembershipChanged))
+
+		// generated resources changed outside the operator are restored
+		for _, generatedKind := range generatedKinds {
+			if _, err := mgr.GetRESTMapper().RESTMapping(generatedKind.GroupKind(), generatedKind.Version); err != nil {
+				if !meta.IsNoMatchError(err) {
+					return fmt.Errorf("failed resolving %s: %w", generatedKind.Kind, err)
+				}
+
+				continue
+			}
+
+			generated := &unstructured.Unstructured{}
+			generated.SetGroupVersionKind(generatedKind)
+			controller = controller.Watches(generated, handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), exposing))
+		}
+
+		err := controller.Complete(reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+			return r.Reconcile(ctx, kind, req)
+		}))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reconcile applies the AuthorizationPolicy and AuthConfig protecting workloads exposed by the resource of the given kind,
+// or removes them when the resource no longer requests authorization, or the authorization provider is not managed.
+func (r *RouteAuthorizationReconciler) Reconcile(ctx context.Context, kind schema.GroupVersionKind, req ctrl.Request) (ctrl.Result, error) {
+	exposing := &unstructured.Unstructured{}
+	exposing.SetGroupVersionKind(kind)
+	if err := r.Client.Get(ctx, req.NamespacedName, exposing); err != nil {
+		// generated resources are garbage collected along with their owner
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	dsciInstances := &dsciv1.DSCInitializationList{}
+	if err := r.Client.List(ctx, dsciInstances); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error getting DSCInitialization to protect %s %s: %w", kind.Kind, req.NamespacedName, err)
+	}
+	dsci := dsciInstances.ActiveInstance()
+
+	if exposing.GetAnnotations()[annotations.Authorization] != "true" || !authorinoManaged(dsci) {
+		return ctrl.Result{}, r.deleteGenerated(ctx, exposing)
+	}
+
+	member, err := r.meshMember(ctx, dsci, exposing.GetNamespace())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !member {
+		// the namespace is reconciled again once it joins the mesh
+		r.Recorder.Eventf(exposing, corev1.EventTypeWarning, NotMeshMemberReason,
+			"Workloads are not protected, as namespace %s is not a member of the service mesh %s/%s", exposing.GetNamespace(),
+			dsci.Spec.ServiceMesh.ControlPlane.Namespace, dsci.Spec.ServiceMesh.ControlPlane.Name)
+
+		return ctrl.Result{}, nil
+	}
+
+	provider, registered, err := servicemesh.AuthExtensionProvider(ctx, r.Client, &dsci.Spec, servicemesh.NewAuthorinoLookup(dsci.Spec.ServiceMesh))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !registered {
+		return ctrl.Result{}, r.deleteGenerated(ctx, exposing)
+	}
+
+	service, err := r.exposedService(ctx, exposing)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	desired, err := DesiredAuthorization(dsci, provider, exposing, service)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed protecting %s %s: %w", kind.Kind, req.NamespacedName, err)
+	}
+
+	r.Log.Info("Protecting exposed workloads", "kind", kind.Kind, "name", req.NamespacedName, "service", service.Name)
+	for _, obj := range desired {
+		if errApply := cluster.Apply(ctx, r.Client, obj, cluster.OwnedBy(exposing, r.Scheme)); errApply != nil {
+			return ctrl.Result{}, errApply
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// GeneratedName is the name of the AuthorizationPolicy and AuthConfig protecting workloads exposed by the resource of the given kind.
+// The kind is part of it, so that a Route and a VirtualService of the same name are told apart.
+func GeneratedName(kind, name string) string {
+	return name + "-" + strings.ToLower(kind) + "-authz"
+}
+
+// DesiredAuthorization renders the AuthorizationPolicy and the AuthConfig protecting workloads of the Service exposed by the resource.
+// The policy delegates requests to the extension provider registered in the control plane, and the AuthConfig admits users allowed
+// to get the resource, so that access is granted using RBAC of its namespace rather than to any authenticated user.
+func DesiredAuthorization(dsci *dsciv1.DSCInitialization, provider servicemesh.ExtensionProvider, exposing *unstructured.Unstructured,
+	service *corev1.Service) ([]*unstructured.Unstructured, error) {
+	if len(service.Spec.Selector) == 0 {
+		return nil, fmt.Errorf("service %s without selector cannot be protected, as policies apply to the workloads it selects", service.Name)
+	}
+
+	hosts, err := exposedHosts(exposing)
+	if err != nil {
+		return nil, err
+	}
+
+	auth := dsci.Spec.ServiceMesh.Auth
+	authConfigLabels, err := k8slabels.ConvertSelectorToLabelsMap(servicemesh.ResolveAuthorino(auth.Authorino).AuthConfigSelector)
+	if err != nil {
+		return nil, fmt.Errorf("AuthConfig selector has to consist of label equalities to generate AuthConfigs: %w", err)
+	}
+	audiences := servicemesh.DefaultAudiences
+	if auth.Audiences != nil && len(*auth.Audiences) > 0 {
+		audiences = *auth.Audiences
+	}
+
+	name := GeneratedName(exposing.GetKind(), exposing.GetName())
+	selector := make(map[string]any, len(service.Spec.Selector))
+	for key, value := range service.Spec.Selector {
+		selector[key] = value
+	}
+
+	policy := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"action":   "CUSTOM",
+			"provider": map[string]any{"name": provider.Name},
+			"rules":    []any{map[string]any{}},
+			"selector": map[string]any{"matchLabels": selector},
+		},
+	}}
+	policy.SetGroupVersionKind(gvk.AuthorizationPolicy)
+
+	authConfig := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"hosts": toAny(hosts),
+			"authentication": map[string]any{
+				"kubernetes-user": map[string]any{
+					"credentials":           map[string]any{"authorizationHeader": map[string]any{"prefix": "Bearer"}},
+					"kubernetesTokenReview": map[string]any{"audiences": toAny(audiences)},
+				},
+			},
+			"authorization": map[string]any{
+				"kubernetes-rbac": map[string]any{
+					"kubernetesSubjectAccessReview": map[string]any{
+						"user":                map[string]any{"selector": "auth.identity.user.username"},
+						"authorizationGroups": map[string]any{"selector": "auth.identity.user.groups"},
+						"resourceAttributes": map[string]any{
+							"verb":      map[string]any{"value": "get"},
+							"group":     map[string]any{"value": exposing.GroupVersionKind().Group},
+							"resource":  map[string]any{"value": exposingResources[exposing.GetKind()]},
+							"namespace": map[string]any{"value": exposing.GetNamespace()},
+							"name":      map[string]any{"value": exposing.GetName()},
+						},
+					},
+				},
+			},
+		},
+	}}
+	authConfig.SetGroupVersionKind(gvk.AuthConfig)
+	authConfig.SetLabels(authConfigLabels)
+
+	generated := []*unstructured.Unstructured{policy, authConfig}
+	for _, obj := range generated {
+		obj.SetName(name)
+		obj.SetNamespace(exposing.GetNamespace())
+		obj.SetLabels(withPartOf(obj.GetLabels()))
+	}
+
+	return generated, nil
+}
+
+// exposedService gets the Service the resource routes to, i.e. the target of the Route, or the destination of the first HTTP route
+// of the VirtualService, which has to be in the same namespace.
+func (r *RouteAuthorizationReconciler) exposedService(ctx context.Context, exposing *unstructured.Unstructured) (*corev1.Service, error) {
+	var name string
+	switch exposing.GetKind() {
+	case gvk.Route.Kind:
+		name, _, _ = unstructured.NestedString(exposing.Object, "spec", "to", "name")
+	case gvk.VirtualService.Kind:
+		httpRoutes, _, _ := unstructured.NestedSlice(exposing.Object, "spec", "http")
+		if httpRoute, ok := first(httpRoutes); ok {
+			destinations, _, _ := unstructured.NestedSlice(httpRoute, "route")
+			if destination, found := first(destinations); found {
+				host, _, _ := unstructured.NestedString(destination, "destination", "host")
+				name = serviceNameOf(host, exposing.GetNamespace())
+			}
+		}
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("%s %s/%s does not route to a Service in its namespace", exposing.GetKind(), exposing.GetNamespace(), exposing.GetName())
+	}
+
+	service := &corev1.Service{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: exposing.GetNamespace()}, service); err != nil {
+		return nil, fmt.Errorf("failed getting Service exposed by %s %s/%s: %w", exposing.GetKind(), exposing.GetNamespace(), exposing.GetName(), err)
+	}
+
+	return service, nil
+}
+
+// serviceNameOf returns the name of the Service of the mesh host, e.g. "dashboard" or "dashboard.opendatahub.svc.cluster.local",
+// or an empty string when the host is not a Service in the namespace.
+func serviceNameOf(host, namespace string) string {
+	name, rest, qualified := strings.Cut(host, ".")
+	if !qualified {
+		return name
+	}
+
+	if hostNamespace, _, _ := strings.Cut(rest, "."); hostNamespace != namespace {
+		return ""
+	}
+
+	return name
+}
+
+func exposedHosts(exposing *unstructured.Unstructured) ([]string, error) {
+	var hosts []string
+	switch exposing.GetKind() {
+	case gvk.Route.Kind:
+		if host, _, _ := unstructured.NestedString(exposing.Object, "spec", "host"); host != "" {
+			hosts = []string{host}
+		}
+	case gvk.VirtualService.Kind:
+		hosts, _, _ = unstructured.NestedStringSlice(exposing.Object, "spec", "hosts")
+	}
+
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("%s %s/%s has no hosts to protect", exposing.GetKind(), exposing.GetNamespace(), exposing.GetName())
+	}
+
+	return hosts, nil
+}
+
+// authorinoManaged checks if DSCInitialization manages Authorino as the authorization provider of the mesh.
+func authorinoManaged(dsci *dsciv1.DSCInitialization) bool {
+	return dsci != nil && dsci.Spec.ServiceMesh != nil && dsci.Spec.ServiceMesh.ManagementState == operatorv1.Managed &&
+		!servicemesh.UsesLightweightAuth(dsci.Spec.ServiceMesh)
+}
+
+// meshMember checks if the namespace is a member of the mesh configured in DSCInitialization, which Service Mesh reports by
+// labeling it with labels.MeshMemberOf.
+func (r *RouteAuthorizationReconciler) meshMember(ctx context.Context, dsci *dsciv1.DSCInitialization, name string) (bool, error) {
+	namespace := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		return false, fmt.Errorf("failed getting namespace %s: %w", name, err)
+	}
+
+	return namespace.GetLabels()[labels.MeshMemberOf] == dsci.Spec.ServiceMesh.ControlPlane.Namespace, nil
+}
+
+// deleteGenerated removes the AuthorizationPolicy and AuthConfig generated for the resource. Resources of the same name
+// which are not owned by it are left intact.
+func (r *RouteAuthorizationReconciler) deleteGenerated(ctx context.Context, exposing *unstructured.Unstructured) error {
+	for _, kind := range generatedKinds {
+		generated := &unstructured.Unstructured{}
+		generated.SetGroupVersionKind(kind)
+		key := types.NamespacedName{Name: GeneratedName(exposing.GetKind(), exposing.GetName()), Namespace: exposing.GetNamespace()}
+		if err := r.Client.Get(ctx, key, generated); err != nil {
+			if k8serr.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return err
+		}
+
+		if !ownedBy(generated, exposing) {
+			continue
+		}
+
+		if err := client.IgnoreNotFound(r.Client.Delete(ctx, generated)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watchDSCInitialization reconciles all the resources of the kind requesting authorization, as changes of the authorization
+// provider configuration apply to all of them.
+func (r *RouteAuthorizationReconciler) watchDSCInitialization(kind schema.GroupVersionKind) handler.MapFunc {
+	return func(ctx context.Context, _ client.Object) []reconcile.Request {
+		exposing := &unstructured.UnstructuredList{}
+		exposing.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+		if err := r.Client.List(ctx, exposing); err != nil {
+			r.Log.Error(err, "failed listing resources requesting authorization", "kind", kind.Kind)
+
+			return nil
+		}
+
+		return requestingAuthorization(exposing)
+	}
+}
+
+// watchNamespace reconciles resources of the kind requesting authorization in the namespace which joined or left the mesh.
+func (r *RouteAuthorizationReconciler) watchNamespace(kind schema.GroupVersionKind) handler.MapFunc {
+	return func(ctx context.Context, namespace client.Object) []reconcile.Request {
+		exposing := &unstructured.UnstructuredList{}
+		exposing.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+		if err := r.Client.List(ctx, exposing, client.InNamespace(namespace.GetName())); err != nil {
+			r.Log.Error(err, "failed listing resources requesting authorization", "kind", kind.Kind, "namespace", namespace.GetName())
+
+			return nil
+		}
+
+		return requestingAuthorization(exposing)
+	}
+}
+
+func requestingAuthorization(exposing *unstructured.UnstructuredList) []reconcile.Request {
+	var requests []reconcile.Request
+	for i := range exposing.Items {
+		if _, requested := exposing.Items[i].GetAnnotations()[annotations.Authorization]; requested {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&exposing.Items[i])})
+		}
+	}
+
+	return requests
+}
+
+//nolint:gochecknoglobals // Reason: stateless predicate
+var meshMembershipChanged = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		_, isMember := e.Object.GetLabels()[labels.MeshMemberOf]
+
+		return isMember
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectOld.GetLabels()[labels.MeshMemberOf] != e.ObjectNew.GetLabels()[labels.MeshMemberOf]
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(event.GenericEvent) bool {
+		return false
+	},
+}
+
+func first(items []any) (map[string]any, bool) {
+	if len(items) == 0 {
+		return nil, false
+	}
+	item, ok := items[0].(map[string]any)
+
+	return item, ok
+}
+
+func ownedBy(obj, owner metav1.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func withPartOf(objLabels map[string]string) map[string]string {
+	if objLabels == nil {
+		objLabels = map[string]string{}
+	}
+	objLabels[labels.K8SCommon.PartOf] = "opendatahub-operator"
+
+	return objLabels
+}
+
+func toAny(values []string) []any {
+	converted := make([]any, 0, len(values))
+	for _, value := range values {
+		converted = append(converted, value)
+	}
+
+	return converted
+}
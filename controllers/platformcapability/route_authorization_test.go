@@ -0,0 +1,259 @@
+package platformcapability_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/platformcapability"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route authorization", func() {
+
+	const namespace = "data-science-project"
+
+	createDSCI := func(mode infrav1.AuthMode) *dsciv1.DSCInitialization {
+		return &dsciv1.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec: dsciv1.DSCInitializationSpec{
+				ApplicationsNamespace: "opendatahub",
+				ServiceMesh: &infrav1.ServiceMeshSpec{
+					ManagementState: operatorv1.Managed,
+					ControlPlane:    infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"},
+					Auth:            infrav1.AuthSpec{Mode: mode, Audiences: &[]string{"https://odh.example.com"}},
+				},
+			},
+		}
+	}
+
+	createRoute := func(authorization string) *unstructured.Unstructured {
+		route := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"host": "notebook.apps.example.com",
+				"to":   map[string]any{"kind": "Service", "name": "notebook"},
+			},
+		}}
+		route.SetGroupVersionKind(gvk.Route)
+		route.SetName("notebook")
+		route.SetNamespace(namespace)
+		route.SetUID("route-uid")
+		if authorization != "" {
+			route.SetAnnotations(map[string]string{annotations.Authorization: authorization})
+		}
+
+		return route
+	}
+
+	provider := servicemesh.ExtensionProvider{
+		Name:    "opendatahub-auth-provider",
+		Service: "authorino-authorino-authorization.opendatahub-auth-provider.svc.cluster.local",
+	}
+
+	createNamespace := func(memberOf string) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+		if memberOf != "" {
+			ns.SetLabels(map[string]string{labels.MeshMemberOf: memberOf})
+		}
+
+		return ns
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "notebook", Namespace: namespace},
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "notebook"}},
+	}
+
+	generated := func(kind schema.GroupVersionKind, owner *unstructured.Unstructured) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(kind)
+		obj.SetName(platformcapability.GeneratedName(gvk.Route.Kind, "notebook"))
+		obj.SetNamespace(namespace)
+		if owner != nil {
+			obj.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: gvk.Route.GroupVersion().String(), Kind: gvk.Route.Kind, Name: owner.GetName(), UID: owner.GetUID()}})
+		}
+
+		return obj
+	}
+
+	var recorder *record.FakeRecorder
+
+	newReconciler := func(objects ...client.Object) (*platformcapability.RouteAuthorizationReconciler, client.Client) {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(dsciv1.AddToScheme(scheme)).To(Succeed())
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+		recorder = record.NewFakeRecorder(10)
+
+		return &platformcapability.RouteAuthorizationReconciler{Client: cli, Scheme: scheme, Log: logr.Discard(), Recorder: recorder}, cli
+	}
+
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: "notebook", Namespace: namespace}}
+
+	It("should delegate authorization of the exposed workloads to Authorino for hosts of the Route", func() {
+		// when
+		desired, err := platformcapability.DesiredAuthorization(createDSCI(infrav1.AuthorinoAuthMode), provider, createRoute("true"), service)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(desired).To(HaveLen(2))
+
+		policy, authConfig := desired[0], desired[1]
+		Expect(policy.GroupVersionKind()).To(Equal(gvk.AuthorizationPolicy))
+		Expect(policy.GetName()).To(Equal("notebook-route-authz"))
+		Expect(policy.GetNamespace()).To(Equal(namespace))
+		Expect(nestedString(policy.Object, "spec", "provider", "name")).To(Equal("opendatahub-auth-provider"))
+		Expect(nestedStringMap(policy.Object, "spec", "selector", "matchLabels")).To(Equal(map[string]string{"app": "notebook"}))
+
+		Expect(authConfig.GroupVersionKind()).To(Equal(gvk.AuthConfig))
+		Expect(authConfig.GetName()).To(Equal("notebook-route-authz"))
+		Expect(authConfig.GetLabels()).To(HaveKeyWithValue("security.opendatahub.io/authorization-group", "default"))
+		Expect(nestedStringSlice(authConfig.Object, "spec", "hosts")).To(ConsistOf("notebook.apps.example.com"))
+		Expect(nestedStringSlice(authConfig.Object, "spec", "authentication", "kubernetes-user", "kubernetesTokenReview", "audiences")).
+			To(ConsistOf("https://odh.example.com"))
+	})
+
+	It("should admit only users allowed to get the Route", func() {
+		// when
+		desired, err := platformcapability.DesiredAuthorization(createDSCI(infrav1.AuthorinoAuthMode), provider, createRoute("true"), service)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		resourceAttributes := []string{"spec", "authorization", "kubernetes-rbac", "kubernetesSubjectAccessReview", "resourceAttributes"}
+		attribute := func(name string) string {
+			return nestedString(desired[1].Object, append(resourceAttributes, name, "value")...)
+		}
+		Expect(attribute("verb")).To(Equal("get"))
+		Expect(attribute("group")).To(Equal("route.openshift.io"))
+		Expect(attribute("resource")).To(Equal("routes"))
+		Expect(attribute("namespace")).To(Equal(namespace))
+		Expect(attribute("name")).To(Equal("notebook"))
+	})
+
+	It("should protect all hosts of the VirtualService", func() {
+		// given
+		virtualService := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"hosts": []any{"notebook.example.com", "notebook.internal"},
+			},
+		}}
+		virtualService.SetGroupVersionKind(gvk.VirtualService)
+		virtualService.SetName("notebook")
+		virtualService.SetNamespace(namespace)
+
+		// when
+		desired, err := platformcapability.DesiredAuthorization(createDSCI(infrav1.AuthorinoAuthMode), provider, virtualService, service)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(desired[1].GetName()).To(Equal("notebook-virtualservice-authz"))
+		Expect(nestedStringSlice(desired[1].Object, "spec", "hosts")).To(ConsistOf("notebook.example.com", "notebook.internal"))
+	})
+
+	It("should refuse to protect Service without selector", func() {
+		// given
+		headless := service.DeepCopy()
+		headless.Spec.Selector = nil
+
+		// when
+		_, err := platformcapability.DesiredAuthorization(createDSCI(infrav1.AuthorinoAuthMode), provider, createRoute("true"), headless)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("without selector")))
+	})
+
+	It("should remove generated resources once the annotation is removed, leaving others intact", func(ctx context.Context) {
+		// given
+		route := createRoute("")
+		ownedPolicy := generated(gvk.AuthorizationPolicy, route)
+		userAuthConfig := generated(gvk.AuthConfig, nil)
+		reconciler, cli := newReconciler(createDSCI(infrav1.AuthorinoAuthMode), route, service, ownedPolicy, userAuthConfig)
+
+		// when
+		_, err := reconciler.Reconcile(ctx, gvk.Route, request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKeyFromObject(ownedPolicy), generated(gvk.AuthorizationPolicy, nil)))).To(BeTrue())
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(userAuthConfig), generated(gvk.AuthConfig, nil))).To(Succeed())
+	})
+
+	It("should remove generated resources when Authorino is not the authorization provider", func(ctx context.Context) {
+		// given
+		route := createRoute("true")
+		ownedAuthConfig := generated(gvk.AuthConfig, route)
+		reconciler, cli := newReconciler(createDSCI(infrav1.LightweightAuthMode), route, service, ownedAuthConfig)
+
+		// when
+		_, err := reconciler.Reconcile(ctx, gvk.Route, request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKeyFromObject(ownedAuthConfig), generated(gvk.AuthConfig, nil)))).To(BeTrue())
+	})
+
+	It("should report workloads of namespace outside of the mesh as not protected", func(ctx context.Context) {
+		// given
+		route := createRoute("true")
+		reconciler, cli := newReconciler(createDSCI(infrav1.AuthorinoAuthMode), createNamespace(""), route, service)
+
+		// when
+		_, err := reconciler.Reconcile(ctx, gvk.Route, request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).To(Receive(ContainSubstring(platformcapability.NotMeshMemberReason)))
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKeyFromObject(generated(gvk.AuthConfig, nil)), generated(gvk.AuthConfig, nil)))).To(BeTrue())
+	})
+
+	It("should fail when the Route does not target a Service", func(ctx context.Context) {
+		// given
+		route := createRoute("true")
+		reconciler, _ := newReconciler(createDSCI(infrav1.AuthorinoAuthMode), createNamespace("istio-system"), route)
+
+		// when
+		_, err := reconciler.Reconcile(ctx, gvk.Route, request)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("failed getting Service exposed by Route")))
+	})
+})
+
+func nestedString(obj map[string]any, fields ...string) string {
+	value, _, _ := unstructured.NestedString(obj, fields...)
+
+	return value
+}
+
+func nestedStringMap(obj map[string]any, fields ...string) map[string]string {
+	value, _, _ := unstructured.NestedStringMap(obj, fields...)
+
+	return value
+}
+
+func nestedStringSlice(obj map[string]any, fields ...string) []string {
+	value, _, _ := unstructured.NestedStringSlice(obj, fields...)
+
+	return value
+}
@@ -0,0 +1,88 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ConditionReady summarizes all the conditions which are required for the resource to be considered fully functional.
+const ConditionReady conditionsv1.ConditionType = "Ready"
+
+const (
+	ReadyReason           string = "Ready"
+	NotReadyReason        string = "NotReady"
+	PendingReason         string = "Pending"
+	ReadyConditionMessage string = "All required conditions are satisfied"
+)
+
+// GatingRule defines when a condition of given type takes part in computing the Ready condition.
+type GatingRule struct {
+	Type conditionsv1.ConditionType
+	// RequiredWhen reports if the condition is required for the resource to be Ready.
+	// When not set, the condition is always required.
+	RequiredWhen func() bool
+}
+
+func (g GatingRule) required() bool {
+	return g.RequiredWhen == nil || g.RequiredWhen()
+}
+
+// Always makes the condition of given type always required for the resource to be Ready.
+func Always(conditionType conditionsv1.ConditionType) GatingRule {
+	return GatingRule{Type: conditionType}
+}
+
+// RequiredWhen makes the condition of given type required for the resource to be Ready only when the predicate holds.
+func RequiredWhen(conditionType conditionsv1.ConditionType, predicate func() bool) GatingRule {
+	return GatingRule{Type: conditionType, RequiredWhen: predicate}
+}
+
+// ConditionAggregator rolls up conditions into a single Ready condition based on the gating rules.
+type ConditionAggregator struct {
+	rules []GatingRule
+}
+
+func NewConditionAggregator(rules ...GatingRule) *ConditionAggregator {
+	return &ConditionAggregator{rules: rules}
+}
+
+// Aggregate computes the Ready condition from given conditions. Ready is:
+//   - False when any of the required conditions is False,
+//   - Unknown when any of the required conditions is not yet reported or its status is Unknown,
+//   - True otherwise.
+func (a *ConditionAggregator) Aggregate(conditions []conditionsv1.Condition) conditionsv1.Condition {
+	var failed, pending []string
+
+	for _, rule := range a.rules {
+		if !rule.required() {
+			continue
+		}
+
+		condition := conditionsv1.FindStatusCondition(conditions, rule.Type)
+		switch {
+		case condition == nil:
+			pending = append(pending, fmt.Sprintf("%s: not reported yet", rule.Type))
+		case condition.Status == corev1.ConditionFalse:
+			failed = append(failed, fmt.Sprintf("%s: %s", rule.Type, condition.Message))
+		case condition.Status != corev1.ConditionTrue:
+			pending = append(pending, fmt.Sprintf("%s: %s", rule.Type, condition.Message))
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		return conditionsv1.Condition{Type: ConditionReady, Status: corev1.ConditionFalse, Reason: NotReadyReason, Message: strings.Join(failed, "; ")}
+	case len(pending) > 0:
+		return conditionsv1.Condition{Type: ConditionReady, Status: corev1.ConditionUnknown, Reason: PendingReason, Message: strings.Join(pending, "; ")}
+	default:
+		return conditionsv1.Condition{Type: ConditionReady, Status: corev1.ConditionTrue, Reason: ReadyReason, Message: ReadyConditionMessage}
+	}
+}
+
+// SetReadyCondition computes the Ready condition using given aggregator and sets it on the conditions.
+func SetReadyCondition(conditions *[]conditionsv1.Condition, aggregator *ConditionAggregator) {
+	conditionsv1.SetStatusCondition(conditions, aggregator.Aggregate(*conditions))
+}
@@ -0,0 +1,89 @@
+package status_test
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ready condition aggregation", func() {
+
+	meshRequired := false
+	aggregator := status.NewConditionAggregator(
+		status.Always(status.ConditionReconcileComplete),
+		status.RequiredWhen(status.CapabilityServiceMesh, func() bool { return meshRequired }),
+	)
+
+	condition := func(conditionType conditionsv1.ConditionType, conditionStatus corev1.ConditionStatus) conditionsv1.Condition {
+		return conditionsv1.Condition{Type: conditionType, Status: conditionStatus, Message: "message from " + string(conditionType)}
+	}
+
+	BeforeEach(func() {
+		meshRequired = false
+	})
+
+	It("should be ready when all required conditions are true", func() {
+		// given
+		conditions := []conditionsv1.Condition{
+			condition(status.ConditionReconcileComplete, corev1.ConditionTrue),
+			condition(status.CapabilityServiceMesh, corev1.ConditionFalse),
+		}
+
+		// when
+		ready := aggregator.Aggregate(conditions)
+
+		// then
+		Expect(ready.Type).To(Equal(status.ConditionReady))
+		Expect(ready.Status).To(Equal(corev1.ConditionTrue))
+	})
+
+	It("should not be ready when gated condition is required and false", func() {
+		// given
+		meshRequired = true
+		conditions := []conditionsv1.Condition{
+			condition(status.ConditionReconcileComplete, corev1.ConditionTrue),
+			condition(status.CapabilityServiceMesh, corev1.ConditionFalse),
+		}
+
+		// when
+		ready := aggregator.Aggregate(conditions)
+
+		// then
+		Expect(ready.Status).To(Equal(corev1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(status.NotReadyReason))
+		Expect(ready.Message).To(ContainSubstring("message from CapabilityServiceMesh"))
+	})
+
+	It("should be unknown when required condition is not reported yet", func() {
+		// given
+		meshRequired = true
+		conditions := []conditionsv1.Condition{
+			condition(status.ConditionReconcileComplete, corev1.ConditionTrue),
+		}
+
+		// when
+		ready := aggregator.Aggregate(conditions)
+
+		// then
+		Expect(ready.Status).To(Equal(corev1.ConditionUnknown))
+		Expect(ready.Reason).To(Equal(status.PendingReason))
+	})
+
+	It("should set Ready condition along existing conditions", func() {
+		// given
+		conditions := []conditionsv1.Condition{
+			condition(status.ConditionReconcileComplete, corev1.ConditionFalse),
+		}
+
+		// when
+		status.SetReadyCondition(&conditions, aggregator)
+
+		// then
+		Expect(conditions).To(HaveLen(2))
+		Expect(conditionsv1.IsStatusConditionFalse(conditions, status.ConditionReady)).To(BeTrue())
+	})
+})
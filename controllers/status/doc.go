@@ -11,6 +11,10 @@
 // conditions, with the only difference being access to an optional error to make changes in the condition
 // to be reported based on the occurred error.
 //
+// Reporter writes the status right away, retrying on conflicts. Reporters configured using WithUpdater queue the updates
+// to the Updater instead, which writes them in the background, so that the reconcile does not wait for status writes.
+// Updates queued for the same object are written together.
+//
 // Example:
 //
 // createReporter initializes a new status reporter for a DSCInitialization resource.
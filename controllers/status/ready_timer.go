@@ -0,0 +1,113 @@
+package status
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TimeToReadyMetricName is the name of the histogram of durations between a change of the spec of an object and the object
+// becoming Ready.
+const TimeToReadyMetricName = "time_to_ready_seconds"
+
+// ReadyTimer measures the time between a change of the spec of an object, i.e. its new generation, and the object becoming Ready.
+// Measurements are exported as the time_to_ready_seconds{kind} histogram, so that reconciliation SLOs can be tracked across
+// operator releases, and are expected to be stamped into the status of the object along with the generation they refer to.
+//
+// Generations are timed from the first reconcile observing them, except for new objects, which are timed from their creation.
+// Changes made while the operator was not running are therefore timed from its start. A nil ReadyTimer measures nothing.
+type ReadyTimer struct {
+	histogram *prometheus.HistogramVec
+
+	mu      sync.Mutex
+	started map[types.UID]generationStart
+}
+
+type generationStart struct {
+	generation int64
+	at         time.Time
+	// readyAfter is set once the generation is Ready, so that it is recorded only once.
+	readyAfter *time.Duration
+}
+
+var _ prometheus.Collector = (*ReadyTimer)(nil)
+
+func NewReadyTimer() *ReadyTimer {
+	return &ReadyTimer{
+		histogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    TimeToReadyMetricName,
+			Help:    "Time in seconds from a change of the spec of the resource until the resource is Ready.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+		}, []string{"kind"}),
+		started: map[types.UID]generationStart{},
+	}
+}
+
+// Start starts timing the current generation of the object, unless it is already timed or it is the readyGeneration,
+// i.e. the object has already been Ready for it.
+func (t *ReadyTimer) Start(obj client.Object, readyGeneration int64) {
+	if t == nil || obj.GetGeneration() == readyGeneration {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if start, found := t.started[obj.GetUID()]; found && start.generation == obj.GetGeneration() {
+		return
+	}
+
+	startedAt := time.Now()
+	if obj.GetGeneration() == 1 {
+		startedAt = obj.GetCreationTimestamp().Time
+	}
+	t.started[obj.GetUID()] = generationStart{generation: obj.GetGeneration(), at: startedAt}
+}
+
+// Ready stops timing the current generation of the object and records how long it took to become Ready. Subsequent calls
+// for the same generation return the same duration without recording it again, so that it can be called when saving status
+// is retried. It returns false when the generation has not been timed, e.g. it is the readyGeneration the object has already
+// been Ready for.
+func (t *ReadyTimer) Ready(obj client.Object, readyGeneration int64) (time.Duration, bool) {
+	if t == nil || obj.GetGeneration() == readyGeneration {
+		return 0, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	start, found := t.started[obj.GetUID()]
+	if !found || start.generation != obj.GetGeneration() {
+		return 0, false
+	}
+
+	if start.readyAfter == nil {
+		elapsed := time.Since(start.at)
+		start.readyAfter = &elapsed
+		t.started[obj.GetUID()] = start
+		t.histogram.WithLabelValues(kindOf(obj)).Observe(elapsed.Seconds())
+	}
+
+	return *start.readyAfter, true
+}
+
+func (t *ReadyTimer) Describe(ch chan<- *prometheus.Desc) {
+	t.histogram.Describe(ch)
+}
+
+func (t *ReadyTimer) Collect(ch chan<- prometheus.Metric) {
+	t.histogram.Collect(ch)
+}
+
+// kindOf returns the kind of the object, which is usually not set in TypeMeta of typed objects read using the client.
+func kindOf(obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
@@ -0,0 +1,85 @@
+package status_test
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ready timer", func() {
+
+	dsci := func(generation int64, createdAgo time.Duration) *dsciv1.DSCInitialization {
+		return &dsciv1.DSCInitialization{ObjectMeta: metav1.ObjectMeta{
+			Name:              "default-dsci",
+			UID:               "dsci-uid",
+			Generation:        generation,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-createdAgo)),
+		}}
+	}
+
+	It("should time new objects from their creation and record the duration once", func() {
+		// given
+		timer := status.NewReadyTimer()
+		instance := dsci(1, 2*time.Minute)
+		timer.Start(instance, 0)
+
+		// when
+		readyAfter, timed := timer.Ready(instance, 0)
+		retriedReadyAfter, retriedTimed := timer.Ready(instance, 0)
+
+		// then
+		Expect(timed).To(BeTrue())
+		Expect(readyAfter).To(BeNumerically(">=", 2*time.Minute))
+		Expect(retriedTimed).To(BeTrue())
+		Expect(retriedReadyAfter).To(Equal(readyAfter))
+		Expect(testutil.CollectAndCount(timer, status.TimeToReadyMetricName)).To(Equal(1))
+	})
+
+	It("should time spec changes from the first reconcile observing them", func() {
+		// given
+		timer := status.NewReadyTimer()
+		instance := dsci(3, time.Hour)
+		timer.Start(instance, 2)
+
+		// when
+		readyAfter, timed := timer.Ready(instance, 2)
+
+		// then
+		Expect(timed).To(BeTrue())
+		Expect(readyAfter).To(BeNumerically("<", time.Minute))
+	})
+
+	It("should not time the generation the object has already been Ready for", func() {
+		// given
+		timer := status.NewReadyTimer()
+		instance := dsci(2, time.Hour)
+		timer.Start(instance, 2)
+
+		// when
+		_, timed := timer.Ready(instance, 2)
+
+		// then
+		Expect(timed).To(BeFalse())
+		Expect(testutil.CollectAndCount(timer, status.TimeToReadyMetricName)).To(BeZero())
+	})
+
+	It("should not time anything when not set", func() {
+		// given
+		var timer *status.ReadyTimer
+		instance := dsci(1, time.Minute)
+		timer.Start(instance, 0)
+
+		// when
+		_, timed := timer.Ready(instance, 0)
+
+		// then
+		Expect(timed).To(BeFalse())
+	})
+})
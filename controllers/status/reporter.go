@@ -14,6 +14,7 @@ type Reporter[T client.Object] struct {
 	object             T
 	client             client.Client
 	determineCondition DetermineCondition[T]
+	updater            *Updater
 }
 
 // DetermineCondition is a function that allow to define how condition should be set.
@@ -30,9 +31,29 @@ func NewStatusReporter[T client.Object](cli client.Client, object T, determine D
 	}
 }
 
+// WithUpdater makes the reporter queue status updates to the Updater instead of writing them right away.
+// Passing nil keeps the updates synchronous.
+func (r *Reporter[T]) WithUpdater(updater *Updater) *Reporter[T] {
+	r.updater = updater
+
+	return r
+}
+
 // ReportCondition updates the status of the object using the determineCondition function.
+// When the reporter uses the Updater, the update is only queued and the object is returned as is.
 func (r *Reporter[T]) ReportCondition(ctx context.Context, optionalErr error) (T, error) {
-	return UpdateWithRetry[T](ctx, r.client, r.object, r.determineCondition(optionalErr))
+	return r.Report(ctx, r.determineCondition(optionalErr))
+}
+
+// Report updates the status of the object using the given function, queued to the Updater the same way ReportCondition does.
+func (r *Reporter[T]) Report(ctx context.Context, update SaveStatusFunc[T]) (T, error) {
+	if r.updater != nil {
+		Enqueue(r.updater, r.object, update)
+
+		return r.object, nil
+	}
+
+	return UpdateWithRetry[T](ctx, r.client, r.object, update)
 }
 
 // SaveStatusFunc is a function that allow to define custom logic of updating status of a concrete resource object.
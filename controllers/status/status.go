@@ -19,6 +19,8 @@ limitations under the License.
 package status
 
 import (
+	"strings"
+
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -69,20 +71,119 @@ const (
 	CapabilityServiceMesh              conditionsv1.ConditionType = "CapabilityServiceMesh"
 	CapabilityServiceMeshAuthorization conditionsv1.ConditionType = "CapabilityServiceMeshAuthorization"
 	CapabilityDSPv2Argo                conditionsv1.ConditionType = "CapabilityDSPv2Argo"
+	// CapabilityServingCertificates reports serving certificates issued for Services listed in spec.servingCertificates.
+	CapabilityServingCertificates conditionsv1.ConditionType = "CapabilityServingCertificates"
 )
 
 const (
-	MissingOperatorReason string = "MissingOperator"
-	ConfiguredReason      string = "Configured"
-	RemovedReason         string = "Removed"
-	CapabilityFailed      string = "CapabilityFailed"
-	ArgoWorkflowExist     string = "ArgoWorkflowExist"
+	MissingOperatorReason         string = "MissingOperator"
+	UnsupportedArchitectureReason string = "UnsupportedArchitecture"
+	ConfiguredReason              string = "Configured"
+	RemovedReason                 string = "Removed"
+	CapabilityFailed              string = "CapabilityFailed"
+	ArgoWorkflowExist             string = "ArgoWorkflowExist"
+	PendingMaintenanceWindow      string = "PendingMaintenanceWindow"
+	PolicyDeniedReason            string = "PolicyDenied"
+	ApplyTimeoutReason            string = "ApplyTimeout"
+	// AuthorinoAdoptedReason reports authorization configured using an existing Authorino instance, see spec.serviceMesh.auth.authorino.adoption.
+	AuthorinoAdoptedReason string = "AuthorinoAdopted"
+	// AuthorinoAdoptionFailedReason reports existing Authorino instances which cannot be adopted, the operator-managed one is deployed instead.
+	AuthorinoAdoptionFailedReason string = "AuthorinoAdoptionFailed"
+	// UnsupportedInstallModeReason reports capabilities configuring namespaces the operator has not been installed for, see cluster.InstallMode.
+	UnsupportedInstallModeReason string = "UnsupportedInstallMode"
+	// UnsupportedClusterVersionReason reports capabilities with features requiring a different OpenShift version than the cluster runs.
+	UnsupportedClusterVersionReason string = "UnsupportedClusterVersion"
+	// CanaryFailedReason reports changes brought by an operator upgrade which failed in the canary namespace, see spec.canaryRollout.
+	CanaryFailedReason string = "CanaryFailed"
+	// ObservedReason reports capabilities managed outside the operator, which are ready, see spec.serviceMesh.managementState.
+	ObservedReason string = "Observed"
 )
 
 const (
 	ReadySuffix = "Ready"
 )
 
+const (
+	// FeatureGroupPrefix prefixes types of the conditions reporting feature groups, see FeatureGroupConditionType.
+	FeatureGroupPrefix = "FeatureGroup"
+
+	// FeatureGroupFailedReason reports feature groups with at least one failed feature, listed in the message of the condition.
+	FeatureGroupFailedReason string = "FeatureGroupFailed"
+)
+
+// FeatureGroupConditionType returns the type of the condition combining the state of the features of the group,
+// e.g. FeatureGroupMeshMetrics for the mesh-metrics group.
+func FeatureGroupConditionType(group string) conditionsv1.ConditionType {
+	var conditionType strings.Builder
+	conditionType.WriteString(FeatureGroupPrefix)
+	for _, word := range strings.Split(group, "-") {
+		if word == "" {
+			continue
+		}
+		conditionType.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	return conditionsv1.ConditionType(conditionType.String())
+}
+
+const (
+	// ConditionApplicationsNamespaceMigrated reports progress of moving applications to the namespace set in spec.applicationsNamespace.
+	ConditionApplicationsNamespaceMigrated conditionsv1.ConditionType = "ApplicationsNamespaceMigrated"
+
+	MigrationInProgressReason string = "MigrationInProgress"
+	MigrationCompletedReason  string = "MigrationCompleted"
+	MigrationFailedReason     string = "MigrationFailed"
+)
+
+const (
+	// ConditionDuplicate is set on DSCInitialization instances which are ignored, because another instance is already active.
+	ConditionDuplicate conditionsv1.ConditionType = "Duplicate"
+
+	DuplicateReason string = "DuplicateInstance"
+)
+
+const (
+	// ConditionVersionSkew is set when features have been applied by a newer version of the operator than the running one,
+	// e.g. after an accidental downgrade. Changes to such features are refused until the newer operator version is installed again.
+	ConditionVersionSkew conditionsv1.ConditionType = "VersionSkew"
+
+	VersionSkewReason   string = "NewerOperatorVersion"
+	NoVersionSkewReason string = "SameOrOlderOperatorVersion"
+)
+
+const (
+	// ConditionDriftDetected is set when fields of the ServiceMeshControlPlane managed by the operator have been changed outside
+	// the operator, listing the changed fields. Depending on spec.serviceMesh.controlPlane.driftPolicy the changes are kept or corrected.
+	ConditionDriftDetected conditionsv1.ConditionType = "DriftDetected"
+
+	ControlPlaneModifiedReason string = "ControlPlaneModified"
+	DriftCorrectedReason       string = "DriftCorrected"
+)
+
+const (
+	// ConditionMissingPermissions is set when the operator has not been granted permissions required by the enabled features,
+	// listing the verbs and resources it lacks, so that they can be granted before the features fail with Forbidden errors.
+	ConditionMissingPermissions conditionsv1.ConditionType = "MissingPermissions"
+
+	MissingPermissionsReason string = "PermissionsNotGranted"
+)
+
+const (
+	// ConditionNameCollision is set when a name derived by the operator, e.g. of the authorization namespace, collides with
+	// another name, so that resources would overwrite each other. Reconciliation stops until the names are changed.
+	ConditionNameCollision conditionsv1.ConditionType = "NameCollision"
+
+	NameCollisionReason string = "GeneratedNameCollision"
+)
+
+const (
+	// ConditionPendingRemovalConfirmation is set when a capability has been set to Removed, but its removal, e.g. of the Service Mesh
+	// control plane, has not been confirmed using the confirmation annotation. The capability is kept until it is confirmed.
+	ConditionPendingRemovalConfirmation conditionsv1.ConditionType = "PendingRemovalConfirmation"
+
+	RemovalNotConfirmedReason string = "RemovalNotConfirmed"
+)
+
 // SetProgressingCondition sets the ProgressingCondition to True and other conditions to false or
 // Unknown. Used when we are just starting to reconcile, and there are no existing conditions.
 func SetProgressingCondition(conditions *[]conditionsv1.Condition, reason string, message string) {
@@ -0,0 +1,125 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Updater writes status updates of objects in the background, so that reconcilers do not wait for the writes, including their
+// retries on conflicts. Updates queued for the same object before it is written are applied together, in the order they have
+// been queued, using a single write. Failed writes are retried with backoff, updates queued in the meantime are applied on top.
+//
+// Updater has to be started, e.g. by adding it to the manager. Updates still queued when it is stopped are dropped, they are
+// expected to be reported again by the next reconcile.
+type Updater struct {
+	client client.Client
+	log    logr.Logger
+	queue  workqueue.RateLimitingInterface
+
+	mu      sync.Mutex
+	pending map[string]*pendingUpdates
+}
+
+type pendingUpdates struct {
+	object  client.Object
+	updates []SaveStatusFunc[client.Object]
+}
+
+var _ manager.Runnable = (*Updater)(nil)
+
+func NewUpdater(cli client.Client, log logr.Logger) *Updater {
+	return &Updater{
+		client: cli,
+		log:    log,
+		queue: workqueue.NewRateLimitingQueueWithConfig(workqueue.DefaultControllerRateLimiter(), workqueue.RateLimitingQueueConfig{
+			Name: "status-updater",
+		}),
+		pending: map[string]*pendingUpdates{},
+	}
+}
+
+// Enqueue queues the update of the status of the object, which is written by the Updater in the background. The Updater writes
+// to its own copy of the object, so the object passed can be used by the caller in the meantime.
+func Enqueue[T client.Object](u *Updater, object T, update SaveStatusFunc[T]) {
+	key := fmt.Sprintf("%T/%s", object, client.ObjectKeyFromObject(object))
+
+	u.mu.Lock()
+	pending, found := u.pending[key]
+	if !found {
+		copied, _ := object.DeepCopyObject().(client.Object)
+		pending = &pendingUpdates{object: copied}
+		u.pending[key] = pending
+	}
+	pending.updates = append(pending.updates, func(saved client.Object) {
+		if typed, isType := saved.(T); isType {
+			update(typed)
+		}
+	})
+	u.mu.Unlock()
+
+	u.queue.Add(key)
+}
+
+// Start writes queued updates until the context is cancelled.
+func (u *Updater) Start(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		u.queue.ShutDown()
+	}()
+
+	for u.processNext(ctx) {
+	}
+
+	return nil
+}
+
+func (u *Updater) processNext(ctx context.Context) bool {
+	item, shutdown := u.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer u.queue.Done(item)
+
+	key, _ := item.(string)
+	u.mu.Lock()
+	pending := u.pending[key]
+	delete(u.pending, key)
+	u.mu.Unlock()
+
+	if pending == nil {
+		u.queue.Forget(item)
+
+		return true
+	}
+
+	_, err := UpdateWithRetry(ctx, u.client, pending.object, func(saved client.Object) {
+		for _, update := range pending.updates {
+			update(saved)
+		}
+	})
+	if err == nil || k8serr.IsNotFound(err) {
+		u.queue.Forget(item)
+
+		return true
+	}
+
+	u.log.Error(err, "failed updating status, retrying", "object", key, "updates", len(pending.updates))
+
+	u.mu.Lock()
+	if queued, found := u.pending[key]; found {
+		queued.updates = append(pending.updates, queued.updates...)
+	} else {
+		u.pending[key] = pending
+	}
+	u.mu.Unlock()
+	u.queue.AddRateLimited(item)
+
+	return true
+}
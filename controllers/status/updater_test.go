@@ -0,0 +1,137 @@
+package status_test
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Status updater", func() {
+
+	var (
+		cli          client.Client
+		dsci         *dsciv1.DSCInitialization
+		statusWrites atomic.Int32
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(dsciv1.AddToScheme(scheme))
+		dsci = &dsciv1.DSCInitialization{ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"}}
+		statusWrites.Store(0)
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(dsci).
+			WithStatusSubresource(dsci).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, cli client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					statusWrites.Add(1)
+
+					return cli.SubResource(subResource).Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+	})
+
+	setCondition := func(conditionType conditionsv1.ConditionType, reason string) status.SaveStatusFunc[*dsciv1.DSCInitialization] {
+		return func(saved *dsciv1.DSCInitialization) {
+			conditionsv1.SetStatusCondition(&saved.Status.Conditions, conditionsv1.Condition{
+				Type:   conditionType,
+				Status: corev1.ConditionTrue,
+				Reason: reason,
+			})
+		}
+	}
+
+	conditionsOf := func(ctx context.Context) []conditionsv1.Condition {
+		current := &dsciv1.DSCInitialization{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(dsci), current)).To(Succeed())
+
+		return current.Status.Conditions
+	}
+
+	It("should write updates queued for the same object using a single write", func(ctx context.Context) {
+		// given
+		updater := status.NewUpdater(cli, logr.Discard())
+		status.Enqueue(updater, dsci, setCondition(status.CapabilityServiceMesh, status.ConfiguredReason))
+		status.Enqueue(updater, dsci, setCondition(status.CapabilityServiceMeshAuthorization, status.ConfiguredReason))
+		status.Enqueue(updater, dsci, setCondition(status.CapabilityServiceMesh, status.CapabilityFailed))
+
+		// when
+		updaterCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		go func() {
+			defer GinkgoRecover()
+			Expect(updater.Start(updaterCtx)).To(Succeed())
+		}()
+
+		// then
+		Eventually(conditionsOf).WithContext(ctx).Should(ConsistOf(
+			HaveField("Reason", status.CapabilityFailed),
+			HaveField("Reason", status.ConfiguredReason),
+		))
+		Consistently(statusWrites.Load).Should(BeEquivalentTo(1))
+	})
+
+	It("should queue conditions reported by the reporter using the updater", func(ctx context.Context) {
+		// given
+		updater := status.NewUpdater(cli, logr.Discard())
+		reporter := status.NewStatusReporter(cli, dsci, func(_ error) status.SaveStatusFunc[*dsciv1.DSCInitialization] {
+			return setCondition(status.CapabilityServiceMesh, status.ConfiguredReason)
+		}).WithUpdater(updater)
+
+		// when
+		_, err := reporter.ReportCondition(ctx, nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conditionsOf(ctx)).To(BeEmpty(), "condition should not be written before the updater is started")
+
+		updaterCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		go func() {
+			defer GinkgoRecover()
+			Expect(updater.Start(updaterCtx)).To(Succeed())
+		}()
+		Eventually(conditionsOf).WithContext(ctx).Should(ConsistOf(HaveField("Type", status.CapabilityServiceMesh)))
+	})
+
+	It("should compute updates from the updates queued before them, without changing the queued object", func(ctx context.Context) {
+		// given
+		updater := status.NewUpdater(cli, logr.Discard())
+		status.Enqueue(updater, dsci, setCondition(status.CapabilityServiceMesh, status.ConfiguredReason))
+		status.Enqueue(updater, dsci, func(saved *dsciv1.DSCInitialization) {
+			status.SetReadyCondition(&saved.Status.Conditions, status.NewConditionAggregator(status.Always(status.CapabilityServiceMesh)))
+		})
+
+		// when
+		updaterCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		go func() {
+			defer GinkgoRecover()
+			Expect(updater.Start(updaterCtx)).To(Succeed())
+		}()
+
+		// then
+		Eventually(conditionsOf).WithContext(ctx).Should(ContainElement(And(
+			HaveField("Type", status.ConditionReady),
+			HaveField("Status", corev1.ConditionTrue),
+		)))
+		Expect(dsci.Status.Conditions).To(BeEmpty())
+	})
+})
@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+//+kubebuilder:webhook:path=/validate-featuretracker-v1,mutating=false,failurePolicy=ignore,sideEffects=None,groups=features.opendatahub.io,resources=featuretrackers;featuretrackers/status,verbs=update,versions=v1,name=featuretracker.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+// FeatureTrackerWebhook denies changes of FeatureTracker spec and status made by anyone else than the operator, as the feature
+// framework relies on them to decide what to apply and manual changes lead to features stuck in their phase. Metadata can still
+// be changed, e.g. to request re-applying the feature using the opendatahub.io/reapply annotation.
+type FeatureTrackerWebhook struct {
+	Decoder *admission.Decoder
+	// AllowedUsers can change spec and status, e.g. "system:serviceaccount:<operator namespace>:<operator service account>".
+	AllowedUsers []string
+	// AllowedGroups can change spec and status, e.g. "system:serviceaccounts:<operator namespace>".
+	AllowedGroups []string
+}
+
+func (w *FeatureTrackerWebhook) SetupWithManager(mgr ctrl.Manager) {
+	hookServer := mgr.GetWebhookServer()
+	featureTrackerWebhook := &webhook.Admission{
+		Handler: w,
+	}
+	hookServer.Register("/validate-featuretracker-v1", featureTrackerWebhook)
+}
+
+func (w *FeatureTrackerWebhook) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Update || w.allowed(req) {
+		return admission.Allowed("")
+	}
+
+	oldTracker := &featurev1.FeatureTracker{}
+	if err := w.Decoder.DecodeRaw(req.OldObject, oldTracker); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	newTracker := &featurev1.FeatureTracker{}
+	if err := w.Decoder.DecodeRaw(req.Object, newTracker); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if equality.Semantic.DeepEqual(oldTracker.Spec, newTracker.Spec) && equality.Semantic.DeepEqual(oldTracker.Status, newTracker.Status) {
+		return admission.Allowed("")
+	}
+
+	return admission.Denied(fmt.Sprintf(
+		"Spec and status of FeatureTracker %s are managed by the operator and cannot be changed by %s. "+
+			"Annotate it with %s=true to apply the feature again instead",
+		req.Name, req.UserInfo.Username, annotations.Reapply,
+	))
+}
+
+func (w *FeatureTrackerWebhook) allowed(req admission.Request) bool {
+	if slices.Contains(w.AllowedUsers, req.UserInfo.Username) {
+		return true
+	}
+
+	for _, group := range req.UserInfo.Groups {
+		if slices.Contains(w.AllowedGroups, group) {
+			return true
+		}
+	}
+
+	return false
+}
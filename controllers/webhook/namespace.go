@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
+)
+
+//+kubebuilder:webhook:path=/validate-namespace-v1,mutating=false,failurePolicy=ignore,sideEffects=None,groups="",resources=namespaces,verbs=delete,versions=v1,name=namespace.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+// NamespaceWebhook protects namespaces created by the operator from being deleted
+// while the capability which owns them is still Managed, unless the operator is being uninstalled.
+type NamespaceWebhook struct {
+	Client  client.Client
+	Decoder *admission.Decoder
+}
+
+func (w *NamespaceWebhook) SetupWithManager(mgr ctrl.Manager) {
+	hookServer := mgr.GetWebhookServer()
+	namespaceWebhook := &webhook.Admission{
+		Handler: w,
+	}
+	hookServer.Register("/validate-namespace-v1", namespaceWebhook)
+}
+
+func (w *NamespaceWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Delete {
+		return admission.Allowed("")
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := w.Decoder.DecodeRaw(req.OldObject, namespace); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if namespace.GetLabels()[labels.ODH.OwnedNamespace] != "true" {
+		return admission.Allowed("")
+	}
+
+	if namespace.GetAnnotations()[annotations.AllowDeletion] == "true" {
+		return admission.Allowed(fmt.Sprintf("Deletion of namespace %s allowed by %s annotation", namespace.Name, annotations.AllowDeletion))
+	}
+
+	dsciList := &dsciv1.DSCInitializationList{}
+	if err := w.Client.List(ctx, dsciList); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if active := dsciList.ActiveInstance(); active != nil && !isUninstalling(ctx, w.Client, active) {
+		if capability, managed := owningCapability(&active.Spec, namespace.Name); managed {
+			return admission.Denied(fmt.Sprintf(
+				"Namespace %s is owned by %s which is still Managed. Set it to Removed first or annotate the namespace with %s=true to force deletion",
+				namespace.Name, capability, annotations.AllowDeletion,
+			))
+		}
+	}
+
+	return admission.Allowed("")
+}
+
+// isUninstalling checks if the operator is removing the platform, i.e. DSCInitialization is being deleted or the operator is being
+// uninstalled using the delete ConfigMap, in which case owned namespaces are deleted on purpose and must not be protected.
+func isUninstalling(ctx context.Context, cli client.Client, instance *dsciv1.DSCInitialization) bool {
+	return !instance.DeletionTimestamp.IsZero() || upgrade.HasDeleteConfigMap(ctx, cli)
+}
+
+// owningCapability finds the capability configured in DSCInitialization which creates given namespace
+// and reports whether it is Managed by the operator.
+func owningCapability(spec *dsciv1.DSCInitializationSpec, namespace string) (string, bool) {
+	if namespace == spec.ApplicationsNamespace {
+		return "DSCInitialization", true
+	}
+
+	if namespace == spec.Monitoring.Namespace {
+		return "Monitoring", spec.Monitoring.ManagementState == operatorv1.Managed
+	}
+
+	if spec.ServiceMesh != nil && namespace == servicemesh.AuthNamespace(spec) {
+		return "Service Mesh Authorization", spec.ServiceMesh.ManagementState == operatorv1.Managed
+	}
+
+	return "", false
+}
@@ -29,12 +29,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/maintenance"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 var log = ctrl.Log.WithName("odh-controller-webhook")
 
-//+kubebuilder:webhook:path=/validate-opendatahub-io-v1,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io;dscinitialization.opendatahub.io,resources=datascienceclusters;dscinitializations,verbs=create;delete,versions=v1,name=operator.opendatahub.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-opendatahub-io-v1,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io;dscinitialization.opendatahub.io,resources=datascienceclusters;dscinitializations,verbs=create;update;delete,versions=v1,name=operator.opendatahub.io,admissionReviewVersions=v1
 //nolint:lll
 
 type OpenDataHubWebhook struct {
@@ -98,11 +103,115 @@ func (w *OpenDataHubWebhook) checkDeletion(ctx context.Context, req admission.Re
 		return admission.Allowed("")
 	}
 
+	// Duplicate DSCI is not reconciled, so it can be removed regardless of DSC
+	dsciList := &dsciv1.DSCInitializationList{}
+	if err := w.Client.List(ctx, dsciList); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if active := dsciList.ActiveInstance(); active != nil && active.Name != req.Name {
+		return admission.Allowed(fmt.Sprintf("Deletion of duplicate DSCInitialization %s allowed", req.Name))
+	}
+
 	// Restrict deletion of DSCI if DSC exists
 	return denyCountGtZero(ctx, w.Client, gvk.DataScienceCluster,
 		fmt.Sprintln("Cannot delete DSCI object when DSC object still exists"))
 }
 
+// validateSimulation ensures that the spec patch proposed using opendatahub.io/simulate annotation
+// on DSCInitialization can be applied, so that the simulation report can be computed.
+func (w *OpenDataHubWebhook) validateSimulation(req admission.Request) admission.Response {
+	if req.Kind.Kind != "DSCInitialization" {
+		return admission.Allowed("")
+	}
+
+	dsci := &dsciv1.DSCInitialization{}
+	if err := w.Decoder.DecodeRaw(req.Object, dsci); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	patch, requested := dsci.GetAnnotations()[annotations.Simulate]
+	if !requested {
+		return admission.Allowed("")
+	}
+
+	if _, err := dsci.ProposedSpec(patch); err != nil {
+		return admission.Denied(fmt.Sprintf("annotation %s does not hold a valid spec patch: %v", annotations.Simulate, err))
+	}
+
+	return admission.Allowed("")
+}
+
+// validateMaintenanceWindow ensures that the maintenance window defined on DSCInitialization can be evaluated.
+func (w *OpenDataHubWebhook) validateMaintenanceWindow(req admission.Request) admission.Response {
+	if req.Kind.Kind != "DSCInitialization" {
+		return admission.Allowed("")
+	}
+
+	dsci := &dsciv1.DSCInitialization{}
+	if err := w.Decoder.DecodeRaw(req.Object, dsci); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if dsci.Spec.MaintenanceWindow == nil {
+		return admission.Allowed("")
+	}
+
+	if _, err := maintenance.NewWindow(dsci.Spec.MaintenanceWindow); err != nil {
+		return admission.Denied(fmt.Sprintf("spec.maintenanceWindow is not valid: %v", err))
+	}
+
+	return admission.Allowed("")
+}
+
+// validateAuthorino ensures that the Authorino listener can be secured with the configured certificate,
+// and that AuthConfigs can be labeled to match the configured selector.
+func (w *OpenDataHubWebhook) validateAuthorino(req admission.Request) admission.Response {
+	if req.Kind.Kind != "DSCInitialization" {
+		return admission.Allowed("")
+	}
+
+	dsci := &dsciv1.DSCInitialization{}
+	if err := w.Decoder.DecodeRaw(req.Object, dsci); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if dsci.Spec.ServiceMesh == nil {
+		return admission.Allowed("")
+	}
+
+	listenerTLS := dsci.Spec.ServiceMesh.Auth.Authorino.ListenerTLS
+	if listenerTLS.Enabled && listenerTLS.CertSecretName == "" {
+		return admission.Denied("spec.serviceMesh.auth.authorino.listenerTLS.certSecretName is required when TLS is enabled")
+	}
+
+	if err := servicemesh.ValidateAuthConfigSelector(dsci.Spec.ServiceMesh.Auth.Authorino.AuthConfigSelector); err != nil {
+		return admission.Denied(fmt.Sprintf("spec.serviceMesh.auth.authorino.authConfigSelector is not valid: %v", err))
+	}
+
+	return admission.Allowed("")
+}
+
+// validateFeatureOverrides ensures that expressions enabling features overridden on DSCInitialization compile,
+// so that they are not only found invalid when features are reconciled.
+func (w *OpenDataHubWebhook) validateFeatureOverrides(req admission.Request) admission.Response {
+	if req.Kind.Kind != "DSCInitialization" {
+		return admission.Allowed("")
+	}
+
+	dsci := &dsciv1.DSCInitialization{}
+	if err := w.Decoder.DecodeRaw(req.Object, dsci); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	for i, override := range dsci.Spec.FeatureOverrides {
+		if err := feature.CompileExpression(override.EnabledWhen); err != nil {
+			return admission.Denied(fmt.Sprintf("spec.featureOverrides[%d].enabledWhen of feature %s is not valid: %v", i, override.Name, err))
+		}
+	}
+
+	return admission.Allowed("")
+}
+
 func (w *OpenDataHubWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
 	var resp admission.Response
 	resp.Allowed = true // initialize Allowed to be true in case Operation falls into "default" case
@@ -110,6 +219,29 @@ func (w *OpenDataHubWebhook) Handle(ctx context.Context, req admission.Request)
 	switch req.Operation {
 	case admissionv1.Create:
 		resp = w.checkDupCreation(ctx, req)
+		if resp.Allowed {
+			resp = w.validateSimulation(req)
+		}
+		if resp.Allowed {
+			resp = w.validateMaintenanceWindow(req)
+		}
+		if resp.Allowed {
+			resp = w.validateAuthorino(req)
+		}
+		if resp.Allowed {
+			resp = w.validateFeatureOverrides(req)
+		}
+	case admissionv1.Update:
+		resp = w.validateSimulation(req)
+		if resp.Allowed {
+			resp = w.validateMaintenanceWindow(req)
+		}
+		if resp.Allowed {
+			resp = w.validateAuthorino(req)
+		}
+		if resp.Allowed {
+			resp = w.validateFeatureOverrides(req)
+		}
 	case admissionv1.Delete:
 		resp = w.checkDeletion(ctx, req)
 	default: // for other operations by default it is admission.Allowed("")
@@ -27,6 +27,7 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -41,6 +42,8 @@ import (
 
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/codeflare"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/dashboard"
@@ -51,6 +54,8 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/trustyai"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/workbenches"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/webhook"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -107,6 +112,12 @@ var _ = BeforeSuite(func() {
 	// Webhook
 	err = admissionv1beta1.AddToScheme(scheme)
 	Expect(err).NotTo(HaveOccurred())
+	// Namespace
+	err = corev1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	// FeatureTracker
+	err = featurev1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
 
 	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme})
 	Expect(err).NotTo(HaveOccurred())
@@ -135,6 +146,15 @@ var _ = BeforeSuite(func() {
 		Decoder: admission.NewDecoder(mgr.GetScheme()),
 	}).SetupWithManager(mgr)
 
+	(&webhook.NamespaceWebhook{
+		Client:  mgr.GetClient(),
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+	}).SetupWithManager(mgr)
+
+	(&webhook.FeatureTrackerWebhook{
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+	}).SetupWithManager(mgr)
+
 	// +kubebuilder:scaffold:webhook
 
 	go func() {
@@ -200,6 +220,156 @@ var _ = Describe("DSC/DSCI webhook", func() {
 		Expect(k8sClient.Delete(ctx, dscInstance)).Should(Succeed())
 		Expect(k8sClient.Delete(ctx, dsciInstance)).Should(Succeed())
 	})
+
+	It("Should block DSCI update when simulate annotation does not hold a valid spec patch", func(ctx context.Context) {
+		dsciInstance := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Create(ctx, dsciInstance)).Should(Succeed())
+
+		dsciInstance.SetAnnotations(map[string]string{annotations.Simulate: `{"serviceMesh": {"unknownField": true}}`})
+		Expect(k8sClient.Update(ctx, dsciInstance)).ShouldNot(Succeed())
+
+		dsciInstance.SetAnnotations(map[string]string{annotations.Simulate: `{"serviceMesh": {"managementState": "Removed"}}`})
+		Expect(k8sClient.Update(ctx, dsciInstance)).Should(Succeed())
+		Expect(clearInstance(ctx, dsciInstance)).Should(Succeed())
+	})
+
+	It("Should block DSCI update when maintenance window schedule is not valid", func(ctx context.Context) {
+		dsciInstance := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Create(ctx, dsciInstance)).Should(Succeed())
+
+		dsciInstance.Spec.MaintenanceWindow = &dsciv1.MaintenanceWindow{Schedule: "0 25 * * *", Duration: metav1.Duration{Duration: time.Hour}}
+		Expect(k8sClient.Update(ctx, dsciInstance)).ShouldNot(Succeed())
+
+		dsciInstance.Spec.MaintenanceWindow.Schedule = "0 2 * * 6"
+		Expect(k8sClient.Update(ctx, dsciInstance)).Should(Succeed())
+		Expect(clearInstance(ctx, dsciInstance)).Should(Succeed())
+	})
+
+	It("Should block DSCI update when Authorino listener TLS is enabled without certificate", func(ctx context.Context) {
+		dsciInstance := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Create(ctx, dsciInstance)).Should(Succeed())
+
+		dsciInstance.Spec.ServiceMesh = &infrav1.ServiceMeshSpec{
+			ManagementState: operatorv1.Managed,
+			Auth: infrav1.AuthSpec{
+				Authorino: infrav1.AuthorinoSpec{
+					ListenerTLS: infrav1.AuthorinoTLSSpec{Enabled: true},
+				},
+			},
+		}
+		Expect(k8sClient.Update(ctx, dsciInstance)).ShouldNot(Succeed())
+
+		dsciInstance.Spec.ServiceMesh.Auth.Authorino.ListenerTLS.CertSecretName = "authorino-server-cert"
+		Expect(k8sClient.Update(ctx, dsciInstance)).Should(Succeed())
+		Expect(clearInstance(ctx, dsciInstance)).Should(Succeed())
+	})
+
+	It("Should block DSCI update when AuthConfig selector cannot be used to label AuthConfigs", func(ctx context.Context) {
+		dsciInstance := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Create(ctx, dsciInstance)).Should(Succeed())
+
+		dsciInstance.Spec.ServiceMesh = &infrav1.ServiceMeshSpec{
+			ManagementState: operatorv1.Managed,
+			Auth: infrav1.AuthSpec{
+				Authorino: infrav1.AuthorinoSpec{
+					AuthConfigSelector: "security.opendatahub.io/authorization-group in (shard-1,shard-2)",
+				},
+			},
+		}
+		Expect(k8sClient.Update(ctx, dsciInstance)).ShouldNot(Succeed())
+
+		dsciInstance.Spec.ServiceMesh.Auth.Authorino.AuthConfigSelector = "security.opendatahub.io/authorization-group=shard-1"
+		Expect(k8sClient.Update(ctx, dsciInstance)).Should(Succeed())
+		Expect(clearInstance(ctx, dsciInstance)).Should(Succeed())
+	})
+
+	It("Should block DSCI creation and update when feature override expression does not compile", func(ctx context.Context) {
+		dsciInstance := newDSCI(nameBase + "-dsci-1")
+		dsciInstance.Spec.FeatureOverrides = []dsciv1.FeatureOverride{{Name: "mesh-metrics-collection", EnabledWhen: "spec.serviceMesh =="}}
+		Expect(k8sClient.Create(ctx, dsciInstance)).ShouldNot(Succeed())
+
+		dsciInstance.Spec.FeatureOverrides = nil
+		Expect(k8sClient.Create(ctx, dsciInstance)).Should(Succeed())
+
+		dsciInstance.Spec.FeatureOverrides = []dsciv1.FeatureOverride{{Name: "mesh-metrics-collection", EnabledWhen: "cluster.ocpMinor + 1"}}
+		Expect(k8sClient.Update(ctx, dsciInstance)).ShouldNot(Succeed())
+
+		dsciInstance.Spec.FeatureOverrides[0].EnabledWhen = "cluster.ocpMinor >= 14"
+		Expect(k8sClient.Update(ctx, dsciInstance)).Should(Succeed())
+		Expect(clearInstance(ctx, dsciInstance)).Should(Succeed())
+	})
+})
+
+var _ = Describe("Namespace webhook", func() {
+	It("Should block deletion of owned namespace while owning capability is Managed", func(ctx context.Context) {
+		dsciInstance := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Create(ctx, dsciInstance)).Should(Succeed())
+
+		ownedNs := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   dsciInstance.Spec.Monitoring.Namespace,
+				Labels: map[string]string{labels.ODH.OwnedNamespace: "true"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, ownedNs)).Should(Succeed())
+		Expect(k8sClient.Delete(ctx, ownedNs)).ShouldNot(Succeed())
+
+		ownedNs.SetAnnotations(map[string]string{annotations.AllowDeletion: "true"})
+		Expect(k8sClient.Update(ctx, ownedNs)).Should(Succeed())
+		Expect(k8sClient.Delete(ctx, ownedNs)).Should(Succeed())
+		Expect(clearInstance(ctx, dsciInstance)).Should(Succeed())
+	})
+
+	It("Should allow deletion of owned namespace while DSCInitialization is being deleted", func(ctx context.Context) {
+		dsciInstance := newDSCI(nameBase + "-dsci-1")
+		dsciInstance.Spec.Monitoring.Namespace = nameBase + "-monitoring-uninstall"
+		dsciInstance.SetFinalizers([]string{"webhook-test/hold"})
+		Expect(k8sClient.Create(ctx, dsciInstance)).Should(Succeed())
+		Expect(k8sClient.Delete(ctx, dsciInstance)).Should(Succeed())
+
+		ownedNs := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   dsciInstance.Spec.Monitoring.Namespace,
+				Labels: map[string]string{labels.ODH.OwnedNamespace: "true"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, ownedNs)).Should(Succeed())
+		Expect(k8sClient.Delete(ctx, ownedNs)).Should(Succeed())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dsciInstance), dsciInstance)).Should(Succeed())
+		dsciInstance.SetFinalizers(nil)
+		Expect(k8sClient.Update(ctx, dsciInstance)).Should(Succeed())
+	})
+
+	It("Should allow deletion of namespace not owned by the operator", func(ctx context.Context) {
+		dsciInstance := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Create(ctx, dsciInstance)).Should(Succeed())
+
+		userNs := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nameBase + "-user-ns",
+			},
+		}
+		Expect(k8sClient.Create(ctx, userNs)).Should(Succeed())
+		Expect(k8sClient.Delete(ctx, userNs)).Should(Succeed())
+		Expect(clearInstance(ctx, dsciInstance)).Should(Succeed())
+	})
+})
+
+var _ = Describe("FeatureTracker webhook", func() {
+	It("Should block changes of spec by users other than the operator while allowing metadata changes", func(ctx context.Context) {
+		tracker := featurev1.NewFeatureTracker("webhook-test-feature", namespace)
+		tracker.Spec = featurev1.FeatureTrackerSpec{AppNamespace: namespace}
+		Expect(k8sClient.Create(ctx, tracker)).Should(Succeed())
+
+		tracker.Spec.AppNamespace = "another-namespace"
+		Expect(k8sClient.Update(ctx, tracker)).ShouldNot(Succeed())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).Should(Succeed())
+		tracker.SetAnnotations(map[string]string{annotations.Reapply: "true"})
+		Expect(k8sClient.Update(ctx, tracker)).Should(Succeed())
+		Expect(clearInstance(ctx, tracker)).Should(Succeed())
+	})
 })
 
 func clearInstance(ctx context.Context, instance client.Object) error {
@@ -0,0 +1,55 @@
+#!/usr/bin/env bash
+
+# Generates typed clientset, listers and informers for the operator APIs into pkg/client.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+# Matches the version of k8s.io/client-go the module is pinned to.
+CODE_GENERATOR_VERSION=${CODE_GENERATOR_VERSION:-v0.28.4}
+
+MODULE=github.com/opendatahub-io/opendatahub-operator/v2
+APIS_PKG=${MODULE}/apis
+OUTPUT_PKG=${MODULE}/pkg/client
+GROUP_VERSIONS=(datasciencecluster/v1 dscinitialization/v1 features/v1)
+BOILERPLATE=${SCRIPT_ROOT}/hack/boilerplate.go.txt
+
+OUTPUT_BASE=$(mktemp -d)
+trap 'rm -rf "${OUTPUT_BASE}"' EXIT
+
+INPUT_DIRS=""
+for gv in "${GROUP_VERSIONS[@]}"; do
+  INPUT_DIRS+="${APIS_PKG}/${gv},"
+done
+INPUT_DIRS=${INPUT_DIRS%,}
+
+gen() {
+  go run "k8s.io/code-generator/cmd/$1@${CODE_GENERATOR_VERSION}" --go-header-file "${BOILERPLATE}" --output-base "${OUTPUT_BASE}" "${@:2}"
+}
+
+echo "Generating clientset"
+gen client-gen \
+  --clientset-name versioned \
+  --input-base "${APIS_PKG}" \
+  --input "$(IFS=,; echo "${GROUP_VERSIONS[*]}")" \
+  --output-package "${OUTPUT_PKG}/clientset"
+
+echo "Generating listers"
+gen lister-gen \
+  --input-dirs "${INPUT_DIRS}" \
+  --output-package "${OUTPUT_PKG}/listers"
+
+echo "Generating informers"
+gen informer-gen \
+  --input-dirs "${INPUT_DIRS}" \
+  --versioned-clientset-package "${OUTPUT_PKG}/clientset/versioned" \
+  --listers-package "${OUTPUT_PKG}/listers" \
+  --output-package "${OUTPUT_PKG}/informers"
+
+# Tests of the generated code in pkg/client are kept.
+for generated in clientset listers informers; do
+  rm -rf "${SCRIPT_ROOT}/pkg/client/${generated}"
+  cp -r "${OUTPUT_BASE}/${OUTPUT_PKG}/${generated}" "${SCRIPT_ROOT}/pkg/client/${generated}"
+done
@@ -18,8 +18,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	addonv1alpha1 "github.com/openshift/addon-operator/apis/addons/v1alpha1"
 	ocappsv1 "github.com/openshift/api/apps/v1" //nolint:importas //reason: conflicts with appsv1 "k8s.io/api/apps/v1"
@@ -29,25 +33,32 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	userv1 "github.com/openshift/api/user/v1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	ctrlwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -58,14 +69,25 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/certconfigmapgenerator"
 	dscctrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/datasciencecluster"
 	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/logging"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/meshcabundle"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/platformcapability"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/secretgenerator"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/webhook"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/events"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/export"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 )
 
-const controllerNum = 4 // we should keep this updated if we have new controllers to add
+const controllerNum = 7 // we should keep this updated if we have new controllers to add
 
 var (
 	scheme   = runtime.NewScheme()
@@ -99,6 +121,21 @@ func init() { //nolint:gochecknoinits
 }
 
 func main() { //nolint:funlen
+	if len(os.Args) > 1 && (os.Args[1] == renderCommand || os.Args[1] == permissionsCommand) {
+		run := runRender
+		if os.Args[1] == permissionsCommand {
+			run = runPermissions
+		}
+		if err := run(ctrl.SetupSignalHandler(), os.Args[2:], os.Stdout); err != nil {
+			if !errors.Is(err, flag.ErrHelp) {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
@@ -106,6 +143,18 @@ func main() { //nolint:funlen
 	var dscMonitoringNamespace string
 	var operatorName string
 	var logmode string
+	var eventOpts events.Options
+	var fatalCapabilities string
+	var capabilityResyncPeriod time.Duration
+	var featureApplyTimeout time.Duration
+	var featureStatusInterval time.Duration
+	var exportOpts export.Options
+	var exportDir string
+	var exportPeriod time.Duration
+	var featureTrackerEditUsers string
+	var featureTrackerEditGroups string
+	var removalConfirmationAnnotation string
+	var orphanedNamespacesPeriod time.Duration
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -118,17 +167,93 @@ func main() { //nolint:funlen
 		"monitoring stack will be deployed")
 	flag.StringVar(&operatorName, "operator-name", "opendatahub", "The name of the operator")
 	flag.StringVar(&logmode, "log-mode", "", "Log mode ('', prod, devel), default to ''")
+	flag.DurationVar(&eventOpts.DeduplicationWindow, "event-dedup-window", 5*time.Minute,
+		"Period in which identical events for the same object are emitted only once, 0 disables deduplication")
+	flag.Float64Var(&eventOpts.RateLimit, "event-rate-limit", 1, "Sustained number of events per second emitted by the operator, 0 disables rate limiting")
+	flag.IntVar(&eventOpts.Burst, "event-burst", 25, "Maximum number of events emitted at once when rate limiting is enabled")
+	flag.StringVar(&eventOpts.MinSeverity, "event-severity", "Normal", "Lowest type of events emitted (Normal, Warning)")
+	flag.StringVar(&fatalCapabilities, "fatal-capabilities", "", "Comma-separated DSCInitialization capability conditions, "+
+		"e.g. CapabilityServiceMesh, whose failure makes the operator report not ready")
+	flag.DurationVar(&capabilityResyncPeriod, "capability-resync-period", 0, "Default interval at which DSCInitialization capabilities "+
+		"re-validate external state in the absence of events, 0 disables periodic re-validation")
+	flag.DurationVar(&featureApplyTimeout, "feature-apply-timeout", 8*time.Minute, "Default time in which a single feature, "+
+		"including waiting for its post-conditions, has to be applied before it is cancelled, 0 disables the timeout")
+	flag.DurationVar(&featureStatusInterval, "feature-status-interval", 10*time.Second, "Minimal time between status writes of the same "+
+		"FeatureTracker which do not change its state, 0 writes the status on every apply")
+	flag.BoolVar(&exportOpts.IncludeSecrets, "config-export-include-secrets", false, "Include values of the Secrets managed by the operator "+
+		"in the platform configuration export, they are redacted otherwise")
+	flag.StringVar(&exportDir, "config-export-dir", "", "Directory, e.g. on a mounted PersistentVolume, to which the platform configuration "+
+		"export is written periodically, empty disables writing it")
+	flag.DurationVar(&exportPeriod, "config-export-period", 24*time.Hour, "Interval at which the platform configuration export is written")
+	flag.StringVar(&featureTrackerEditUsers, "featuretracker-edit-users", "", "Comma-separated users allowed to change spec and status "+
+		"of FeatureTrackers, in addition to service accounts of the operator namespace")
+	flag.StringVar(&featureTrackerEditGroups, "featuretracker-edit-groups", "", "Comma-separated groups allowed to change spec and status "+
+		"of FeatureTrackers, in addition to service accounts of the operator namespace")
+	flag.StringVar(&removalConfirmationAnnotation, "removal-confirmation-annotation", dscictrl.DefaultRemovalConfirmationAnnotation,
+		"Annotation of DSCInitialization which has to list capabilities set to Removed, e.g. servicemesh, before they are removed, "+
+			"empty removes them without confirmation")
+
+	flag.DurationVar(&orphanedNamespacesPeriod, "orphaned-namespaces-check-period", time.Hour, "Interval at which namespaces created by the "+
+		"operator are checked for no longer being used, see spec.orphanedNamespaces of DSCInitialization")
 
 	flag.Parse()
 
-	ctrl.SetLogger(logger.ConfigLoggers(logmode))
+	dynamicLogger := logger.NewDynamic(logmode, os.Stdout)
+	ctrl.SetLogger(dynamicLogger.Logger())
+
+	if err := events.ValidateSeverity(eventOpts.MinSeverity); err != nil {
+		setupLog.Error(err, "invalid event configuration")
+		os.Exit(1)
+	}
 
 	// root context
 	ctx := ctrl.SetupSignalHandler()
 
+	// Create new uncached client to run initial setup
+	setupCfg, err := config.GetConfig()
+	if err != nil {
+		setupLog.Error(err, "error getting config for setup")
+		os.Exit(1)
+	}
+	// uplift default limiataions
+	setupCfg.QPS = rest.DefaultQPS * controllerNum     // 5 * 7 controllers
+	setupCfg.Burst = rest.DefaultBurst * controllerNum // 10 * 7 controllers
+
+	setupClient, err := client.New(setupCfg, client.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Error(err, "error getting client for setup")
+		os.Exit(1)
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(setupCfg)
+	if err != nil {
+		setupLog.Error(err, "error getting discovery client")
+		os.Exit(1)
+	}
+
+	installMode := cluster.GetInstallMode()
+	if installMode.NamespaceScoped() {
+		setupLog.Info("operator installed for namespaces, capabilities configuring other namespaces are not supported", "namespaces", installMode.Namespaces)
+	}
+
+	cacheOpts, err := createCacheOptions(installMode)
+	if err != nil {
+		setupLog.Error(err, "unable to configure cache")
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{ // single pod does not need to have LeaderElection
-		Scheme:  scheme,
-		Metrics: ctrlmetrics.Options{BindAddress: metricsAddr},
+		Scheme: scheme,
+		Metrics: ctrlmetrics.Options{
+			BindAddress: metricsAddr,
+		},
+		Cache: cacheOpts,
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// Cache of these kinds is scoped, reading them directly ensures objects outside of it are found
+				DisableFor: []client.Object{&appsv1.Deployment{}, &corev1.Secret{}},
+			},
+		},
 		WebhookServer: ctrlwebhook.NewServer(ctrlwebhook.Options{
 			Port: 9443,
 			// TLSOpts: , // TODO: do we need tls for webhook
@@ -158,17 +283,100 @@ func main() { //nolint:funlen
 		Decoder: admission.NewDecoder(mgr.GetScheme()),
 	}).SetupWithManager(mgr)
 
-	if err = (&dscictrl.DSCInitializationReconciler{
-		Client:                mgr.GetClient(),
-		Scheme:                mgr.GetScheme(),
-		Log:                   logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("controllers").WithName("DSCInitialization"), logmode),
-		Recorder:              mgr.GetEventRecorderFor("dscinitialization-controller"),
-		ApplicationsNamespace: dscApplicationsNamespace,
-	}).SetupWithManager(ctx, mgr); err != nil {
+	(&webhook.NamespaceWebhook{
+		Client:  mgr.GetClient(),
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+	}).SetupWithManager(mgr)
+
+	featureTrackerGroups := parseList(featureTrackerEditGroups)
+	if operatorNs, errNs := cluster.GetOperatorNamespace(); errNs == nil && operatorNs != "" {
+		featureTrackerGroups = append(featureTrackerGroups, "system:serviceaccounts:"+operatorNs)
+	} else {
+		setupLog.Info("unable to determine operator namespace, only configured users and groups can change FeatureTrackers")
+	}
+	(&webhook.FeatureTrackerWebhook{
+		Decoder:       admission.NewDecoder(mgr.GetScheme()),
+		AllowedUsers:  parseList(featureTrackerEditUsers),
+		AllowedGroups: featureTrackerGroups,
+	}).SetupWithManager(mgr)
+
+	// Exposes time_to_ready_seconds metric tracking how long it takes to reconcile spec changes
+	readyTimer := status.NewReadyTimer()
+	metrics.Registry.MustRegister(readyTimer)
+
+	// Conditions of capabilities are written in the background, so that slow status writes do not extend reconciles
+	statusUpdater := status.NewUpdater(mgr.GetClient(), ctrl.Log.WithName(operatorName).WithName("status-updater"))
+	if err = mgr.Add(statusUpdater); err != nil {
+		setupLog.Error(err, "unable to set up status updater")
+		os.Exit(1)
+	}
+
+	dsciReconciler := &dscictrl.DSCInitializationReconciler{
+		Client:                        mgr.GetClient(),
+		Scheme:                        mgr.GetScheme(),
+		Log:                           logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("controllers").WithName("DSCInitialization"), logmode),
+		Recorder:                      events.NewRecorder(mgr.GetEventRecorderFor("dscinitialization-controller"), eventOpts),
+		ApplicationsNamespace:         dscApplicationsNamespace,
+		CapabilityResyncPeriod:        capabilityResyncPeriod,
+		APIReader:                     mgr.GetAPIReader(),
+		RemovalConfirmationAnnotation: removalConfirmationAnnotation,
+		StatusUpdater:                 statusUpdater,
+		ReadyTimer:                    readyTimer,
+		InstallMode:                   installMode,
+	}
+	if err = dsciReconciler.SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DSCInitiatlization")
 		os.Exit(1)
 	}
 
+	if err = (&dscictrl.FeatureReapplyReconciler{
+		Client:            mgr.GetClient(),
+		Log:               logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("controllers").WithName("FeatureReapply"), logmode),
+		Recorder:          events.NewRecorder(mgr.GetEventRecorderFor("feature-reapply-controller"), eventOpts),
+		DSCInitialization: dsciReconciler,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "FeatureReapply")
+		os.Exit(1)
+	}
+
+	if err = (&dscictrl.NamespaceRecreationReconciler{
+		Client:   mgr.GetClient(),
+		Log:      logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("controllers").WithName("NamespaceRecreation"), logmode),
+		Recorder: events.NewRecorder(mgr.GetEventRecorderFor("namespace-recreation-controller"), eventOpts),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NamespaceRecreation")
+		os.Exit(1)
+	}
+
+	// OLM sets the name of the operator CSV, clean-up on uninstall is only relevant when installed through it
+	csvName, installedByOLM := os.LookupEnv("OPERATOR_CONDITION_NAME")
+	operatorNs, errNs := cluster.GetOperatorNamespace()
+	if installedByOLM && errNs == nil {
+		if err = (&dscictrl.UninstallReconciler{
+			Client:            mgr.GetClient(),
+			Log:               logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("controllers").WithName("Uninstall"), logmode),
+			DSCInitialization: dsciReconciler,
+			CSVName:           csvName,
+			OperatorNamespace: operatorNs,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Uninstall")
+			os.Exit(1)
+		}
+	}
+
+	// Logging configuration is read from the operator namespace, it is unknown when running locally
+	if errNs == nil {
+		if err = (&logging.ConfigReconciler{
+			Client:    mgr.GetClient(),
+			Log:       ctrl.Log.WithName(operatorName).WithName("controllers").WithName("LoggingConfig"),
+			Namespace: operatorNs,
+			Logging:   dynamicLogger,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "LoggingConfig")
+			os.Exit(1)
+		}
+	}
+
 	if err = (&dscctrl.DataScienceClusterReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -178,7 +386,8 @@ func main() { //nolint:funlen
 				ApplicationsNamespace: dscApplicationsNamespace,
 			},
 		},
-		Recorder: mgr.GetEventRecorderFor("datasciencecluster-controller"),
+		Recorder:   events.NewRecorder(mgr.GetEventRecorderFor("datasciencecluster-controller"), eventOpts),
+		ReadyTimer: readyTimer,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DataScienceCluster")
 		os.Exit(1)
@@ -202,21 +411,34 @@ func main() { //nolint:funlen
 		os.Exit(1)
 	}
 
-	// Create new uncached client to run initial setup
-	setupCfg, err := config.GetConfig()
-	if err != nil {
-		setupLog.Error(err, "error getting config for setup")
+	if err = (&meshcabundle.MeshCABundleReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Log:    logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("controllers").WithName("MeshCABundle"), logmode),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MeshCABundle")
 		os.Exit(1)
 	}
-	// uplift default limiataions
-	setupCfg.QPS = rest.DefaultQPS * controllerNum     // 5 * 4 controllers
-	setupCfg.Burst = rest.DefaultBurst * controllerNum // 10 * 4 controllers
 
-	setupClient, err := client.New(setupCfg, client.Options{Scheme: scheme})
-	if err != nil {
-		setupLog.Error(err, "error getting client for setup")
+	if err = (&platformcapability.PlatformCapabilityReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Log:    logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("controllers").WithName("PlatformCapability"), logmode),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PlatformCapability")
+		os.Exit(1)
+	}
+
+	if err = (&platformcapability.RouteAuthorizationReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Log:      logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("controllers").WithName("RouteAuthorization"), logmode),
+		Recorder: events.NewRecorder(mgr.GetEventRecorderFor("route-authorization-controller"), eventOpts),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RouteAuthorization")
 		os.Exit(1)
 	}
+
 	// Get operator platform
 	platform, err := cluster.GetPlatform(ctx, setupClient)
 	if err != nil {
@@ -257,27 +479,135 @@ func main() { //nolint:funlen
 			os.Exit(1)
 		}
 	}
-	// Cleanup resources from previous v2 releases
-	var cleanExistingResourceFunc manager.RunnableFunc = func(ctx context.Context) error {
-		if err = upgrade.CleanupExistingResource(ctx, setupClient, platform, dscApplicationsNamespace, dscMonitoringNamespace); err != nil {
-			setupLog.Error(err, "unable to perform cleanup")
+	// Features stuck waiting for the cluster do not block other features beyond their timeout
+	feature.SetDefaultApplyTimeout(featureApplyTimeout)
+	// Frequent re-applies of unchanged features do not rewrite their trackers
+	feature.SetTrackerStatusInterval(featureStatusInterval)
+
+	// Charts render resources matching the version and APIs of the cluster, not Helm defaults
+	manifest.SetChartDiscovery(discoveryClient)
+
+	// Templates declaring fields unknown to the API server, e.g. misspelled in SMCP patches, fail the apply
+	schemaCache := resource.NewSchemaCache(discoveryClient.OpenAPIV3())
+	resource.SetSchemaCache(schemaCache)
+	var warmSchemaCacheFunc manager.RunnableFunc = func(ctx context.Context) error {
+		// Schemas which cannot be fetched upfront are fetched on first apply instead
+		if err := schemaCache.Warm(
+			gvk.ServiceMeshControlPlane.GroupVersion(),
+			gvk.ServiceMeshMember.GroupVersion(),
+			gvk.Authorino.GroupVersion(),
+			gvk.AuthorizationPolicy.GroupVersion(),
+			gvk.Deployment.GroupVersion(),
+			autoscalingv2.SchemeGroupVersion,
+			monitoringv1.SchemeGroupVersion,
+		); err != nil {
+			setupLog.Error(err, "unable to pre-warm schema cache")
 		}
-		return err
+		return nil
+	}
+	if err = mgr.Add(warmSchemaCacheFunc); err != nil {
+		setupLog.Error(err, "error scheduling schema cache warm-up")
+		os.Exit(1)
 	}
 
-	err = mgr.Add(cleanExistingResourceFunc)
+	// Features applied by a newer operator version are not changed, e.g. after an accidental downgrade
+	operatorVersion := ""
+	if release, errRelease := cluster.GetRelease(ctx, setupClient); errRelease != nil {
+		setupLog.Info("unable to determine operator version, version skew detection is disabled", "error", errRelease.Error())
+	} else {
+		feature.SetOperatorVersion(release.Version.Version)
+		operatorVersion = release.Version.Version.String()
+	}
+
+	// Migrate resources from previous releases, each migration is run once and recorded in the ledger
+	migrationRunner := &upgrade.MigrationRunner{
+		Client:          setupClient,
+		Log:             logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("migrations"), logmode),
+		Namespace:       operatorNs,
+		OperatorVersion: operatorVersion,
+		Migrations:      upgrade.Migrations(ctx, setupClient, platform, dscApplicationsNamespace, dscMonitoringNamespace),
+	}
+	var runMigrationsFunc manager.RunnableFunc = func(ctx context.Context) error {
+		if err := migrationRunner.Run(ctx); err != nil {
+			setupLog.Error(err, "unable to migrate resources from previous version")
+			return err
+		}
+		return nil
+	}
+	if err = mgr.Add(runMigrationsFunc); err != nil {
+		setupLog.Error(err, "error scheduling migrations")
+		os.Exit(1)
+	}
+	var reportVersionSkewFunc manager.RunnableFunc = func(ctx context.Context) error {
+		// Failing to report the skew does not stop the operator, features applied by a newer version are protected regardless
+		if err := dscictrl.ReportVersionSkew(ctx, setupClient); err != nil {
+			setupLog.Error(err, "unable to report version skew")
+		}
+		return nil
+	}
+	if err = mgr.Add(reportVersionSkewFunc); err != nil {
+		setupLog.Error(err, "error scheduling version skew detection")
+		os.Exit(1)
+	}
+	var reportMissingPermissionsFunc manager.RunnableFunc = func(ctx context.Context) error {
+		// Missing permissions are reported, features lacking them fail when applied regardless
+		if err := dsciReconciler.ReportMissingPermissions(ctx); err != nil {
+			setupLog.Error(err, "unable to report missing permissions")
+		}
+		return nil
+	}
+	if err = mgr.Add(reportMissingPermissionsFunc); err != nil {
+		setupLog.Error(err, "error scheduling missing permissions report")
+		os.Exit(1)
+	}
+
+	if err = mgr.Add(&dscictrl.OrphanedNamespacesDetector{
+		Client: setupClient,
+		Log:    setupLog.WithName("orphaned-namespaces"),
+		Period: orphanedNamespacesPeriod,
+	}); err != nil {
+		setupLog.Error(err, "error scheduling orphaned namespaces detection")
+		os.Exit(1)
+	}
+
+	if exportDir != "" {
+		if err = mgr.Add(&export.Writer{
+			Client:  setupClient,
+			Log:     setupLog.WithName("export"),
+			Dir:     exportDir,
+			Period:  exportPeriod,
+			Options: exportOpts,
+		}); err != nil {
+			setupLog.Error(err, "error scheduling platform configuration export")
+			os.Exit(1)
+		}
+	}
+
+	usageCache, err := feature.NewUsageCache(mgr.GetConfig(), mgr.GetScheme())
 	if err != nil {
-		setupLog.Error(err, "error remove deprecated resources from previous version")
+		setupLog.Error(err, "unable to create cache for feature resource usage")
+		os.Exit(1)
 	}
+	if err = mgr.Add(usageCache); err != nil {
+		setupLog.Error(err, "unable to add cache for feature resource usage")
+		os.Exit(1)
+	}
+
+	// Exposes feature_phase metric used by the alerts configured through DSCI .spec.monitoring.featureAlerts
+	metrics.Registry.MustRegister(feature.NewPhaseCollector(mgr.GetClient()), feature.NewUsageCollector(usageCache, mgr.GetAPIReader()))
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
-	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+	if err := mgr.AddReadyzCheck("readyz", dscictrl.ReadinessCheck(mgr.GetCache())); err != nil {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if err := mgr.AddReadyzCheck("capabilities", dscictrl.CapabilitiesCheck(mgr.GetClient(), parseCapabilities(fatalCapabilities)...)); err != nil {
+		setupLog.Error(err, "unable to set up capabilities ready check")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctx); err != nil {
@@ -285,3 +615,58 @@ func main() { //nolint:funlen
 		os.Exit(1)
 	}
 }
+
+// createCacheOptions scopes the cache of kinds numerous on large clusters to the objects the operator acts on:
+//   - Deployments and Secrets are cached only when labeled as part of the platform,
+//   - ClusterServiceVersions are cached only in the operator namespace.
+//
+// Label selectors apply cluster-wide, so namespaces configured in DSCInitialization after the operator started are watched as well.
+//
+// When the operator is installed for its own namespace, or a single one, namespaced objects are cached in those namespaces only.
+func createCacheOptions(installMode cluster.InstallMode) (cache.Options, error) {
+	platformOwned, err := k8slabels.NewRequirement(labels.K8SCommon.PartOf, selection.Exists, nil)
+	if err != nil {
+		return cache.Options{}, err
+	}
+
+	byObject := map[client.Object]cache.ByObject{
+		&appsv1.Deployment{}: {Label: k8slabels.NewSelector().Add(*platformOwned)},
+		&corev1.Secret{}:     {Label: k8slabels.NewSelector().Add(*platformOwned)},
+	}
+	// OLM copies CSVs of operators installed in all namespaces to each of them, only the operator's own ones are needed
+	if operatorNs, errNs := cluster.GetOperatorNamespace(); errNs == nil {
+		byObject[&ofapiv1alpha1.ClusterServiceVersion{}] = cache.ByObject{Namespaces: map[string]cache.Config{operatorNs: {}}}
+	}
+
+	opts := cache.Options{ByObject: byObject}
+	if installMode.NamespaceScoped() {
+		opts.DefaultNamespaces = map[string]cache.Config{}
+		for _, ns := range installMode.Namespaces {
+			opts.DefaultNamespaces[ns] = cache.Config{}
+		}
+	}
+
+	return opts, nil
+}
+
+func parseList(values string) []string {
+	var items []string
+	for _, value := range strings.Split(values, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			items = append(items, value)
+		}
+	}
+
+	return items
+}
+
+func parseCapabilities(capabilities string) []conditionsv1.ConditionType {
+	var conditionTypes []conditionsv1.ConditionType
+	for _, capability := range strings.Split(capabilities, ",") {
+		if capability = strings.TrimSpace(capability); capability != "" {
+			conditionTypes = append(conditionTypes, conditionsv1.ConditionType(capability))
+		}
+	}
+
+	return conditionTypes
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/permissions"
+)
+
+// permissionsCommand is the first argument of the operator binary printing the least-privilege permissions report instead of
+// running the manager.
+const permissionsCommand = "permissions"
+
+// runPermissions prints the least-privilege role derived from the resources managed on the cluster pointed at by the kubeconfig,
+// and permissions granted to the given service account beyond it. The cluster is read with the credentials of the caller, so
+// the report only covers what the caller is allowed to read.
+func runPermissions(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet(permissionsCommand, flag.ContinueOnError)
+	kubeconfig := flags.String("kubeconfig", "", "Kubeconfig of the cluster to report on, defaults to the current context")
+	serviceAccount := flags.String("service-account", "", "Service account, as <namespace>/<name>, whose permissions are compared with the derived role")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var granted types.NamespacedName
+	if *serviceAccount != "" {
+		namespace, name, found := strings.Cut(*serviceAccount, "/")
+		if !found || namespace == "" || name == "" {
+			return fmt.Errorf("--service-account has to be given as <namespace>/<name>, got %q", *serviceAccount)
+		}
+		granted = types.NamespacedName{Namespace: namespace, Name: name}
+	}
+
+	restCfg, err := loadConfig(*kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	cli, err := clusterClient(*kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restCfg)
+	if err != nil {
+		return fmt.Errorf("failed creating discovery client: %w", err)
+	}
+
+	report, err := permissions.GenerateReport(ctx, cli, discoveryClient, granted)
+	if err != nil {
+		return err
+	}
+
+	content, err := yaml.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	_, err = out.Write(content)
+
+	return err
+}
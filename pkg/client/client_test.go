@@ -0,0 +1,52 @@
+package client_test
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/client/clientset/versioned/fake"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/client/informers/externalversions"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generated client", func() {
+
+	It("should read operator resources through the clientset", func(ctx context.Context) {
+		// given
+		clientset := fake.NewSimpleClientset(&dsciv1.DSCInitialization{ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"}})
+
+		// when
+		dsci, err := clientset.DscinitializationV1().DSCInitializations().Get(ctx, "default-dsci", metav1.GetOptions{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dsci.Name).To(Equal("default-dsci"))
+	})
+
+	It("should list resources created after the informer has started using its lister", func(ctx context.Context) {
+		// given
+		clientset := fake.NewSimpleClientset()
+		factory := externalversions.NewSharedInformerFactory(clientset, 0)
+		trackers := factory.Features().V1().FeatureTrackers()
+		informer := trackers.Informer()
+		factory.Start(ctx.Done())
+		Expect(cache.WaitForCacheSync(ctx.Done(), informer.HasSynced)).To(BeTrue())
+
+		// when
+		tracker := featurev1.NewFeatureTracker("mesh-control-plane-creation", "opendatahub")
+		_, err := clientset.FeaturesV1().FeatureTrackers().Create(ctx, tracker, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Eventually(func() ([]*featurev1.FeatureTracker, error) {
+			return trackers.Lister().List(labels.Everything())
+		}).Should(ConsistOf(HaveField("Name", tracker.Name)))
+	})
+})
@@ -0,0 +1,131 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDataScienceClusters implements DataScienceClusterInterface
+type FakeDataScienceClusters struct {
+	Fake *FakeDatascienceclusterV1
+}
+
+var datascienceclustersResource = v1.SchemeGroupVersion.WithResource("datascienceclusters")
+
+var datascienceclustersKind = v1.SchemeGroupVersion.WithKind("DataScienceCluster")
+
+// Get takes name of the dataScienceCluster, and returns the corresponding dataScienceCluster object, and an error if there is any.
+func (c *FakeDataScienceClusters) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.DataScienceCluster, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(datascienceclustersResource, name), &v1.DataScienceCluster{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DataScienceCluster), err
+}
+
+// List takes label and field selectors, and returns the list of DataScienceClusters that match those selectors.
+func (c *FakeDataScienceClusters) List(ctx context.Context, opts metav1.ListOptions) (result *v1.DataScienceClusterList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(datascienceclustersResource, datascienceclustersKind, opts), &v1.DataScienceClusterList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.DataScienceClusterList{ListMeta: obj.(*v1.DataScienceClusterList).ListMeta}
+	for _, item := range obj.(*v1.DataScienceClusterList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested dataScienceClusters.
+func (c *FakeDataScienceClusters) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(datascienceclustersResource, opts))
+}
+
+// Create takes the representation of a dataScienceCluster and creates it.  Returns the server's representation of the dataScienceCluster, and an error, if there is any.
+func (c *FakeDataScienceClusters) Create(ctx context.Context, dataScienceCluster *v1.DataScienceCluster, opts metav1.CreateOptions) (result *v1.DataScienceCluster, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(datascienceclustersResource, dataScienceCluster), &v1.DataScienceCluster{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DataScienceCluster), err
+}
+
+// Update takes the representation of a dataScienceCluster and updates it. Returns the server's representation of the dataScienceCluster, and an error, if there is any.
+func (c *FakeDataScienceClusters) Update(ctx context.Context, dataScienceCluster *v1.DataScienceCluster, opts metav1.UpdateOptions) (result *v1.DataScienceCluster, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(datascienceclustersResource, dataScienceCluster), &v1.DataScienceCluster{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DataScienceCluster), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDataScienceClusters) UpdateStatus(ctx context.Context, dataScienceCluster *v1.DataScienceCluster, opts metav1.UpdateOptions) (*v1.DataScienceCluster, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(datascienceclustersResource, "status", dataScienceCluster), &v1.DataScienceCluster{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DataScienceCluster), err
+}
+
+// Delete takes name of the dataScienceCluster and deletes it. Returns an error if one occurs.
+func (c *FakeDataScienceClusters) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(datascienceclustersResource, name, opts), &v1.DataScienceCluster{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDataScienceClusters) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(datascienceclustersResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.DataScienceClusterList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched dataScienceCluster.
+func (c *FakeDataScienceClusters) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.DataScienceCluster, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(datascienceclustersResource, name, pt, data, subresources...), &v1.DataScienceCluster{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DataScienceCluster), err
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDSCInitializations implements DSCInitializationInterface
+type FakeDSCInitializations struct {
+	Fake *FakeDscinitializationV1
+}
+
+var dscinitializationsResource = v1.SchemeGroupVersion.WithResource("dscinitializations")
+
+var dscinitializationsKind = v1.SchemeGroupVersion.WithKind("DSCInitialization")
+
+// Get takes name of the dSCInitialization, and returns the corresponding dSCInitialization object, and an error if there is any.
+func (c *FakeDSCInitializations) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.DSCInitialization, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(dscinitializationsResource, name), &v1.DSCInitialization{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DSCInitialization), err
+}
+
+// List takes label and field selectors, and returns the list of DSCInitializations that match those selectors.
+func (c *FakeDSCInitializations) List(ctx context.Context, opts metav1.ListOptions) (result *v1.DSCInitializationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(dscinitializationsResource, dscinitializationsKind, opts), &v1.DSCInitializationList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.DSCInitializationList{ListMeta: obj.(*v1.DSCInitializationList).ListMeta}
+	for _, item := range obj.(*v1.DSCInitializationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested dSCInitializations.
+func (c *FakeDSCInitializations) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(dscinitializationsResource, opts))
+}
+
+// Create takes the representation of a dSCInitialization and creates it.  Returns the server's representation of the dSCInitialization, and an error, if there is any.
+func (c *FakeDSCInitializations) Create(ctx context.Context, dSCInitialization *v1.DSCInitialization, opts metav1.CreateOptions) (result *v1.DSCInitialization, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(dscinitializationsResource, dSCInitialization), &v1.DSCInitialization{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DSCInitialization), err
+}
+
+// Update takes the representation of a dSCInitialization and updates it. Returns the server's representation of the dSCInitialization, and an error, if there is any.
+func (c *FakeDSCInitializations) Update(ctx context.Context, dSCInitialization *v1.DSCInitialization, opts metav1.UpdateOptions) (result *v1.DSCInitialization, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(dscinitializationsResource, dSCInitialization), &v1.DSCInitialization{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DSCInitialization), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDSCInitializations) UpdateStatus(ctx context.Context, dSCInitialization *v1.DSCInitialization, opts metav1.UpdateOptions) (*v1.DSCInitialization, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(dscinitializationsResource, "status", dSCInitialization), &v1.DSCInitialization{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DSCInitialization), err
+}
+
+// Delete takes name of the dSCInitialization and deletes it. Returns an error if one occurs.
+func (c *FakeDSCInitializations) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(dscinitializationsResource, name, opts), &v1.DSCInitialization{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDSCInitializations) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(dscinitializationsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.DSCInitializationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched dSCInitialization.
+func (c *FakeDSCInitializations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.DSCInitialization, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(dscinitializationsResource, name, pt, data, subresources...), &v1.DSCInitialization{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.DSCInitialization), err
+}
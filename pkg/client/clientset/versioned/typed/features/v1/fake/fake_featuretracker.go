@@ -0,0 +1,131 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeFeatureTrackers implements FeatureTrackerInterface
+type FakeFeatureTrackers struct {
+	Fake *FakeFeaturesV1
+}
+
+var featuretrackersResource = v1.SchemeGroupVersion.WithResource("featuretrackers")
+
+var featuretrackersKind = v1.SchemeGroupVersion.WithKind("FeatureTracker")
+
+// Get takes name of the featureTracker, and returns the corresponding featureTracker object, and an error if there is any.
+func (c *FakeFeatureTrackers) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.FeatureTracker, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(featuretrackersResource, name), &v1.FeatureTracker{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.FeatureTracker), err
+}
+
+// List takes label and field selectors, and returns the list of FeatureTrackers that match those selectors.
+func (c *FakeFeatureTrackers) List(ctx context.Context, opts metav1.ListOptions) (result *v1.FeatureTrackerList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(featuretrackersResource, featuretrackersKind, opts), &v1.FeatureTrackerList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.FeatureTrackerList{ListMeta: obj.(*v1.FeatureTrackerList).ListMeta}
+	for _, item := range obj.(*v1.FeatureTrackerList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested featureTrackers.
+func (c *FakeFeatureTrackers) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(featuretrackersResource, opts))
+}
+
+// Create takes the representation of a featureTracker and creates it.  Returns the server's representation of the featureTracker, and an error, if there is any.
+func (c *FakeFeatureTrackers) Create(ctx context.Context, featureTracker *v1.FeatureTracker, opts metav1.CreateOptions) (result *v1.FeatureTracker, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(featuretrackersResource, featureTracker), &v1.FeatureTracker{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.FeatureTracker), err
+}
+
+// Update takes the representation of a featureTracker and updates it. Returns the server's representation of the featureTracker, and an error, if there is any.
+func (c *FakeFeatureTrackers) Update(ctx context.Context, featureTracker *v1.FeatureTracker, opts metav1.UpdateOptions) (result *v1.FeatureTracker, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(featuretrackersResource, featureTracker), &v1.FeatureTracker{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.FeatureTracker), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeFeatureTrackers) UpdateStatus(ctx context.Context, featureTracker *v1.FeatureTracker, opts metav1.UpdateOptions) (*v1.FeatureTracker, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(featuretrackersResource, "status", featureTracker), &v1.FeatureTracker{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.FeatureTracker), err
+}
+
+// Delete takes name of the featureTracker and deletes it. Returns an error if one occurs.
+func (c *FakeFeatureTrackers) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(featuretrackersResource, name, opts), &v1.FeatureTracker{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeFeatureTrackers) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(featuretrackersResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.FeatureTrackerList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched featureTracker.
+func (c *FakeFeatureTrackers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.FeatureTracker, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(featuretrackersResource, name, pt, data, subresources...), &v1.FeatureTracker{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.FeatureTracker), err
+}
@@ -0,0 +1,37 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// fieldOwner is the field manager of the fields applied by the operator.
+const fieldOwner = "rhods-operator"
+
+// Apply creates or updates the object using server-side apply, after applying the MetaOptions to it. Only the fields set in the
+// object are owned by the operator, labels, annotations and other fields set by others are left intact. Fields applied before
+// but missing from the object are removed. The object is updated with the state returned by the API server.
+//
+// Typed objects are supported as long as their kind is registered in the scheme of the client.
+func Apply(ctx context.Context, cli client.Client, obj client.Object, metaOptions ...MetaOptions) error {
+	if err := ApplyMetaOptions(obj, metaOptions...); err != nil {
+		return err
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, cli.Scheme())
+	if err != nil {
+		return fmt.Errorf("failed resolving kind of %s: %w", obj.GetName(), err)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	// Applied configuration must not carry managed fields, e.g. when the object has been read from the cluster.
+	obj.SetManagedFields(nil)
+
+	if err := cli.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(fieldOwner)); err != nil {
+		return fmt.Errorf("failed applying %s %s: %w", gvk.Kind, client.ObjectKeyFromObject(obj), err)
+	}
+
+	return nil
+}
@@ -40,6 +40,42 @@ func GetDomain(ctx context.Context, c client.Client) (string, error) {
 	return domain, err
 }
 
+// GetOCPVersion returns the version of OpenShift the cluster is currently running. The most recently completed
+// update takes precedence over the desired one, which might still be in progress.
+func GetOCPVersion(ctx context.Context, c client.Client) (semver.Version, error) {
+	clusterVersion := &unstructured.Unstructured{}
+	clusterVersion.SetGroupVersionKind(gvk.ClusterVersion)
+
+	if err := c.Get(ctx, client.ObjectKey{Name: "version"}, clusterVersion); err != nil {
+		return semver.Version{}, fmt.Errorf("failed fetching cluster version: %w", err)
+	}
+
+	history, _, err := unstructured.NestedSlice(clusterVersion.Object, "status", "history")
+	if err != nil {
+		return semver.Version{}, err
+	}
+
+	for _, entry := range history {
+		update, ok := entry.(map[string]any)
+		if !ok || update["state"] != "Completed" {
+			continue
+		}
+		if v, ok := update["version"].(string); ok {
+			return semver.ParseTolerant(v)
+		}
+	}
+
+	desired, found, err := unstructured.NestedString(clusterVersion.Object, "status", "desired", "version")
+	if err != nil {
+		return semver.Version{}, err
+	}
+	if !found {
+		return semver.Version{}, errors.New("status.desired.version not found")
+	}
+
+	return semver.ParseTolerant(desired)
+}
+
 func GetOperatorNamespace() (string, error) {
 	operatorNS, exist := os.LookupEnv("OPERATOR_NAMESPACE")
 	if exist && operatorNS != "" {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntime "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -213,4 +214,79 @@ var _ = Describe("Creating cluster resources", func() {
 		})
 	})
 
+	Context("applying resources", func() {
+
+		var (
+			objectCleaner *envtestutil.Cleaner
+			namespace     string
+		)
+
+		BeforeEach(func(ctx context.Context) {
+			objectCleaner = envtestutil.CreateCleaner(envTestClient, envTest.Config, timeout, interval)
+			namespace = envtestutil.AppendRandomNameTo("apply-ns")
+			_, errNs := cluster.CreateNamespace(ctx, envTestClient, namespace)
+			Expect(errNs).ToNot(HaveOccurred())
+		})
+
+		It("should update applied fields keeping the ones set by others", func(ctx context.Context) {
+			// given
+			desired := func(data map[string]string) *corev1.ConfigMap {
+				return &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "applied-config", Namespace: namespace},
+					Data:       data,
+				}
+			}
+			applied := desired(map[string]string{"applied-key": "value", "obsolete-key": "value"})
+			Expect(cluster.Apply(ctx, envTestClient, applied, cluster.WithLabels(labels.K8SCommon.PartOf, "opendatahub"))).To(Succeed())
+			defer objectCleaner.DeleteAll(ctx, applied)
+
+			applied.Labels["opendatahub.io/set-by-user"] = "true"
+			Expect(envTestClient.Update(ctx, applied)).To(Succeed())
+
+			// when
+			reapplied := desired(map[string]string{"applied-key": "new-value"})
+			Expect(cluster.Apply(ctx, envTestClient, reapplied, cluster.WithLabels(labels.K8SCommon.PartOf, "opendatahub"))).To(Succeed())
+
+			// then
+			Expect(reapplied.Data).To(Equal(map[string]string{"applied-key": "new-value"}))
+			Expect(reapplied.Labels).To(And(
+				HaveKeyWithValue(labels.K8SCommon.PartOf, "opendatahub"),
+				HaveKeyWithValue("opendatahub.io/set-by-user", "true"),
+			))
+		})
+	})
+
+	Context("platform RBAC", func() {
+
+		var objectCleaner *envtestutil.Cleaner
+
+		BeforeEach(func() {
+			objectCleaner = envtestutil.CreateCleaner(envTestClient, envTest.Config, timeout, interval)
+		})
+
+		It("should bind the rules to the subject using role and binding of the same name", func(ctx context.Context) {
+			// given
+			name := envtestutil.AppendRandomNameTo("platform-capability")
+			subject := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "odh-platform-controller", Namespace: "opendatahub"}
+			rules := []rbacv1.PolicyRule{{APIGroups: []string{"route.openshift.io"}, Resources: []string{"routes"}, Verbs: []string{"get"}}}
+
+			// when
+			Expect(cluster.CreateOrUpdatePlatformRBAC(ctx, envTestClient, name, subject, rules)).To(Succeed())
+			defer objectCleaner.DeleteAll(ctx,
+				&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: name}},
+				&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name}},
+			)
+
+			// then
+			role := &rbacv1.ClusterRole{}
+			Expect(envTestClient.Get(ctx, ctrlruntime.ObjectKey{Name: name}, role)).To(Succeed())
+			Expect(role.Rules).To(Equal(rules))
+
+			binding := &rbacv1.ClusterRoleBinding{}
+			Expect(envTestClient.Get(ctx, ctrlruntime.ObjectKey{Name: name}, binding)).To(Succeed())
+			Expect(binding.Subjects).To(ConsistOf(subject))
+			Expect(binding.RoleRef).To(Equal(rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: name}))
+		})
+	})
+
 })
@@ -10,3 +10,6 @@ const (
 	// Unknown indicates that operator is not deployed using OLM.
 	Unknown Platform = ""
 )
+
+// OperatorName identifies the operator in the app.kubernetes.io/managed-by label of the resources it creates.
+const OperatorName = "opendatahub-operator"
@@ -3,5 +3,6 @@
 // This includes getting cluster domain, operator namespace and CSV,
 // defining different GVK being used in the project,
 // config metadata on reousrces,
-// managing reousrces like rolebinding, secret and configmap.
+// managing reousrces like rolebinding, secret and configmap,
+// applying arbitrary resources using server-side apply.
 package cluster
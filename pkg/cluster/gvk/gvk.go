@@ -21,6 +21,12 @@ var (
 		Kind:    "Deployment",
 	}
 
+	PodMetrics = schema.GroupVersionKind{
+		Group:   "metrics.k8s.io",
+		Version: "v1beta1",
+		Kind:    "PodMetrics",
+	}
+
 	KnativeServing = schema.GroupVersionKind{
 		Group:   "operator.knative.dev",
 		Version: "v1beta1",
@@ -33,12 +39,66 @@ var (
 		Kind:    "Ingress",
 	}
 
+	ClusterVersion = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "ClusterVersion",
+	}
+
 	ServiceMeshControlPlane = schema.GroupVersionKind{
 		Group:   "maistra.io",
 		Version: "v2",
 		Kind:    "ServiceMeshControlPlane",
 	}
 
+	ServiceMeshMember = schema.GroupVersionKind{
+		Group:   "maistra.io",
+		Version: "v1",
+		Kind:    "ServiceMeshMember",
+	}
+
+	Authorino = schema.GroupVersionKind{
+		Group:   "operator.authorino.kuadrant.io",
+		Version: "v1beta1",
+		Kind:    "Authorino",
+	}
+
+	AuthorizationPolicy = schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "AuthorizationPolicy",
+	}
+
+	VirtualService = schema.GroupVersionKind{
+		Group:   "networking.istio.io",
+		Version: "v1beta1",
+		Kind:    "VirtualService",
+	}
+
+	Route = schema.GroupVersionKind{
+		Group:   "route.openshift.io",
+		Version: "v1",
+		Kind:    "Route",
+	}
+
+	ServiceEntry = schema.GroupVersionKind{
+		Group:   "networking.istio.io",
+		Version: "v1beta1",
+		Kind:    "ServiceEntry",
+	}
+
+	DestinationRule = schema.GroupVersionKind{
+		Group:   "networking.istio.io",
+		Version: "v1beta1",
+		Kind:    "DestinationRule",
+	}
+
+	AuthConfig = schema.GroupVersionKind{
+		Group:   "authorino.kuadrant.io",
+		Version: "v1beta2",
+		Kind:    "AuthConfig",
+	}
+
 	OdhApplication = schema.GroupVersionKind{
 		Group:   "dashboard.opendatahub.io",
 		Version: "v1",
@@ -62,4 +122,10 @@ var (
 		Version: "v1alpha",
 		Kind:    "OdhDashboardConfig",
 	}
+
+	CertManagerCertificate = schema.GroupVersionKind{
+		Group:   "cert-manager.io",
+		Version: "v1",
+		Kind:    "Certificate",
+	}
 )
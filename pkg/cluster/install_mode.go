@@ -0,0 +1,51 @@
+package cluster
+
+import (
+	"os"
+	"slices"
+	"strings"
+)
+
+// WatchNamespaceEnv holds the namespaces the operator has been installed for, as set by OLM from the olm.targetNamespaces
+// annotation. It is empty when the operator is installed for all namespaces.
+const WatchNamespaceEnv = "WATCH_NAMESPACE"
+
+// InstallMode tells which namespaces the operator has been installed for by OLM. Installed in OwnNamespace or SingleNamespace
+// mode, the operator is not granted permissions to namespaced resources of other namespaces.
+type InstallMode struct {
+	// Namespaces the operator has been installed for, empty when it has been installed for all namespaces.
+	Namespaces []string
+}
+
+// GetInstallMode reads the install mode from WATCH_NAMESPACE env.
+func GetInstallMode() InstallMode {
+	var namespaces []string
+	for _, namespace := range strings.Split(os.Getenv(WatchNamespaceEnv), ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	return InstallMode{Namespaces: namespaces}
+}
+
+// NamespaceScoped is true when the operator has not been installed for all namespaces.
+func (m InstallMode) NamespaceScoped() bool {
+	return len(m.Namespaces) > 0
+}
+
+// Unwatched returns those of the namespaces the operator has not been installed for.
+func (m InstallMode) Unwatched(namespaces ...string) []string {
+	if !m.NamespaceScoped() {
+		return nil
+	}
+
+	var unwatched []string
+	for _, namespace := range namespaces {
+		if namespace != "" && !slices.Contains(m.Namespaces, namespace) && !slices.Contains(unwatched, namespace) {
+			unwatched = append(unwatched, namespace)
+		}
+	}
+
+	return unwatched
+}
@@ -0,0 +1,36 @@
+package cluster_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Install mode", func() {
+
+	It("should watch all namespaces when installed for all namespaces", func() {
+		// given
+		GinkgoT().Setenv(cluster.WatchNamespaceEnv, "")
+
+		// when
+		installMode := cluster.GetInstallMode()
+
+		// then
+		Expect(installMode.NamespaceScoped()).To(BeFalse())
+		Expect(installMode.Unwatched("opendatahub", "istio-system")).To(BeEmpty())
+	})
+
+	It("should report namespaces the operator has not been installed for", func() {
+		// given
+		GinkgoT().Setenv(cluster.WatchNamespaceEnv, "opendatahub, redhat-ods-operator")
+
+		// when
+		installMode := cluster.GetInstallMode()
+
+		// then
+		Expect(installMode.NamespaceScoped()).To(BeTrue())
+		Expect(installMode.Namespaces).To(ConsistOf("opendatahub", "redhat-ods-operator"))
+		Expect(installMode.Unwatched("opendatahub", "istio-system", "istio-system", "")).To(ConsistOf("istio-system"))
+	})
+})
@@ -0,0 +1,39 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups="core",resources=nodes,verbs=get;list;watch
+
+// GetNodeArchitectures returns sorted, distinct CPU architectures of the cluster nodes
+// as reported by the well-known kubernetes.io/arch label.
+func GetNodeArchitectures(ctx context.Context, cli client.Client) ([]string, error) {
+	nodes := &corev1.NodeList{}
+	if err := cli.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed listing cluster nodes: %w", err)
+	}
+
+	seen := map[string]struct{}{}
+	architectures := []string{}
+	for _, node := range nodes.Items {
+		arch, found := node.GetLabels()[corev1.LabelArchStable]
+		if !found {
+			continue
+		}
+		if _, exists := seen[arch]; exists {
+			continue
+		}
+		seen[arch] = struct{}{}
+		architectures = append(architectures, arch)
+	}
+
+	sort.Strings(architectures)
+
+	return architectures, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
@@ -35,6 +36,49 @@ func SubscriptionExists(ctx context.Context, cli client.Client, name string) (bo
 	return false, nil
 }
 
+// SubscriptionLookup memoizes Subscriptions of the cluster, so that checks whether operators are installed
+// hit the API at most once. It does not observe Subscriptions changed after the first check, so it is meant
+// to be shared by the checks of a single reconcile pass and then discarded.
+//
+// Nil SubscriptionLookup is valid and lists Subscriptions on every check, as SubscriptionExists does.
+type SubscriptionLookup struct {
+	mu            sync.Mutex
+	listed        bool
+	subscriptions []v1alpha1.Subscription
+}
+
+func NewSubscriptionLookup() *SubscriptionLookup {
+	return &SubscriptionLookup{}
+}
+
+// Exists checks if a Subscription of the given name exists in any namespace. Subscriptions are listed on the first
+// successful check only.
+func (l *SubscriptionLookup) Exists(ctx context.Context, cli client.Client, name string) (bool, error) {
+	if l == nil {
+		return SubscriptionExists(ctx, cli, name)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.listed {
+		subscriptionList := &v1alpha1.SubscriptionList{}
+		if err := cli.List(ctx, subscriptionList); err != nil {
+			return false, err
+		}
+		l.subscriptions = subscriptionList.Items
+		l.listed = true
+	}
+
+	for i := range l.subscriptions {
+		if l.subscriptions[i].Name == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // DeleteExistingSubscription deletes given Subscription if it exists
 // Do not error if the Subscription does not exist.
 func DeleteExistingSubscription(ctx context.Context, cli client.Client, operatorNs string, subsName string) error {
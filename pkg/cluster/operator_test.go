@@ -0,0 +1,75 @@
+package cluster_test
+
+import (
+	"context"
+
+	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Subscription lookup", func() {
+
+	var (
+		cli   client.Client
+		lists int
+	)
+
+	BeforeEach(func() {
+		lists = 0
+
+		scheme := runtime.NewScheme()
+		utilruntime.Must(ofapiv1alpha1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(&ofapiv1alpha1.Subscription{ObjectMeta: metav1.ObjectMeta{Name: "authorino-operator", Namespace: "openshift-operators"}}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					lists++
+
+					return c.List(ctx, list, opts...)
+				},
+			}).
+			Build()
+	})
+
+	It("should list subscriptions once for all the checks", func(ctx context.Context) {
+		// given
+		subscriptions := cluster.NewSubscriptionLookup()
+
+		// when
+		authorinoInstalled, errAuthorino := subscriptions.Exists(ctx, cli, "authorino-operator")
+		meshInstalled, errMesh := subscriptions.Exists(ctx, cli, "servicemeshoperator")
+
+		// then
+		Expect(errAuthorino).ToNot(HaveOccurred())
+		Expect(errMesh).ToNot(HaveOccurred())
+		Expect(authorinoInstalled).To(BeTrue())
+		Expect(meshInstalled).To(BeFalse())
+		Expect(lists).To(Equal(1))
+	})
+
+	It("should list subscriptions on every check without lookup", func(ctx context.Context) {
+		// given
+		var subscriptions *cluster.SubscriptionLookup
+
+		// when
+		for i := 0; i < 2; i++ {
+			installed, err := subscriptions.Exists(ctx, cli, "authorino-operator")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(installed).To(BeTrue())
+		}
+
+		// then
+		Expect(lists).To(Equal(2))
+	})
+})
@@ -11,7 +11,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -89,6 +92,7 @@ func CreateSecret(ctx context.Context, cli client.Client, name, namespace string
 
 // CreateOrUpdateConfigMap creates a new configmap or updates an existing one.
 // If the configmap already exists, it will be updated with the merged Data and MetaOptions, if any.
+// Unlike Apply, keys set by previous calls are kept, so that the configmap can be filled in one key at a time.
 // ConfigMap.ObjectMeta.Name and ConfigMap.ObjectMeta.Namespace are both required, it returns an error otherwise.
 func CreateOrUpdateConfigMap(ctx context.Context, c client.Client, desiredCfgMap *corev1.ConfigMap, metaOptions ...MetaOptions) error {
 	if applyErr := ApplyMetaOptions(desiredCfgMap, metaOptions...); applyErr != nil {
@@ -156,6 +160,17 @@ func CreateNamespace(ctx context.Context, cli client.Client, namespace string, m
 	return desiredNamespace, client.IgnoreAlreadyExists(createErr)
 }
 
+// MeshOptedOut checks if the namespace is labeled as exempt from Service Mesh enrollment, see labels.ServiceMeshDisabled.
+// A namespace which does not exist has not opted out.
+func MeshOptedOut(ctx context.Context, cli client.Client, namespace string) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return ns.GetLabels()[labels.ODH.ServiceMesh] == labels.ServiceMeshDisabled, nil
+}
+
 // ExecuteOnAllNamespaces executes the passed function for all namespaces in the cluster retrieved in batches.
 func ExecuteOnAllNamespaces(ctx context.Context, cli client.Client, processFunc func(*corev1.Namespace) error) error {
 	namespaces := &corev1.NamespaceList{}
@@ -239,3 +254,51 @@ func CreateWithRetry(ctx context.Context, cli client.Client, obj client.Object,
 		return false, errCreate
 	})
 }
+
+// +kubebuilder:rbac:groups="core",resources=resourcequotas,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="core",resources=limitranges,verbs=get;list;watch;create;update;patch;delete
+
+// CreateOrUpdateResourceQuota creates a ResourceQuota with the given spec in the namespace or overrides the spec of the existing one.
+func CreateOrUpdateResourceQuota(ctx context.Context, cli client.Client, name, namespace string, spec corev1.ResourceQuotaSpec, metaOptions ...MetaOptions) error {
+	return Apply(ctx, cli, &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: spec,
+	}, metaOptions...)
+}
+
+// CreateOrUpdateLimitRange creates a LimitRange with the given spec in the namespace or overrides the spec of the existing one.
+func CreateOrUpdateLimitRange(ctx context.Context, cli client.Client, name, namespace string, spec corev1.LimitRangeSpec, metaOptions ...MetaOptions) error {
+	return Apply(ctx, cli, &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: spec,
+	}, metaOptions...)
+}
+
+// ListFeatureResources lists resources of the given kinds labeled as created by the feature with the given name
+// (see feature.WithFeatureLabels). Kinds which are not served by the cluster are skipped.
+func ListFeatureResources(ctx context.Context, cli client.Client, featureName string, gvks ...schema.GroupVersionKind) ([]unstructured.Unstructured, error) {
+	var resources []unstructured.Unstructured
+
+	for _, gvk := range gvks {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+		if err := cli.List(ctx, list, client.MatchingLabels{labels.ODH.Feature: featureName}); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed listing %s resources of feature %s: %w", gvk.Kind, featureName, err)
+		}
+
+		resources = append(resources, list.Items...)
+	}
+
+	return resources, nil
+}
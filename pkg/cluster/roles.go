@@ -4,12 +4,12 @@ import (
 	"context"
 
 	rbacv1 "k8s.io/api/rbac/v1"
-	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // CreateOrUpdateClusterRole creates cluster role based on define PolicyRules and optional metadata fields and updates the rules if it already exists.
+// See Apply for how the existing role is updated.
 func CreateOrUpdateClusterRole(ctx context.Context, cli client.Client, name string, rules []rbacv1.PolicyRule, metaOptions ...MetaOptions) (*rbacv1.ClusterRole, error) {
 	desiredClusterRole := &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
@@ -18,22 +18,7 @@ func CreateOrUpdateClusterRole(ctx context.Context, cli client.Client, name stri
 		Rules: rules,
 	}
 
-	if err := ApplyMetaOptions(desiredClusterRole, metaOptions...); err != nil {
-		return nil, err
-	}
-
-	foundClusterRole := &rbacv1.ClusterRole{}
-	err := cli.Get(ctx, client.ObjectKey{Name: desiredClusterRole.GetName()}, foundClusterRole)
-	if k8serr.IsNotFound(err) {
-		return desiredClusterRole, cli.Create(ctx, desiredClusterRole)
-	}
-
-	if err := ApplyMetaOptions(foundClusterRole, metaOptions...); err != nil {
-		return nil, err
-	}
-	foundClusterRole.Rules = rules
-
-	return foundClusterRole, cli.Update(ctx, foundClusterRole)
+	return desiredClusterRole, Apply(ctx, cli, desiredClusterRole, metaOptions...)
 }
 
 // DeleteClusterRole simply calls delete on a ClusterRole with the given name. Any error is returned. Check for IsNotFound.
@@ -47,6 +32,7 @@ func DeleteClusterRole(ctx context.Context, cli client.Client, name string) erro
 }
 
 // CreateOrUpdateClusterRoleBinding creates cluster role bindings based on define PolicyRules and optional metadata fields and updates the bindings if it already exists.
+// See Apply for how the existing binding is updated.
 func CreateOrUpdateClusterRoleBinding(ctx context.Context, cli client.Client, name string,
 	subjects []rbacv1.Subject, roleRef rbacv1.RoleRef,
 	metaOptions ...MetaOptions) (*rbacv1.ClusterRoleBinding, error) {
@@ -58,23 +44,7 @@ func CreateOrUpdateClusterRoleBinding(ctx context.Context, cli client.Client, na
 		RoleRef:  roleRef,
 	}
 
-	if err := ApplyMetaOptions(desiredClusterRoleBinding, metaOptions...); err != nil {
-		return nil, err
-	}
-
-	foundClusterRoleBinding := &rbacv1.ClusterRoleBinding{}
-	err := cli.Get(ctx, client.ObjectKey{Name: desiredClusterRoleBinding.GetName()}, foundClusterRoleBinding)
-	if k8serr.IsNotFound(err) {
-		return desiredClusterRoleBinding, cli.Create(ctx, desiredClusterRoleBinding)
-	}
-
-	if err := ApplyMetaOptions(foundClusterRoleBinding, metaOptions...); err != nil {
-		return nil, err
-	}
-	foundClusterRoleBinding.Subjects = subjects
-	foundClusterRoleBinding.RoleRef = roleRef
-
-	return foundClusterRoleBinding, cli.Update(ctx, foundClusterRoleBinding)
+	return desiredClusterRoleBinding, Apply(ctx, cli, desiredClusterRoleBinding, metaOptions...)
 }
 
 // DeleteClusterRoleBinding simply calls delete on a ClusterRoleBinding with the given name. Any error is returned. Check for IsNotFound.
@@ -87,3 +57,18 @@ func DeleteClusterRoleBinding(ctx context.Context, cli client.Client, name strin
 
 	return cli.Delete(ctx, desiredClusterRoleBinding)
 }
+
+// CreateOrUpdatePlatformRBAC grants the rules to the subject, e.g. the service account of a controller extending the platform,
+// using a ClusterRole and a ClusterRoleBinding of the given name. Both are created or updated the same way as by
+// CreateOrUpdateClusterRole and CreateOrUpdateClusterRoleBinding.
+func CreateOrUpdatePlatformRBAC(ctx context.Context, cli client.Client, name string, subject rbacv1.Subject, rules []rbacv1.PolicyRule,
+	metaOptions ...MetaOptions) error {
+	if _, err := CreateOrUpdateClusterRole(ctx, cli, name, rules, metaOptions...); err != nil {
+		return err
+	}
+
+	roleRef := rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: name}
+	_, err := CreateOrUpdateClusterRoleBinding(ctx, cli, name, []rbacv1.Subject{subject}, roleRef, metaOptions...)
+
+	return err
+}
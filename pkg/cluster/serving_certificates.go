@@ -0,0 +1,97 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ServingCertRefsName is the ConfigMap in the applications namespace referencing serving certificates issued by DSCInitialization
+// (see spec.servingCertificates), so that serving components use them instead of issuing certificates on their own.
+const ServingCertRefsName = "serving-cert-refs"
+
+// ServingCertificateRefs references serving certificates published in the serving-cert-refs ConfigMap.
+type ServingCertificateRefs struct {
+	// Provider issuing the certificates, see dsciv1.ServingCertificatesProvider.
+	Provider string
+	// CABundleConfigMap is the ConfigMap holding the CA bundle in every namespace, set when certificates are issued by the OpenShift service CA.
+	CABundleConfigMap string
+	// CABundleSecretKey is the key holding the CA certificate in secrets of the certificates, set for other providers.
+	CABundleSecretKey string
+
+	// secrets holds names of the secrets by "<namespace>.<service>" keys.
+	secrets map[string]string
+}
+
+// GetServingCertificateRefs reads references published in the applications namespace. found is false when serving
+// certificates are not managed by DSCInitialization.
+func GetServingCertificateRefs(ctx context.Context, cli client.Client, namespace string) (*ServingCertificateRefs, bool, error) {
+	refsConfigMap := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: ServingCertRefsName, Namespace: namespace}, refsConfigMap); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, fmt.Errorf("failed getting %s/%s: %w", namespace, ServingCertRefsName, err)
+	}
+
+	refs := &ServingCertificateRefs{secrets: map[string]string{}}
+	for key, value := range refsConfigMap.Data {
+		switch key {
+		case "provider":
+			refs.Provider = value
+		case "caBundleConfigMap":
+			refs.CABundleConfigMap = value
+		case "caBundleSecretKey":
+			refs.CABundleSecretKey = value
+		default:
+			refs.secrets[key] = value
+		}
+	}
+
+	return refs, true, nil
+}
+
+// SecretOf returns the name of the secret holding the certificate of the Service.
+func (r *ServingCertificateRefs) SecretOf(namespace, service string) (string, bool) {
+	secretName, found := r.secrets[namespace+"."+service]
+
+	return secretName, found
+}
+
+// SharedSecretOf returns the name of the secret holding certificates of the Services of the given name in all namespaces,
+// for components which create the same Service in every namespace serving models and configure its secret only once.
+// It fails when the Services use secrets of different names.
+func (r *ServingCertificateRefs) SharedSecretOf(service string) (string, bool, error) {
+	secretNames := map[string][]string{}
+	for key, secretName := range r.secrets {
+		namespace, found := strings.CutSuffix(key, "."+service)
+		if !found || namespace == "" || strings.Contains(namespace, ".") {
+			continue
+		}
+		secretNames[secretName] = append(secretNames[secretName], namespace)
+	}
+
+	switch len(secretNames) {
+	case 0:
+		return "", false, nil
+	case 1:
+		for secretName := range secretNames {
+			return secretName, true, nil
+		}
+	}
+
+	names := make([]string, 0, len(secretNames))
+	for secretName, namespaces := range secretNames {
+		sort.Strings(namespaces)
+		names = append(names, fmt.Sprintf("%s (%s)", secretName, strings.Join(namespaces, ", ")))
+	}
+	sort.Strings(names)
+
+	return "", false, fmt.Errorf("services %s use secrets of different names %s, while the same name is required", service, strings.Join(names, ", "))
+}
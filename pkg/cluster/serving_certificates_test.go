@@ -0,0 +1,93 @@
+package cluster_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Serving certificate references", func() {
+
+	refsConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: cluster.ServingCertRefsName, Namespace: "opendatahub"},
+			Data:       data,
+		}
+	}
+
+	It("should report references as not published when serving certificates are not managed", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().Build()
+
+		// when
+		_, published, err := cluster.GetServingCertificateRefs(ctx, cli, "opendatahub")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(published).To(BeFalse())
+	})
+
+	It("should resolve secrets of services", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(refsConfigMap(map[string]string{
+			"provider":                      "OpenShift",
+			"caBundleConfigMap":             "openshift-service-ca.crt",
+			"kserve-demo.sklearn-predictor": "sklearn-tls",
+		})).Build()
+
+		// when
+		refs, published, err := cluster.GetServingCertificateRefs(ctx, cli, "opendatahub")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(published).To(BeTrue())
+		Expect(refs.Provider).To(Equal("OpenShift"))
+		Expect(refs.CABundleConfigMap).To(Equal("openshift-service-ca.crt"))
+		secretName, found := refs.SecretOf("kserve-demo", "sklearn-predictor")
+		Expect(found).To(BeTrue())
+		Expect(secretName).To(Equal("sklearn-tls"))
+	})
+
+	It("should resolve the secret shared by services of the same name in all namespaces", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(refsConfigMap(map[string]string{
+			"provider":                    "SelfSigned",
+			"models.modelmesh-serving":    "modelmesh-serving-cert",
+			"fraud.modelmesh-serving":     "modelmesh-serving-cert",
+			"kserve-demo.modelmesh-proxy": "proxy-tls",
+		})).Build()
+		refs, _, err := cluster.GetServingCertificateRefs(ctx, cli, "opendatahub")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		secretName, found, err := refs.SharedSecretOf("modelmesh-serving")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(secretName).To(Equal("modelmesh-serving-cert"))
+	})
+
+	It("should fail when services of the same name use secrets of different names", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(refsConfigMap(map[string]string{
+			"models.modelmesh-serving": "modelmesh-serving-cert",
+			"fraud.modelmesh-serving":  "fraud-tls",
+		})).Build()
+		refs, _, err := cluster.GetServingCertificateRefs(ctx, cli, "opendatahub")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, _, err = refs.SharedSecretOf("modelmesh-serving")
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("fraud-tls (fraud), modelmesh-serving-cert (models)")))
+	})
+})
@@ -0,0 +1,134 @@
+// Package events provides an event recorder which limits the amount of events emitted by the operator,
+// so that repeated reconcile failures, e.g. during long outages, do not flood the event stream.
+package events
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// Options controls which events are forwarded to the underlying recorder.
+type Options struct {
+	// DeduplicationWindow is the period in which an event with the same type, reason and message
+	// for the same object is emitted only once. Zero disables deduplication.
+	DeduplicationWindow time.Duration
+	// RateLimit is the sustained number of events per second which can be emitted. Zero disables rate limiting.
+	RateLimit float64
+	// Burst is the maximum number of events which can be emitted at once when RateLimit is set.
+	Burst int
+	// MinSeverity is the lowest event type which is emitted. Setting it to corev1.EventTypeWarning
+	// drops all Normal events. Defaults to corev1.EventTypeNormal.
+	MinSeverity string
+}
+
+// ValidateSeverity checks if the severity is a known event type.
+func ValidateSeverity(severity string) error {
+	switch severity {
+	case "", corev1.EventTypeNormal, corev1.EventTypeWarning:
+		return nil
+	}
+
+	return fmt.Errorf("unknown event severity %q, expected one of %s, %s", severity, corev1.EventTypeNormal, corev1.EventTypeWarning)
+}
+
+type recorder struct {
+	delegate record.EventRecorder
+	opts     Options
+	limiter  *rate.Limiter
+
+	mu       sync.Mutex
+	lastSeen map[string]time.Time
+}
+
+var _ record.EventRecorder = (*recorder)(nil)
+
+// NewRecorder wraps given recorder with deduplication, rate limiting and severity filtering.
+func NewRecorder(delegate record.EventRecorder, opts Options) record.EventRecorder {
+	r := &recorder{
+		delegate: delegate,
+		opts:     opts,
+		lastSeen: map[string]time.Time{},
+	}
+
+	if opts.RateLimit > 0 {
+		burst := opts.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		r.limiter = rate.NewLimiter(rate.Limit(opts.RateLimit), burst)
+	}
+
+	return r
+}
+
+func (r *recorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if r.shouldEmit(object, eventtype, reason, message) {
+		r.delegate.Event(object, eventtype, reason, message)
+	}
+}
+
+func (r *recorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *recorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if r.shouldEmit(object, eventtype, reason, message) {
+		r.delegate.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+	}
+}
+
+func (r *recorder) shouldEmit(object runtime.Object, eventtype, reason, message string) bool {
+	if r.opts.MinSeverity == corev1.EventTypeWarning && eventtype != corev1.EventTypeWarning {
+		return false
+	}
+
+	if r.opts.DeduplicationWindow <= 0 {
+		return r.limiter == nil || r.limiter.Allow()
+	}
+
+	now := time.Now()
+	key := eventKey(object, eventtype, reason, message)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.forgetExpired(now)
+	if _, seen := r.lastSeen[key]; seen {
+		return false
+	}
+
+	// Only emitted events are remembered, so that an event dropped by the rate limiter is not deduplicated away as well.
+	if r.limiter != nil && !r.limiter.Allow() {
+		return false
+	}
+	r.lastSeen[key] = now
+
+	return true
+}
+
+// forgetExpired removes events which have been emitted outside of deduplication window, so they can be emitted again.
+// Must be called with the lock held.
+func (r *recorder) forgetExpired(now time.Time) {
+	for key, emitted := range r.lastSeen {
+		if now.Sub(emitted) >= r.opts.DeduplicationWindow {
+			delete(r.lastSeen, key)
+		}
+	}
+}
+
+func eventKey(object runtime.Object, eventtype, reason, message string) string {
+	ref := object.GetObjectKind().GroupVersionKind().Kind
+	if accessor, err := meta.Accessor(object); err == nil {
+		ref = fmt.Sprintf("%s/%s/%s/%s", ref, accessor.GetNamespace(), accessor.GetName(), accessor.GetUID())
+	}
+
+	return fmt.Sprintf("%s|%s|%s|%s", ref, eventtype, reason, message)
+}
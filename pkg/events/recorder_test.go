@@ -0,0 +1,101 @@
+package events_test
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/events"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event recorder", func() {
+
+	var (
+		fakeRecorder *record.FakeRecorder
+		object       *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeRecorder = record.NewFakeRecorder(10)
+		object = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test-ns"}}
+	})
+
+	It("should emit identical events only once within deduplication window", func() {
+		// given
+		recorder := events.NewRecorder(fakeRecorder, events.Options{DeduplicationWindow: time.Hour})
+
+		// when
+		recorder.Eventf(object, corev1.EventTypeWarning, "ReconcileError", "failed %d", 1)
+		recorder.Eventf(object, corev1.EventTypeWarning, "ReconcileError", "failed %d", 1)
+		recorder.Eventf(object, corev1.EventTypeWarning, "ReconcileError", "failed %d", 2)
+
+		// then
+		Expect(fakeRecorder.Events).To(HaveLen(2))
+		Expect(<-fakeRecorder.Events).To(Equal("Warning ReconcileError failed 1"))
+		Expect(<-fakeRecorder.Events).To(Equal("Warning ReconcileError failed 2"))
+	})
+
+	It("should emit identical events again once deduplication window passed", func() {
+		// given
+		recorder := events.NewRecorder(fakeRecorder, events.Options{DeduplicationWindow: 10 * time.Millisecond})
+
+		// when
+		recorder.Event(object, corev1.EventTypeWarning, "ReconcileError", "failed")
+		time.Sleep(20 * time.Millisecond)
+		recorder.Event(object, corev1.EventTypeWarning, "ReconcileError", "failed")
+
+		// then
+		Expect(fakeRecorder.Events).To(HaveLen(2))
+	})
+
+	It("should drop events of lower severity", func() {
+		// given
+		recorder := events.NewRecorder(fakeRecorder, events.Options{MinSeverity: corev1.EventTypeWarning})
+
+		// when
+		recorder.Event(object, corev1.EventTypeNormal, "Created", "all good")
+		recorder.Event(object, corev1.EventTypeWarning, "ReconcileError", "failed")
+
+		// then
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+		Expect(<-fakeRecorder.Events).To(Equal("Warning ReconcileError failed"))
+	})
+
+	It("should drop events exceeding rate limit", func() {
+		// given
+		recorder := events.NewRecorder(fakeRecorder, events.Options{RateLimit: 0.001, Burst: 2})
+
+		// when
+		for _, reason := range []string{"First", "Second", "Third"} {
+			recorder.Event(object, corev1.EventTypeWarning, reason, "failed")
+		}
+
+		// then
+		Expect(fakeRecorder.Events).To(HaveLen(2))
+	})
+
+	It("should emit event dropped by rate limit once the limit allows it", func() {
+		// given
+		recorder := events.NewRecorder(fakeRecorder, events.Options{DeduplicationWindow: time.Hour, RateLimit: 20, Burst: 1})
+		recorder.Event(object, corev1.EventTypeWarning, "First", "failed")
+		recorder.Event(object, corev1.EventTypeWarning, "Second", "failed")
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+
+		// when
+		time.Sleep(100 * time.Millisecond)
+		recorder.Event(object, corev1.EventTypeWarning, "Second", "failed")
+
+		// then
+		Expect(fakeRecorder.Events).To(HaveLen(2))
+	})
+
+	It("should reject unknown severity", func() {
+		Expect(events.ValidateSeverity("Critical")).To(HaveOccurred())
+		Expect(events.ValidateSeverity(corev1.EventTypeWarning)).To(Succeed())
+	})
+})
@@ -0,0 +1,79 @@
+package feature_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Adoption policy of the feature", func() {
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-auth-provider"}}).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			Build()
+	})
+
+	createAuthNamespace := func(ctx context.Context, f *feature.Feature) error {
+		return feature.CreateNamespace(ctx, f, "opendatahub-auth-provider", feature.OwnedBy(f), feature.WithFeatureLabels(f))
+	}
+
+	defineFeature := func(policy resource.AdoptionPolicy) *feature.Feature {
+		f, err := feature.Define("mesh-control-plane-external-authz").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			AdoptionPolicy(policy).
+			PreConditions(createAuthNamespace).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		return f
+	}
+
+	It("should adopt the namespace pre-created by the user", func(ctx context.Context) {
+		// given
+		f := defineFeature(resource.AdoptExisting)
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		namespace := &corev1.Namespace{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "opendatahub-auth-provider"}, namespace)).To(Succeed())
+		Expect(namespace.Labels).To(HaveKeyWithValue(labels.ODH.Feature, "mesh-control-plane-external-authz"))
+		Expect(namespace.OwnerReferences).To(ContainElement(HaveField("Kind", "FeatureTracker")))
+	})
+
+	It("should fail the feature when the namespace has been pre-created by the user", func(ctx context.Context) {
+		// given
+		f := defineFeature(resource.FailOnExisting)
+
+		// when
+		err := f.Apply(ctx)
+
+		// then
+		Expect(resource.IsResourceConflict(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,82 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
+)
+
+// ArchitecturesKey is the key under which schedulable node architectures are stored in the Feature's data,
+// so templates can render node affinity using {{ .Architectures }}.
+const ArchitecturesKey = "Architectures"
+
+type UnsupportedArchitectureError struct {
+	available []string
+	supported []string
+}
+
+func NewUnsupportedArchitectureError(available, supported []string) *UnsupportedArchitectureError {
+	return &UnsupportedArchitectureError{
+		available: available,
+		supported: supported,
+	}
+}
+
+func (e *UnsupportedArchitectureError) Error() string {
+	return fmt.Sprintf("none of the cluster node architectures [%s] is supported, expected one of [%s]",
+		strings.Join(e.available, ", "), strings.Join(e.supported, ", "))
+}
+
+// SchedulableArchitectures resolves the intersection of the given supported architectures and those
+// available on the cluster nodes. When nodes do not report their architecture, all supported ones are returned.
+func SchedulableArchitectures(supported ...string) provider.DataProviderFunc[[]string] {
+	return func(ctx context.Context, cli client.Client) ([]string, error) {
+		available, err := cluster.GetNodeArchitectures(ctx, cli)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(available) == 0 {
+			return supported, nil
+		}
+
+		return intersect(available, supported), nil
+	}
+}
+
+// EnsureArchitectureSupported fails with UnsupportedArchitectureError when none of the nodes
+// in a cluster runs on one of the supported architectures.
+func EnsureArchitectureSupported(supported ...string) Action {
+	return func(ctx context.Context, f *Feature) error {
+		available, err := cluster.GetNodeArchitectures(ctx, f.Client)
+		if err != nil {
+			return err
+		}
+
+		if len(available) > 0 && len(intersect(available, supported)) == 0 {
+			return NewUnsupportedArchitectureError(available, supported)
+		}
+
+		return nil
+	}
+}
+
+func intersect(available, supported []string) []string {
+	result := []string{}
+	for _, arch := range available {
+		for _, s := range supported {
+			if arch == s {
+				result = append(result, arch)
+
+				break
+			}
+		}
+	}
+
+	return result
+}
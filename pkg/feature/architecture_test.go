@@ -0,0 +1,79 @@
+package feature_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Node architectures", func() {
+
+	node := func(name, arch string) client.Object {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{corev1.LabelArchStable: arch},
+			},
+		}
+	}
+
+	DescribeTable("resolving schedulable architectures",
+		func(ctx context.Context, nodes []client.Object, expected []string) {
+			// given
+			cli := fake.NewClientBuilder().WithObjects(nodes...).Build()
+
+			// when
+			archs, err := feature.SchedulableArchitectures("amd64", "arm64")(ctx, cli)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archs).To(Equal(expected))
+		},
+		Entry("should keep only supported architectures of a heterogeneous cluster",
+			[]client.Object{node("a", "arm64"), node("b", "s390x"), node("c", "amd64"), node("d", "arm64")},
+			[]string{"amd64", "arm64"},
+		),
+		Entry("should fall back to supported architectures when nodes do not report any",
+			[]client.Object{},
+			[]string{"amd64", "arm64"},
+		),
+	)
+
+	It("should fail with unsupported architecture error when no node can run the workload", func(ctx context.Context) {
+		// given
+		f := &feature.Feature{
+			Name:   "arch-test",
+			Client: fake.NewClientBuilder().WithObjects(node("a", "s390x")).Build(),
+		}
+
+		// when
+		err := feature.EnsureArchitectureSupported("amd64", "arm64")(ctx, f)
+
+		// then
+		var archErr *feature.UnsupportedArchitectureError
+		Expect(err).To(BeAssignableToTypeOf(archErr))
+		Expect(err.Error()).To(ContainSubstring("s390x"))
+	})
+
+	It("should pass when at least one node runs supported architecture", func(ctx context.Context) {
+		// given
+		f := &feature.Feature{
+			Name:   "arch-test",
+			Client: fake.NewClientBuilder().WithObjects(node("a", "s390x"), node("b", "arm64")).Build(),
+		}
+
+		// when
+		err := feature.EnsureArchitectureSupported("amd64", "arm64")(ctx, f)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -3,6 +3,7 @@ package feature
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -14,7 +15,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
 )
 
@@ -27,6 +31,13 @@ type featureBuilder struct {
 	targetNs    string
 
 	config *rest.Config
+	client client.Client
+
+	// group the feature belongs to, if any, see FeatureGroup.
+	group *FeatureGroup
+
+	// capability the feature is part of, if any, see ForCapability.
+	capability string
 
 	builders []partialBuilder
 }
@@ -94,6 +105,18 @@ func (fb *featureBuilder) Manifests(creators ...resource.Creator) *featureBuilde
 	return fb
 }
 
+// Patches defines changes of resources which are not owned by the feature, such as resources managed by other operators.
+// Patches are applied after manifests, and the changes they made are reverted when the feature is deleted.
+func (fb *featureBuilder) Patches(patches ...Patch) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.patches = append(f.patches, patches...)
+
+		return nil
+	})
+
+	return fb
+}
+
 // Managed marks the feature as managed by the operator.  This effectively marks all resources which are part of this feature
 // as those that should be updated on operator reconcile.
 // Managed marks the feature as managed by the operator.
@@ -173,6 +196,20 @@ func (fb *featureBuilder) PostConditions(postconditions ...Action) *featureBuild
 	return fb
 }
 
+// DependsOn declares features (by name) which have to be applied before this one.
+// FeaturesHandler uses this information to apply features in dependency order and to tear them down in the reverse one,
+// so that resources are not removed while dependent features still rely on them.
+// Dependencies on features which are not part of the same handler are ignored.
+func (fb *featureBuilder) DependsOn(featureNames ...string) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.dependsOn = append(f.dependsOn, featureNames...)
+
+		return nil
+	})
+
+	return fb
+}
+
 // OnDelete allow to add cleanup hooks that are executed when the feature is going to be deleted.
 func (fb *featureBuilder) OnDelete(cleanups ...CleanupFunc) *featureBuilder {
 	fb.builders = append(fb.builders, func(f *Feature) error {
@@ -184,6 +221,226 @@ func (fb *featureBuilder) OnDelete(cleanups ...CleanupFunc) *featureBuilder {
 	return fb
 }
 
+// CleanupResources declares resources which are not rendered from manifests, e.g. ConfigMaps created by resource actions,
+// to be deleted when the feature is removed. Resources which have already been deleted are skipped, as well as those
+// which belong to another feature.
+func (fb *featureBuilder) CleanupResources(references ...resource.Reference) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.addCleanup(deleteResources(f, references...))
+
+		return nil
+	})
+
+	return fb
+}
+
+// Disruptive marks the feature as disrupting running workloads when its configuration changes, e.g. because
+// it restarts Service Mesh control plane or gateways. Once applied, changes to such feature are only
+// applied within the maintenance window, if one is defined.
+func (fb *featureBuilder) Disruptive() *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.disruptive = true
+
+		return nil
+	})
+
+	return fb
+}
+
+// MaintenanceWindow defines when changes to disruptive features can be applied. Nil means changes are applied immediately.
+// withSubscriptionLookup sets the lookup used to check installed operators, shared within the reconcile pass.
+func (fb *featureBuilder) withSubscriptionLookup(subscriptions *cluster.SubscriptionLookup) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.subscriptions = subscriptions
+
+		return nil
+	})
+
+	return fb
+}
+
+// UsingPoller makes the feature wait for its conditions, e.g. pods becoming ready, using the given poller. Useful for testing
+// timeouts with a fake clock.
+func (fb *featureBuilder) UsingPoller(poller *Poller) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.poller = poller
+
+		return nil
+	})
+
+	return fb
+}
+
+// RequiresOpenShift restricts the feature to clusters running OpenShift of the given range of versions, e.g. ">=4.14" or
+// ">=4.14 <4.17", when it relies on APIs served only by some versions. On other clusters the feature fails upfront with
+// UnsupportedClusterVersionError, instead of obscure errors of the missing APIs.
+func (fb *featureBuilder) RequiresOpenShift(constraint string) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		supported, err := parseVersionRange(constraint)
+		if err != nil {
+			return fmt.Errorf("invalid OpenShift version range %q of feature %s: %w", constraint, f.Name, err)
+		}
+		f.requiredOpenShift = &versionRequirement{constraint: constraint, supported: supported}
+
+		return nil
+	})
+
+	return fb
+}
+
+func (fb *featureBuilder) MaintenanceWindow(window *dsciv1.MaintenanceWindow) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.maintenanceWindow = window
+
+		return nil
+	})
+
+	return fb
+}
+
+// PolicyExemptions defines labels and annotations added to resources created by the feature,
+// so that admission policies enforced in the cluster let them through.
+func (fb *featureBuilder) PolicyExemptions(exemptions *dsciv1.PolicyExemptions) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.policyExemptions = exemptions
+
+		return nil
+	})
+
+	return fb
+}
+
+// ImageMirrors defines mirrors which image references in manifests and charts of the feature are rewritten with
+// when they are rendered, e.g. to pull images from the registry of a disconnected cluster.
+func (fb *featureBuilder) ImageMirrors(mirrors []dsciv1.ImageMirror) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		if len(mirrors) == 0 {
+			return nil
+		}
+
+		imageMirrors := make(resource.ImageMirrors, len(mirrors))
+		for _, mirror := range mirrors {
+			imageMirrors[mirror.Source] = mirror.Mirror
+		}
+
+		return f.Set(manifest.ImageMirrorsKey, imageMirrors)
+	})
+
+	return fb
+}
+
+// MetadataRepair defines how labels and annotations of resources of the feature which already exist, and have been created by it,
+// are repaired when the feature is applied again. Resources can override it in their manifests using the opendatahub.io/metadata-repair
+// annotation.
+func (fb *featureBuilder) MetadataRepair(policy resource.MetadataRepairPolicy) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.metadataRepair = policy
+
+		return nil
+	})
+
+	return fb
+}
+
+// ClusterResourcesOnDelete defines what happens with cluster-scoped resources of the feature when it is removed. The feature tracks
+// and deletes them explicitly, also when they no longer reference its FeatureTracker as their owner, unless the policy is
+// resource.OnDeleteOrphan. Resources can override it in their manifests using the opendatahub.io/on-delete annotation.
+func (fb *featureBuilder) ClusterResourcesOnDelete(policy resource.OnDeletePolicy) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		if policy != resource.OnDeleteRemove && policy != resource.OnDeleteOrphan {
+			return fmt.Errorf("invalid on-delete policy %q of cluster-scoped resources, expected one of %s, %s",
+				policy, resource.OnDeleteRemove, resource.OnDeleteOrphan)
+		}
+
+		f.clusterResourcesOnDelete = policy
+
+		return nil
+	})
+
+	return fb
+}
+
+// NamespaceDeletion defines how namespaces owned by the FeatureTracker of the feature are deleted when the feature is removed.
+// Nil leaves them to the garbage collector.
+func (fb *featureBuilder) NamespaceDeletion(deletion *dsciv1.NamespaceDeletion) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.namespaceDeletion = deletion
+
+		return nil
+	})
+
+	return fb
+}
+
+// RequiresPermissions declares access the operations of the feature need, e.g. to create resources of its manifests
+// or to patch resources owned by other operators. Permissions which the operator lacks are reported upfront.
+func (fb *featureBuilder) RequiresPermissions(permissions ...Permission) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.permissions = append(f.permissions, permissions...)
+
+		return nil
+	})
+
+	return fb
+}
+
+// CostAttribution defines labels added to namespaces and workloads created by the feature,
+// so that chargeback tools attribute their cost.
+func (fb *featureBuilder) CostAttribution(attribution *dsciv1.CostAttribution) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.costAttribution = attribution
+
+		return nil
+	})
+
+	return fb
+}
+
+// withCapability sets the capability the feature is part of. Calling it multiple times has no effect, as the first value is used.
+func (fb *featureBuilder) withCapability(capability string) *featureBuilder {
+	if fb.capability == "" {
+		fb.capability = capability
+	}
+
+	return fb
+}
+
+// ApplyTimeout bounds applying the feature, including waiting for its post-conditions, overriding the operator default.
+// Feature which has not been applied in time fails with ApplyTimeoutError, letting other features of the handler proceed.
+func (fb *featureBuilder) ApplyTimeout(timeout time.Duration) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.applyTimeout = timeout
+
+		return nil
+	})
+
+	return fb
+}
+
+// AdoptionPolicy defines what happens with resources of the feature which already exist, but have not been created by it,
+// e.g. on brownfield clusters. Resources can override it in their manifests using the opendatahub.io/adoption-policy annotation.
+func (fb *featureBuilder) AdoptionPolicy(policy resource.AdoptionPolicy) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.adoptionPolicy = policy
+
+		return nil
+	})
+
+	return fb
+}
+
+// Exports publishes the value under the name once the feature has been applied, e.g. the hostname of the gateway it created.
+// Values are reported in the status of DSCInitialization under the capability the feature is part of, see CapabilityExports.
+func (fb *featureBuilder) Exports(name string, value ExportFunc) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.exports = append(f.exports, export{name: name, value: value})
+
+		return nil
+	})
+
+	return fb
+}
+
 // Create creates a new Feature instance and add it to corresponding FeaturesHandler.
 // The actual feature creation in the cluster is not performed here.
 func (fb *featureBuilder) Create() (*Feature, error) {
@@ -197,18 +454,24 @@ func (fb *featureBuilder) Create() (*Feature, error) {
 		Enabled: alwaysEnabled,
 		Log:     log.Log.WithName("features").WithValues("feature", fb.featureName),
 		source:  &fb.source,
+
+		capability: fb.capability,
 	}
 
-	// UsingConfig builder wasn't called while constructing this feature.
+	// Neither UsingConfig nor UsingClient builder was called while constructing this feature.
 	// Get default settings and create needed clients.
-	if fb.config == nil {
-		if err := fb.withDefaultClient(); err != nil {
-			return nil, err
+	if fb.client != nil {
+		f.Client = fb.client
+	} else {
+		if fb.config == nil {
+			if err := fb.withDefaultClient(); err != nil {
+				return nil, err
+			}
 		}
-	}
 
-	if err := createClient(fb.config)(f); err != nil {
-		return nil, err
+		if err := createClient(fb.config)(f); err != nil {
+			return nil, err
+		}
 	}
 
 	for i := range fb.builders {
@@ -217,6 +480,16 @@ func (fb *featureBuilder) Create() (*Feature, error) {
 		}
 	}
 
+	if fb.group != nil {
+		f.group = fb.group
+		f.Enabled = fb.group.isEnabled(f.Enabled)
+		f.dataProviders = append([]Action{fb.group.loadData}, f.dataProviders...)
+	}
+
+	// Resources patched by the feature are only known once it is applied, either through Patches or patch manifests
+	// declaring resource.OnDeleteRevertPatch policy, so reverting is always part of the cleanup.
+	f.addCleanup(revertPatches(f))
+
 	return f, nil
 }
 
@@ -226,6 +499,13 @@ func (fb *featureBuilder) UsingConfig(config *rest.Config) *featureBuilder {
 	return fb
 }
 
+// UsingClient makes the feature use the given client instead of creating one, e.g. a client backed by fixtures
+// to render manifests of the feature offline. The scheme of the client has to include types the feature works with.
+func (fb *featureBuilder) UsingClient(cli client.Client) *featureBuilder {
+	fb.client = cli
+	return fb
+}
+
 func createClient(config *rest.Config) partialBuilder {
 	return func(f *Feature) error {
 		var err error
@@ -0,0 +1,263 @@
+package feature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// canarySuffix is appended to the name of the feature applied to the canary namespace.
+const canarySuffix = "canary"
+
+// CanaryFailedError indicates that changes of the feature brought by the operator upgrade failed in the canary namespace,
+// so they have not been rolled out and the feature is kept as applied by the previous operator version.
+type CanaryFailedError struct {
+	featureName string
+	namespace   string
+	err         error
+}
+
+func (e *CanaryFailedError) Error() string {
+	return fmt.Sprintf("changes of feature %q failed in canary namespace %s and have not been rolled out: %v", e.featureName, e.namespace, e.err)
+}
+
+func (e *CanaryFailedError) Unwrap() error {
+	return e.err
+}
+
+// IsCanaryFailed checks if the error, possibly wrapped, is caused by changes of a feature failing in the canary namespace.
+func IsCanaryFailed(err error) bool {
+	var canaryErr *CanaryFailedError
+
+	return errors.As(err, &canaryErr)
+}
+
+// CanaryName is the name of the copy of the feature applied to the canary namespace.
+func CanaryName(featureName string) string {
+	return featureName + "-" + canarySuffix
+}
+
+// CanaryProbes defines checks run against the copy of the feature applied to the canary namespace, in addition to its post-conditions,
+// e.g. sending a request through the canary gateway. They are not run when the feature is applied to its target namespace.
+// Only features defining canary probes are verified in the canary namespace, so defining them also declares that resources created
+// by Go functions of the feature, e.g. those passed to WithResources, are created in its target namespace.
+func (fb *featureBuilder) CanaryProbes(probes ...Action) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.canaryProbes = append(f.canaryProbes, probes...)
+
+		return nil
+	})
+
+	return fb
+}
+
+// WithCanary makes the handler verify changes brought by operator upgrades in the canary namespace before rolling them out,
+// see applyWithCanary. Nil applies the changes directly.
+func (fh *FeaturesHandler) WithCanary(rollout *dsciv1.CanaryRollout) *FeaturesHandler {
+	fh.canaryNamespace = canaryNamespaceOf(rollout)
+
+	return fh
+}
+
+func canaryNamespaceOf(rollout *dsciv1.CanaryRollout) string {
+	if rollout == nil {
+		return ""
+	}
+
+	return rollout.Namespace
+}
+
+// createCanary creates the copy of the feature targeting the canary namespace. The copy is built from the same definition,
+// so its resources are rendered with the canary namespace as TargetNamespace, and its canary probes run as post-conditions.
+// Features without canary probes, or patching resources, which are shared regardless of the target namespace, have no canary.
+func (fb *featureBuilder) createCanary(namespace string) (*Feature, error) {
+	featureName, targetNs := fb.featureName, fb.targetNs
+	defer func() {
+		fb.featureName, fb.targetNs = featureName, targetNs
+	}()
+
+	fb.featureName, fb.targetNs = CanaryName(featureName), namespace
+
+	canary, err := fb.Create()
+	if err != nil {
+		return nil, fmt.Errorf("failed creating canary of feature %s: %w", featureName, err)
+	}
+
+	if len(canary.canaryProbes) == 0 || len(canary.patches) > 0 {
+		return nil, nil //nolint:nilnil // Reason: feature is applied directly when it cannot be verified in the canary namespace
+	}
+
+	canary.clusterOperations = append([]Action{createCanaryNamespace(namespace)}, canary.clusterOperations...)
+	canary.postconditions = append(canary.postconditions, canary.canaryProbes...)
+
+	return canary, nil
+}
+
+// applyWithCanary applies the feature, first applying its canary copy when the feature has been applied by an older version
+// of the operator. Changes are only rolled out when the canary succeeds, including its post-conditions and probes, after which
+// the canary is removed. A failed canary is kept for inspection and retried in the next reconciliation. Features rendering
+// resources outside of their target namespace, e.g. in the control plane namespace, are applied directly, as their canary
+// would change the very resources it is meant to protect.
+func applyWithCanary(ctx context.Context, f *Feature) error {
+	if f.canary == nil {
+		return f.Apply(ctx)
+	}
+
+	upgrading, err := f.upgrading(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !upgrading {
+		return multierror.Append(f.Apply(ctx), removeCanary(ctx, f.canary)).ErrorOrNil()
+	}
+
+	confined, errConfined := confinedToTargetNamespace(ctx, f.canary)
+	if errConfined != nil {
+		return &CanaryFailedError{featureName: f.Name, namespace: f.canary.TargetNamespace, err: errConfined}
+	}
+	if !confined {
+		f.Log.Info("applying changes directly, as the feature has resources outside of its target namespace")
+
+		return f.Apply(ctx)
+	}
+
+	f.Log.Info("applying changes to the canary namespace first", "namespace", f.canary.TargetNamespace)
+	if errCanary := f.canary.Apply(ctx); errCanary != nil {
+		return &CanaryFailedError{featureName: f.Name, namespace: f.canary.TargetNamespace, err: errCanary}
+	}
+
+	if errApply := f.Apply(ctx); errApply != nil {
+		return errApply
+	}
+
+	return removeCanary(ctx, f.canary)
+}
+
+// upgrading checks if the enabled feature has been applied by an older version of the operator than the running one.
+func (f *Feature) upgrading(ctx context.Context) (bool, error) {
+	if enabled, err := f.Enabled(ctx, f); !enabled || err != nil {
+		return false, err
+	}
+
+	if operatorVersion.Equals(semver.Version{}) {
+		return false, nil
+	}
+
+	tracker, err := getFeatureTracker(ctx, f.Client, f.Name, f.TargetNamespace)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	trackerVersion, err := semver.ParseTolerant(tracker.Status.OperatorVersion)
+	if err != nil {
+		return false, nil //nolint:nilerr // Reason: trackers without a valid version have been applied before it has been recorded
+	}
+
+	return trackerVersion.LT(operatorVersion), nil
+}
+
+// confinedToTargetNamespace checks if all resources of the canary are created in its target namespace. Resources of appliers
+// which cannot be rendered upfront are considered to be created elsewhere.
+func confinedToTargetNamespace(ctx context.Context, canary *Feature) (bool, error) {
+	for _, applier := range canary.appliers {
+		if _, canRender := applier.(resource.Renderer); !canRender {
+			return false, nil
+		}
+	}
+
+	objects, err := canary.Render(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, obj := range objects {
+		namespace := obj.GetNamespace()
+		if namespace == "" {
+			namespaced, errScope := canary.Client.IsObjectNamespaced(obj)
+			if errScope != nil {
+				return false, fmt.Errorf("failed determining scope of %s %s: %w", obj.GetKind(), obj.GetName(), errScope)
+			}
+			if namespaced {
+				// applied to the target namespace
+				continue
+			}
+		}
+
+		if namespace != canary.TargetNamespace {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// createCanaryNamespace creates the canary namespace marked as such, so that it is removed together with the last canary.
+func createCanaryNamespace(namespace string) Action {
+	return func(ctx context.Context, f *Feature) error {
+		return CreateNamespace(ctx, f, namespace, cluster.WithLabels(labels.CanaryNamespace, "true"), WithPolicyExemptions(f))
+	}
+}
+
+// removeCanary deletes the canary copy of the feature, if it has been applied. Cleanups of the copy, such as OnDelete hooks,
+// are not run, as they would act on resources shared with the feature, which has just been rolled out. The canary namespace
+// created by the operator is deleted once no other canary is applied to it.
+func removeCanary(ctx context.Context, canary *Feature) error {
+	if _, err := getFeatureTracker(ctx, canary.Client, canary.Name, canary.TargetNamespace); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	canary.cleanups = nil
+	if err := canary.Cleanup(ctx); err != nil {
+		return err
+	}
+
+	return removeCanaryNamespace(ctx, canary.Client, canary.TargetNamespace)
+}
+
+func removeCanaryNamespace(ctx context.Context, cli client.Client, namespace string) error {
+	canaryNamespace := &corev1.Namespace{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: namespace}, canaryNamespace); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if canaryNamespace.GetLabels()[labels.CanaryNamespace] != "true" || canaryNamespace.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	trackers := &featurev1.FeatureTrackerList{}
+	if err := cli.List(ctx, trackers); err != nil {
+		return fmt.Errorf("failed listing feature trackers: %w", err)
+	}
+	for i := range trackers.Items {
+		if trackers.Items[i].Spec.AppNamespace == namespace && trackers.Items[i].GetDeletionTimestamp() == nil {
+			return nil
+		}
+	}
+
+	if err := cli.Delete(ctx, canaryNamespace); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting canary namespace %s: %w", namespace, err)
+	}
+
+	return nil
+}
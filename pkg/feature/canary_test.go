@@ -0,0 +1,135 @@
+package feature_test
+
+import (
+	"context"
+	"testing/fstest"
+
+	"github.com/blang/semver/v4"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Canary rollout of features", func() {
+
+	const (
+		canaryNamespace = "opendatahub-canary"
+		gatewayConfig   = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: gateway-config
+  namespace: {{ .TargetNamespace }}
+`
+		controlPlaneConfig = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: control-plane-config
+  namespace: istio-system
+`
+	)
+
+	var (
+		cli     client.Client
+		dsci    *dsciv1.DSCInitialization
+		probed  []string
+		deleted []string
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			Build()
+
+		dsci = &dsciv1.DSCInitialization{}
+		dsci.Name = "default-dsci"
+		dsci.Spec.ApplicationsNamespace = "opendatahub"
+		dsci.Spec.CanaryRollout = &dsciv1.CanaryRollout{Namespace: canaryNamespace}
+		probed, deleted = nil, nil
+		feature.SetOperatorVersion(semver.MustParse("2.9.0"))
+	})
+
+	AfterEach(func() {
+		feature.SetOperatorVersion(semver.Version{})
+	})
+
+	gatewayFeature := func(manifests string) feature.FeaturesProvider {
+		return func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("canary-gateway").
+					Manifests(manifest.Location(fstest.MapFS{"gateway/config.tmpl.yaml": {Data: []byte(manifests)}}).Include("gateway")).
+					OnDelete(func(_ context.Context, _ client.Client) error {
+						deleted = append(deleted, "canary-gateway")
+
+						return nil
+					}).
+					CanaryProbes(func(_ context.Context, f *feature.Feature) error {
+						probed = append(probed, f.TargetNamespace)
+
+						return nil
+					}),
+			)
+		}
+	}
+
+	upgrade := func(ctx context.Context, manifests string) error {
+		Expect(feature.ClusterFeaturesHandler(dsci, gatewayFeature(manifests)).UsingClient(cli).Apply(ctx)).To(Succeed())
+		feature.SetOperatorVersion(semver.MustParse("2.10.0"))
+
+		return feature.ClusterFeaturesHandler(dsci, gatewayFeature(manifests)).UsingClient(cli).Apply(ctx)
+	}
+
+	It("should remove the canary namespace without running cleanups of the canary once changes are rolled out", func(ctx context.Context) {
+		// when
+		Expect(upgrade(ctx, gatewayConfig)).To(Succeed())
+
+		// then
+		Expect(probed).To(Equal([]string{canaryNamespace}))
+		Expect(deleted).To(BeEmpty())
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "gateway-config", Namespace: "opendatahub"}, &corev1.ConfigMap{})).To(Succeed())
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: canaryNamespace}, &corev1.Namespace{}))).To(BeTrue())
+	})
+
+	It("should keep the canary namespace which has not been created for canaries", func(ctx context.Context) {
+		// given
+		existing := &corev1.Namespace{}
+		existing.Name = canaryNamespace
+		Expect(cli.Create(ctx, existing)).To(Succeed())
+
+		// when
+		Expect(upgrade(ctx, gatewayConfig)).To(Succeed())
+
+		// then
+		Expect(probed).To(Equal([]string{canaryNamespace}))
+		namespace := &corev1.Namespace{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: canaryNamespace}, namespace)).To(Succeed())
+		Expect(namespace.GetLabels()).ToNot(HaveKey(labels.CanaryNamespace))
+	})
+
+	It("should apply changes directly when the feature has resources outside of its target namespace", func(ctx context.Context) {
+		// when
+		Expect(upgrade(ctx, controlPlaneConfig)).To(Succeed())
+
+		// then
+		Expect(probed).To(BeEmpty())
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: canaryNamespace}, &corev1.Namespace{}))).To(BeTrue())
+		tracker := featurev1.NewFeatureTracker("canary-gateway", "opendatahub")
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).To(Succeed())
+		Expect(tracker.Status.OperatorVersion).To(Equal("2.10.0"))
+	})
+})
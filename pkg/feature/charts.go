@@ -0,0 +1,32 @@
+package feature
+
+import (
+	"reflect"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+)
+
+// recordAppliedCharts stages names and versions of Helm charts the resources of the feature have been rendered from
+// for the FeatureTracker status, so that upgrades of the charts can be followed.
+func (f *Feature) recordAppliedCharts() {
+	var charts []featurev1.AppliedChart
+	for _, applier := range f.appliers {
+		chartSource, isChart := applier.(resource.ChartSource)
+		if !isChart {
+			continue
+		}
+
+		if name, version, source, ok := chartSource.AppliedChart(); ok {
+			charts = append(charts, featurev1.AppliedChart{Name: name, Version: version, Source: source})
+		}
+	}
+
+	if reflect.DeepEqual(charts, f.tracker.Status.Charts) {
+		return
+	}
+
+	f.stageStatus(func(saved *featurev1.FeatureTracker) {
+		saved.Status.Charts = charts
+	})
+}
@@ -0,0 +1,93 @@
+package feature_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cleanup of resources by reference", func() {
+
+	newScheme := func() *runtime.Scheme {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+
+		return scheme
+	}
+
+	configMap := func(name string, configMapLabels map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "opendatahub", Labels: configMapLabels}}
+	}
+
+	reference := func(name string) resource.Reference {
+		return resource.Reference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "opendatahub", Name: name}
+	}
+
+	It("should delete referenced resources when the feature is removed", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().
+			WithScheme(newScheme()).
+			WithObjects(
+				configMap("service-mesh-refs", nil),
+				configMap("auth-refs", map[string]string{labels.ODH.Feature: "mesh-shared-configmap"}),
+				configMap("unrelated", nil),
+			).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			Build()
+
+		f, err := feature.Define("mesh-shared-configmap").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			CleanupResources(reference("service-mesh-refs"), reference("auth-refs")).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Cleanup(ctx)).To(Succeed())
+
+		// then
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Namespace: "opendatahub", Name: "service-mesh-refs"}, &corev1.ConfigMap{}))).To(BeTrue())
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Namespace: "opendatahub", Name: "auth-refs"}, &corev1.ConfigMap{}))).To(BeTrue())
+		Expect(cli.Get(ctx, client.ObjectKey{Namespace: "opendatahub", Name: "unrelated"}, &corev1.ConfigMap{})).To(Succeed())
+	})
+
+	It("should skip resources which have already been deleted or belong to another feature", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().
+			WithScheme(newScheme()).
+			WithObjects(configMap("auth-refs", map[string]string{labels.ODH.Feature: "another-feature"})).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			Build()
+
+		f, err := feature.Define("mesh-shared-configmap").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			CleanupResources(reference("service-mesh-refs"), reference("auth-refs")).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Cleanup(ctx)).To(Succeed())
+
+		// then
+		Expect(cli.Get(ctx, client.ObjectKey{Namespace: "opendatahub", Name: "auth-refs"}, &corev1.ConfigMap{})).To(Succeed())
+	})
+})
@@ -0,0 +1,171 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// collectClusterResource is a meta option collecting cluster-scoped resources applied from the manifests of the feature, so that
+// they are deleted explicitly when the feature is removed. Cluster-scoped resources without declared on-delete policy get the one
+// set by ClusterResourcesOnDelete. Kinds not known to the cluster yet are left to the garbage collector. Collected resources are
+// only candidates, as the option runs before the resource is applied, see recordAppliedClusterResources.
+func (f *Feature) collectClusterResource(obj metav1.Object) error {
+	source, isUnstructured := obj.(*unstructured.Unstructured)
+	if !isUnstructured || source.GetNamespace() != "" {
+		return nil
+	}
+
+	namespaced, errScope := f.Client.IsObjectNamespaced(source)
+	if errScope != nil {
+		if meta.IsNoMatchError(errScope) {
+			return nil
+		}
+
+		return fmt.Errorf("failed determining scope of %s: %w", resource.ReferenceOf(source), errScope)
+	}
+	if namespaced {
+		return nil
+	}
+
+	if f.clusterResourcesOnDelete != "" {
+		source.SetAnnotations(mergeMissing(source.GetAnnotations(), map[string]string{annotations.OnDelete: string(f.clusterResourcesOnDelete)}))
+	}
+
+	policy, errPolicy := resource.OnDeletePolicyOf(source, false)
+	if errPolicy != nil {
+		return errPolicy
+	}
+	if policy == resource.OnDeleteOrphan {
+		return nil
+	}
+
+	f.appliedClusterResources.Insert(featurev1.TrackedResource{APIVersion: source.GetAPIVersion(), Kind: source.GetKind(), Name: source.GetName()})
+
+	return nil
+}
+
+// recordAppliedClusterResources stages cluster-scoped resources created or adopted by the feature for the FeatureTracker status.
+// Existing resources skipped by the adoption policy, e.g. a ConsoleLink created by the user, are left out, so that they are not
+// deleted together with the feature. Resources recorded by previous apply passes are kept, so that resources no longer rendered
+// by the feature are still deleted together with it.
+func (f *Feature) recordAppliedClusterResources(ctx context.Context) error {
+	all := sets.New(f.tracker.Status.ClusterResources...)
+	for _, applied := range f.appliedClusterResources.UnsortedList() {
+		if all.Has(applied) {
+			continue
+		}
+
+		existing, errGet := getClusterResource(ctx, f.Client, applied)
+		if errGet != nil {
+			return fmt.Errorf("failed getting %s %s applied by feature %s: %w", applied.Kind, applied.Name, f.Name, errGet)
+		}
+		if existing != nil && createdByFeature(existing, f.Name, f.tracker) {
+			all.Insert(applied)
+		}
+	}
+
+	tracked := all.UnsortedList()
+	sort.Slice(tracked, func(i, j int) bool {
+		if tracked[i].Kind != tracked[j].Kind {
+			return tracked[i].Kind < tracked[j].Kind
+		}
+
+		return tracked[i].Name < tracked[j].Name
+	})
+
+	if len(tracked) == 0 || reflect.DeepEqual(tracked, f.tracker.Status.ClusterResources) {
+		return nil
+	}
+
+	f.stageStatus(func(saved *featurev1.FeatureTracker) {
+		saved.Status.ClusterResources = tracked
+	})
+
+	return nil
+}
+
+// createdByFeature checks if the existing resource has been created or adopted by the feature, i.e. it is labeled with the feature,
+// or, when it is not labeled with any feature, owned by its FeatureTracker.
+func createdByFeature(existing *unstructured.Unstructured, featureName string, tracker *featurev1.FeatureTracker) bool {
+	if owner := existing.GetLabels()[labels.ODH.Feature]; owner != "" {
+		return owner == featureName
+	}
+
+	return tracker != nil && slices.ContainsFunc(existing.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+		return ref.UID == tracker.UID
+	})
+}
+
+// getClusterResource gets the tracked resource, nil when it does not exist or its kind is no longer served.
+func getClusterResource(ctx context.Context, cli client.Client, tracked featurev1.TrackedResource) (*unstructured.Unstructured, error) {
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion(tracked.APIVersion)
+	existing.SetKind(tracked.Kind)
+	if err := cli.Get(ctx, client.ObjectKey{Name: tracked.Name}, existing); err != nil {
+		if k8serr.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil, nil //nolint:nilnil // Reason: missing resource is not an error
+		}
+
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+// deleteClusterResources deletes cluster-scoped resources recorded in the FeatureTracker status, see recordAppliedClusterResources.
+// FeatureTracker is cluster-scoped, so the garbage collector removes resources owned by it as well. Deleting them explicitly covers
+// resources which no longer reference the tracker as their owner, e.g. restored by tools which drop owner references, and completes
+// their removal together with the feature, instead of leaving it to the garbage collector in the background. Resources which have not
+// been created by the feature, have been labeled with another feature since, or declare the orphan on-delete policy, are left intact,
+// as are namespaces orphaned according to the namespace deletion policy.
+func deleteClusterResources(f *Feature) CleanupFunc {
+	return func(ctx context.Context, cli client.Client) error {
+		tracker, errGet := getFeatureTracker(ctx, cli, f.Name, f.TargetNamespace)
+		if errGet != nil {
+			return client.IgnoreNotFound(errGet)
+		}
+
+		var multiErr *multierror.Error
+		for _, tracked := range tracker.Status.ClusterResources {
+			if tracked.APIVersion == "v1" && tracked.Kind == "Namespace" && namespacePropagationOf(f.namespaceDeletion) == dsciv1.OrphanNamespacePropagation {
+				continue
+			}
+
+			existing, errGetResource := getClusterResource(ctx, cli, tracked)
+			if errGetResource != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("failed getting %s %s to delete: %w", tracked.Kind, tracked.Name, errGetResource))
+
+				continue
+			}
+			if existing == nil || !createdByFeature(existing, f.Name, tracker) {
+				continue
+			}
+			if existing.GetAnnotations()[annotations.OnDelete] == string(resource.OnDeleteOrphan) {
+				continue
+			}
+
+			if err := cli.Delete(ctx, existing); client.IgnoreNotFound(err) != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("failed deleting %s %s: %w", tracked.Kind, tracked.Name, err))
+			}
+		}
+
+		return multiErr.ErrorOrNil()
+	}
+}
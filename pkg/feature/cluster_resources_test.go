@@ -0,0 +1,195 @@
+package feature_test
+
+import (
+	"context"
+	"testing/fstest"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cluster-scoped resources of the feature", func() {
+
+	const (
+		featureName = "mesh-control-plane-external-authz"
+		clusterRole = `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: authorino-token-reviewer
+rules:
+- apiGroups: ["authentication.k8s.io"]
+  resources: ["tokenreviews"]
+  verbs: ["create"]
+`
+		authNamespace = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: opendatahub-auth-provider
+`
+	)
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		utilruntime.Must(rbacv1.AddToScheme(scheme))
+
+		mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{featurev1.GroupVersion, rbacv1.SchemeGroupVersion, corev1.SchemeGroupVersion})
+		mapper.Add(featurev1.GroupVersion.WithKind("FeatureTracker"), meta.RESTScopeRoot)
+		mapper.Add(rbacv1.SchemeGroupVersion.WithKind("ClusterRole"), meta.RESTScopeRoot)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithRESTMapper(mapper).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			Build()
+	})
+
+	createFeature := func(manifestContent string, policy resource.OnDeletePolicy) *feature.Feature {
+		builder := feature.Define(featureName).
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(fstest.MapFS{"authorino/cluster-role.yaml": {Data: []byte(manifestContent)}}).Include("authorino"))
+		if policy != "" {
+			builder = builder.ClusterResourcesOnDelete(policy)
+		}
+
+		f, err := builder.Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		return f
+	}
+
+	getTracker := func(ctx context.Context) *featurev1.FeatureTracker {
+		tracker := featurev1.NewFeatureTracker(featureName, "opendatahub")
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).To(Succeed())
+
+		return tracker
+	}
+
+	clusterRoleExists := func(ctx context.Context) bool {
+		err := cli.Get(ctx, client.ObjectKey{Name: "authorino-token-reviewer"}, &rbacv1.ClusterRole{})
+		Expect(client.IgnoreNotFound(err)).ToNot(HaveOccurred())
+
+		return !k8serr.IsNotFound(err)
+	}
+
+	It("should track cluster-scoped resources and delete them when the feature is removed", func(ctx context.Context) {
+		// given
+		f := createFeature(clusterRole, "")
+		Expect(f.Apply(ctx)).To(Succeed())
+		Expect(getTracker(ctx).Status.ClusterResources).To(ConsistOf(featurev1.TrackedResource{
+			APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "authorino-token-reviewer",
+		}))
+
+		// when
+		Expect(f.Cleanup(ctx)).To(Succeed())
+
+		// then
+		Expect(clusterRoleExists(ctx)).To(BeFalse())
+	})
+
+	It("should leave cluster-scoped resources intact when the feature orphans them", func(ctx context.Context) {
+		// given
+		f := createFeature(clusterRole, resource.OnDeleteOrphan)
+		Expect(f.Apply(ctx)).To(Succeed())
+		Expect(getTracker(ctx).Status.ClusterResources).To(BeEmpty())
+
+		// when
+		Expect(f.Cleanup(ctx)).To(Succeed())
+
+		// then
+		Expect(clusterRoleExists(ctx)).To(BeTrue())
+	})
+
+	It("should not delete tracked cluster-scoped resources which have been taken over by another feature", func(ctx context.Context) {
+		// given
+		f := createFeature(clusterRole, "")
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		takenOver := &rbacv1.ClusterRole{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "authorino-token-reviewer"}, takenOver)).To(Succeed())
+		takenOver.Labels[labels.ODH.Feature] = "kserve-external-authz"
+		Expect(cli.Update(ctx, takenOver)).To(Succeed())
+
+		// when
+		Expect(f.Cleanup(ctx)).To(Succeed())
+
+		// then
+		Expect(clusterRoleExists(ctx)).To(BeTrue())
+	})
+
+	It("should neither track nor delete existing cluster-scoped resources the feature has not created", func(ctx context.Context) {
+		// given
+		userClusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "authorino-token-reviewer"}}
+		Expect(cli.Create(ctx, userClusterRole)).To(Succeed())
+
+		f := createFeature(clusterRole, "")
+		Expect(f.Apply(ctx)).To(Succeed())
+		Expect(getTracker(ctx).Status.ClusterResources).To(BeEmpty())
+
+		// when
+		Expect(f.Cleanup(ctx)).To(Succeed())
+
+		// then
+		Expect(clusterRoleExists(ctx)).To(BeTrue())
+	})
+
+	It("should not delete namespaces orphaned according to the namespace deletion policy", func(ctx context.Context) {
+		// given
+		f, err := feature.Define(featureName).
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(fstest.MapFS{"authorino/namespace.yaml": {Data: []byte(authNamespace)}}).Include("authorino")).
+			NamespaceDeletion(&dsciv1.NamespaceDeletion{Propagation: dsciv1.OrphanNamespacePropagation}).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+		Expect(f.Apply(ctx)).To(Succeed())
+		Expect(getTracker(ctx).Status.ClusterResources).To(ConsistOf(featurev1.TrackedResource{
+			APIVersion: "v1", Kind: "Namespace", Name: "opendatahub-auth-provider",
+		}))
+
+		// when
+		Expect(f.Cleanup(ctx)).To(Succeed())
+
+		// then
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "opendatahub-auth-provider"}, &corev1.Namespace{})).To(Succeed())
+	})
+
+	It("should reject on-delete policy which does not apply to created resources", func() {
+		// when
+		_, err := feature.Define(featureName).
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			ClusterResourcesOnDelete(resource.OnDeleteRevertPatch).
+			Create()
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`invalid on-delete policy "revert-patch" of cluster-scoped resources`)))
+	})
+})
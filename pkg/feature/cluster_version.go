@@ -0,0 +1,89 @@
+package feature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// versionRequirement is the range of OpenShift versions the feature supports, see RequiresOpenShift.
+type versionRequirement struct {
+	constraint string
+	supported  semver.Range
+}
+
+// UnsupportedClusterVersionError indicates that the feature relies on APIs which are not served by the version
+// of OpenShift the cluster runs, e.g. Gateway API, so it is not applied.
+type UnsupportedClusterVersionError struct {
+	featureName string
+	constraint  string
+	// Version of OpenShift, nil when the cluster does not run OpenShift.
+	Version *semver.Version
+}
+
+func (e *UnsupportedClusterVersionError) Error() string {
+	if e.Version == nil {
+		return fmt.Sprintf("feature %q requires OpenShift %s, but the cluster does not run OpenShift", e.featureName, e.constraint)
+	}
+
+	return fmt.Sprintf("feature %q requires OpenShift %s, but the cluster runs %s", e.featureName, e.constraint, e.Version)
+}
+
+// IsUnsupportedClusterVersion checks if the error, possibly wrapped, is caused by the feature not supporting the cluster version.
+func IsUnsupportedClusterVersion(err error) bool {
+	var versionErr *UnsupportedClusterVersionError
+
+	return errors.As(err, &versionErr)
+}
+
+// parseVersionRange parses the range of versions, such as ">=4.14" or ">=4.14.0 <4.17.0", see semver.ParseRange.
+// Versions without the patch number, as OpenShift versions are usually referred to, are completed with zero.
+func parseVersionRange(constraint string) (semver.Range, error) {
+	fields := strings.Fields(constraint)
+	for i, field := range fields {
+		if version := strings.TrimLeft(field, "<>=!"); strings.Count(version, ".") == 1 {
+			fields[i] = field + ".0"
+		}
+	}
+
+	return semver.ParseRange(strings.Join(fields, " "))
+}
+
+// ensureClusterVersionSupported fails with UnsupportedClusterVersionError when the feature requires a range of OpenShift versions,
+// which the version of the cluster is not in.
+func (f *Feature) ensureClusterVersionSupported(ctx context.Context) error {
+	if f.requiredOpenShift == nil {
+		return nil
+	}
+
+	version, err := cluster.GetOCPVersion(ctx, f.Client)
+	switch {
+	case err == nil:
+		if f.requiredOpenShift.supported(release(version)) {
+			return nil
+		}
+
+		return &UnsupportedClusterVersionError{featureName: f.Name, constraint: f.requiredOpenShift.constraint, Version: &version}
+	case k8serr.IsNotFound(err) || meta.IsNoMatchError(err):
+		return &UnsupportedClusterVersionError{featureName: f.Name, constraint: f.requiredOpenShift.constraint}
+	default:
+		return err
+	}
+}
+
+// release returns the version without its pre-release and build parts, so that release candidates and nightly builds,
+// e.g. 4.14.0-rc.3 or 4.14.0-0.nightly-2023-09-01-123456, satisfy the ranges of the release they precede, which semver
+// would otherwise order before it.
+func release(version semver.Version) semver.Version {
+	version.Pre = nil
+	version.Build = nil
+
+	return version
+}
@@ -0,0 +1,107 @@
+package feature_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cluster version requirements", func() {
+
+	newClient := func(ocpVersion string) client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		builder := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{})
+
+		if ocpVersion != "" {
+			clusterVersion := &unstructured.Unstructured{}
+			clusterVersion.SetGroupVersionKind(gvk.ClusterVersion)
+			clusterVersion.SetName("version")
+			Expect(unstructured.SetNestedField(clusterVersion.Object, ocpVersion, "status", "desired", "version")).To(Succeed())
+			builder = builder.WithObjects(clusterVersion)
+		}
+
+		return builder.Build()
+	}
+
+	defineFeature := func(cli client.Client, constraint string, applied *bool) *feature.Feature {
+		f, err := feature.Define("console-plugin").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			RequiresOpenShift(constraint).
+			PreConditions(func(_ context.Context, _ *feature.Feature) error {
+				*applied = true
+
+				return nil
+			}).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		return f
+	}
+
+	DescribeTable("applying feature depending on the version of OpenShift",
+		func(ctx context.Context, ocpVersion, constraint string, supported bool) {
+			// given
+			applied := false
+			f := defineFeature(newClient(ocpVersion), constraint, &applied)
+
+			// when
+			err := f.Apply(ctx)
+
+			// then
+			Expect(feature.IsUnsupportedClusterVersion(err)).To(Equal(!supported))
+			Expect(applied).To(Equal(supported))
+		},
+		Entry("should apply when the version is in the range", "4.15.3", ">=4.14", true),
+		Entry("should apply when the version is at the lower bound", "4.14.0", ">=4.14 <4.17", true),
+		Entry("should apply when the version is a release candidate of the lower bound", "4.14.0-rc.3", ">=4.14", true),
+		Entry("should apply when the version is a nightly build of the lower bound", "4.14.0-0.nightly-2023-09-01-123456", ">=4.14", true),
+		Entry("should refuse when the version is older", "4.13.9", ">=4.14", false),
+		Entry("should refuse when the version is past the upper bound", "4.17.1", ">=4.14 <4.17", false),
+		Entry("should refuse when the cluster does not run OpenShift", "", ">=4.14", false),
+	)
+
+	It("should report unsupported version in FeatureTracker", func(ctx context.Context) {
+		// given
+		cli := newClient("4.12.40")
+		f := defineFeature(cli, ">=4.14", new(bool))
+
+		// when
+		err := f.Apply(ctx)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`feature "console-plugin" requires OpenShift >=4.14, but the cluster runs 4.12.40`)))
+
+		tracker := featurev1.NewFeatureTracker(f.Name, f.TargetNamespace)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).To(Succeed())
+		Expect(tracker.Status.Phase).To(Equal(status.PhaseError))
+		Expect(tracker.Status.Conditions).To(ContainElement(HaveField("Reason", string(featurev1.ConditionReason.UnsupportedClusterVersion))))
+	})
+
+	It("should fail creating feature with invalid version range", func() {
+		// when
+		_, err := feature.Define("console-plugin").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			RequiresOpenShift("at least 4.14").
+			Create()
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`invalid OpenShift version range "at least 4.14"`)))
+	})
+})
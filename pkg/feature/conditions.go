@@ -5,13 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 )
 
 const (
@@ -31,6 +29,11 @@ func NewMissingOperatorError(operatorName string, err error) *MissingOperatorErr
 	}
 }
 
+// OperatorName returns the name of the package of the missing operator, e.g. "servicemeshoperator".
+func (e *MissingOperatorError) OperatorName() string {
+	return e.operatorName
+}
+
 func (e *MissingOperatorError) Unwrap() error {
 	return e.err
 }
@@ -41,7 +44,7 @@ func (e *MissingOperatorError) Error() string {
 
 func EnsureOperatorIsInstalled(operatorName string) Action {
 	return func(ctx context.Context, f *Feature) error {
-		if found, err := cluster.SubscriptionExists(ctx, f.Client, operatorName); !found || err != nil {
+		if found, err := f.subscriptions.Exists(ctx, f.Client, operatorName); !found || err != nil {
 			return fmt.Errorf(
 				"failed to find the pre-requisite operator subscription %q, please ensure operator is installed. %w",
 				operatorName,
@@ -54,9 +57,9 @@ func EnsureOperatorIsInstalled(operatorName string) Action {
 
 func WaitForPodsToBeReady(namespace string) Action {
 	return func(ctx context.Context, f *Feature) error {
-		f.Log.Info("waiting for pods to become ready", "namespace", namespace, "duration (s)", duration.Seconds())
+		f.Log.Info("waiting for pods to become ready", "namespace", namespace, "duration (s)", f.Poller().Timeout().Seconds())
 
-		return wait.PollUntilContextTimeout(ctx, interval, duration, false, func(ctx context.Context) (bool, error) {
+		return f.Poller().Poll(ctx, false, func(ctx context.Context) (bool, error) {
 			var podList corev1.PodList
 
 			err := f.Client.List(ctx, &podList, client.InNamespace(namespace))
@@ -102,11 +105,46 @@ func WaitForPodsToBeReady(namespace string) Action {
 	}
 }
 
+// WaitForHPAActive waits until the HorizontalPodAutoscaler is able to scale its target, i.e. the target exists
+// and its metrics can be fetched, as reported by the ScalingActive condition.
+func WaitForHPAActive(namespace, name string) Action {
+	return func(ctx context.Context, f *Feature) error {
+		f.Log.Info("waiting for autoscaler to become active", "namespace", namespace, "name", name, "duration (s)", f.Poller().Timeout().Seconds())
+
+		var lastMessage string
+		err := f.Poller().Poll(ctx, false, func(ctx context.Context) (bool, error) {
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+			if err := f.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, hpa); err != nil {
+				return false, client.IgnoreNotFound(err)
+			}
+
+			for _, condition := range hpa.Status.Conditions {
+				if condition.Type != autoscalingv2.ScalingActive {
+					continue
+				}
+				lastMessage = condition.Message
+				if condition.Status == corev1.ConditionTrue {
+					f.Log.Info("autoscaler active", "namespace", namespace, "name", name)
+
+					return true, nil
+				}
+			}
+
+			return false, nil
+		})
+		if err != nil && lastMessage != "" {
+			return fmt.Errorf("autoscaler %s/%s is not active: %s: %w", namespace, name, lastMessage, err)
+		}
+
+		return err
+	}
+}
+
 func WaitForResourceToBeCreated(namespace string, gvk schema.GroupVersionKind) Action {
 	return func(ctx context.Context, f *Feature) error {
 		f.Log.Info("waiting for resource to be created", "namespace", namespace, "resource", gvk)
 
-		return wait.PollUntilContextTimeout(ctx, interval, duration, false, func(ctx context.Context) (bool, error) {
+		return f.Poller().Poll(ctx, false, func(ctx context.Context) (bool, error) {
 			list := &unstructured.UnstructuredList{}
 			list.SetGroupVersionKind(gvk)
 
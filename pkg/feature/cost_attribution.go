@@ -0,0 +1,140 @@
+package feature
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// Keys of the cost-attribution labels used when DSCI spec.metadataDefaults.costAttribution does not define them.
+const (
+	DefaultTeamLabel       = "team"
+	DefaultProductLabel    = "product"
+	DefaultCapabilityLabel = "capability"
+
+	// omittedLabel as the key of the capability label leaves the capability out.
+	omittedLabel = "-"
+)
+
+// podTemplatePaths hold paths to the metadata of pods created by workload kinds, so that pods carry cost-attribution labels,
+// which chargeback tools read, as well.
+var podTemplatePaths = map[string][]string{ //nolint:gochecknoglobals // Reason: read-only lookup of workload kinds
+	"Deployment":  {"spec", "template", "metadata", "labels"},
+	"StatefulSet": {"spec", "template", "metadata", "labels"},
+	"DaemonSet":   {"spec", "template", "metadata", "labels"},
+	"ReplicaSet":  {"spec", "template", "metadata", "labels"},
+	"Job":         {"spec", "template", "metadata", "labels"},
+	"CronJob":     {"spec", "jobTemplate", "spec", "template", "metadata", "labels"},
+	"Pod":         nil,
+}
+
+// ForCapability makes the features added by the provider part of the platform capability, e.g. "service-mesh",
+// which is then set in their cost-attribution labels.
+func ForCapability(capability string, provider FeaturesProvider) FeaturesProvider {
+	return func(registry FeaturesRegistry) error {
+		return provider(&capabilityRegistry{FeaturesRegistry: registry, capability: capability})
+	}
+}
+
+type capabilityRegistry struct {
+	FeaturesRegistry
+	capability string
+}
+
+func (r *capabilityRegistry) Add(builders ...*featureBuilder) error {
+	for _, fb := range builders {
+		fb.withCapability(r.capability)
+	}
+
+	return r.FeaturesRegistry.Add(builders...)
+}
+
+func (r *capabilityRegistry) AddGroup(groups ...*FeatureGroup) error {
+	for _, group := range groups {
+		for _, fb := range group.builders {
+			fb.withCapability(r.capability)
+		}
+	}
+
+	return r.FeaturesRegistry.AddGroup(groups...)
+}
+
+// CostAttributionOf returns cost-attribution labels defined in DSCI spec.metadataDefaults, nil when they are not defined.
+func CostAttributionOf(defaults *dsciv1.MetadataDefaults) *dsciv1.CostAttribution {
+	if defaults == nil {
+		return nil
+	}
+
+	return defaults.CostAttribution
+}
+
+// costAttributionLabels returns the labels attributing the cost of the feature resources, without the ones having empty values.
+func costAttributionLabels(attribution *dsciv1.CostAttribution, capability string) map[string]string {
+	keyOrDefault := func(key, defaultKey string) string {
+		if key == "" {
+			return defaultKey
+		}
+
+		return key
+	}
+
+	costLabels := map[string]string{}
+	if attribution.Team != "" {
+		costLabels[keyOrDefault(attribution.TeamLabel, DefaultTeamLabel)] = attribution.Team
+	}
+	if attribution.Product != "" {
+		costLabels[keyOrDefault(attribution.ProductLabel, DefaultProductLabel)] = attribution.Product
+	}
+	if capabilityLabel := keyOrDefault(attribution.CapabilityLabel, DefaultCapabilityLabel); capability != "" && capabilityLabel != omittedLabel {
+		costLabels[capabilityLabel] = capability
+	}
+
+	return costLabels
+}
+
+// WithCostAttribution returns a cluster.MetaOptions that adds labels defined in DSCI spec.metadataDefaults.costAttribution to
+// namespaces and workloads, including their pod templates. Other resources are left intact. Labels already set on the resource,
+// e.g. by its manifest or WithFeatureLabels, take precedence.
+func WithCostAttribution(f *Feature) cluster.MetaOptions {
+	return func(obj metav1.Object) error {
+		if f.costAttribution == nil {
+			return nil
+		}
+
+		costLabels := costAttributionLabels(f.costAttribution, f.capability)
+		if len(costLabels) == 0 {
+			return nil
+		}
+
+		if _, isNamespace := obj.(*corev1.Namespace); isNamespace {
+			obj.SetLabels(mergeMissing(obj.GetLabels(), costLabels))
+
+			return nil
+		}
+
+		u, isUnstructured := obj.(*unstructured.Unstructured)
+		if !isUnstructured {
+			return nil
+		}
+
+		podTemplatePath, isWorkload := podTemplatePaths[u.GetKind()]
+		if !isWorkload && u.GetKind() != "Namespace" {
+			return nil
+		}
+
+		u.SetLabels(mergeMissing(u.GetLabels(), costLabels))
+		if len(podTemplatePath) == 0 {
+			return nil
+		}
+
+		podLabels, _, err := unstructured.NestedStringMap(u.Object, podTemplatePath...)
+		if err != nil {
+			return err
+		}
+
+		return unstructured.SetNestedStringMap(u.Object, mergeMissing(podLabels, costLabels), podTemplatePath...)
+	}
+}
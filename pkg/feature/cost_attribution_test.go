@@ -0,0 +1,119 @@
+package feature_test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cost attribution", func() {
+
+	attribution := &dsciv1.CostAttribution{Team: "ml-platform", Product: "opendatahub"}
+
+	createFeature := func(attribution *dsciv1.CostAttribution) *feature.Feature {
+		f, err := feature.Define("mesh-metrics-collection").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			CostAttribution(attribution).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+
+		return f
+	}
+
+	deployment := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "prometheus", "labels": map[string]any{"team": "monitoring"}},
+			"spec": map[string]any{
+				"template": map[string]any{"metadata": map[string]any{"labels": map[string]any{"app": "prometheus"}}},
+			},
+		}}
+	}
+
+	It("should label workloads and their pod templates without overriding labels set by the manifest", func() {
+		// given
+		f := createFeature(attribution)
+		workload := deployment()
+
+		// when
+		err := cluster.ApplyMetaOptions(workload, feature.WithCostAttribution(f))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(workload.GetLabels()).To(Equal(map[string]string{"team": "monitoring", "product": "opendatahub"}))
+		podLabels, _, _ := unstructured.NestedStringMap(workload.Object, "spec", "template", "metadata", "labels")
+		Expect(podLabels).To(Equal(map[string]string{"app": "prometheus", "team": "ml-platform", "product": "opendatahub"}))
+	})
+
+	It("should label namespaces and leave other resources intact", func() {
+		// given
+		f := createFeature(attribution)
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-auth-provider"}}
+		configMap := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": "mesh-config"},
+		}}
+
+		// when
+		Expect(cluster.ApplyMetaOptions(namespace, feature.WithCostAttribution(f))).To(Succeed())
+		Expect(cluster.ApplyMetaOptions(configMap, feature.WithCostAttribution(f))).To(Succeed())
+
+		// then
+		Expect(namespace.Labels).To(Equal(map[string]string{"team": "ml-platform", "product": "opendatahub"}))
+		Expect(configMap.GetLabels()).To(BeEmpty())
+	})
+
+	It("should use configured label keys and attribute features to their capability", func() {
+		// given
+		builder := feature.Define("mesh-metrics-collection").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub")
+		handler := feature.ComponentFeaturesHandler("kserve", "opendatahub", nil).
+			WithMetadataDefaults(&dsciv1.MetadataDefaults{CostAttribution: &dsciv1.CostAttribution{
+				Team:            "ml-platform",
+				TeamLabel:       "kubecost.com/team",
+				CapabilityLabel: "opendatahub.io/capability",
+			}})
+
+		provider := feature.ForCapability("service-mesh", func(registry feature.FeaturesRegistry) error {
+			return registry.Add(builder)
+		})
+
+		// when
+		Expect(provider(handler)).To(Succeed())
+		f, err := builder.Create()
+		Expect(err).ToNot(HaveOccurred())
+		workload := deployment()
+		Expect(cluster.ApplyMetaOptions(workload, feature.WithCostAttribution(f))).To(Succeed())
+
+		// then
+		Expect(workload.GetLabels()).To(Equal(map[string]string{
+			"team":                      "monitoring",
+			"kubecost.com/team":         "ml-platform",
+			"opendatahub.io/capability": "service-mesh",
+		}))
+	})
+
+	It("should leave the capability out when its label is omitted", func() {
+		// given
+		f := createFeature(&dsciv1.CostAttribution{Product: "opendatahub", CapabilityLabel: "-"})
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-auth-provider"}}
+
+		// when
+		Expect(cluster.ApplyMetaOptions(namespace, feature.WithCostAttribution(f))).To(Succeed())
+
+		// then
+		Expect(namespace.Labels).To(Equal(map[string]string{"product": "opendatahub"}))
+	})
+})
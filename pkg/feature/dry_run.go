@@ -0,0 +1,132 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+)
+
+// DryRun runs the feature without changing the cluster and returns references to the resources it creates or updates, sorted
+// and without duplicates. Preconditions and Go functions, e.g. those passed to WithResources, are run against the dry-run client,
+// which records what they write, and resources of manifests, Helm charts and patches are rendered, see Feature.Render.
+// Failures of preconditions and Go functions, e.g. when the namespace they write to does not exist yet, are only logged,
+// as resources they have attempted to write are reported nonetheless.
+func (f *Feature) DryRun(ctx context.Context) ([]resource.Reference, error) {
+	recorder := &recordingClient{Client: client.NewDryRunClient(f.Client), written: map[resource.Reference]struct{}{}}
+	restore, errDryRun := f.useDryRun(ctx, recorder)
+	defer restore()
+	if errDryRun != nil {
+		return nil, errDryRun
+	}
+
+	var multiErr *multierror.Error
+	for _, dataProvider := range f.dataProviders {
+		multiErr = multierror.Append(multiErr, dataProvider(ctx, f))
+	}
+	if errDataLoad := multiErr.ErrorOrNil(); errDataLoad != nil {
+		return nil, fmt.Errorf("failed loading data of feature %s: %w", f.Name, errDataLoad)
+	}
+
+	for _, precondition := range f.preconditions {
+		multiErr = multierror.Append(multiErr, precondition(ctx, f))
+	}
+	for _, clusterOperation := range f.clusterOperations {
+		multiErr = multierror.Append(multiErr, clusterOperation(ctx, f))
+	}
+	if errRun := multiErr.ErrorOrNil(); errRun != nil {
+		f.Log.V(1).Info("dry run of feature failed, reporting resources attempted to write", "feature", f.Name, "error", errRun.Error())
+	}
+
+	rendered, errRender := f.renderLoaded()
+	if errRender != nil {
+		return nil, errRender
+	}
+	for _, obj := range rendered {
+		recorder.written[resource.ReferenceOf(obj)] = struct{}{}
+	}
+
+	references := make([]resource.Reference, 0, len(recorder.written))
+	for reference := range recorder.written {
+		references = append(references, reference)
+	}
+	sort.Slice(references, func(i, j int) bool {
+		return references[i].String() < references[j].String()
+	})
+
+	return references, nil
+}
+
+// DryRun returns references to the resources of the features enabled for the current configuration, see Feature.DryRun.
+// Features read the cluster to load their data and check if they are enabled, writes are only sent as dry run.
+func (fh *FeaturesHandler) DryRun(ctx context.Context) ([]resource.Reference, error) {
+	fh.features = make([]*Feature, 0)
+
+	for _, featuresProvider := range fh.featuresProviders {
+		if err := featuresProvider(fh); err != nil {
+			return nil, fmt.Errorf("failed adding features to the handler. cause: %w", err)
+		}
+	}
+
+	features, errOrder := inDependencyOrder(fh.features)
+	if errOrder != nil {
+		return nil, errOrder
+	}
+
+	var references []resource.Reference
+	for _, f := range features {
+		enabled, err := f.Enabled(ctx, f)
+		if err != nil {
+			return nil, fmt.Errorf("failed checking if feature %s is enabled: %w", f.Name, err)
+		}
+		if !enabled {
+			continue
+		}
+
+		featureReferences, err := f.DryRun(ctx)
+		if err != nil {
+			return nil, err
+		}
+		references = append(references, featureReferences...)
+	}
+
+	return references, nil
+}
+
+// recordingClient records resources created, updated or patched through it before passing the request on, so that writes
+// rejected by the dry run are recorded as well.
+type recordingClient struct {
+	client.Client
+	written map[resource.Reference]struct{}
+}
+
+func (c *recordingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	c.record(obj)
+
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *recordingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	c.record(obj)
+
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *recordingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	c.record(obj)
+
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *recordingClient) record(obj client.Object) {
+	reference := resource.Reference{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	if gvk, err := apiutil.GVKForObject(obj, c.Scheme()); err == nil {
+		reference.APIVersion, reference.Kind = gvk.GroupVersion().String(), gvk.Kind
+	}
+	c.written[reference] = struct{}{}
+}
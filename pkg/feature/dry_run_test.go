@@ -0,0 +1,85 @@
+package feature_test
+
+import (
+	"context"
+	"testing/fstest"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dry run of features", func() {
+
+	const meshRefs = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: service-mesh-refs
+  namespace: {{ .TargetNamespace }}
+`
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().WithScheme(scheme).Build()
+	})
+
+	createAuthRefs := func(ctx context.Context, f *feature.Feature) error {
+		return f.Client.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "auth-refs", Namespace: f.TargetNamespace}})
+	}
+
+	disabled := func(_ context.Context, _ *feature.Feature) (bool, error) {
+		return false, nil
+	}
+
+	It("should report resources written by enabled features and defined by their manifests without applying them", func(ctx context.Context) {
+		// given
+		templates := fstest.MapFS{"mesh/mesh-refs.tmpl.yaml": {Data: []byte(meshRefs)}}
+		handler := feature.ComponentFeaturesHandler("kserve", "opendatahub", func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("mesh-refs").
+					PreConditions(feature.CreateNamespaceIfNotExists("istio-system")).
+					WithResources(createAuthRefs).
+					Manifests(manifest.Location(templates).Include("mesh")),
+				feature.Define("disabled-mesh-refs").
+					EnabledWhen(disabled).
+					PreConditions(feature.CreateNamespaceIfNotExists("disabled-system")),
+			)
+		}).UsingClient(cli)
+
+		// when
+		references, err := handler.DryRun(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(references).To(ConsistOf(
+			resource.Reference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "opendatahub", Name: "auth-refs"},
+			resource.Reference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "opendatahub", Name: "service-mesh-refs"},
+			resource.Reference{APIVersion: "v1", Kind: "Namespace", Name: "istio-system"},
+		))
+
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: "istio-system"}, &corev1.Namespace{}))).
+			To(BeTrue(), "namespace should only be created as dry run")
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: "auth-refs", Namespace: "opendatahub"}, &corev1.ConfigMap{}))).
+			To(BeTrue(), "resources should only be created as dry run")
+		trackers := &featurev1.FeatureTrackerList{}
+		Expect(cli.List(ctx, trackers)).To(Succeed())
+		Expect(trackers.Items).To(BeEmpty())
+	})
+})
@@ -0,0 +1,143 @@
+package feature
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const endpointRequestTimeout = 5 * time.Second
+
+// EndpointTLS defines how the server certificate of the probed endpoint is verified.
+// Zero value verifies it using the system CA certificates.
+type EndpointTLS struct {
+	// InsecureSkipVerify disables verification of the server certificate, e.g. for self-signed certificates.
+	InsecureSkipVerify bool
+	// ServerName overrides the host name the certificate is verified for, e.g. when probing a Service behind a Route.
+	ServerName string
+	// CABundle refers to the ConfigMap key holding PEM encoded CA certificates trusted in addition to the system ones,
+	// e.g. the service CA injected by OpenShift.
+	CABundle *CABundleRef
+}
+
+// CABundleRef refers to the key of the ConfigMap holding PEM encoded CA certificates.
+type CABundleRef struct {
+	Namespace string
+	Name      string
+	Key       string
+}
+
+// WaitForEndpoint waits until the HTTP(S) endpoint responds with the expected status, so that the feature is only reported
+// Ready when the functionality it provides actually responds, not just when its pods are running. The URL is a template
+// rendered using the feature data, e.g. "https://{{ .GatewayHost }}/healthz/ready". The endpoint has to be reachable from the
+// operator pod, which is not a mesh member, so services enforcing mesh mTLS are probed through the Route exposing them.
+func WaitForEndpoint(urlTemplate string, expectStatus int, tlsOptions EndpointTLS) Action {
+	return func(ctx context.Context, f *Feature) error {
+		endpoint, errURL := renderURL(urlTemplate, f.data)
+		if errURL != nil {
+			return fmt.Errorf("failed rendering endpoint URL of feature %s: %w", f.Name, errURL)
+		}
+
+		tlsConfig, errTLS := endpointTLSConfig(ctx, f.Client, tlsOptions)
+		if errTLS != nil {
+			return errTLS
+		}
+
+		httpClient := &http.Client{
+			Timeout:   endpointRequestTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment},
+		}
+
+		f.Log.Info("waiting for endpoint to respond", "url", endpoint, "status", expectStatus, "duration (s)", f.Poller().Timeout().Seconds())
+
+		var lastResult string
+		errWait := f.Poller().Poll(ctx, true, func(ctx context.Context) (bool, error) {
+			status, errProbe := probeEndpoint(ctx, httpClient, endpoint)
+			if errProbe != nil {
+				// endpoint can be unavailable until the functionality is up, e.g. while the route is admitted
+				lastResult = errProbe.Error()
+
+				return false, nil
+			}
+
+			lastResult = fmt.Sprintf("status %d", status)
+
+			return status == expectStatus, nil
+		})
+		if errWait != nil {
+			return fmt.Errorf("endpoint %s did not respond with status %d, last result: %s: %w", endpoint, expectStatus, lastResult, errWait)
+		}
+
+		f.Log.Info("endpoint responded", "url", endpoint, "status", expectStatus)
+
+		return nil
+	}
+}
+
+func renderURL(urlTemplate string, data map[string]any) (string, error) {
+	rendered, err := renderValue(urlTemplate, data)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint, err := url.Parse(rendered)
+	if err != nil {
+		return "", err
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return "", fmt.Errorf("unsupported scheme of endpoint %s, expected http or https", endpoint)
+	}
+
+	return endpoint.String(), nil
+}
+
+func endpointTLSConfig(ctx context.Context, cli client.Client, tlsOptions EndpointTLS) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         tlsOptions.ServerName,
+		InsecureSkipVerify: tlsOptions.InsecureSkipVerify, //nolint:gosec // Reason: explicitly requested by the feature, e.g. for self-signed certificates
+	}
+
+	if tlsOptions.CABundle == nil {
+		return tlsConfig, nil
+	}
+
+	ref := tlsOptions.CABundle
+	caConfigMap := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, caConfigMap); err != nil {
+		return nil, fmt.Errorf("failed getting CA bundle %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM([]byte(caConfigMap.Data[ref.Key])) {
+		return nil, fmt.Errorf("no CA certificates found in key %s of ConfigMap %s/%s", ref.Key, ref.Namespace, ref.Name)
+	}
+	tlsConfig.RootCAs = rootCAs
+
+	return tlsConfig, nil
+}
+
+func probeEndpoint(ctx context.Context, httpClient *http.Client, endpoint string) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
@@ -0,0 +1,98 @@
+package feature_test
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Endpoint post-condition", func() {
+
+	healthServer := func(status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/health" {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+			w.WriteHeader(status)
+		}))
+	}
+
+	It("should succeed when rendered endpoint responds with expected status", func(ctx context.Context) {
+		// given
+		server := healthServer(http.StatusOK)
+		defer server.Close()
+
+		f := &feature.Feature{Name: "authz-health"}
+		Expect(f.Set("AuthProviderURL", server.URL)).To(Succeed())
+
+		// when
+		err := feature.WaitForEndpoint("{{ .AuthProviderURL }}/health", http.StatusOK, feature.EndpointTLS{})(ctx, f)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report last status when endpoint does not respond as expected", func(ctx context.Context) {
+		// given
+		server := healthServer(http.StatusServiceUnavailable)
+		defer server.Close()
+
+		probeCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+		defer cancel()
+
+		// when
+		err := feature.WaitForEndpoint(server.URL+"/health", http.StatusOK, feature.EndpointTLS{})(probeCtx, &feature.Feature{Name: "authz-health"})
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("last result: status 503")))
+	})
+
+	It("should verify server certificate using CA bundle from ConfigMap", func(ctx context.Context) {
+		// given
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		cli := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "service-ca", Namespace: "opendatahub"},
+			Data:       map[string]string{"service-ca.crt": string(caBundle)},
+		}).Build()
+
+		tlsOptions := feature.EndpointTLS{
+			CABundle: &feature.CABundleRef{Namespace: "opendatahub", Name: "service-ca", Key: "service-ca.crt"},
+		}
+
+		// when
+		err := feature.WaitForEndpoint(server.URL, http.StatusOK, tlsOptions)(ctx, &feature.Feature{Name: "gateway-health", Client: cli})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fail without probing when rendered URL is not HTTP(S)", func(ctx context.Context) {
+		// given
+		f := &feature.Feature{Name: "authz-health"}
+		Expect(f.Set("Host", "authorino.opendatahub-auth-provider.svc")).To(Succeed())
+
+		// when
+		err := feature.WaitForEndpoint("grpc://{{ .Host }}:50051", http.StatusOK, feature.EndpointTLS{})(ctx, f)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("unsupported scheme")))
+	})
+})
@@ -0,0 +1,132 @@
+package feature
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"text/template"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+)
+
+// ExportFunc resolves the value the feature publishes once it has been applied.
+type ExportFunc func(ctx context.Context, f *Feature) (string, error)
+
+type export struct {
+	name  string
+	value ExportFunc
+}
+
+// ExportTemplate resolves the exported value by rendering the template using the feature data,
+// e.g. "{{ .AuthProviderName }}-authorino-authorization.{{ .AuthNamespace }}.svc.cluster.local".
+func ExportTemplate(valueTemplate string) ExportFunc {
+	return func(_ context.Context, f *Feature) (string, error) {
+		return renderValue(valueTemplate, f.data)
+	}
+}
+
+// recordExports resolves the values exported by the feature and stages them for the FeatureTracker status, along with the
+// capability the feature is part of. Values exported by the previous apply pass are dropped when the feature exports none.
+// Values which cannot be resolved are only logged, as exports do not affect the feature itself, and the previously resolved
+// ones are kept instead.
+func (f *Feature) recordExports(ctx context.Context) {
+	var exports *featurev1.FeatureExports
+	if len(f.exports) > 0 {
+		exports = &featurev1.FeatureExports{Capability: f.capability, Values: make(map[string]string, len(f.exports))}
+		for _, exported := range f.exports {
+			value, err := exported.value(ctx, f)
+			if err != nil {
+				f.Log.Error(err, "failed resolving exported value", "feature", f.Name, "value", exported.name)
+
+				previous, found := previousExport(f.tracker, exported.name)
+				if !found {
+					continue
+				}
+				value = previous
+			}
+			exports.Values[exported.name] = value
+		}
+	}
+
+	if reflect.DeepEqual(exports, f.tracker.Status.Exports) {
+		return
+	}
+
+	f.stageStatus(func(saved *featurev1.FeatureTracker) {
+		saved.Status.Exports = exports
+	})
+}
+
+func previousExport(tracker *featurev1.FeatureTracker, name string) (string, bool) {
+	if tracker.Status.Exports == nil {
+		return "", false
+	}
+
+	value, found := tracker.Status.Exports.Values[name]
+
+	return value, found
+}
+
+// CapabilityExports aggregates values exported by the features applied for the source, e.g. DSCInitialization, under the capability
+// the features are part of, sorted by its name. Values of features which are not part of any capability are listed under the feature
+// name. Exported values are the ones resolved when the feature has been applied successfully last.
+func CapabilityExports(ctx context.Context, cli client.Reader, source featurev1.Source) ([]dsciv1.CapabilityStatus, error) {
+	trackers := &featurev1.FeatureTrackerList{}
+	if err := cli.List(ctx, trackers); err != nil {
+		return nil, fmt.Errorf("failed listing feature trackers: %w", err)
+	}
+
+	sort.Slice(trackers.Items, func(i, j int) bool {
+		return trackers.Items[i].Name < trackers.Items[j].Name
+	})
+
+	values := map[string]map[string]string{}
+	for i := range trackers.Items {
+		tracker := &trackers.Items[i]
+		exports := tracker.Status.Exports
+		if tracker.Spec.Source != source || exports == nil || len(exports.Values) == 0 {
+			continue
+		}
+
+		capability := exports.Capability
+		if capability == "" {
+			capability = tracker.FeatureName()
+		}
+		if values[capability] == nil {
+			values[capability] = map[string]string{}
+		}
+		for name, value := range exports.Values {
+			values[capability][name] = value
+		}
+	}
+
+	var capabilities []dsciv1.CapabilityStatus
+	for name, capabilityValues := range values {
+		capabilities = append(capabilities, dsciv1.CapabilityStatus{Name: name, Values: capabilityValues})
+	}
+	sort.Slice(capabilities, func(i, j int) bool {
+		return capabilities[i].Name < capabilities[j].Name
+	})
+
+	return capabilities, nil
+}
+
+// renderValue renders the template using the feature data, failing when it refers to missing data.
+func renderValue(valueTemplate string, data map[string]any) (string, error) {
+	tmpl, err := template.New("value").Option("missingkey=error").Parse(valueTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", err
+	}
+
+	return rendered.String(), nil
+}
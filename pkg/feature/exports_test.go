@@ -0,0 +1,130 @@
+package feature_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Exported values", func() {
+
+	var cli client.Client
+
+	dsciSource := featurev1.Source{Type: featurev1.DSCIType, Name: "default-dsci"}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+	})
+
+	tracker := func(name string, source featurev1.Source, exports *featurev1.FeatureExports) *featurev1.FeatureTracker {
+		featureTracker := featurev1.NewFeatureTracker(name, "opendatahub")
+		featureTracker.Spec.Source = source
+		featureTracker.Spec.AppNamespace = "opendatahub"
+		featureTracker.Status.Exports = exports
+
+		return featureTracker
+	}
+
+	It("should record values resolved from feature data in FeatureTracker", func(ctx context.Context) {
+		// given
+		f, err := feature.Define("mesh-gateway").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			WithData(feature.Entry("GatewayNamespace", func(_ context.Context, _ client.Client) (string, error) {
+				return "istio-system", nil
+			})).
+			Exports("gatewayHostname", feature.ExportTemplate("istio-ingressgateway.{{ .GatewayNamespace }}.svc.cluster.local")).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		applied := featurev1.NewFeatureTracker("mesh-gateway", "opendatahub")
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(applied), applied)).To(Succeed())
+		Expect(applied.Status.Exports).To(Equal(&featurev1.FeatureExports{
+			Values: map[string]string{"gatewayHostname": "istio-ingressgateway.istio-system.svc.cluster.local"},
+		}))
+	})
+
+	It("should keep the feature applied and the previously resolved value when the exported value cannot be resolved", func(ctx context.Context) {
+		// given
+		existing := tracker("mesh-gateway-unresolved", featurev1.Source{}, &featurev1.FeatureExports{
+			Values: map[string]string{"gatewayHostname": "istio-ingressgateway-istio-system.apps.example.com"},
+		})
+		Expect(cli.Create(ctx, existing)).To(Succeed())
+		Expect(cli.Status().Update(ctx, existing)).To(Succeed())
+
+		f, err := feature.Define("mesh-gateway-unresolved").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Exports("gatewayHostname", feature.ExportTemplate("istio-ingressgateway.{{ .GatewayNamespace }}.svc.cluster.local")).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		err = f.Apply(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		applied := featurev1.NewFeatureTracker("mesh-gateway-unresolved", "opendatahub")
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(applied), applied)).To(Succeed())
+		Expect(applied.Status.Exports.Values).To(HaveKeyWithValue("gatewayHostname", "istio-ingressgateway-istio-system.apps.example.com"))
+	})
+
+	It("should aggregate values of the features applied for the source by capability", func(ctx context.Context) {
+		// given
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		trackersCli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			tracker("mesh-control-plane-creation", dsciSource, &featurev1.FeatureExports{
+				Capability: "service-mesh",
+				Values:     map[string]string{"gatewayHostname": "istio-ingressgateway.istio-system.svc.cluster.local", "controlPlaneVersion": "2.6.1"},
+			}),
+			tracker("mesh-control-plane-external-authz", dsciSource, &featurev1.FeatureExports{
+				Capability: "service-mesh-authorization",
+				Values:     map[string]string{"authProviderURL": "grpc://authorino-authorino-authorization.opendatahub-auth-provider.svc.cluster.local:50051"},
+			}),
+			tracker("console-links", dsciSource, &featurev1.FeatureExports{
+				Values: map[string]string{"dashboardURL": "https://dashboard.apps.example.com"},
+			}),
+			tracker("mesh-metrics-collection", dsciSource, nil),
+			tracker("kserve-gateway", featurev1.Source{Type: featurev1.ComponentType, Name: "kserve"}, &featurev1.FeatureExports{
+				Capability: "service-mesh",
+				Values:     map[string]string{"knativeGateway": "knative-ingress-gateway"},
+			}),
+		).Build()
+
+		// when
+		capabilities, err := feature.CapabilityExports(ctx, trackersCli, dsciSource)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(capabilities).To(Equal([]dsciv1.CapabilityStatus{
+			{Name: "console-links", Values: map[string]string{"dashboardURL": "https://dashboard.apps.example.com"}},
+			{Name: "service-mesh", Values: map[string]string{
+				"gatewayHostname":     "istio-ingressgateway.istio-system.svc.cluster.local",
+				"controlPlaneVersion": "2.6.1",
+			}},
+			{Name: "service-mesh-authorization", Values: map[string]string{
+				"authProviderURL": "grpc://authorino-authorino-authorization.opendatahub-auth-provider.svc.cluster.local:50051",
+			}},
+		}))
+	})
+})
@@ -0,0 +1,132 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// EnabledWhenExpression creates EnabledFunc evaluating the given CEL expression. The expression has access to:
+//
+//   - `spec` - the given spec (e.g. DSCInitializationSpec) in its serialized form,
+//     so fields are referred to by their JSON names (e.g. spec.serviceMesh.controlPlane.metricsCollection)
+//   - `cluster` - facts about the cluster: `ocpVersion` (string), `ocpMajor` and `ocpMinor` (ints)
+//     and `architectures` (list of node CPU architectures).
+//
+// Optional fields which are not set are absent in `spec`, use has() macro to guard against that.
+// The expression has to evaluate to bool.
+func EnabledWhenExpression(expression string, spec any) EnabledFunc {
+	return func(ctx context.Context, f *Feature) (bool, error) {
+		specVars, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec)
+		if err != nil {
+			return false, fmt.Errorf("failed converting spec for expression %q: %w", expression, err)
+		}
+
+		clusterVars, err := clusterFacts(ctx, f.Client)
+		if err != nil {
+			return false, err
+		}
+
+		return EvaluateExpression(expression, map[string]any{
+			"spec":    specVars,
+			"cluster": clusterVars,
+		})
+	}
+}
+
+// EvaluateExpression compiles and evaluates the CEL expression using the given variables. The expression has to evaluate to bool.
+func EvaluateExpression(expression string, variables map[string]any) (bool, error) {
+	options := make([]cel.EnvOption, 0, len(variables))
+	for name := range variables {
+		options = append(options, cel.Variable(name, cel.DynType))
+	}
+
+	env, err := cel.NewEnv(options...)
+	if err != nil {
+		return false, err
+	}
+
+	ast, err := compileExpression(env, expression)
+	if err != nil {
+		return false, err
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return false, fmt.Errorf("failed creating program for expression %q: %w", expression, err)
+	}
+
+	result, _, err := program.Eval(variables)
+	if err != nil {
+		return false, fmt.Errorf("failed evaluating expression %q: %w", expression, err)
+	}
+
+	enabled, ok := result.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q evaluated to %v instead of bool", expression, result.Value())
+	}
+
+	return enabled, nil
+}
+
+// CompileExpression checks that the CEL expression given to EnabledWhenExpression compiles using `spec` and `cluster`
+// variables and can evaluate to bool, so that invalid expressions are rejected before the features are enabled using them.
+// Fields referred to are not checked, as variables are not typed.
+func CompileExpression(expression string) error {
+	env, err := cel.NewEnv(cel.Variable("spec", cel.DynType), cel.Variable("cluster", cel.DynType))
+	if err != nil {
+		return err
+	}
+
+	_, err = compileExpression(env, expression)
+
+	return err
+}
+
+func compileExpression(env *cel.Env, expression string) (*cel.Ast, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed compiling expression %q: %w", expression, issues.Err())
+	}
+
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression %q evaluates to %s instead of bool", expression, outputType)
+	}
+
+	return ast, nil
+}
+
+func clusterFacts(ctx context.Context, cli client.Client) (map[string]any, error) {
+	facts := map[string]any{
+		"ocpVersion": "",
+		"ocpMajor":   int64(0),
+		"ocpMinor":   int64(0),
+	}
+
+	version, err := cluster.GetOCPVersion(ctx, cli)
+	switch {
+	case err == nil:
+		facts["ocpVersion"] = version.String()
+		facts["ocpMajor"] = int64(version.Major)
+		facts["ocpMinor"] = int64(version.Minor)
+	case k8serr.IsNotFound(err) || meta.IsNoMatchError(err):
+		// not running on OpenShift, version facts are left empty
+	default:
+		return nil, err
+	}
+
+	architectures, err := cluster.GetNodeArchitectures(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+	facts["architectures"] = architectures
+
+	return facts, nil
+}
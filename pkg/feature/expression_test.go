@@ -0,0 +1,97 @@
+package feature_test
+
+import (
+	"context"
+
+	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CEL expressions", func() {
+
+	DescribeTable("evaluating expressions",
+		func(expression string, expected bool) {
+			// given
+			variables := map[string]any{
+				"spec": map[string]any{
+					"serviceMesh": map[string]any{
+						"controlPlane": map[string]any{"metricsCollection": "Istio"},
+					},
+				},
+				"cluster": map[string]any{"ocpMinor": int64(14)},
+			}
+
+			// when
+			enabled, err := feature.EvaluateExpression(expression, variables)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(Equal(expected))
+		},
+		Entry("should match spec field", "spec.serviceMesh.controlPlane.metricsCollection == 'Istio'", true),
+		Entry("should combine spec and cluster facts", "spec.serviceMesh.controlPlane.metricsCollection == 'Istio' && cluster.ocpMinor >= 15", false),
+		Entry("should guard optional fields", "has(spec.trustedCABundle) && spec.trustedCABundle.managementState == 'Managed'", false),
+	)
+
+	It("should fail when expression does not evaluate to bool", func() {
+		_, err := feature.EvaluateExpression("'Istio'", map[string]any{})
+
+		Expect(err).To(MatchError(ContainSubstring("instead of bool")))
+	})
+
+	It("should fail when expression cannot be compiled", func() {
+		_, err := feature.EvaluateExpression("spec.serviceMesh ==", map[string]any{"spec": map[string]any{}})
+
+		Expect(err).To(MatchError(ContainSubstring("failed compiling expression")))
+	})
+
+	DescribeTable("compiling expressions without evaluating them",
+		func(expression string, expectedErr types.GomegaMatcher) {
+			// when
+			err := feature.CompileExpression(expression)
+
+			// then
+			Expect(err).To(expectedErr)
+		},
+		Entry("should accept expression using spec and cluster facts", "spec.serviceMesh.controlPlane.metricsCollection == 'Istio' && cluster.ocpMinor >= 14", Succeed()),
+		Entry("should accept field of unknown type", "spec.serviceMesh.controlPlane.metricsCollectionEnabled", Succeed()),
+		Entry("should reject syntax error", "spec.serviceMesh ==", MatchError(ContainSubstring("failed compiling expression"))),
+		Entry("should reject undeclared variable", "dsci.spec.profile == 'serving'", MatchError(ContainSubstring("undeclared reference"))),
+		Entry("should reject expression which is not bool", "cluster.ocpMinor + 1 > 0 ? 'yes' : 'no'", MatchError(ContainSubstring("instead of bool"))),
+	)
+
+	It("should evaluate expression using DSCI spec and cluster facts", func(ctx context.Context) {
+		// given
+		clusterVersion := &unstructured.Unstructured{}
+		clusterVersion.SetGroupVersionKind(gvk.ClusterVersion)
+		clusterVersion.SetName("version")
+		Expect(unstructured.SetNestedField(clusterVersion.Object, "4.15.2", "status", "desired", "version")).To(Succeed())
+
+		f := &feature.Feature{
+			Name:   "cel-test",
+			Client: fake.NewClientBuilder().WithObjects(clusterVersion).Build(),
+		}
+
+		spec := &dsciv1.DSCInitializationSpec{
+			ServiceMesh: &infrav1.ServiceMeshSpec{
+				ControlPlane: infrav1.ControlPlaneSpec{MetricsCollection: "Istio"},
+			},
+		}
+
+		// when
+		enabled, err := feature.EnabledWhenExpression("spec.serviceMesh.controlPlane.metricsCollection == 'Istio' && cluster.ocpMinor >= 14", spec)(ctx, f)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+	})
+})
@@ -2,18 +2,22 @@ package feature
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 // Feature is a high-level abstraction that represents a collection of resources and actions
@@ -49,7 +53,68 @@ type Feature struct {
 
 	data map[string]any
 
+	// group the feature belongs to, nil for standalone features.
+	group *FeatureGroup
+
+	// dependsOn holds names of the features which have to be applied before this one.
+	dependsOn []string
+
+	// disruptive features are only updated within the maintenance window, if it is defined.
+	disruptive        bool
+	maintenanceWindow *dsciv1.MaintenanceWindow
+
+	// policyExemptions hold metadata added to created resources to satisfy exemptions of admission policies.
+	policyExemptions *dsciv1.PolicyExemptions
+
+	// costAttribution defines labels added to created namespaces and workloads, attributed to the capability of the feature.
+	costAttribution *dsciv1.CostAttribution
+	capability      string
+
+	// namespaceDeletion defines how namespaces owned by the FeatureTracker are deleted when the feature is removed.
+	namespaceDeletion *dsciv1.NamespaceDeletion
+
+	// permissions required by the operations of the feature, checked upfront by MissingPermissions.
+	permissions []Permission
+
+	// applyTimeout bounds applying the feature, overriding the operator default when positive.
+	applyTimeout time.Duration
+
+	// adoptionPolicy defines what happens with resources existing before the feature created them, empty keeps the default.
+	adoptionPolicy resource.AdoptionPolicy
+
+	// metadataRepair defines how metadata of existing resources created by the feature is repaired, empty keeps the default.
+	metadataRepair resource.MetadataRepairPolicy
+
+	// clusterResourcesOnDelete defines what happens with cluster-scoped resources of the feature when it is removed, empty removes them.
+	clusterResourcesOnDelete resource.OnDeletePolicy
+
+	// appliedNamespaces collects namespaces of the resources created by the current apply pass.
+	appliedNamespaces sets.Set[string]
+
+	// appliedClusterResources collects cluster-scoped resources created by the current apply pass, see collectClusterResource.
+	appliedClusterResources sets.Set[featurev1.TrackedResource]
+
+	// exports are values published by the feature once it has been applied, see Exports.
+	exports []export
+
+	// stagedStatus holds updates of the FeatureTracker status made by the current apply pass, written together with its outcome.
+	stagedStatus []status.SaveStatusFunc[*featurev1.FeatureTracker]
+
+	// poller waits for conditions of the feature, nil uses DefaultPoller.
+	poller *Poller
+
+	// requiredOpenShift is the range of OpenShift versions the feature supports, nil when it supports any cluster.
+	requiredOpenShift *versionRequirement
+
+	// subscriptions memoizes Subscriptions listed when checking installed operators, nil lists them on every check.
+	subscriptions *cluster.SubscriptionLookup
+
+	// canary is the copy of the feature applied to the canary namespace before changes brought by operator upgrades are rolled out.
+	canary       *Feature
+	canaryProbes []Action
+
 	appliers []resource.Applier
+	patches  []Patch
 
 	cleanups          []CleanupFunc
 	clusterOperations []Action
@@ -73,7 +138,13 @@ type EnabledFunc func(ctx context.Context, feature *Feature) (bool, error)
 
 // Apply applies the feature to the cluster.
 // It creates a FeatureTracker resource to establish ownership and reports the result of the operation as a condition.
+//
+// Overlapping applies of the same feature are serialized. Within the operator process they wait for each other,
+// while applying the feature when another process holds the lease on the FeatureTracker results in ApplyInProgressError.
 func (f *Feature) Apply(ctx context.Context) error {
+	unlock := lockFeature(featurev1.NewFeatureTracker(f.Name, f.TargetNamespace).Name)
+	defer unlock()
+
 	// If the feature is disabled, but the FeatureTracker exists in the cluster, ensure clean-up is triggered.
 	// This means that the feature was previously enabled, but now it is not anymore.
 	if enabled, err := f.Enabled(ctx, f); !enabled || err != nil {
@@ -81,28 +152,69 @@ func (f *Feature) Apply(ctx context.Context) error {
 			return err
 		}
 
-		return f.Cleanup(ctx)
+		return f.cleanup(ctx)
 	}
 
 	if trackerErr := createFeatureTracker(ctx, f); trackerErr != nil {
 		return trackerErr
 	}
 
-	if _, updateErr := status.UpdateWithRetry(ctx, f.Client, f.tracker, func(saved *featurev1.FeatureTracker) {
-		status.SetProgressingCondition(&saved.Status.Conditions, string(featurev1.ConditionReason.FeatureCreated), fmt.Sprintf("Applying feature [%s]", f.Name))
-		saved.Status.Phase = status.PhaseProgressing
-	}); updateErr != nil {
+	if skewErr := checkVersionSkew(f.Name, f.tracker); skewErr != nil {
+		return skewErr
+	}
+
+	return f.applyWithLease(ctx)
+}
+
+// applyWithLease applies the feature and reports the result, as long as the operator instance holds the lease on the feature.
+func (f *Feature) applyWithLease(ctx context.Context) error {
+	f.stagedStatus = nil
+	stagedProgressing, updateErr := f.markProgressing(ctx)
+	if updateErr != nil {
 		return updateErr
 	}
 
-	applyErr := f.applyFeature(ctx)
-	_, reportErr := createFeatureTrackerStatusReporter(f).ReportCondition(ctx, applyErr)
+	stopRenewal := f.keepLease(ctx, stagedProgressing)
+	applyErr := f.applyFeatureWithTimeout(ctx)
+	if renewed := stopRenewal(); renewed != nil {
+		f.tracker.Status = *renewed
+	}
+
+	if applyErr == nil {
+		applyErr = f.recordAppliedData(ctx)
+	}
+	if applyErr == nil {
+		applyErr = f.recordAppliedNamespaces(ctx)
+	}
+	if applyErr == nil {
+		applyErr = f.recordAppliedClusterResources(ctx)
+	}
+	if applyErr == nil {
+		f.recordAppliedCharts()
+	}
+	if applyErr == nil {
+		f.recordExports(ctx)
+	}
+
+	// Lease expired while applying and has been taken over, results of the apply pass holding it take precedence.
+	if held, leaseErr := f.stillHoldsLease(ctx); leaseErr != nil || !held {
+		return multierror.Append(applyErr, leaseErr).ErrorOrNil()
+	}
+
+	reportErr := f.writeStatus(ctx, trackerOutcome(f, applyErr))
 
 	return multierror.Append(applyErr, reportErr).ErrorOrNil()
 }
 
 func (f *Feature) applyFeature(ctx context.Context) error {
 	var multiErr *multierror.Error
+	f.appliedNamespaces = sets.New[string]()
+	f.appliedClusterResources = sets.New[featurev1.TrackedResource]()
+
+	// checked first, as data providers may already rely on APIs of the required version
+	if errVersion := f.ensureClusterVersionSupported(ctx); errVersion != nil {
+		return &withConditionReasonError{reason: featurev1.ConditionReason.UnsupportedClusterVersion, err: errVersion}
+	}
 
 	for _, dataProvider := range f.dataProviders {
 		multiErr = multierror.Append(multiErr, dataProvider(ctx, f))
@@ -111,6 +223,10 @@ func (f *Feature) applyFeature(ctx context.Context) error {
 		return &withConditionReasonError{reason: featurev1.ConditionReason.LoadTemplateData, err: errDataLoad}
 	}
 
+	if errWindow := f.ensureWithinMaintenanceWindow(); errWindow != nil {
+		return &withConditionReasonError{reason: featurev1.ConditionReason.PendingMaintenanceWindow, err: errWindow}
+	}
+
 	for _, precondition := range f.preconditions {
 		multiErr = multierror.Append(multiErr, precondition(ctx, f))
 	}
@@ -126,11 +242,15 @@ func (f *Feature) applyFeature(ctx context.Context) error {
 
 	for i := range f.appliers {
 		r := f.appliers[i]
-		if processErr := r.Apply(ctx, f.Client, f.data, DefaultMetaOptions(f)...); processErr != nil {
+		if processErr := r.Apply(ctx, f.Client, f.data, append(DefaultMetaOptions(f), f.collectNamespace, f.collectClusterResource)...); processErr != nil {
 			return &withConditionReasonError{reason: featurev1.ConditionReason.ApplyManifests, err: processErr}
 		}
 	}
 
+	if errPatches := f.applyPatches(ctx); errPatches != nil {
+		return &withConditionReasonError{reason: featurev1.ConditionReason.ApplyManifests, err: errPatches}
+	}
+
 	for _, postcondition := range f.postconditions {
 		multiErr = multierror.Append(multiErr, postcondition(ctx, f))
 	}
@@ -142,15 +262,36 @@ func (f *Feature) applyFeature(ctx context.Context) error {
 }
 
 func (f *Feature) Cleanup(ctx context.Context) error {
-	// Ensure associated FeatureTracker instance has been removed as last one
-	// in the chain of cleanups.
-	f.addCleanup(removeFeatureTracker(f))
+	unlock := lockFeature(featurev1.NewFeatureTracker(f.Name, f.TargetNamespace).Name)
+	defer unlock()
+
+	return f.cleanup(ctx)
+}
+
+func (f *Feature) cleanup(ctx context.Context) error {
+	if tracker, errGet := getFeatureTracker(ctx, f.Client, f.Name, f.TargetNamespace); client.IgnoreNotFound(errGet) != nil {
+		return errGet
+	} else if errGet == nil {
+		if skewErr := checkVersionSkew(f.Name, tracker); skewErr != nil {
+			return skewErr
+		}
+	}
+
+	// Ensure associated FeatureTracker instance has been removed as last one in the chain of cleanups,
+	// after namespaces it owns and cluster-scoped resources it tracks have been handled.
+	f.addCleanup(removeOwnedNamespaces(f), deleteClusterResources(f))
 
 	var cleanupErrors *multierror.Error
 	for _, cleanupFunc := range f.cleanups {
 		cleanupErrors = multierror.Append(cleanupErrors, cleanupFunc(ctx, f.Client))
 	}
 
+	// The tracker owning namespaces which are still terminating is kept, so that they are checked again when the removal is retried.
+	var terminating *NamespacesTerminatingError
+	if !errors.As(cleanupErrors.ErrorOrNil(), &terminating) {
+		cleanupErrors = multierror.Append(cleanupErrors, removeFeatureTracker(f)(ctx, f.Client))
+	}
+
 	return cleanupErrors.ErrorOrNil()
 }
 
@@ -158,6 +299,15 @@ func (f *Feature) addCleanup(cleanupFuncs ...CleanupFunc) {
 	f.cleanups = append(f.cleanups, cleanupFuncs...)
 }
 
+// Poller returns the poller the feature waits for its conditions with.
+func (f *Feature) Poller() *Poller {
+	if f.poller == nil {
+		return DefaultPoller()
+	}
+
+	return f.poller
+}
+
 // AsOwnerReference returns an OwnerReference for the FeatureTracker resource.
 func (f *Feature) AsOwnerReference() metav1.OwnerReference {
 	return f.tracker.ToOwnerReference()
@@ -168,8 +318,92 @@ func OwnedBy(f *Feature) cluster.MetaOptions {
 	return cluster.WithOwnerReference(f.AsOwnerReference())
 }
 
+// WithFeatureLabels returns a cluster.MetaOptions that adds labels identifying the feature and its source to the resource,
+// so that resources created by the feature can be queried using cluster.ListFeatureResources.
+// Existing labels of the resource are preserved.
+func WithFeatureLabels(f *Feature) cluster.MetaOptions {
+	return func(obj metav1.Object) error {
+		objLabels := obj.GetLabels()
+		if objLabels == nil {
+			objLabels = make(map[string]string)
+		}
+
+		objLabels[labels.K8SCommon.ManagedBy] = cluster.OperatorName
+		objLabels[labels.ODH.Feature] = f.Name
+		if f.source != nil {
+			objLabels[labels.ODH.FeatureSourceType] = string(f.source.Type)
+			objLabels[labels.ODH.FeatureSourceName] = f.source.Name
+		}
+		obj.SetLabels(objLabels)
+
+		return nil
+	}
+}
+
+// WithPolicyExemptions returns a cluster.MetaOptions that adds labels and annotations defined in DSCI spec.policyExemptions
+// to the resource. Labels and annotations already set on the resource, e.g. by its manifest or WithFeatureLabels, take precedence.
+func WithPolicyExemptions(f *Feature) cluster.MetaOptions {
+	return func(obj metav1.Object) error {
+		if f.policyExemptions == nil {
+			return nil
+		}
+
+		obj.SetLabels(mergeMissing(obj.GetLabels(), f.policyExemptions.Labels))
+		obj.SetAnnotations(mergeMissing(obj.GetAnnotations(), f.policyExemptions.Annotations))
+
+		return nil
+	}
+}
+
+func mergeMissing(existing, additional map[string]string) map[string]string {
+	if len(additional) == 0 {
+		return existing
+	}
+
+	if existing == nil {
+		existing = make(map[string]string, len(additional))
+	}
+	for key, value := range additional {
+		if _, exists := existing[key]; !exists {
+			existing[key] = value
+		}
+	}
+
+	return existing
+}
+
+// WithAdoptionPolicy returns a cluster.MetaOptions that annotates the resource with the adoption policy of the feature, if it
+// defines one. Policy declared in the manifest of the resource takes precedence.
+func WithAdoptionPolicy(f *Feature) cluster.MetaOptions {
+	return func(obj metav1.Object) error {
+		if f.adoptionPolicy == "" {
+			return nil
+		}
+
+		obj.SetAnnotations(mergeMissing(obj.GetAnnotations(), map[string]string{annotations.AdoptionPolicy: string(f.adoptionPolicy)}))
+
+		return nil
+	}
+}
+
+// WithMetadataRepairPolicy returns a cluster.MetaOptions that annotates the resource with the metadata repair policy of the feature,
+// if it defines one. Policy declared in the manifest of the resource takes precedence.
+func WithMetadataRepairPolicy(f *Feature) cluster.MetaOptions {
+	return func(obj metav1.Object) error {
+		if f.metadataRepair == "" {
+			return nil
+		}
+
+		obj.SetAnnotations(mergeMissing(obj.GetAnnotations(), map[string]string{annotations.MetadataRepair: string(f.metadataRepair)}))
+
+		return nil
+	}
+}
+
 func DefaultMetaOptions(f *Feature) []cluster.MetaOptions {
-	resourceMeta := []cluster.MetaOptions{OwnedBy(f)}
+	resourceMeta := []cluster.MetaOptions{
+		OwnedBy(f), WithFeatureLabels(f), WithPolicyExemptions(f), WithCostAttribution(f), WithAdoptionPolicy(f), WithMetadataRepairPolicy(f),
+	}
 	if f.Managed {
 		resourceMeta = append(resourceMeta, func(obj metav1.Object) error {
 			objAnnotations := obj.GetAnnotations()
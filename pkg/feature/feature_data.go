@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/provider"
 )
 
@@ -39,7 +41,61 @@ type DataDefinition[S, T any] struct {
 	Extract func(f *Feature) (T, error)
 }
 
+// DataKey is a typed name under which a value of type T is stored in the Feature's data. As the type is bound to the key
+// when it is defined, storing the value using DataKey.From and fetching it using DataKey.Extract is checked by the compiler,
+// rather than relying on the same string key and a type cast at runtime.
+type DataKey[T any] struct {
+	name string
+}
+
+// DefineData creates a typed key for the value stored in the Feature's data under the given name. The name is also how
+// templates refer to the value, e.g. {{ .ControlPlane.Namespace }}.
+func DefineData[T any](name string) DataKey[T] {
+	return DataKey[T]{name: name}
+}
+
+// Name is the name under which the value is stored in the Feature's data.
+func (k DataKey[T]) Name() string {
+	return k.name
+}
+
+// From associates the key with the provider of its value.
+func (k DataKey[T]) From(value provider.DataProviderFunc[T]) DataEntry[T] {
+	return DataEntry[T]{Key: k.name, Value: value}
+}
+
+// Extract fetches the value stored under the key from the Feature. Unlike Get, it reports which data is missing and
+// how to provide it, as the error usually means the feature has not been defined with the data it uses.
+func (k DataKey[T]) Extract(f *Feature) (T, error) {
+	var data T
+
+	input, found := f.data[k.name]
+	if !found {
+		return data, fmt.Errorf("feature %s has no data %q of type %T, it has to be provided using WithData", f.Name, k.name, data)
+	}
+
+	data, ok := input.(T)
+	if !ok {
+		return data, fmt.Errorf("data %q of feature %s is of type %T instead of %T", k.name, f.Name, input, data)
+	}
+
+	return data, nil
+}
+
+// DataDefinitionOf defines the data stored under the given key, resolved from the source when the feature is applied.
+func DataDefinitionOf[S, T any](key DataKey[T], resolve func(ctx context.Context, cli client.Client, source *S) (T, error)) DataDefinition[S, T] {
+	return DataDefinition[S, T]{
+		Define: func(source *S) DataEntry[T] {
+			return key.From(func(ctx context.Context, cli client.Client) (T, error) {
+				return resolve(ctx, cli, source)
+			})
+		},
+		Extract: key.Extract,
+	}
+}
+
 // ExtractEntry is a convenient way to define how to extract a value from the given Feature's data using defined key.
+// Prefer keys defined using DefineData, which bind the type of the value to the key.
 func ExtractEntry[T any](key string) func(f *Feature) (T, error) {
 	return func(f *Feature) (T, error) {
 		return Get[T](f, key)
@@ -0,0 +1,67 @@
+package feature_test
+
+import (
+	"context"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Typed feature data", func() {
+
+	var replicas = feature.DefineData[int32]("AuthorinoReplicas")
+
+	createFeature := func() *feature.Feature {
+		f, err := feature.Define("mesh-control-plane-external-authz").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+
+		return f
+	}
+
+	It("should extract the value provided under the key", func(ctx context.Context) {
+		// given
+		definition := feature.DataDefinitionOf(replicas, func(_ context.Context, _ client.Client, source *int32) (int32, error) {
+			return *source + 1, nil
+		})
+		source := int32(2)
+		f := createFeature()
+
+		// when
+		Expect(definition.Define(&source).AsAction()(ctx, f)).To(Succeed())
+
+		// then
+		Expect(definition.Extract(f)).To(Equal(int32(3)))
+		Expect(feature.Get[int32](f, "AuthorinoReplicas")).To(Equal(int32(3)))
+	})
+
+	It("should report data which has not been provided", func() {
+		// given
+		f := createFeature()
+
+		// when
+		_, err := replicas.Extract(f)
+
+		// then
+		Expect(err).To(MatchError(`feature mesh-control-plane-external-authz has no data "AuthorinoReplicas" of type int32, it has to be provided using WithData`))
+	})
+
+	It("should report data stored under the name with another type", func() {
+		// given
+		f := createFeature()
+		Expect(f.Set("AuthorinoReplicas", "3")).To(Succeed())
+
+		// when
+		_, err := replicas.Extract(f)
+
+		// then
+		Expect(err).To(MatchError(`data "AuthorinoReplicas" of feature mesh-control-plane-external-authz is of type string instead of int32`))
+	})
+})
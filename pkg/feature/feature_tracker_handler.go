@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -42,7 +43,14 @@ func createFeatureTracker(ctx context.Context, f *Feature) error {
 			AppNamespace: f.TargetNamespace,
 		}
 		if errCreate := f.Client.Create(ctx, tracker); errCreate != nil {
-			return errCreate
+			if !k8serr.IsAlreadyExists(errCreate) {
+				return errCreate
+			}
+
+			// Created in the meantime, e.g. restored from the platform configuration export, so it is adopted instead.
+			if tracker, errGet = getFeatureTracker(ctx, f.Client, f.Name, f.TargetNamespace); errGet != nil {
+				return errGet
+			}
 		}
 	}
 
@@ -101,24 +109,42 @@ func ensureGVKSet(obj runtime.Object, scheme *runtime.Scheme) error {
 	return nil
 }
 
-func createFeatureTrackerStatusReporter(f *Feature) *status.Reporter[*featurev1.FeatureTracker] {
-	return status.NewStatusReporter(f.Client, f.tracker, func(err error) status.SaveStatusFunc[*featurev1.FeatureTracker] {
-		updatedCondition := func(saved *featurev1.FeatureTracker) {
-			status.SetCompleteCondition(&saved.Status.Conditions, string(featurev1.ConditionReason.FeatureCreated), fmt.Sprintf("Applied feature [%s] successfully", f.Name))
+// trackerOutcome updates the FeatureTracker status with the outcome of applying the feature, failed when the error is not nil.
+func trackerOutcome(f *Feature, err error) status.SaveStatusFunc[*featurev1.FeatureTracker] {
+	if err == nil {
+		return func(saved *featurev1.FeatureTracker) {
+			reason, message := string(featurev1.ConditionReason.FeatureCreated), fmt.Sprintf("Applied feature [%s] successfully", f.Name)
+			status.SetCompleteCondition(&saved.Status.Conditions, reason, message)
 			saved.Status.Phase = status.PhaseReady
+			saved.RecordTransition(status.PhaseReady, reason, message, time.Now())
+			saved.Summarize()
 		}
-		if err != nil {
-			reason := featurev1.ConditionReason.FailedApplying // generic reason when error is not related to any specific step of the feature apply
-			var conditionErr *withConditionReasonError
-			if errors.As(err, &conditionErr) {
-				reason = conditionErr.reason
-			}
-			updatedCondition = func(saved *featurev1.FeatureTracker) {
-				status.SetErrorCondition(&saved.Status.Conditions, string(reason), fmt.Sprintf("Failed applying [%s]: %+v", f.Name, err))
-				saved.Status.Phase = status.PhaseError
-			}
-		}
+	}
+
+	reason := conditionReasonOf(err)
+
+	return func(saved *featurev1.FeatureTracker) {
+		message := fmt.Sprintf("Failed applying [%s]: %+v", f.Name, err)
+		status.SetErrorCondition(&saved.Status.Conditions, string(reason), message)
+		saved.Status.Phase = status.PhaseError
+		saved.RecordTransition(status.PhaseError, string(reason), message, time.Now())
+		saved.Summarize()
+	}
+}
+
+// conditionReasonOf returns the reason the failure of the feature is reported with in its FeatureTracker conditions.
+func conditionReasonOf(err error) featurev1.FeatureConditionReason {
+	reason := featurev1.ConditionReason.FailedApplying // generic reason when error is not related to any specific step of the feature apply
+	var conditionErr *withConditionReasonError
+	if errors.As(err, &conditionErr) {
+		reason = conditionErr.reason
+	}
+	if _, denied := AsPolicyDenial(err); denied {
+		reason = featurev1.ConditionReason.PolicyDenied
+	}
+	if IsApplyTimeout(err) {
+		reason = featurev1.ConditionReason.ApplyTimeout
+	}
 
-		return updatedCondition
-	})
+	return reason
 }
@@ -0,0 +1,106 @@
+package featuretesting
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// InstantClock is a fake clock which moves forward by the duration of each timer when the timer is created, firing it right away.
+// Pollers driven by it run through their intervals and timeout instantly, while still measuring the time, e.g. for a feature
+// waiting for the control plane for 5 minutes, so that timeouts and backoff of wait conditions can be verified in unit tests.
+//
+// It supports code waiting for a single timer at a time, such as feature.Poller.
+type InstantClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits []time.Duration
+}
+
+var _ clock.Clock = (*InstantClock)(nil)
+
+// NewInstantClock creates a clock starting at the given time.
+func NewInstantClock(start time.Time) *InstantClock {
+	return &InstantClock{now: start}
+}
+
+// NewInstantPoller creates a copy of the poller, e.g. feature.DefaultPoller(), driven by InstantClock. The clock is returned
+// to inspect the waits of the poller.
+func NewInstantPoller(poller *feature.Poller) (*feature.Poller, *InstantClock) {
+	instantClock := NewInstantClock(time.Now())
+
+	return poller.WithClock(instantClock), instantClock
+}
+
+// Now returns the current time of the clock.
+func (c *InstantClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Since returns the time elapsed on the clock since the given time.
+func (c *InstantClock) Since(ts time.Time) time.Duration {
+	return c.Now().Sub(ts)
+}
+
+// After moves the clock forward by d and returns a channel which has already received the current time.
+func (c *InstantClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// NewTimer moves the clock forward by d and returns a timer which has already fired.
+func (c *InstantClock) NewTimer(d time.Duration) clock.Timer {
+	fired := make(chan time.Time, 1)
+	fired <- c.advance(d)
+
+	return &firedTimer{c: fired}
+}
+
+// Sleep moves the clock forward by d.
+func (c *InstantClock) Sleep(d time.Duration) {
+	c.advance(d)
+}
+
+// Tick is not supported, as tickers fire repeatedly.
+func (c *InstantClock) Tick(_ time.Duration) <-chan time.Time {
+	panic("InstantClock does not support tickers")
+}
+
+// Waits returns durations of the timers created using the clock, in the order of their creation.
+func (c *InstantClock) Waits() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]time.Duration{}, c.waits...)
+}
+
+func (c *InstantClock) advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.waits = append(c.waits, d)
+	c.now = c.now.Add(d)
+
+	return c.now
+}
+
+type firedTimer struct {
+	c chan time.Time
+}
+
+func (t *firedTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *firedTimer) Stop() bool {
+	return false
+}
+
+func (t *firedTimer) Reset(_ time.Duration) bool {
+	return false
+}
@@ -0,0 +1,214 @@
+// Package featuretesting provides reusable behavior suites verifying that features defined by a FeaturesProvider
+// follow the contract of the Feature framework. Suites are meant to be run against envtest, so that authors of
+// capabilities built on top of the framework can catch regressions without relying on a full cluster.
+//
+// Example:
+//
+//	var _ = featuretesting.ConformanceSuite("my capability", func() featuretesting.Subject {
+//		return featuretesting.Subject{
+//			Config:   envTest.Config,
+//			Client:   envTestClient,
+//			DSCI:     dsci,
+//			Provider: myCapabilityFeatures,
+//		}
+//	})
+package featuretesting
+
+import (
+	"context"
+	"time"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:stylecheck // This is the standard for ginkgo and gomega.
+	. "github.com/onsi/gomega"    //nolint:stylecheck // This is the standard for ginkgo and gomega.
+)
+
+const (
+	defaultTimeout  = 30 * time.Second
+	defaultInterval = 250 * time.Millisecond
+)
+
+// Subject describes features verified by the ConformanceSuite.
+type Subject struct {
+	// Config of the cluster features are applied to, e.g. envtest.Environment.Config.
+	Config *rest.Config
+	// Client used to inspect the cluster. Its scheme has to include FeatureTracker.
+	Client client.Client
+	// DSCI which is the source of the features.
+	DSCI *dsciv1.DSCInitialization
+	// Provider defines features under test.
+	Provider feature.FeaturesProvider
+	// Resources expected to be created by the features. Only name, namespace and type are relevant.
+	// +optional
+	Resources []client.Object
+	// Timeout and Interval used when waiting for the cluster state. Defaults to 30s and 250ms.
+	// +optional
+	Timeout, Interval time.Duration
+}
+
+// ConformanceSuite registers Ginkgo specs verifying that features defined by the subject:
+//   - can be applied repeatedly without errors, keeping the same FeatureTracker,
+//   - create FeatureTracker pointing to the DSCI as a source and reporting the result through conditions,
+//   - make created resources owned by the FeatureTracker, so they are garbage collected with it,
+//   - label created resources with the feature and its source, so they can be found using cluster.ListFeatureResources,
+//   - can be deleted repeatedly without errors, removing their FeatureTrackers.
+//
+// The subject is resolved lazily, so that it can rely on values initialized in BeforeSuite, such as envtest config.
+func ConformanceSuite(description string, subject func() Subject) bool {
+	return Describe("Feature conformance: "+description, Ordered, func() {
+
+		var (
+			s        Subject
+			trackers map[string]k8stypes.UID
+		)
+
+		newHandler := func() *feature.FeaturesHandler {
+			return feature.ClusterFeaturesHandler(s.DSCI, s.Provider).UsingConfig(s.Config)
+		}
+
+		BeforeAll(func() {
+			s = subject()
+			if s.Timeout == 0 {
+				s.Timeout = defaultTimeout
+			}
+			if s.Interval == 0 {
+				s.Interval = defaultInterval
+			}
+			trackers = map[string]k8stypes.UID{}
+		})
+
+		It("should apply features", func(ctx context.Context) {
+			// given
+			handler := newHandler()
+
+			// when
+			Expect(handler.Apply(ctx)).To(Succeed())
+
+			// then
+			for _, f := range enabledFeatures(ctx, handler) {
+				tracker := getTracker(ctx, s.Client, f)
+				Expect(tracker.Spec.Source).To(Equal(featurev1.Source{Type: featurev1.DSCIType, Name: s.DSCI.Name}), "feature %s", f.Name)
+				Expect(tracker.Status.Phase).To(Equal(status.PhaseReady), "feature %s", f.Name)
+				Expect(conditionsv1.IsStatusConditionTrue(tracker.Status.Conditions, conditionsv1.ConditionAvailable)).To(BeTrue(), "feature %s", f.Name)
+				trackers[tracker.Name] = tracker.UID
+			}
+		})
+
+		It("should make created resources owned by feature trackers", func(ctx context.Context) {
+			for _, resource := range s.Resources {
+				found, ok := resource.DeepCopyObject().(client.Object)
+				Expect(ok).To(BeTrue())
+
+				Eventually(func(ctx context.Context) error {
+					return s.Client.Get(ctx, client.ObjectKeyFromObject(resource), found)
+				}).WithContext(ctx).WithTimeout(s.Timeout).WithPolling(s.Interval).Should(Succeed())
+
+				owners := make([]k8stypes.UID, 0, len(found.GetOwnerReferences()))
+				for _, ownerRef := range found.GetOwnerReferences() {
+					owners = append(owners, ownerRef.UID)
+				}
+				Expect(owners).To(ContainElement(BeElementOf(uids(trackers))), "resource %s", client.ObjectKeyFromObject(resource))
+			}
+		})
+
+		It("should label created resources with their feature", func(ctx context.Context) {
+			for _, resource := range s.Resources {
+				found, ok := resource.DeepCopyObject().(client.Object)
+				Expect(ok).To(BeTrue())
+				Expect(s.Client.Get(ctx, client.ObjectKeyFromObject(resource), found)).To(Succeed())
+
+				Expect(found.GetLabels()).To(HaveKeyWithValue(labels.K8SCommon.ManagedBy, cluster.OperatorName), "resource %s", client.ObjectKeyFromObject(resource))
+				Expect(found.GetLabels()).To(HaveKeyWithValue(labels.ODH.FeatureSourceName, s.DSCI.Name), "resource %s", client.ObjectKeyFromObject(resource))
+				featureName, hasFeature := found.GetLabels()[labels.ODH.Feature]
+				Expect(hasFeature).To(BeTrue(), "resource %s", client.ObjectKeyFromObject(resource))
+
+				gvk, errGVK := apiutil.GVKForObject(found, s.Client.Scheme())
+				Expect(errGVK).ToNot(HaveOccurred())
+				featureResources, errList := cluster.ListFeatureResources(ctx, s.Client, featureName, gvk)
+				Expect(errList).ToNot(HaveOccurred())
+				listed := make([]k8stypes.UID, 0, len(featureResources))
+				for i := range featureResources {
+					listed = append(listed, featureResources[i].GetUID())
+				}
+				Expect(listed).To(ContainElement(found.GetUID()), "resource %s", client.ObjectKeyFromObject(resource))
+			}
+		})
+
+		It("should apply features again without recreating feature trackers", func(ctx context.Context) {
+			// given
+			handler := newHandler()
+
+			// when
+			Expect(handler.Apply(ctx)).To(Succeed())
+
+			// then
+			for _, f := range enabledFeatures(ctx, handler) {
+				tracker := getTracker(ctx, s.Client, f)
+				Expect(tracker.UID).To(Equal(trackers[tracker.Name]), "feature %s", f.Name)
+				Expect(tracker.Status.Phase).To(Equal(status.PhaseReady), "feature %s", f.Name)
+			}
+		})
+
+		It("should delete features and their feature trackers", func(ctx context.Context) {
+			// given
+			handler := newHandler()
+
+			// when
+			Expect(handler.Delete(ctx)).To(Succeed())
+
+			// then
+			for _, f := range handler.Features() {
+				tracker := featurev1.NewFeatureTracker(f.Name, f.TargetNamespace)
+				Eventually(func(ctx context.Context) bool {
+					return k8serr.IsNotFound(s.Client.Get(ctx, client.ObjectKeyFromObject(tracker), tracker))
+				}).WithContext(ctx).WithTimeout(s.Timeout).WithPolling(s.Interval).Should(BeTrue(), "feature %s", f.Name)
+			}
+		})
+
+		It("should delete features again without errors", func(ctx context.Context) {
+			Expect(newHandler().Delete(ctx)).To(Succeed())
+		})
+	})
+}
+
+func enabledFeatures(ctx context.Context, handler *feature.FeaturesHandler) []*feature.Feature {
+	enabled := make([]*feature.Feature, 0, len(handler.Features()))
+	for _, f := range handler.Features() {
+		isEnabled, err := f.Enabled(ctx, f)
+		Expect(err).ToNot(HaveOccurred())
+		if isEnabled {
+			enabled = append(enabled, f)
+		}
+	}
+
+	return enabled
+}
+
+func getTracker(ctx context.Context, cli client.Client, f *feature.Feature) *featurev1.FeatureTracker {
+	tracker := featurev1.NewFeatureTracker(f.Name, f.TargetNamespace)
+	ExpectWithOffset(1, cli.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).To(Succeed(), "feature %s", f.Name)
+
+	return tracker
+}
+
+func uids(trackers map[string]k8stypes.UID) []k8stypes.UID {
+	values := make([]k8stypes.UID, 0, len(trackers))
+	for _, uid := range trackers {
+		values = append(values, uid)
+	}
+
+	return values
+}
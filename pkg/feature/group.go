@@ -0,0 +1,187 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// FeatureGroup bundles related features, e.g. all the features setting up the authorization provider, which:
+//   - share data providers, resolved once per apply pass instead of by every feature of the group,
+//   - are enabled or disabled together, features of a disabled group are disabled regardless of their own criteria,
+//   - are reported as one, failures of the features are combined into a single GroupError, and the outcome of the group
+//     is available through FeaturesHandler.GroupResults, e.g. to be reported as a condition of the source resource.
+//
+// Use FeaturesRegistry.AddGroup to register features of the group with the handler.
+type FeatureGroup struct {
+	name          string
+	enabled       EnabledFunc
+	dataProviders []Action
+	builders      []*featureBuilder
+
+	mu       sync.Mutex
+	resolved bool
+	data     map[string]any
+	dataErr  error
+
+	// disabled and err hold the outcome of the last apply or delete pass of the features of the group.
+	disabled bool
+	err      *GroupError
+}
+
+// Group creates a new, empty group of features with the given name.
+func Group(name string) *FeatureGroup {
+	return &FeatureGroup{name: name}
+}
+
+// Name returns the name of the group.
+func (g *FeatureGroup) Name() string {
+	return g.name
+}
+
+// EnabledWhen determines if features of the group should be applied. Features of a disabled group are cleaned up
+// the same way as disabled features are.
+func (g *FeatureGroup) EnabledWhen(enabled EnabledFunc) *FeatureGroup {
+	g.enabled = enabled
+
+	return g
+}
+
+// WithData adds data providers shared by all the features of the group. They are resolved once, when the first
+// feature of the group is applied, and the resolved values are available to every feature as if defined by the feature itself.
+func (g *FeatureGroup) WithData(dataProviders ...Action) *FeatureGroup {
+	g.dataProviders = append(g.dataProviders, dataProviders...)
+
+	return g
+}
+
+// Add puts features defined by the builders into the group.
+func (g *FeatureGroup) Add(builders ...*featureBuilder) *FeatureGroup {
+	for _, fb := range builders {
+		fb.group = g
+	}
+	g.builders = append(g.builders, builders...)
+
+	return g
+}
+
+// isEnabled combines enablement of the group with the one of the feature.
+func (g *FeatureGroup) isEnabled(featureEnabled EnabledFunc) EnabledFunc {
+	if g.enabled == nil {
+		return featureEnabled
+	}
+
+	return func(ctx context.Context, f *Feature) (bool, error) {
+		enabled, err := g.enabled(ctx, f)
+
+		g.mu.Lock()
+		g.disabled = err == nil && !enabled
+		g.mu.Unlock()
+
+		if !enabled || err != nil {
+			return false, err
+		}
+
+		return featureEnabled(ctx, f)
+	}
+}
+
+// loadData resolves shared data of the group on first use and copies it to the feature.
+func (g *FeatureGroup) loadData(ctx context.Context, f *Feature) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.resolved {
+		shared := &Feature{Name: g.name, TargetNamespace: f.TargetNamespace, Client: f.Client, Log: f.Log}
+		var multiErr *multierror.Error
+		for _, dataProvider := range g.dataProviders {
+			multiErr = multierror.Append(multiErr, dataProvider(ctx, shared))
+		}
+		g.data, g.dataErr, g.resolved = shared.data, multiErr.ErrorOrNil(), true
+	}
+
+	if g.dataErr != nil {
+		return fmt.Errorf("failed loading data of feature group %s: %w", g.name, g.dataErr)
+	}
+
+	for key, value := range g.data {
+		if err := f.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GroupResult is the combined outcome of the features of a group.
+type GroupResult struct {
+	Group string
+	// Disabled is set when the group has been disabled, its features have been cleaned up then.
+	Disabled bool
+	// Err combines failures of the features of the group, nil when all of them succeeded.
+	Err *GroupError
+}
+
+func (g *FeatureGroup) result() GroupResult {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return GroupResult{Group: g.name, Disabled: g.disabled, Err: g.err}
+}
+
+// GroupError combines failures of the features belonging to the same FeatureGroup.
+type GroupError struct {
+	Group    string
+	Features []string
+	err      *multierror.Error
+}
+
+func (e *GroupError) Error() string {
+	causes := make([]string, 0, len(e.err.Errors))
+	for _, err := range e.err.Errors {
+		causes = append(causes, err.Error())
+	}
+
+	return fmt.Sprintf("feature group %s failed in [%s]: %s", e.Group, strings.Join(e.Features, ", "), strings.Join(causes, "; "))
+}
+
+func (e *GroupError) Unwrap() error {
+	return e.err
+}
+
+// combineErrors collects errors of the features, in the order of the features. Errors of the features belonging
+// to a group are combined into a single GroupError, reported in place of the first failed feature of the group.
+func combineErrors(features []*Feature, errs map[*Feature]error, wrapMessage string) error {
+	var multiErr *multierror.Error
+	groupErrs := map[*FeatureGroup]*GroupError{}
+
+	for _, f := range features {
+		err := errs[f]
+		if err == nil {
+			continue
+		}
+
+		if f.group == nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%s. cause: %w", wrapMessage, err))
+
+			continue
+		}
+
+		groupErr, found := groupErrs[f.group]
+		if !found {
+			groupErr = &GroupError{Group: f.group.name, err: &multierror.Error{}}
+			groupErrs[f.group] = groupErr
+			multiErr = multierror.Append(multiErr, groupErr)
+			f.group.mu.Lock()
+			f.group.err = groupErr
+			f.group.mu.Unlock()
+		}
+		groupErr.Features = append(groupErr.Features, f.Name)
+		groupErr.err = multierror.Append(groupErr.err, err)
+	}
+
+	return multiErr.ErrorOrNil()
+}
@@ -0,0 +1,168 @@
+package feature_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature groups", func() {
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+	})
+
+	create := func(fb interface {
+		Create() (*feature.Feature, error)
+	}) *feature.Feature {
+		f, err := fb.Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		return f
+	}
+
+	It("should resolve shared data once and make it available to all the features of the group", func(ctx context.Context) {
+		// given
+		resolved := 0
+		authNamespace := func(_ context.Context, _ client.Client) (string, error) {
+			resolved++
+
+			return "opendatahub-auth-provider", nil
+		}
+
+		var authNs []string
+		captureAuthNamespace := func(_ context.Context, f *feature.Feature) error {
+			ns, err := feature.Get[string](f, "AuthNamespace")
+			authNs = append(authNs, ns)
+
+			return err
+		}
+
+		extAuthz := feature.Define("mesh-control-plane-external-authz").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			PreConditions(captureAuthNamespace)
+		policies := feature.Define("authorization-policies").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			PreConditions(captureAuthNamespace)
+		feature.Group("authorino").
+			WithData(feature.Entry("AuthNamespace", authNamespace)).
+			Add(extAuthz, policies)
+
+		// when
+		Expect(create(extAuthz).Apply(ctx)).To(Succeed())
+		Expect(create(policies).Apply(ctx)).To(Succeed())
+
+		// then
+		Expect(resolved).To(Equal(1))
+		Expect(authNs).To(Equal([]string{"opendatahub-auth-provider", "opendatahub-auth-provider"}))
+	})
+
+	It("should not apply features of a disabled group", func(ctx context.Context) {
+		// given
+		applied := false
+		fb := feature.Define("mesh-lightweight-auth").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			PreConditions(func(_ context.Context, _ *feature.Feature) error {
+				applied = true
+
+				return nil
+			})
+		feature.Group("lightweight-auth").
+			EnabledWhen(func(_ context.Context, _ *feature.Feature) (bool, error) {
+				return false, nil
+			}).
+			Add(fb)
+
+		// when
+		Expect(create(fb).Apply(ctx)).To(Succeed())
+
+		// then
+		Expect(applied).To(BeFalse())
+		trackers := &featurev1.FeatureTrackerList{}
+		Expect(cli.List(ctx, trackers)).To(Succeed())
+		Expect(trackers.Items).To(BeEmpty())
+	})
+
+	It("should combine failures of the features of the group into a single error", func(ctx context.Context) {
+		// given
+		errMissingOperator := errors.New("authorino-operator not installed")
+		failing := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return false, errMissingOperator
+		}
+
+		handler := feature.ComponentFeaturesHandler("kserve", "opendatahub", func(registry feature.FeaturesRegistry) error {
+			return registry.AddGroup(feature.Group("authorino").Add(
+				feature.Define("mesh-control-plane-external-authz").EnabledWhen(failing),
+				feature.Define("authorization-policies").EnabledWhen(failing),
+			))
+		}).UsingConfig(&rest.Config{Host: "https://localhost:6443"})
+
+		// when
+		err := handler.Apply(ctx)
+
+		// then
+		var groupErr *feature.GroupError
+		Expect(errors.As(err, &groupErr)).To(BeTrue())
+		Expect(groupErr.Group).To(Equal("authorino"))
+		Expect(groupErr.Features).To(ConsistOf("mesh-control-plane-external-authz", "authorization-policies"))
+		Expect(errors.Is(err, errMissingOperator)).To(BeTrue())
+		var multiErr *multierror.Error
+		Expect(errors.As(err, &multiErr)).To(BeTrue())
+		Expect(multiErr.Errors).To(HaveLen(1))
+	})
+
+	It("should report the outcome of each group of the handler", func(ctx context.Context) {
+		// given
+		errMissingOperator := errors.New("authorino-operator not installed")
+		failing := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return false, errMissingOperator
+		}
+		disabled := func(_ context.Context, _ *feature.Feature) (bool, error) {
+			return false, nil
+		}
+
+		handler := feature.ComponentFeaturesHandler("kserve", "opendatahub", func(registry feature.FeaturesRegistry) error {
+			return registry.AddGroup(
+				feature.Group("authorino").Add(
+					feature.Define("mesh-control-plane-external-authz").EnabledWhen(failing),
+				),
+				feature.Group("lightweight-auth").EnabledWhen(disabled).Add(
+					feature.Define("mesh-lightweight-auth"),
+				),
+			)
+		}).UsingClient(cli)
+
+		// when
+		_ = handler.Apply(ctx)
+
+		// then
+		results := handler.GroupResults()
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Group).To(Equal("authorino"))
+		Expect(results[0].Disabled).To(BeFalse())
+		Expect(results[0].Err).To(HaveField("Features", ConsistOf("mesh-control-plane-external-authz")))
+		Expect(results[1].Group).To(Equal("lightweight-auth"))
+		Expect(results[1].Disabled).To(BeTrue())
+		Expect(results[1].Err).To(BeNil())
+	})
+})
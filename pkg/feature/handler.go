@@ -3,16 +3,24 @@ package feature
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/go-multierror"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregate"
 )
 
+// ErrFeatureNotDefined is returned when the feature to be applied is not defined by any of the providers of the handler.
+var ErrFeatureNotDefined = errors.New("feature is not defined")
+
 type featuresHandler interface {
 	Apply(ctx context.Context) error
 	Delete(ctx context.Context) error
@@ -20,6 +28,7 @@ type featuresHandler interface {
 
 type FeaturesRegistry interface {
 	Add(builders ...*featureBuilder) error
+	AddGroup(groups ...*FeatureGroup) error
 }
 
 var _ featuresHandler = (*FeaturesHandler)(nil)
@@ -31,27 +40,99 @@ type FeaturesHandler struct {
 	source            featurev1.Source
 	features          []*Feature
 	featuresProviders []FeaturesProvider
+	overrides         []dsciv1.FeatureOverride
+	overridesSpec     any
+	profile           ProfileResolver
+	maintenanceWindow *dsciv1.MaintenanceWindow
+	policyExemptions  *dsciv1.PolicyExemptions
+	costAttribution   *dsciv1.CostAttribution
+	imageMirrors      []dsciv1.ImageMirror
+	namespaceDeletion *dsciv1.NamespaceDeletion
+	capability        string
+	gates             featuregate.Gates
+	config            *rest.Config
+	client            client.Client
+	subscriptions     *cluster.SubscriptionLookup
+	poller            *Poller
+	canaryNamespace   string
 }
 
 var _ FeaturesRegistry = (*FeaturesHandler)(nil)
 
 // Add loads features defined by passed builders and adds to internal list which is then used to Apply on the cluster.
 // It also makes sure that both TargetNamespace and Source are added to the feature before it's `Create()`ed.
+// Features which are not part of the profile selected in DSCI spec.profile are disabled.
+// Features overridden in DSCI spec.featureOverrides are enabled based on the provided expression instead.
 func (fh *FeaturesHandler) Add(builders ...*featureBuilder) error {
 	var multiErr *multierror.Error
 
 	for i := range builders {
 		fb := builders[i]
+		if fh.profile != nil && !fh.profile(fb.featureName) {
+			fb.EnabledWhen(excludedByProfile)
+		}
+		for _, override := range fh.overrides {
+			if override.Name == fb.featureName {
+				fb.EnabledWhen(EnabledWhenExpression(override.EnabledWhen, fh.overridesSpec))
+			}
+		}
+		if fh.config != nil && fb.config == nil {
+			fb.UsingConfig(fh.config)
+		}
+		if fh.client != nil && fb.config == nil && fb.client == nil {
+			fb.UsingClient(fh.client)
+		}
+		if fh.poller != nil {
+			fb.UsingPoller(fh.poller)
+		}
 		feature, err := fb.TargetNamespace(fh.targetNamespace).
 			Source(fh.source).
+			MaintenanceWindow(fh.maintenanceWindow).
+			PolicyExemptions(fh.policyExemptions).
+			CostAttribution(fh.costAttribution).
+			ImageMirrors(fh.imageMirrors).
+			NamespaceDeletion(fh.namespaceDeletion).
+			withCapability(fh.capability).
+			withSubscriptionLookup(fh.subscriptions).
 			Create()
 		multiErr = multierror.Append(multiErr, err)
+		if err == nil && fh.canaryNamespace != "" {
+			feature.canary, err = fb.createCanary(fh.canaryNamespace)
+			multiErr = multierror.Append(multiErr, err)
+		}
 		fh.features = append(fh.features, feature)
 	}
 
 	return multiErr.ErrorOrNil()
 }
 
+// AddGroup loads features of the groups, the same way Add does for standalone features.
+func (fh *FeaturesHandler) AddGroup(groups ...*FeatureGroup) error {
+	var multiErr *multierror.Error
+
+	for _, group := range groups {
+		multiErr = multierror.Append(multiErr, fh.Add(group.builders...))
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+// GroupResults returns the outcome of each FeatureGroup of the handled features in the last Apply or Delete,
+// in the order the groups have been added.
+func (fh *FeaturesHandler) GroupResults() []GroupResult {
+	var results []GroupResult
+	seen := map[*FeatureGroup]bool{}
+	for _, f := range fh.features {
+		if f.group == nil || seen[f.group] {
+			continue
+		}
+		seen[f.group] = true
+		results = append(results, f.group.result())
+	}
+
+	return results
+}
+
 func (fh *FeaturesHandler) Apply(ctx context.Context) error {
 	fh.features = make([]*Feature, 0)
 
@@ -61,35 +142,209 @@ func (fh *FeaturesHandler) Apply(ctx context.Context) error {
 		}
 	}
 
-	var multiErr *multierror.Error
+	features, errOrder := inDependencyOrder(fh.features)
+	if errOrder != nil {
+		return errOrder
+	}
+
+	if fh.gates.Enabled(featuregate.ParallelFeatureApply) {
+		return applyInParallel(ctx, features)
+	}
+
+	errs := make(map[*Feature]error, len(features))
+	for _, f := range features {
+		errs[f] = applyWithCanary(ctx, f)
+	}
+
+	return combineErrors(features, errs, "failed applying FeatureHandler features")
+}
+
+// applyInParallel applies features in stages, where each stage holds features whose dependencies have been applied
+// in the previous stages. Features of the same stage are applied concurrently. As with sequential apply, failure
+// of a feature does not prevent features depending on it from being applied.
+func applyInParallel(ctx context.Context, features []*Feature) error {
+	var mu sync.Mutex
+	errs := make(map[*Feature]error, len(features))
+
+	for _, stage := range inDependencyStages(features) {
+		var wg sync.WaitGroup
+		for _, f := range stage {
+			wg.Add(1)
+			go func(f *Feature) {
+				defer wg.Done()
+				applyErr := applyWithCanary(ctx, f)
+				mu.Lock()
+				errs[f] = applyErr
+				mu.Unlock()
+			}(f)
+		}
+		wg.Wait()
+	}
+
+	return combineErrors(features, errs, "failed applying FeatureHandler features")
+}
+
+// inDependencyStages groups features, already sorted by inDependencyOrder, by the length of the longest chain of their dependencies.
+func inDependencyStages(ordered []*Feature) [][]*Feature {
+	stageOf := make(map[string]int, len(ordered))
+	var stages [][]*Feature
+
+	for _, f := range ordered {
+		stage := 0
+		for _, dependency := range f.dependsOn {
+			if dependencyStage, found := stageOf[dependency]; found {
+				stage = max(stage, dependencyStage+1)
+			}
+		}
+		stageOf[f.Name] = stage
+
+		if stage == len(stages) {
+			stages = append(stages, nil)
+		}
+		stages[stage] = append(stages[stage], f)
+	}
+
+	return stages
+}
+
+// ApplyOnly applies the single feature of the given name out of the features defined by the providers of the handler,
+// e.g. to re-apply a feature which drifted without applying all the others. Features it depends on are not applied.
+func (fh *FeaturesHandler) ApplyOnly(ctx context.Context, featureName string) error {
+	fh.features = make([]*Feature, 0)
+
+	for _, featuresProvider := range fh.featuresProviders {
+		if err := featuresProvider(fh); err != nil {
+			return fmt.Errorf("failed adding features to the handler. cause: %w", err)
+		}
+	}
+
 	for _, f := range fh.features {
-		if applyErr := f.Apply(ctx); applyErr != nil {
-			multiErr = multierror.Append(multiErr, fmt.Errorf("failed applying FeatureHandler features. cause: %w", applyErr))
+		if f.Name == featureName {
+			return f.Apply(ctx)
 		}
 	}
 
-	return multiErr.ErrorOrNil()
+	return fmt.Errorf("%w: %s", ErrFeatureNotDefined, featureName)
 }
 
-// Delete executes registered clean-up tasks for handled Features in the opposite order they were initiated.
-// This approach assumes that Features are either instantiated in the correct sequence or are self-contained.
+// MissingPermissions checks permissions declared by the enabled features of the handler, see RequiresPermissions,
+// and returns the ones the operator has not been granted. Nothing is applied to the cluster.
+func (fh *FeaturesHandler) MissingPermissions(ctx context.Context) ([]MissingPermission, error) {
+	fh.features = make([]*Feature, 0)
+
+	for _, featuresProvider := range fh.featuresProviders {
+		if err := featuresProvider(fh); err != nil {
+			return nil, fmt.Errorf("failed adding features to the handler. cause: %w", err)
+		}
+	}
+
+	var missing []MissingPermission
+	for _, f := range fh.features {
+		enabled, err := f.Enabled(ctx, f)
+		if err != nil {
+			return nil, fmt.Errorf("failed checking if feature %s is enabled: %w", f.Name, err)
+		}
+		if !enabled {
+			continue
+		}
+
+		missingOfFeature, err := f.MissingPermissions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		missing = append(missing, missingOfFeature...)
+	}
+
+	return missing, nil
+}
+
+// Delete executes registered clean-up tasks for handled Features in the opposite order they were applied.
+// Features declaring dependencies using DependsOn are cleaned up before the features they depend on,
+// otherwise the reverse order of instantiation is used.
 func (fh *FeaturesHandler) Delete(ctx context.Context) error {
+	_, err := fh.DeleteWithReport(ctx)
+
+	return err
+}
+
+// DeleteWithReport works as Delete and additionally reports what happened to the resources of the handled features,
+// see Feature.CleanupWithReport.
+func (fh *FeaturesHandler) DeleteWithReport(ctx context.Context) (RemovalReport, error) {
 	fh.features = make([]*Feature, 0)
+	report := RemovalReport{}
 
 	for _, featuresProvider := range fh.featuresProviders {
 		if err := featuresProvider(fh); err != nil {
-			return fmt.Errorf("delete phase failed when wiring Feature instances in FeatureHandler.Delete. cause: %w", err)
+			return report, fmt.Errorf("delete phase failed when wiring Feature instances in FeatureHandler.Delete. cause: %w", err)
 		}
 	}
 
-	var multiErr *multierror.Error
-	for i := len(fh.features) - 1; i >= 0; i-- {
-		if cleanupErr := fh.features[i].Cleanup(ctx); cleanupErr != nil {
-			multiErr = multierror.Append(multiErr, fmt.Errorf("failed executing cleanup in FeatureHandler. cause: %w", cleanupErr))
+	features, errOrder := inDependencyOrder(fh.features)
+	if errOrder != nil {
+		return report, errOrder
+	}
+
+	errs := make(map[*Feature]error, len(features))
+	reversed := make([]*Feature, 0, len(features))
+	for i := len(features) - 1; i >= 0; i-- {
+		featureReport, errCleanup := features[i].CleanupWithReport(ctx)
+		report.Merge(featureReport)
+		if features[i].canary != nil {
+			errCleanup = multierror.Append(errCleanup, removeCanary(ctx, features[i].canary)).ErrorOrNil()
 		}
+		errs[features[i]] = errCleanup
+		reversed = append(reversed, features[i])
 	}
 
-	return multiErr.ErrorOrNil()
+	return report, combineErrors(reversed, errs, "failed executing cleanup in FeatureHandler")
+}
+
+// inDependencyOrder sorts features topologically based on their declared dependencies, preserving
+// the order of registration for features which are independent of each other.
+func inDependencyOrder(features []*Feature) ([]*Feature, error) {
+	byName := make(map[string]*Feature, len(features))
+	for _, f := range features {
+		byName[f.Name] = f
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := make(map[string]int, len(features))
+	ordered := make([]*Feature, 0, len(features))
+
+	var visit func(f *Feature, path []string) error
+	visit = func(f *Feature, path []string) error {
+		switch state[f.Name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular dependency between features: %v", append(path, f.Name))
+		}
+
+		state[f.Name] = visiting
+		for _, dependency := range f.dependsOn {
+			if dependent, found := byName[dependency]; found {
+				if err := visit(dependent, append(path, f.Name)); err != nil {
+					return err
+				}
+			}
+		}
+		state[f.Name] = visited
+		ordered = append(ordered, f)
+
+		return nil
+	}
+
+	for _, f := range features {
+		if err := visit(f, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
 }
 
 // FeaturesProvider is a function which allow to define list of features
@@ -97,10 +352,22 @@ func (fh *FeaturesHandler) Delete(ctx context.Context) error {
 type FeaturesProvider func(registry FeaturesRegistry) error
 
 func ClusterFeaturesHandler(dsci *dsciv1.DSCInitialization, def ...FeaturesProvider) *FeaturesHandler {
+	gates, _ := featuregate.Resolve(dsci.Spec.FeatureGates) // ignored gates are reported by DSCInitialization controller
+
 	return &FeaturesHandler{
 		targetNamespace:   dsci.Spec.ApplicationsNamespace,
 		source:            featurev1.Source{Type: featurev1.DSCIType, Name: dsci.Name},
 		featuresProviders: def,
+		overrides:         dsci.Spec.FeatureOverrides,
+		overridesSpec:     &dsci.Spec,
+		profile:           ResolveProfile(dsci.Spec.Profile),
+		maintenanceWindow: dsci.Spec.MaintenanceWindow,
+		policyExemptions:  dsci.Spec.PolicyExemptions,
+		costAttribution:   CostAttributionOf(dsci.Spec.MetadataDefaults),
+		imageMirrors:      dsci.Spec.ImageMirrors,
+		namespaceDeletion: dsci.Spec.NamespaceDeletion,
+		gates:             gates,
+		canaryNamespace:   canaryNamespaceOf(dsci.Spec.CanaryRollout),
 	}
 }
 
@@ -109,9 +376,87 @@ func ComponentFeaturesHandler(componentName, targetNamespace string, def ...Feat
 		targetNamespace:   targetNamespace,
 		source:            featurev1.Source{Type: featurev1.ComponentType, Name: componentName},
 		featuresProviders: def,
+		capability:        componentName,
 	}
 }
 
+// WithMaintenanceWindow defines when changes to disruptive features managed by the handler can be applied.
+func (fh *FeaturesHandler) WithMaintenanceWindow(window *dsciv1.MaintenanceWindow) *FeaturesHandler {
+	fh.maintenanceWindow = window
+
+	return fh
+}
+
+// WithPolicyExemptions defines labels and annotations added to resources created by features managed by the handler.
+func (fh *FeaturesHandler) WithPolicyExemptions(exemptions *dsciv1.PolicyExemptions) *FeaturesHandler {
+	fh.policyExemptions = exemptions
+
+	return fh
+}
+
+// WithMetadataDefaults defines metadata, such as cost-attribution labels, added to namespaces and workloads created by features
+// managed by the handler.
+func (fh *FeaturesHandler) WithMetadataDefaults(defaults *dsciv1.MetadataDefaults) *FeaturesHandler {
+	fh.costAttribution = CostAttributionOf(defaults)
+
+	return fh
+}
+
+// WithImageMirrors defines mirrors which image references in manifests of features managed by the handler are rewritten with.
+func (fh *FeaturesHandler) WithImageMirrors(mirrors []dsciv1.ImageMirror) *FeaturesHandler {
+	fh.imageMirrors = mirrors
+
+	return fh
+}
+
+// WithNamespaceDeletion defines how namespaces owned by features managed by the handler are deleted when the features are removed.
+func (fh *FeaturesHandler) WithNamespaceDeletion(deletion *dsciv1.NamespaceDeletion) *FeaturesHandler {
+	fh.namespaceDeletion = deletion
+
+	return fh
+}
+
+// WithFeatureGates turns experimental behaviors of the handler on or off.
+func (fh *FeaturesHandler) WithFeatureGates(gates featuregate.Gates) *FeaturesHandler {
+	fh.gates = gates
+
+	return fh
+}
+
+// WithSubscriptionLookup makes features managed by the handler check installed operators using the given lookup,
+// which can be shared with other handlers applied in the same reconcile pass to list Subscriptions only once.
+func (fh *FeaturesHandler) WithSubscriptionLookup(subscriptions *cluster.SubscriptionLookup) *FeaturesHandler {
+	fh.subscriptions = subscriptions
+
+	return fh
+}
+
+// UsingConfig makes features which do not define their own rest.Config use the given one. Useful for testing.
+func (fh *FeaturesHandler) UsingConfig(config *rest.Config) *FeaturesHandler {
+	fh.config = config
+
+	return fh
+}
+
+// UsingClient makes features which do not define their own rest.Config or client use the given client.
+func (fh *FeaturesHandler) UsingClient(cli client.Client) *FeaturesHandler {
+	fh.client = cli
+
+	return fh
+}
+
+// UsingPoller makes features wait for their conditions using the given poller, e.g. one driven by a fake clock.
+func (fh *FeaturesHandler) UsingPoller(poller *Poller) *FeaturesHandler {
+	fh.poller = poller
+
+	return fh
+}
+
+// Features returns features loaded by the handler during the last Apply or Delete.
+func (fh *FeaturesHandler) Features() []*Feature {
+	return fh.features
+}
+
 // EmptyFeaturesHandler is noop handler so that we can avoid nil checks in the code and safely call Apply/Delete methods.
 var EmptyFeaturesHandler = &FeaturesHandler{
 	features:          []*Feature{},
@@ -123,6 +468,8 @@ var EmptyFeaturesHandler = &FeaturesHandler{
 type HandlerWithReporter[T client.Object] struct {
 	handler  *FeaturesHandler
 	reporter *status.Reporter[T]
+	// groupCondition determines the condition reported for each FeatureGroup, nil reports the handler as a whole only.
+	groupCondition func(result GroupResult) status.SaveStatusFunc[T]
 }
 
 var _ featuresHandler = (*HandlerWithReporter[client.Object])(nil)
@@ -134,18 +481,51 @@ func NewHandlerWithReporter[T client.Object](handler *FeaturesHandler, reporter
 	}
 }
 
+// WithGroupConditions additionally reports the combined outcome of each FeatureGroup of the handler, e.g. as a condition
+// of the source resource, so that users see the state of related features as one.
+func (h *HandlerWithReporter[T]) WithGroupConditions(groupCondition func(result GroupResult) status.SaveStatusFunc[T]) *HandlerWithReporter[T] {
+	h.groupCondition = groupCondition
+
+	return h
+}
+
 func (h HandlerWithReporter[T]) Apply(ctx context.Context) error {
 	applyErr := h.handler.Apply(ctx)
 	_, reportErr := h.reporter.ReportCondition(ctx, applyErr)
 	// We could have failed during Apply phase as well as during reporting.
 	// We should return both errors to the caller.
-	return multierror.Append(applyErr, reportErr).ErrorOrNil()
+	return multierror.Append(applyErr, reportErr, h.reportGroups(ctx, false)).ErrorOrNil()
 }
 
 func (h HandlerWithReporter[T]) Delete(ctx context.Context) error {
-	deleteErr := h.handler.Delete(ctx)
+	_, err := h.DeleteWithReport(ctx)
+
+	return err
+}
+
+// DeleteWithReport works as Delete and additionally returns the report of removed resources, see FeaturesHandler.DeleteWithReport.
+func (h HandlerWithReporter[T]) DeleteWithReport(ctx context.Context) (RemovalReport, error) {
+	removal, deleteErr := h.handler.DeleteWithReport(ctx)
 	_, reportErr := h.reporter.ReportCondition(ctx, deleteErr)
 	// We could have failed during Delete phase as well as during reporting.
 	// We should return both errors to the caller.
-	return multierror.Append(deleteErr, reportErr).ErrorOrNil()
+	return removal, multierror.Append(deleteErr, reportErr, h.reportGroups(ctx, true)).ErrorOrNil()
+}
+
+// reportGroups reports the outcome of each FeatureGroup, groups whose features have been deleted are reported as disabled.
+func (h HandlerWithReporter[T]) reportGroups(ctx context.Context, deleted bool) error {
+	if h.groupCondition == nil {
+		return nil
+	}
+
+	var multiErr *multierror.Error
+	for _, result := range h.handler.GroupResults() {
+		if deleted && result.Err == nil {
+			result.Disabled = true
+		}
+		_, reportErr := h.reporter.Report(ctx, h.groupCondition(result))
+		multiErr = multierror.Append(multiErr, reportErr)
+	}
+
+	return multiErr.ErrorOrNil()
 }
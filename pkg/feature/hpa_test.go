@@ -0,0 +1,67 @@
+package feature_test
+
+import (
+	"context"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Waiting for HorizontalPodAutoscaler", func() {
+
+	autoscaler := func(status corev1.ConditionStatus, message string) *autoscalingv2.HorizontalPodAutoscaler {
+		return &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "istio-ingressgateway", Namespace: "istio-system"},
+			Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+				Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{
+					{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue},
+					{Type: autoscalingv2.ScalingActive, Status: status, Message: message},
+				},
+			},
+		}
+	}
+
+	defineFeature := func(hpa *autoscalingv2.HorizontalPodAutoscaler) *feature.Feature {
+		f, err := feature.Define("mesh-gateway-autoscaling").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = fake.NewClientBuilder().WithObjects(hpa).Build()
+
+		return f
+	}
+
+	It("should succeed once the autoscaler is active", func(ctx context.Context) {
+		// given
+		f := defineFeature(autoscaler(corev1.ConditionTrue, "the HPA was able to successfully calculate a replica count"))
+
+		// when
+		err := feature.WaitForHPAActive("istio-system", "istio-ingressgateway")(ctx, f)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report why the autoscaler is not active", func(ctx context.Context) {
+		// given
+		f := defineFeature(autoscaler(corev1.ConditionFalse, "the HPA was unable to compute the replica count: missing request for cpu"))
+		waitCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+		defer cancel()
+
+		// when
+		err := feature.WaitForHPAActive("istio-system", "istio-ingressgateway")(waitCtx, f)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("missing request for cpu")))
+	})
+})
@@ -0,0 +1,25 @@
+package feature
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnsupportedInstallModeError is returned for capabilities configuring namespaces which the operator has not been
+// installed for, see cluster.InstallMode.
+type UnsupportedInstallModeError struct {
+	watched   []string
+	unwatched []string
+}
+
+func NewUnsupportedInstallModeError(watched, unwatched []string) *UnsupportedInstallModeError {
+	return &UnsupportedInstallModeError{
+		watched:   watched,
+		unwatched: unwatched,
+	}
+}
+
+func (e *UnsupportedInstallModeError) Error() string {
+	return fmt.Sprintf("namespaces [%s] are not watched by the operator installed for namespaces [%s]",
+		strings.Join(e.unwatched, ", "), strings.Join(e.watched, ", "))
+}
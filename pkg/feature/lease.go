@@ -0,0 +1,214 @@
+package feature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// leaseDuration bounds how long the lease on the feature is valid without being renewed. It is renewed every leaseRenewInterval
+// while the feature is applied, so that it only expires when the operator instance holding it is gone.
+const (
+	leaseDuration      = 2 * time.Minute
+	leaseRenewInterval = leaseDuration / 4
+)
+
+// leaseHolder identifies the operator process in leases it holds, similarly to identities used for leader election.
+var leaseHolder = newLeaseHolder() //nolint:gochecknoglobals // Reason: identity is shared by all handlers of the process
+
+func newLeaseHolder() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "opendatahub-operator"
+	}
+
+	return hostname + "_" + string(uuid.NewUUID())
+}
+
+// featureLocks serializes apply and clean-up of the same feature within the operator process, keyed by FeatureTracker name.
+var featureLocks sync.Map //nolint:gochecknoglobals // Reason: locks have to be shared by all handlers of the process
+
+func lockFeature(trackerName string) func() {
+	mutex, _ := featureLocks.LoadOrStore(trackerName, &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock() //nolint:forcetypeassert // Reason: only mutexes are stored
+
+	return mutex.(*sync.Mutex).Unlock //nolint:forcetypeassert // Reason: only mutexes are stored
+}
+
+// ApplyInProgressError indicates that the feature is being applied by another apply pass, e.g. an overlapping reconcile
+// of a different operator instance. The apply should be retried once the lease expires or is released.
+type ApplyInProgressError struct {
+	featureName string
+	Holder      string
+	ExpiresAt   time.Time
+}
+
+func (e *ApplyInProgressError) Error() string {
+	return fmt.Sprintf("feature %q is being applied by %s until %s", e.featureName, e.Holder, e.ExpiresAt.Format(time.RFC3339))
+}
+
+// IsApplyInProgress checks if the error, possibly wrapped, is caused by the feature being applied concurrently.
+func IsApplyInProgress(err error) bool {
+	var inProgress *ApplyInProgressError
+
+	return errors.As(err, &inProgress)
+}
+
+// acquireLease takes the lease on the FeatureTracker for the operator instance, writing it in the same status update as the given one.
+// The lease is kept between apply passes of the instance, so when it is held long enough already and the status has been written
+// recently, the update is staged instead, to be written together with the outcome of the pass, which is then reported as true.
+func (f *Feature) acquireLease(ctx context.Context, update status.SaveStatusFunc[*featurev1.FeatureTracker]) (bool, error) {
+	if err := f.leaseConflict(f.tracker); err != nil {
+		return false, err
+	}
+
+	if holdsLease(f.tracker, leaseDuration/2) && f.statusWrittenRecently() {
+		f.stageStatus(update)
+
+		return true, nil
+	}
+
+	var saved *featurev1.FeatureTracker
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := getFeatureTracker(ctx, f.Client, f.Name, f.TargetNamespace)
+		if err != nil {
+			return err
+		}
+
+		if errConflict := f.leaseConflict(current); errConflict != nil {
+			return errConflict
+		}
+
+		update(current)
+		renewLease(current)
+
+		if errUpdate := f.Client.Status().Update(ctx, current); errUpdate != nil {
+			return errUpdate
+		}
+		saved = current
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	trackerStatusWrites.Store(saved.Name, time.Now())
+
+	return false, f.attachTracker(saved)
+}
+
+// keepLease renews the lease every leaseRenewInterval until the returned func is called, which returns the status of the tracker
+// as written by the last renewal, nil when the lease has not been renewed. The staged update, if any, is written together with
+// the lease once the apply pass lasts longer than trackerStatusInterval, so that a long apply is not shown in its previous state.
+func (f *Feature) keepLease(ctx context.Context, staged status.SaveStatusFunc[*featurev1.FeatureTracker]) func() *featurev1.FeatureTrackerStatus {
+	tracker := f.tracker.DeepCopy()
+	done := make(chan struct{})
+	renewed := make(chan *featurev1.FeatureTrackerStatus, 1)
+
+	go func() {
+		var last *featurev1.FeatureTrackerStatus
+		defer func() { renewed <- last }()
+
+		ticker := time.NewTicker(leaseRenewInterval)
+		defer ticker.Stop()
+
+		var stagedDue <-chan time.Time
+		if staged != nil {
+			stagedTimer := time.NewTimer(trackerStatusInterval)
+			defer stagedTimer.Stop()
+			stagedDue = stagedTimer.C
+		}
+
+		renew := func(update status.SaveStatusFunc[*featurev1.FeatureTracker]) {
+			saved, err := status.UpdateWithRetry(ctx, f.Client, tracker, func(saved *featurev1.FeatureTracker) {
+				if holdsLease(saved, 0) {
+					update(saved)
+					renewLease(saved)
+				}
+			})
+			if err != nil {
+				f.Log.Error(err, "failed renewing lease of feature", "feature", f.Name)
+
+				return
+			}
+			trackerStatusWrites.Store(saved.Name, time.Now())
+			last = saved.Status.DeepCopy()
+		}
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-stagedDue:
+				stagedDue = nil
+				renew(staged)
+			case <-ticker.C:
+				renew(func(*featurev1.FeatureTracker) {})
+			}
+		}
+	}()
+
+	return func() *featurev1.FeatureTrackerStatus {
+		close(done)
+
+		return <-renewed
+	}
+}
+
+// stillHoldsLease checks if the operator instance still holds the lease, i.e. it has not been taken over after expiring.
+// Results of a pass which lost its lease must not be reported.
+func (f *Feature) stillHoldsLease(ctx context.Context) (bool, error) {
+	tracker, err := getFeatureTracker(ctx, f.Client, f.Name, f.TargetNamespace)
+	if err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return holdsLease(tracker, 0), nil
+}
+
+func (f *Feature) leaseConflict(tracker *featurev1.FeatureTracker) error {
+	lease := tracker.Status.ApplyLease
+	if lease == nil || lease.Holder == leaseHolder || !time.Now().Before(lease.ExpiresAt.Time) {
+		return nil
+	}
+
+	return &ApplyInProgressError{featureName: f.Name, Holder: lease.Holder, ExpiresAt: lease.ExpiresAt.Time}
+}
+
+func (f *Feature) attachTracker(tracker *featurev1.FeatureTracker) error {
+	if errGVK := ensureGVKSet(tracker, f.Client.Scheme()); errGVK != nil {
+		return errGVK
+	}
+
+	f.tracker = tracker
+
+	return nil
+}
+
+// holdsLease checks if the lease on the tracker is held by the operator instance for at least the given time.
+func holdsLease(tracker *featurev1.FeatureTracker, validFor time.Duration) bool {
+	lease := tracker.Status.ApplyLease
+
+	return lease != nil && lease.Holder == leaseHolder && time.Until(lease.ExpiresAt.Time) > validFor
+}
+
+func renewLease(tracker *featurev1.FeatureTracker) {
+	tracker.Status.ApplyLease = &featurev1.ApplyLease{
+		Holder:    leaseHolder,
+		ExpiresAt: metav1.NewTime(time.Now().Add(leaseDuration).UTC().Truncate(time.Second)),
+	}
+}
@@ -0,0 +1,139 @@
+package feature
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/maintenance"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// PendingMaintenanceWindowError indicates that changes to a disruptive feature are postponed until the maintenance window opens.
+type PendingMaintenanceWindowError struct {
+	featureName string
+	OpensAt     time.Time
+}
+
+func NewPendingMaintenanceWindowError(featureName string, opensAt time.Time) *PendingMaintenanceWindowError {
+	return &PendingMaintenanceWindowError{
+		featureName: featureName,
+		OpensAt:     opensAt,
+	}
+}
+
+func (e *PendingMaintenanceWindowError) Error() string {
+	if e.OpensAt.IsZero() {
+		return fmt.Sprintf("changes to feature %q are pending, maintenance window never opens", e.featureName)
+	}
+
+	return fmt.Sprintf("changes to feature %q are pending until maintenance window opens at %s", e.featureName, e.OpensAt.Format(time.RFC3339))
+}
+
+// PendingMaintenanceWindowOnly checks if the error is caused solely by changes postponed until the maintenance window opens,
+// as opposed to actual failures. It returns the pending change which is going to be applied first.
+func PendingMaintenanceWindowOnly(err error) (*PendingMaintenanceWindowError, bool) {
+	switch typedErr := err.(type) { //nolint:errorlint // Reason: wrapped errors are unwrapped recursively
+	case nil:
+		return nil, false
+	case *PendingMaintenanceWindowError:
+		return typedErr, true
+	case *multierror.Error:
+		var first *PendingMaintenanceWindowError
+		for _, nestedErr := range typedErr.Errors {
+			pending, ok := PendingMaintenanceWindowOnly(nestedErr)
+			if !ok {
+				return nil, false
+			}
+			if first == nil || pending.OpensAt.Before(first.OpensAt) {
+				first = pending
+			}
+		}
+
+		return first, first != nil
+	default:
+		return PendingMaintenanceWindowOnly(errors.Unwrap(err))
+	}
+}
+
+// ensureWithinMaintenanceWindow postpones changes to already applied disruptive features until the maintenance window opens.
+// Changes are detected by comparing the digest of feature data with the one recorded when the feature has been last applied.
+func (f *Feature) ensureWithinMaintenanceWindow() error {
+	if !f.disruptive || f.maintenanceWindow == nil {
+		return nil
+	}
+
+	applied, found := f.tracker.GetAnnotations()[annotations.AppliedDataDigest]
+	if !found {
+		return nil
+	}
+
+	digest, err := f.dataDigest()
+	if err != nil {
+		return err
+	}
+
+	if digest == applied {
+		return nil
+	}
+
+	window, err := maintenance.NewWindow(f.maintenanceWindow)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if window.IsOpen(now) {
+		return nil
+	}
+
+	return NewPendingMaintenanceWindowError(f.Name, window.NextOpening(now))
+}
+
+// recordAppliedData stores the digest of the data disruptive feature has been applied with on its FeatureTracker.
+func (f *Feature) recordAppliedData(ctx context.Context) error {
+	if !f.disruptive {
+		return nil
+	}
+
+	digest, err := f.dataDigest()
+	if err != nil {
+		return err
+	}
+
+	if f.tracker.GetAnnotations()[annotations.AppliedDataDigest] == digest {
+		return nil
+	}
+
+	original := f.tracker.DeepCopy()
+	trackerAnnotations := f.tracker.GetAnnotations()
+	if trackerAnnotations == nil {
+		trackerAnnotations = map[string]string{}
+	}
+	trackerAnnotations[annotations.AppliedDataDigest] = digest
+	f.tracker.SetAnnotations(trackerAnnotations)
+
+	if errPatch := f.Client.Patch(ctx, f.tracker, client.MergeFrom(original)); errPatch != nil {
+		return fmt.Errorf("failed recording applied data of feature %s: %w", f.Name, errPatch)
+	}
+
+	return nil
+}
+
+func (f *Feature) dataDigest() (string, error) {
+	data, err := json.Marshal(f.data)
+	if err != nil {
+		return "", fmt.Errorf("failed computing digest of feature %s data: %w", f.Name, err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,54 @@
+package feature_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pending maintenance window", func() {
+
+	opensAt := time.Date(2024, 5, 4, 2, 0, 0, 0, time.UTC)
+
+	It("should detect changes pending within wrapped errors", func() {
+		// given
+		err := multierror.Append(nil,
+			fmt.Errorf("failed applying FeatureHandler features. cause: %w", feature.NewPendingMaintenanceWindowError("first", opensAt.Add(time.Hour))),
+			fmt.Errorf("failed applying FeatureHandler features. cause: %w", feature.NewPendingMaintenanceWindowError("second", opensAt)),
+		)
+
+		// when
+		pending, isPending := feature.PendingMaintenanceWindowOnly(err)
+
+		// then
+		Expect(isPending).To(BeTrue())
+		Expect(pending.OpensAt).To(Equal(opensAt))
+	})
+
+	It("should not treat actual failures as pending", func() {
+		// given
+		err := multierror.Append(nil,
+			feature.NewPendingMaintenanceWindowError("first", opensAt),
+			errors.New("failed applying manifests"),
+		)
+
+		// when
+		_, isPending := feature.PendingMaintenanceWindowOnly(err)
+
+		// then
+		Expect(isPending).To(BeFalse())
+	})
+
+	It("should not treat success as pending", func() {
+		_, isPending := feature.PendingMaintenanceWindowOnly(nil)
+
+		Expect(isPending).To(BeFalse())
+	})
+})
@@ -8,7 +8,13 @@ import (
 
 type Builder struct {
 	manifestLocation fs.FS
-	paths            []string
+	includes         []include
+}
+
+// include is a path of manifests along with the namespace they target, empty for the target namespace of the feature.
+type include struct {
+	path      string
+	namespace string
 }
 
 // Location sets the root file system from which manifest paths are loaded.
@@ -18,25 +24,34 @@ func Location(fsys fs.FS) *Builder {
 
 // Include loads manifests from the provided paths.
 func (b *Builder) Include(paths ...string) *Builder {
-	b.paths = append(b.paths, paths...)
+	return b.IncludeInNamespace("", paths...)
+}
+
+// IncludeInNamespace loads manifests from the provided paths, which target the given namespace instead of the target namespace
+// of the feature, e.g. resources of the authorization provider. The namespace is a template rendered using the feature data,
+// e.g. "{{ .AuthNamespace }}", and it is available to the manifests as TargetNamespace.
+func (b *Builder) IncludeInNamespace(namespace string, paths ...string) *Builder {
+	for _, path := range paths {
+		b.includes = append(b.includes, include{path: path, namespace: namespace})
+	}
+
 	return b
 }
 
 func (b *Builder) Create() ([]resource.Applier, error) {
 	var manifests []*Manifest
-	for _, path := range b.paths {
-		currManifests, err := LoadManifests(b.manifestLocation, path)
+	for _, included := range b.includes {
+		currManifests, err := LoadManifests(b.manifestLocation, included.path)
 		if err != nil {
 			return nil, err // TODO wrap
 		}
 
-		manifests = append(manifests, currManifests...)
-	}
+		for _, m := range currManifests {
+			m.namespace = included.namespace
+		}
 
-	resources := make([]resource.Applier, 0, len(manifests))
-	for _, m := range manifests {
-		resources = append(resources, createApplier(m))
+		manifests = append(manifests, currManifests...)
 	}
 
-	return resources, nil
+	return []resource.Applier{createApplier(manifests...)}, nil
 }
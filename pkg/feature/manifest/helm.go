@@ -0,0 +1,381 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/conversion"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+)
+
+const (
+	ociScheme = "oci://"
+	// helmHookAnnotation marks resources Helm creates at lifecycle events of the release instead of installing them.
+	helmHookAnnotation = "helm.sh/hook"
+	// defaultReleaseName is used as .Release.Name of charts unless ReleaseName is set.
+	defaultReleaseName = "opendatahub"
+)
+
+// ChartValues computes values the chart is rendered with from the data of the feature, which is populated by its data providers.
+type ChartValues func(data map[string]any) (map[string]any, error)
+
+// ValuesFromData uses the value stored under the key in the feature data as chart values.
+func ValuesFromData(key string) ChartValues {
+	return func(data map[string]any) (map[string]any, error) {
+		value, found := data[key]
+		if !found {
+			return nil, fmt.Errorf("key %s holding chart values not found in feature data", key)
+		}
+
+		values, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid type %T of chart values stored under key %s", value, key)
+		}
+
+		return values, nil
+	}
+}
+
+// StaticValues renders the chart with the same values regardless of the feature data.
+func StaticValues(values map[string]any) ChartValues {
+	return func(map[string]any) (map[string]any, error) {
+		return values, nil
+	}
+}
+
+// ChartBuilder creates an Applier rendering the Helm chart in-process, without installing a release, and applying rendered
+// resources the same way as resources defined in manifests.
+type ChartBuilder struct {
+	ref         string
+	version     string
+	releaseName string
+	values      ChartValues
+	fsys        fs.FS
+}
+
+// HelmChart creates a builder of the chart referenced by ref, which is either:
+//   - "oci://<registry>/<repository>/<chart>" reference of the chart pushed to OCI registry,
+//   - "http(s)://<repository url>/<chart>" chart repository URL followed by the name of the chart,
+//   - path to the chart directory or packaged chart (.tgz) in the file system set by Location.
+//
+// Charts fetched from repositories and registries are cached for the lifetime of the operator by their reference and
+// version, so that features re-created on every reconcile do not download them again.
+func HelmChart(ref string, values ChartValues) *ChartBuilder {
+	return &ChartBuilder{
+		ref:         ref,
+		releaseName: defaultReleaseName,
+		values:      values,
+	}
+}
+
+// Version pins the version of the chart fetched from a repository or registry. The latest version is used when not set.
+func (b *ChartBuilder) Version(version string) *ChartBuilder {
+	b.version = version
+	return b
+}
+
+// ReleaseName sets .Release.Name the chart is rendered with, as charts commonly derive names of resources from it.
+func (b *ChartBuilder) ReleaseName(name string) *ChartBuilder {
+	b.releaseName = name
+	return b
+}
+
+// Location loads the chart from the file system, e.g. embedded in the operator binary, instead of fetching it.
+func (b *ChartBuilder) Location(fsys fs.FS) *ChartBuilder {
+	b.fsys = fsys
+	return b
+}
+
+func (b *ChartBuilder) Create() ([]resource.Applier, error) {
+	if b.ref == "" {
+		return nil, fmt.Errorf("chart reference is required")
+	}
+
+	if b.values == nil {
+		b.values = StaticValues(nil)
+	}
+
+	return []resource.Applier{&ChartApplier{
+		ref:         b.ref,
+		version:     b.version,
+		releaseName: b.releaseName,
+		values:      b.values,
+		fsys:        b.fsys,
+	}}, nil
+}
+
+// ChartApplier renders the chart with values computed from the feature data and applies rendered resources.
+// Resources declared as Helm hooks are not applied, as there is no release whose lifecycle would trigger them.
+type ChartApplier struct {
+	ref         string
+	version     string
+	releaseName string
+	values      ChartValues
+	fsys        fs.FS
+
+	mu     sync.Mutex
+	chart  *chart.Chart
+	loaded bool
+}
+
+var (
+	_ resource.ChartSource = (*ChartApplier)(nil)
+	_ resource.Renderer    = (*ChartApplier)(nil)
+)
+
+func (c *ChartApplier) Apply(ctx context.Context, cli client.Client, data map[string]any, options ...cluster.MetaOptions) error {
+	objects, err := c.Render(data)
+	if err != nil {
+		return err
+	}
+
+	namespace, _ := data[targetNamespaceKey].(string)
+	for _, obj := range objects {
+		if obj.GetNamespace() != "" || namespace == "" {
+			continue
+		}
+
+		namespaced, errScope := cli.IsObjectNamespaced(obj)
+		if errScope != nil {
+			return fmt.Errorf("failed determining scope of %s %s rendered from chart %s: %w", obj.GetKind(), obj.GetName(), c.ref, errScope)
+		}
+		if namespaced {
+			obj.SetNamespace(namespace)
+		}
+	}
+
+	return resource.Apply(ctx, cli, objects, options...)
+}
+
+// Render loads the chart and renders its templates and CRDs using values computed from the data.
+func (c *ChartApplier) Render(data map[string]any) ([]*unstructured.Unstructured, error) {
+	chrt, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := c.values(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing values of chart %s: %w", c.ref, err)
+	}
+
+	capabilities, err := clusterCapabilities()
+	if err != nil {
+		return nil, fmt.Errorf("failed discovering capabilities of the cluster to render chart %s: %w", c.ref, err)
+	}
+
+	namespace, _ := data[targetNamespaceKey].(string)
+	renderValues, err := chartutil.ToRenderValues(chrt, values, chartutil.ReleaseOptions{
+		Name:      c.releaseName,
+		Namespace: namespace,
+		Revision:  1,
+		IsInstall: true,
+	}, capabilities)
+	if err != nil {
+		return nil, fmt.Errorf("invalid values of chart %s: %w", c.ref, err)
+	}
+
+	rendered, err := engine.Render(chrt, renderValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed rendering chart %s: %w", c.ref, err)
+	}
+
+	var objects []*unstructured.Unstructured
+	for _, crd := range chrt.CRDObjects() {
+		crds, errConvert := conversion.StrToUnstructured(string(crd.File.Data))
+		if errConvert != nil {
+			return nil, fmt.Errorf("failed parsing %s of chart %s: %w", crd.Filename, c.ref, errConvert)
+		}
+		objects = append(objects, crds...)
+	}
+
+	for _, name := range sortedTemplates(rendered) {
+		templateObjects, errConvert := conversion.StrToUnstructured(rendered[name])
+		if errConvert != nil {
+			return nil, fmt.Errorf("failed parsing %s of chart %s: %w", name, c.ref, errConvert)
+		}
+
+		for _, obj := range templateObjects {
+			if _, isHook := obj.GetAnnotations()[helmHookAnnotation]; isHook || len(obj.Object) == 0 {
+				continue
+			}
+			objects = append(objects, obj)
+		}
+	}
+
+	imageMirrorsOf(data).Rewrite(objects...)
+
+	return objects, nil
+}
+
+// AppliedChart returns the name and version of the chart, as defined in its Chart.yaml.
+func (c *ChartApplier) AppliedChart() (string, string, string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		return "", "", "", false
+	}
+
+	return c.chart.Metadata.Name, c.chart.Metadata.Version, c.ref, true
+}
+
+func (c *ChartApplier) load() (*chart.Chart, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		return c.chart, nil
+	}
+
+	chrt, err := c.fetch()
+	if err != nil {
+		return nil, fmt.Errorf("failed loading chart %s: %w", c.ref, err)
+	}
+
+	if c.version != "" && chrt.Metadata.Version != c.version {
+		return nil, fmt.Errorf("chart %s has version %s, expected %s", c.ref, chrt.Metadata.Version, c.version)
+	}
+
+	c.chart = chrt
+	c.loaded = true
+
+	return chrt, nil
+}
+
+func (c *ChartApplier) fetch() (*chart.Chart, error) {
+	switch {
+	case c.fsys != nil:
+		return loadFromFS(c.fsys, c.ref)
+	case strings.HasPrefix(c.ref, ociScheme):
+		return remoteCharts.get(c.ref, c.version, pullFromRegistry)
+	case strings.HasPrefix(c.ref, "http://"), strings.HasPrefix(c.ref, "https://"):
+		return remoteCharts.get(c.ref, c.version, downloadFromRepository)
+	default:
+		return nil, fmt.Errorf("unsupported chart reference, expected oci:// or http(s):// reference, or a path with Location set")
+	}
+}
+
+func loadFromFS(fsys fs.FS, chartPath string) (*chart.Chart, error) {
+	info, err := fs.Stat(fsys, chartPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		archive, errRead := fs.ReadFile(fsys, chartPath)
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		return loader.LoadArchive(bytes.NewReader(archive))
+	}
+
+	var files []*loader.BufferedFile
+	errWalk := fs.WalkDir(fsys, chartPath, func(filePath string, dirEntry fs.DirEntry, errWalk error) error {
+		if errWalk != nil {
+			return errWalk
+		}
+
+		if dirEntry.IsDir() {
+			return nil
+		}
+
+		content, errRead := fs.ReadFile(fsys, filePath)
+		if errRead != nil {
+			return errRead
+		}
+
+		files = append(files, &loader.BufferedFile{
+			Name: strings.TrimPrefix(filePath, path.Clean(chartPath)+"/"),
+			Data: content,
+		})
+
+		return nil
+	})
+	if errWalk != nil {
+		return nil, errWalk
+	}
+
+	return loader.LoadFiles(files)
+}
+
+// chartDiscovery is used to render charts with capabilities of the cluster, nil renders them with Helm defaults.
+var chartDiscovery discovery.DiscoveryInterface //nolint:gochecknoglobals // Reason: client is configured once on startup and shared by all features
+
+// SetChartDiscovery sets the client discovering version and API versions of the cluster, which charts commonly use
+// through .Capabilities to decide which resources to render. It has to be called before any feature is applied.
+func SetChartDiscovery(client discovery.DiscoveryInterface) {
+	chartDiscovery = client
+}
+
+// clusterCapabilities discovers capabilities of the cluster the same way Helm does when installing a release.
+func clusterCapabilities() (*chartutil.Capabilities, error) {
+	if chartDiscovery == nil {
+		return chartutil.DefaultCapabilities, nil
+	}
+
+	serverVersion, err := chartDiscovery.ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	// Groups which cannot be discovered, e.g. served by unavailable aggregated API servers, are left out
+	groups, resources, err := chartDiscovery.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	apiVersions := sets.New[string]()
+	for _, group := range groups {
+		for _, version := range group.Versions {
+			apiVersions.Insert(version.GroupVersion)
+		}
+	}
+	for _, resourceList := range resources {
+		for _, apiResource := range resourceList.APIResources {
+			apiVersions.Insert(resourceList.GroupVersion + "/" + apiResource.Kind)
+		}
+	}
+
+	return &chartutil.Capabilities{
+		KubeVersion: chartutil.KubeVersion{
+			Version: serverVersion.GitVersion,
+			Major:   serverVersion.Major,
+			Minor:   serverVersion.Minor,
+		},
+		APIVersions: sets.List(apiVersions),
+		HelmVersion: chartutil.DefaultCapabilities.HelmVersion,
+	}, nil
+}
+
+// sortedTemplates lists names of rendered templates holding resources in a stable order, skipping partials and notes.
+func sortedTemplates(rendered map[string]string) []string {
+	names := make([]string, 0, len(rendered))
+	for name, content := range rendered {
+		base := path.Base(name)
+		if strings.HasPrefix(base, "_") || base == "NOTES.txt" || strings.TrimSpace(content) == "" {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
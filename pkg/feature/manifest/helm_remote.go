@@ -0,0 +1,392 @@
+package manifest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	helmChartLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	// maxChartSize limits the size of downloaded indexes and archives, the same way Helm limits size of loaded archives.
+	maxChartSize = 20 << 20
+)
+
+// chartHTTPClient fetches charts from repositories and registries. Proxy settings are read from the environment.
+var chartHTTPClient = &http.Client{Timeout: 2 * time.Minute} //nolint:gochecknoglobals // Reason: shared by all chart appliers
+
+// remoteCharts holds charts fetched from repositories and registries for the lifetime of the operator, keyed by their
+// reference and requested version. Features are created on every reconcile, so charts cached by their appliers would
+// be downloaded over and over again. Charts without a pinned version are therefore resolved once per operator run.
+var remoteCharts = &chartCache{charts: map[string]*chart.Chart{}} //nolint:gochecknoglobals // Reason: see above
+
+type chartCache struct {
+	mu     sync.Mutex
+	charts map[string]*chart.Chart
+}
+
+// get returns the cached chart or fetches it. The lock is held while fetching, so that features sharing the chart
+// and applied in parallel download it once.
+func (c *chartCache) get(ref, version string, fetch func(ref, version string) (*chart.Chart, error)) (*chart.Chart, error) {
+	key := ref + "@" + version
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if chrt, found := c.charts[key]; found {
+		return chrt, nil
+	}
+
+	chrt, err := fetch(ref, version)
+	if err != nil {
+		return nil, err
+	}
+	c.charts[key] = chrt
+
+	return chrt, nil
+}
+
+// repositoryIndex is the subset of index.yaml of a chart repository needed to locate the chart archive.
+type repositoryIndex struct {
+	Entries map[string][]struct {
+		Version string   `json:"version"`
+		URLs    []string `json:"urls"`
+	} `json:"entries"`
+}
+
+func downloadFromRepository(ref, version string) (*chart.Chart, error) {
+	repoURL, chartName := path.Split(strings.TrimSuffix(ref, "/"))
+	if chartName == "" {
+		return nil, fmt.Errorf("chart name is missing in the reference")
+	}
+
+	indexURL, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	indexURL = indexURL.JoinPath("index.yaml")
+
+	content, err := download(indexURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed downloading index of %s: %w", repoURL, err)
+	}
+
+	index := &repositoryIndex{}
+	if errUnmarshal := yaml.Unmarshal(content, index); errUnmarshal != nil {
+		return nil, fmt.Errorf("invalid index of %s: %w", repoURL, errUnmarshal)
+	}
+
+	entries := index.Entries[chartName]
+	versions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		versions = append(versions, entry.Version)
+	}
+
+	resolved, err := resolveVersion(versions, version)
+	if err != nil {
+		return nil, fmt.Errorf("chart %s not found in %s: %w", chartName, repoURL, err)
+	}
+
+	for _, entry := range entries {
+		if entry.Version != resolved {
+			continue
+		}
+		if len(entry.URLs) == 0 {
+			return nil, fmt.Errorf("chart %s version %s has no downloadable URLs", chartName, resolved)
+		}
+
+		archiveURL, errParse := url.Parse(entry.URLs[0])
+		if errParse != nil {
+			return nil, errParse
+		}
+		archiveURL = indexURL.ResolveReference(archiveURL)
+
+		archive, errDownload := download(archiveURL.String(), nil)
+		if errDownload != nil {
+			return nil, fmt.Errorf("failed downloading %s: %w", archiveURL, errDownload)
+		}
+
+		return loader.LoadArchive(bytes.NewReader(archive))
+	}
+
+	return nil, fmt.Errorf("chart %s version %s not found in %s", chartName, resolved, repoURL)
+}
+
+// pullFromRegistry pulls the chart pushed to an OCI registry using anonymous access, as the operator has no
+// credentials of registries holding charts.
+func pullFromRegistry(ref, version string) (*chart.Chart, error) {
+	host, repository, found := strings.Cut(strings.TrimPrefix(ref, ociScheme), "/")
+	if !found || repository == "" {
+		return nil, fmt.Errorf("repository is missing in the reference")
+	}
+
+	registry := &ociRegistry{base: "https://" + host + "/v2/" + repository}
+
+	tag := version
+	if tag == "" {
+		tags, err := registry.tags()
+		if err != nil {
+			return nil, fmt.Errorf("failed listing tags of %s: %w", ref, err)
+		}
+
+		if tag, err = resolveVersion(tags, ""); err != nil {
+			return nil, fmt.Errorf("chart %s not found: %w", ref, err)
+		}
+	}
+
+	layer, err := registry.chartLayer(tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed pulling manifest of %s:%s: %w", ref, tag, err)
+	}
+
+	archive, err := registry.blob(layer)
+	if err != nil {
+		return nil, fmt.Errorf("failed pulling %s:%s: %w", ref, tag, err)
+	}
+
+	return loader.LoadArchive(bytes.NewReader(archive))
+}
+
+type ociRegistry struct {
+	base  string
+	token string
+}
+
+func (r *ociRegistry) tags() ([]string, error) {
+	content, err := r.get(r.base+"/tags/list", "")
+	if err != nil {
+		return nil, err
+	}
+
+	tagList := struct {
+		Tags []string `json:"tags"`
+	}{}
+	if errUnmarshal := json.Unmarshal(content, &tagList); errUnmarshal != nil {
+		return nil, errUnmarshal
+	}
+
+	// Helm pushes build metadata of chart versions with "_" instead of "+", which is not allowed in tags.
+	for i, tag := range tagList.Tags {
+		tagList.Tags[i] = strings.ReplaceAll(tag, "_", "+")
+	}
+
+	return tagList.Tags, nil
+}
+
+// chartLayer returns the digest of the layer holding the chart archive.
+func (r *ociRegistry) chartLayer(tag string) (string, error) {
+	content, err := r.get(r.base+"/manifests/"+strings.ReplaceAll(tag, "+", "_"), ociManifestMediaType)
+	if err != nil {
+		return "", err
+	}
+
+	manifest := struct {
+		Layers []struct {
+			MediaType string `json:"mediaType"`
+			Digest    string `json:"digest"`
+		} `json:"layers"`
+	}{}
+	if errUnmarshal := json.Unmarshal(content, &manifest); errUnmarshal != nil {
+		return "", errUnmarshal
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == helmChartLayerMediaType {
+			return layer.Digest, nil
+		}
+	}
+
+	return "", fmt.Errorf("manifest has no layer of type %s", helmChartLayerMediaType)
+}
+
+func (r *ociRegistry) blob(digest string) ([]byte, error) {
+	algorithm, expected, _ := strings.Cut(digest, ":")
+	if algorithm != "sha256" {
+		return nil, fmt.Errorf("unsupported digest %s", digest)
+	}
+
+	content, err := r.get(r.base+"/blobs/"+digest, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if sum := sha256.Sum256(content); hex.EncodeToString(sum[:]) != expected {
+		return nil, fmt.Errorf("content of blob does not match its digest %s", digest)
+	}
+
+	return content, nil
+}
+
+// get fetches the content, obtaining an anonymous bearer token when the registry requests one.
+func (r *ociRegistry) get(target, accept string) ([]byte, error) {
+	headers := http.Header{}
+	if accept != "" {
+		headers.Set("Accept", accept)
+	}
+	if r.token != "" {
+		headers.Set("Authorization", "Bearer "+r.token)
+	}
+
+	content, err := download(target, headers)
+
+	var challengeErr *authChallengeError
+	if !errors.As(err, &challengeErr) || r.token != "" {
+		return content, err
+	}
+
+	if r.token, err = anonymousToken(challengeErr.challenge); err != nil {
+		return nil, fmt.Errorf("failed obtaining anonymous token: %w", err)
+	}
+	headers.Set("Authorization", "Bearer "+r.token)
+
+	return download(target, headers)
+}
+
+// authChallengeError is returned by download when the server requires a bearer token.
+type authChallengeError struct {
+	challenge string
+}
+
+func (e *authChallengeError) Error() string {
+	return "authentication required: " + e.challenge
+}
+
+// anonymousToken requests a token from the realm of the "Bearer" challenge, as described by the distribution spec.
+func anonymousToken(challenge string) (string, error) {
+	params := parseChallenge(challenge)
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("invalid challenge %q", challenge)
+	}
+
+	query := realm.Query()
+	for _, param := range []string{"service", "scope"} {
+		if value := params[param]; value != "" {
+			query.Set(param, value)
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	content, err := download(realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if errUnmarshal := json.Unmarshal(content, &token); errUnmarshal != nil {
+		return "", errUnmarshal
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+
+	return token.AccessToken, nil
+}
+
+// parseChallenge reads parameters of `Bearer realm="...",service="...",scope="..."` challenge.
+func parseChallenge(challenge string) map[string]string {
+	params := map[string]string{}
+
+	scheme, rest, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return params
+	}
+
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, ", "), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		params[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+
+	return params
+}
+
+func download(target string, headers http.Header) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, target, nil) //nolint:noctx // Reason: bound by the timeout of the client
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range headers {
+		req.Header[name] = values
+	}
+
+	resp, err := chartHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized && resp.Header.Get("WWW-Authenticate") != "" {
+		return nil, &authChallengeError{challenge: resp.Header.Get("WWW-Authenticate")}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response %s", resp.Status)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxChartSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxChartSize {
+		return nil, fmt.Errorf("content exceeds %d bytes", maxChartSize)
+	}
+
+	return content, nil
+}
+
+// resolveVersion returns the requested version if available, or the latest stable version when none is requested,
+// following what Helm resolves for charts without a version.
+func resolveVersion(versions []string, requested string) (string, error) {
+	var latest *semver.Version
+	var resolved string
+	for _, version := range versions {
+		if requested != "" {
+			if version == requested {
+				return version, nil
+			}
+			continue
+		}
+
+		parsed, err := semver.ParseTolerant(version)
+		if err != nil || len(parsed.Pre) > 0 {
+			continue
+		}
+		if latest == nil || parsed.GT(*latest) {
+			latest, resolved = &parsed, version
+		}
+	}
+
+	if resolved == "" {
+		if requested != "" {
+			return "", fmt.Errorf("version %s is not available", requested)
+		}
+
+		return "", fmt.Errorf("no stable version is available")
+	}
+
+	return resolved, nil
+}
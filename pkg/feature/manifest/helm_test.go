@@ -0,0 +1,257 @@
+package manifest_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing/fstest"
+
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Helm chart rendering", func() {
+
+	newClient := func() client.Client {
+		mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("Pod"), meta.RESTScopeNamespace)
+
+		return fake.NewClientBuilder().WithRESTMapper(mapper).Build()
+	}
+
+	chartFS := fstest.MapFS{
+		"charts/collector/Chart.yaml": {Data: []byte(`
+apiVersion: v2
+name: collector
+version: 0.42.0
+`)},
+		"charts/collector/values.yaml": {Data: []byte(`
+mode: deployment
+replicas: 1
+`)},
+		"charts/collector/templates/_helpers.tpl": {Data: []byte(`
+{{- define "collector.fullname" -}}{{ .Release.Name }}-collector{{- end -}}
+`)},
+		"charts/collector/templates/configmap.yaml": {Data: []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ include "collector.fullname" . }}
+data:
+  mode: {{ .Values.mode }}
+  replicas: {{ .Values.replicas | quote }}
+`)},
+		"charts/collector/templates/test-connection.yaml": {Data: []byte(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: {{ include "collector.fullname" . }}-test
+  annotations:
+    helm.sh/hook: test
+`)},
+		"charts/collector/templates/NOTES.txt": {Data: []byte(`Collector installed in {{ .Release.Namespace }}`)},
+	}
+
+	It("should render chart with values computed from feature data and apply it to the target namespace", func(ctx context.Context) {
+		// given
+		appliers, err := manifest.HelmChart("charts/collector", manifest.ValuesFromData("CollectorValues")).
+			Location(chartFS).
+			ReleaseName("odh").
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		cli := newClient()
+		data := map[string]any{
+			"TargetNamespace": "opendatahub",
+			"CollectorValues": map[string]any{"replicas": 3},
+		}
+
+		// when
+		err = appliers[0].Apply(ctx, cli, data)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		configMap := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "odh-collector", Namespace: "opendatahub"}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"mode": "deployment", "replicas": "3"}))
+
+		pods := &corev1.PodList{}
+		Expect(cli.List(ctx, pods)).To(Succeed())
+		Expect(pods.Items).To(BeEmpty(), "hooks should not be applied")
+	})
+
+	It("should report the applied chart version", func(ctx context.Context) {
+		// given
+		appliers, err := manifest.HelmChart("charts/collector", nil).Location(chartFS).Create()
+		Expect(err).ToNot(HaveOccurred())
+		chartSource, isChart := appliers[0].(resource.ChartSource)
+		Expect(isChart).To(BeTrue())
+		_, _, _, applied := chartSource.AppliedChart()
+		Expect(applied).To(BeFalse())
+
+		// when
+		Expect(appliers[0].Apply(ctx, newClient(), map[string]any{"TargetNamespace": "opendatahub"})).To(Succeed())
+
+		// then
+		name, version, source, applied := chartSource.AppliedChart()
+		Expect(applied).To(BeTrue())
+		Expect(name).To(Equal("collector"))
+		Expect(version).To(Equal("0.42.0"))
+		Expect(source).To(Equal("charts/collector"))
+	})
+
+	It("should fail when chart does not have the pinned version", func(ctx context.Context) {
+		// given
+		appliers, err := manifest.HelmChart("charts/collector", nil).Location(chartFS).Version("0.43.0").Create()
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = appliers[0].Apply(ctx, newClient(), map[string]any{"TargetNamespace": "opendatahub"})
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("chart charts/collector has version 0.42.0, expected 0.43.0")))
+	})
+
+	It("should download chart from the repository", func(ctx context.Context) {
+		// given
+		repoDir := GinkgoT().TempDir()
+		collector, err := loader.LoadDir(writeChart(chartFS, filepath.Join(GinkgoT().TempDir(), "collector")))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = chartutil.Save(collector, repoDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(repoDir, "index.yaml"), []byte(`
+apiVersion: v1
+entries:
+  collector:
+    - name: collector
+      version: 0.42.0
+      urls:
+        - collector-0.42.0.tgz
+`), 0600)).To(Succeed())
+		server := httptest.NewServer(http.FileServer(http.Dir(repoDir)))
+		DeferCleanup(server.Close)
+
+		appliers, err := manifest.HelmChart(server.URL+"/collector", manifest.StaticValues(map[string]any{"mode": "daemonset"})).
+			Version("0.42.0").
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		cli := newClient()
+
+		// when
+		err = appliers[0].Apply(ctx, cli, map[string]any{"TargetNamespace": "opendatahub"})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		configMap := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "opendatahub-collector", Namespace: "opendatahub"}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKeyWithValue("mode", "daemonset"))
+	})
+
+	It("should download chart from the repository once for features created on each reconcile", func(ctx context.Context) {
+		// given
+		repoDir := GinkgoT().TempDir()
+		collector, err := loader.LoadDir(writeChart(chartFS, filepath.Join(GinkgoT().TempDir(), "collector")))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = chartutil.Save(collector, repoDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(repoDir, "index.yaml"), []byte(`
+apiVersion: v1
+entries:
+  collector:
+    - name: collector
+      version: 0.42.0
+      urls:
+        - collector-0.42.0.tgz
+`), 0600)).To(Succeed())
+		var requests atomic.Int32
+		fileServer := http.FileServer(http.Dir(repoDir))
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			fileServer.ServeHTTP(w, r)
+		}))
+		DeferCleanup(server.Close)
+		cli := newClient()
+
+		// when
+		for i := 0; i < 2; i++ {
+			appliers, errCreate := manifest.HelmChart(server.URL+"/collector", nil).Create()
+			Expect(errCreate).ToNot(HaveOccurred())
+			Expect(appliers[0].Apply(ctx, cli, map[string]any{"TargetNamespace": "opendatahub"})).To(Succeed())
+		}
+
+		// then
+		Expect(requests.Load()).To(BeEquivalentTo(2), "index and archive should be downloaded only once")
+	})
+
+	It("should render chart with capabilities of the cluster", func(ctx context.Context) {
+		// given
+		manifest.SetChartDiscovery(&fakediscovery.FakeDiscovery{
+			Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{{
+				GroupVersion: "route.openshift.io/v1",
+				APIResources: []metav1.APIResource{{Name: "routes", Kind: "Route", Namespaced: true}},
+			}}},
+			FakedServerVersion: &version.Info{GitVersion: "v1.28.6+f1618d5", Major: "1", Minor: "28"},
+		})
+		DeferCleanup(func() {
+			manifest.SetChartDiscovery(nil)
+		})
+
+		capabilitiesFS := fstest.MapFS{
+			"charts/capabilities/Chart.yaml": {Data: []byte(`
+apiVersion: v2
+name: capabilities
+version: 0.1.0
+`)},
+			"charts/capabilities/templates/configmap.yaml": {Data: []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: capabilities
+data:
+  kubeVersion: {{ .Capabilities.KubeVersion.Version | quote }}
+  routes: {{ .Capabilities.APIVersions.Has "route.openshift.io/v1/Route" | quote }}
+`)},
+		}
+		appliers, err := manifest.HelmChart("charts/capabilities", nil).Location(capabilitiesFS).Create()
+		Expect(err).ToNot(HaveOccurred())
+		cli := newClient()
+
+		// when
+		err = appliers[0].Apply(ctx, cli, map[string]any{"TargetNamespace": "opendatahub"})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		configMap := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "capabilities", Namespace: "opendatahub"}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"kubeVersion": "v1.28.6+f1618d5", "routes": "true"}))
+	})
+
+})
+
+func writeChart(chartFS fstest.MapFS, dir string) string {
+	for name, file := range chartFS {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		Expect(os.MkdirAll(filepath.Dir(target), 0755)).To(Succeed())
+		Expect(os.WriteFile(target, file.Data, 0600)).To(Succeed())
+	}
+
+	return filepath.Join(dir, "charts", "collector")
+}
@@ -1,13 +1,21 @@
 package manifest_test
 
 import (
+	"context"
 	"io/fs"
 	"path/filepath"
 
 	"github.com/spf13/afero"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -106,6 +114,234 @@ data:
 
 	})
 
+	Describe("Manifests Targeting Other Namespaces", func() {
+
+		configMapYaml := func(name string) string {
+			return `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ` + name + `
+  namespace: {{ .TargetNamespace }}
+data:
+  {{- range .Keys }}
+  {{ . }}: {{ targetNamespace }}
+  {{- end }}
+`
+		}
+
+		BeforeEach(func() {
+			Expect(afero.WriteFile(inMemFS.Fs, "authz/app.tmpl.yaml", []byte(configMapYaml("app-config")), 0644)).To(Succeed())
+			Expect(afero.WriteFile(inMemFS.Fs, "authz/auth/provider.tmpl.yaml", []byte(configMapYaml("provider-config")), 0644)).To(Succeed())
+		})
+
+		It("should apply manifests to the namespace resolved from the feature data", func(ctx context.Context) {
+			// given
+			cli := fake.NewClientBuilder().Build()
+			appliers, err := manifest.Location(inMemFS).
+				Include("authz/app.tmpl.yaml").
+				IncludeInNamespace("{{ .AuthNamespace }}", "authz/auth").
+				Create()
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = appliers[0].Apply(ctx, cli, map[string]any{
+				"TargetNamespace": "opendatahub",
+				"AuthNamespace":   "opendatahub-auth-provider",
+				"Keys":            []string{"namespace"},
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			appConfig := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "app-config", Namespace: "opendatahub"}, appConfig)).To(Succeed())
+			Expect(appConfig.Data).To(HaveKeyWithValue("namespace", "opendatahub"))
+			providerConfig := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "provider-config", Namespace: "opendatahub-auth-provider"}, providerConfig)).To(Succeed())
+			Expect(providerConfig.Data).To(HaveKeyWithValue("namespace", "opendatahub-auth-provider"))
+		})
+
+		It("should fail when namespace of the manifests resolves to empty value", func(ctx context.Context) {
+			// given
+			appliers, err := manifest.Location(inMemFS).IncludeInNamespace("{{ .AuthNamespace }}", "authz/auth").Create()
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = appliers[0].Apply(ctx, fake.NewClientBuilder().Build(), map[string]any{"TargetNamespace": "opendatahub", "AuthNamespace": ""})
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("resolved to empty value")))
+		})
+	})
+
+	Describe("Patch Manifests with On Delete Policy", func() {
+
+		patchYaml := func(value, onDelete string) string {
+			return `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-configmap
+  namespace: fake-ns
+  annotations:
+    ` + annotations.OnDelete + `: ` + onDelete + `
+data:
+  ` + value + `: patched
+`
+		}
+
+		BeforeEach(func() {
+			Expect(afero.WriteFile(inMemFS.Fs, "patches/orphaned.patch.yaml", []byte(patchYaml("orphaned", "orphan")), 0644)).To(Succeed())
+			Expect(afero.WriteFile(inMemFS.Fs, "patches/reverted.patch.yaml", []byte(patchYaml("reverted", "revert-patch")), 0644)).To(Succeed())
+		})
+
+		It("should leave patches to be reverted to the feature", func(ctx context.Context) {
+			// given
+			cli := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-configmap", Namespace: "fake-ns"},
+				Data:       map[string]string{"key": "value"},
+			}).Build()
+			appliers, err := manifest.Location(inMemFS).Include("patches").Create()
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			Expect(appliers[0].Apply(ctx, cli, map[string]any{})).To(Succeed())
+			revertible, err := appliers[0].(resource.RevertiblePatchSource).RevertiblePatches(map[string]any{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revertible).To(HaveLen(1))
+			Expect(revertible[0].Object).To(HaveKeyWithValue("data", HaveKey("reverted")))
+
+			patched := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "my-configmap", Namespace: "fake-ns"}, patched)).To(Succeed())
+			Expect(patched.Data).To(Equal(map[string]string{"key": "value", "orphaned": "patched"}))
+			Expect(patched.GetAnnotations()).ToNot(HaveKey(annotations.OnDelete))
+		})
+	})
+
+	Describe("Overlay Manifests", func() {
+
+		controlPlaneYaml := `
+apiVersion: maistra.io/v2
+kind: ServiceMeshControlPlane
+metadata:
+  name: data-science-smcp
+  namespace: {{ .TargetNamespace }}
+spec:
+  tracing:
+    type: None
+  techPreview:
+    meshConfig:
+      extensionProviders:
+        - name: opendatahub-auth-provider
+`
+
+		deploymentYaml := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: authorino
+  namespace: fake-ns
+spec:
+  template:
+    spec:
+      containers:
+        - name: authorino
+          image: authorino:latest
+        - name: sidecar
+          image: sidecar:latest
+`
+
+		applyOverlays := func(ctx context.Context, cli client.Client) error {
+			appliers, err := manifest.Location(inMemFS).Include("overlays").Create()
+			Expect(err).ToNot(HaveOccurred())
+
+			return appliers[0].Apply(ctx, cli, map[string]any{"TargetNamespace": "istio-system"})
+		}
+
+		It("should merge fields and apply JSON patch operations to rendered custom resource", func(ctx context.Context) {
+			// given
+			Expect(afero.WriteFile(inMemFS.Fs, "overlays/smcp.tmpl.yaml", []byte(controlPlaneYaml), 0644)).To(Succeed())
+			Expect(afero.WriteFile(inMemFS.Fs, "overlays/smcp.overlay.tmpl.yaml", []byte(`
+apiVersion: maistra.io/v2
+kind: ServiceMeshControlPlane
+metadata:
+  name: data-science-smcp
+  namespace: {{ .TargetNamespace }}
+spec:
+  tracing:
+    type: Jaeger
+    sampling: 100
+jsonPatch:
+  - op: add
+    path: /spec/techPreview/meshConfig/extensionProviders/-
+    value:
+      name: kserve-auth-provider
+`), 0644)).To(Succeed())
+			cli := fake.NewClientBuilder().Build()
+
+			// when
+			err := applyOverlays(ctx, cli)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			smcp := &unstructured.Unstructured{}
+			smcp.SetAPIVersion("maistra.io/v2")
+			smcp.SetKind("ServiceMeshControlPlane")
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "data-science-smcp", Namespace: "istio-system"}, smcp)).To(Succeed())
+			Expect(smcp.Object["spec"]).To(HaveKeyWithValue("tracing", map[string]any{"type": "Jaeger", "sampling": int64(100)}))
+			providers, _, _ := unstructured.NestedSlice(smcp.Object, "spec", "techPreview", "meshConfig", "extensionProviders")
+			Expect(providers).To(Equal([]any{
+				map[string]any{"name": "opendatahub-auth-provider"},
+				map[string]any{"name": "kserve-auth-provider"},
+			}))
+			Expect(smcp.Object).ToNot(HaveKey("jsonPatch"))
+		})
+
+		It("should merge lists of built-in kinds by their keys", func(ctx context.Context) {
+			// given
+			Expect(afero.WriteFile(inMemFS.Fs, "overlays/deployment.yaml", []byte(deploymentYaml), 0644)).To(Succeed())
+			Expect(afero.WriteFile(inMemFS.Fs, "overlays/deployment.overlay.yaml", []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: authorino
+  namespace: fake-ns
+spec:
+  template:
+    spec:
+      containers:
+        - name: authorino
+          image: authorino:v0.18
+`), 0644)).To(Succeed())
+			cli := fake.NewClientBuilder().Build()
+
+			// when
+			err := applyOverlays(ctx, cli)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			deployment := &appsv1.Deployment{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "authorino", Namespace: "fake-ns"}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(2))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("authorino:v0.18"))
+			Expect(deployment.Spec.Template.Spec.Containers[1].Image).To(Equal("sidecar:latest"))
+		})
+
+		It("should fail when overlay targets resource which is not rendered", func(ctx context.Context) {
+			// given
+			Expect(afero.WriteFile(inMemFS.Fs, "overlays/deployment.yaml", []byte(deploymentYaml), 0644)).To(Succeed())
+			Expect(afero.WriteFile(inMemFS.Fs, "overlays/smcp.overlay.tmpl.yaml", []byte(controlPlaneYaml), 0644)).To(Succeed())
+
+			// when
+			err := applyOverlays(ctx, fake.NewClientBuilder().Build())
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("overlay targets ServiceMeshControlPlane istio-system/data-science-smcp which is not rendered")))
+		})
+	})
+
 })
 
 func process(data any, m ...*manifest.Manifest) []*unstructured.Unstructured {
@@ -0,0 +1,138 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// jsonPatchField holds JSON patch (RFC 6902) operations of the overlay.
+const jsonPatchField = "jsonPatch"
+
+// Overlays are manifests changing resources rendered from other manifests of the same Applier before they are applied.
+// They are a structured alternative to templating complex specs, such as of ServiceMeshControlPlane, as they only touch
+// the fields they define and cannot produce malformed YAML. Overlays are files containing ".overlay." in their names,
+// which can be templates as well. Each resource they hold is identified by its apiVersion, kind, name and namespace and:
+//   - its fields are merged into the rendered resource. Kinds known to client-go are merged using strategic merge patch,
+//     e.g. containers are merged by their name, other kinds using JSON merge patch (RFC 7386), replacing lists and removing null fields.
+//   - operations listed in its "jsonPatch" field are applied afterwards as JSON patch (RFC 6902), e.g. to append to a list.
+//
+// Overlays are applied in the order of their manifests, an overlay without matching rendered resource is an error.
+func applyOverlays(objects, overlays []*unstructured.Unstructured) error {
+	for _, overlay := range overlays {
+		target := findTarget(objects, overlay)
+		if target == nil {
+			return fmt.Errorf("overlay targets %s %s which is not rendered by any of the manifests", overlay.GetKind(), identityOf(overlay))
+		}
+
+		if err := applyOverlay(target, overlay); err != nil {
+			return fmt.Errorf("failed applying overlay to %s %s: %w", overlay.GetKind(), identityOf(overlay), err)
+		}
+	}
+
+	return nil
+}
+
+func applyOverlay(target, overlay *unstructured.Unstructured) error {
+	fields := overlay.DeepCopy().Object
+	operations, hasOperations := fields[jsonPatchField]
+	delete(fields, jsonPatchField)
+
+	merged, err := merge(target, fields)
+	if err != nil {
+		return err
+	}
+
+	if hasOperations {
+		if merged, err = applyJSONPatch(merged, operations); err != nil {
+			return err
+		}
+	}
+
+	target.SetUnstructuredContent(merged)
+
+	return nil
+}
+
+func merge(target *unstructured.Unstructured, fields map[string]any) (map[string]any, error) {
+	if typed, err := scheme.Scheme.New(target.GroupVersionKind()); err == nil {
+		merged, errMerge := strategicpatch.StrategicMergeMapPatch(target.Object, fields, typed)
+		if errMerge != nil {
+			return nil, fmt.Errorf("strategic merge failed: %w", errMerge)
+		}
+
+		return merged, nil
+	}
+
+	original, err := json.Marshal(target.Object)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedJSON, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		return nil, fmt.Errorf("merge failed: %w", err)
+	}
+
+	merged := map[string]any{}
+
+	return merged, utiljson.Unmarshal(mergedJSON, &merged)
+}
+
+func applyJSONPatch(object map[string]any, operations any) (map[string]any, error) {
+	if _, isList := operations.([]any); !isList {
+		return nil, fmt.Errorf("%s has to be a list of operations", jsonPatchField)
+	}
+
+	operationsJSON, err := json.Marshal(operations)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.DecodePatch(operationsJSON)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", jsonPatchField, err)
+	}
+
+	original, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedJSON, err := patch.Apply(original)
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w", jsonPatchField, err)
+	}
+
+	patched := map[string]any{}
+
+	return patched, utiljson.Unmarshal(patchedJSON, &patched)
+}
+
+func findTarget(objects []*unstructured.Unstructured, overlay *unstructured.Unstructured) *unstructured.Unstructured {
+	for _, obj := range objects {
+		if obj.GroupVersionKind() == overlay.GroupVersionKind() &&
+			obj.GetName() == overlay.GetName() &&
+			obj.GetNamespace() == overlay.GetNamespace() {
+			return obj
+		}
+	}
+
+	return nil
+}
+
+func identityOf(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
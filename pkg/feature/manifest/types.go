@@ -18,13 +18,21 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
 )
 
+// targetNamespaceKey is the key of the feature data holding the namespace the manifests are applied to.
+const targetNamespaceKey = "TargetNamespace"
+
+// ImageMirrorsKey is the key of the feature data holding resource.ImageMirrors, which image references of rendered
+// manifests and charts are rewritten with.
+const ImageMirrorsKey = "ImageMirrors"
+
 func Create(fsys fs.FS, path string) *Manifest {
 	basePath := filepath.Base(path)
 	return &Manifest{
-		name:  basePath,
-		path:  path,
-		patch: isPatch(basePath),
-		fsys:  fsys,
+		name:    basePath,
+		path:    path,
+		patch:   isPatch(basePath),
+		overlay: isOverlay(basePath),
+		fsys:    fsys,
 	}
 }
 
@@ -59,36 +67,169 @@ func LoadManifests(fsys fs.FS, path string) ([]*Manifest, error) {
 type Manifest struct {
 	name,
 	path string
-	patch bool
-	fsys  fs.FS
+	patch   bool
+	overlay bool
+	fsys    fs.FS
+	// namespace overrides the target namespace of the feature for this manifest, see Builder.IncludeInNamespace.
+	namespace string
 }
 
-// Applier wraps an instance of Manifest and provides a way to apply it to the cluster.
+// Applier wraps a set of manifests and provides a way to apply them to the cluster.
+// Resources from all manifests are applied together, ordered by their kind (see resource.Phase),
+// so that e.g. CustomResourceDefinitions are established before their instances are created,
+// regardless of the file they have been defined in. Patches are applied last, except those declaring
+// resource.OnDeleteRevertPatch policy, which are provided to the feature through RevertiblePatches.
+// Overlay manifests change the rendered resources before they are applied, see applyOverlays.
 type Applier struct {
-	manifest *Manifest
+	manifests []*Manifest
 }
 
-func createApplier(manifest *Manifest) *Applier {
+var (
+	_ resource.RevertiblePatchSource = (*Applier)(nil)
+	_ resource.Renderer              = (*Applier)(nil)
+)
+
+func createApplier(manifests ...*Manifest) *Applier {
 	return &Applier{
-		manifest: manifest,
+		manifests: manifests,
 	}
 }
 
-// Apply processes owned manifest and apply it to a cluster.
+// Apply processes owned manifests and apply them to a cluster.
 func (a Applier) Apply(ctx context.Context, cli client.Client, data map[string]any, options ...cluster.MetaOptions) error {
-	objects, errProcess := a.manifest.Process(data)
-	if errProcess != nil {
-		return errProcess
+	objects, patches, err := a.process(data)
+	if err != nil {
+		return err
 	}
 
-	applierFunc := resource.Apply
-	if a.manifest.patch {
-		applierFunc = func(ctx context.Context, cli client.Client, objects []*unstructured.Unstructured, _ ...cluster.MetaOptions) error {
-			return resource.Patch(ctx, cli, objects)
+	if errApply := resource.Apply(ctx, cli, objects, options...); errApply != nil {
+		return errApply
+	}
+
+	return resource.Patch(ctx, cli, patches)
+}
+
+// Render processes owned manifests the same way Apply does and returns the resources, followed by all the patches,
+// including those declaring resource.OnDeleteRevertPatch policy. Nothing is applied to the cluster.
+func (a Applier) Render(data map[string]any) ([]*unstructured.Unstructured, error) {
+	objects, patches, err := a.process(data)
+	if err != nil {
+		return nil, err
+	}
+
+	revertiblePatches, err := a.RevertiblePatches(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append(objects, patches...), revertiblePatches...), nil
+}
+
+// process renders owned manifests, applies overlays to the resulting resources and returns them together with patches
+// which are applied by the Applier itself.
+func (a Applier) process(data map[string]any) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
+	var objects, overlays, patches []*unstructured.Unstructured
+
+	for _, m := range a.manifests {
+		manifestData, errData := m.dataFor(data)
+		if errData != nil {
+			return nil, nil, errData
+		}
+
+		processed, errProcess := m.Process(manifestData)
+		if errProcess != nil {
+			return nil, nil, errProcess
+		}
+
+		if m.overlay {
+			overlays = append(overlays, processed...)
+
+			continue
+		}
+
+		if !m.patch {
+			objects = append(objects, processed...)
+
+			continue
+		}
+
+		for _, patch := range processed {
+			policy, errPolicy := resource.OnDeletePolicyOf(patch, true)
+			if errPolicy != nil {
+				return nil, nil, errPolicy
+			}
+			if policy != resource.OnDeleteRevertPatch {
+				patches = append(patches, patch)
+			}
 		}
 	}
 
-	return applierFunc(ctx, cli, objects, options...)
+	if errOverlay := applyOverlays(objects, overlays); errOverlay != nil {
+		return nil, nil, errOverlay
+	}
+
+	return objects, patches, nil
+}
+
+// RevertiblePatches processes patch manifests and returns patches declaring resource.OnDeleteRevertPatch policy.
+func (a Applier) RevertiblePatches(data map[string]any) ([]*unstructured.Unstructured, error) {
+	var patches []*unstructured.Unstructured
+
+	for _, m := range a.manifests {
+		if !m.patch {
+			continue
+		}
+
+		manifestData, errData := m.dataFor(data)
+		if errData != nil {
+			return nil, errData
+		}
+
+		processed, errProcess := m.Process(manifestData)
+		if errProcess != nil {
+			return nil, errProcess
+		}
+
+		for _, patch := range processed {
+			policy, errPolicy := resource.OnDeletePolicyOf(patch, true)
+			if errPolicy != nil {
+				return nil, errPolicy
+			}
+			if policy == resource.OnDeleteRevertPatch {
+				patches = append(patches, patch)
+			}
+		}
+	}
+
+	return patches, nil
+}
+
+// dataFor returns the feature data with TargetNamespace overridden by the namespace of the manifest, if it defines one.
+func (m *Manifest) dataFor(data map[string]any) (map[string]any, error) {
+	if m.namespace == "" {
+		return data, nil
+	}
+
+	tmpl, err := template.New(m.name + "-namespace").Option("missingkey=error").Parse(m.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse namespace of manifest %s: %w", m.path, err)
+	}
+
+	var namespace bytes.Buffer
+	if err := tmpl.Execute(&namespace, data); err != nil {
+		return nil, fmt.Errorf("failed to resolve namespace of manifest %s: %w", m.path, err)
+	}
+	if namespace.Len() == 0 {
+		return nil, fmt.Errorf("namespace %q of manifest %s resolved to empty value", m.namespace, m.path)
+	}
+
+	manifestData := make(map[string]any, len(data)+1)
+	for key, value := range data {
+		manifestData[key] = value
+	}
+	manifestData[targetNamespaceKey] = namespace.String()
+
+	return manifestData, nil
 }
 
 // Process allows any arbitrary struct to be passed and used while processing the content of the manifest.
@@ -110,6 +251,7 @@ func (m *Manifest) Process(data any) ([]*unstructured.Unstructured, error) {
 	if isTemplate(m.path) {
 		tmpl, err := template.New(m.name).
 			Option("missingkey=error").
+			Funcs(templateFuncs(data)).
 			Parse(resources)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse template: %w", err)
@@ -123,13 +265,51 @@ func (m *Manifest) Process(data any) ([]*unstructured.Unstructured, error) {
 		resources = buffer.String()
 	}
 
-	return conversion.StrToUnstructured(resources)
+	objects, err := conversion.StrToUnstructured(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	imageMirrorsOf(data).Rewrite(objects...)
+
+	return objects, nil
+}
+
+// imageMirrorsOf returns mirrors stored in the feature data, if any.
+func imageMirrorsOf(data any) resource.ImageMirrors {
+	if dataMap, isMap := data.(map[string]any); isMap {
+		mirrors, _ := dataMap[ImageMirrorsKey].(resource.ImageMirrors)
+
+		return mirrors
+	}
+
+	return nil
+}
+
+// templateFuncs are available to all templates. targetNamespace returns TargetNamespace of the manifest, also in blocks
+// changing the dot, such as range, where .TargetNamespace is not accessible.
+func templateFuncs(data any) template.FuncMap {
+	return template.FuncMap{
+		"targetNamespace": func() string {
+			if dataMap, isMap := data.(map[string]any); isMap {
+				namespace, _ := dataMap[targetNamespaceKey].(string)
+
+				return namespace
+			}
+
+			return ""
+		},
+	}
 }
 
 func isPatch(path string) bool {
 	return strings.Contains(filepath.Base(path), ".patch.")
 }
 
+func isOverlay(path string) bool {
+	return strings.Contains(filepath.Base(path), ".overlay.")
+}
+
 func isTemplate(path string) bool {
 	return strings.Contains(filepath.Base(path), ".tmpl.")
 }
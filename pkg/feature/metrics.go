@@ -0,0 +1,72 @@
+package feature
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// PhaseMetricName is the name of the gauge reporting the phase of each feature, as recorded in its FeatureTracker.
+const PhaseMetricName = "feature_phase"
+
+const phaseCollectTimeout = 10 * time.Second
+
+// trackedPhases are the phases a FeatureTracker goes through. A series is reported for each of them,
+// so that the previous phase of a feature drops to 0 rather than disappearing.
+var trackedPhases = []string{status.PhaseProgressing, status.PhaseReady, status.PhaseError}
+
+// PhaseCollector reports the phase of every FeatureTracker in the cluster as the feature_phase{feature,phase} gauge.
+// The value is 1 for the current phase of the feature and 0 for the others. FeatureTrackers are read when
+// the metrics are scraped, so the gauge always reflects their latest status.
+type PhaseCollector struct {
+	client client.Reader
+	desc   *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*PhaseCollector)(nil)
+
+func NewPhaseCollector(cli client.Reader) *PhaseCollector {
+	return &PhaseCollector{
+		client: cli,
+		desc: prometheus.NewDesc(
+			PhaseMetricName,
+			"Phase of the feature applied by the operator, 1 for the current phase of the feature and 0 otherwise.",
+			[]string{"feature", "phase"},
+			nil,
+		),
+	}
+}
+
+func (c *PhaseCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *PhaseCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), phaseCollectTimeout)
+	defer cancel()
+
+	trackers := &featurev1.FeatureTrackerList{}
+	if err := c.client.List(ctx, trackers); err != nil {
+		ctrl.Log.WithName("metrics").Error(err, "failed listing feature trackers, skipping "+PhaseMetricName)
+
+		return
+	}
+
+	for i := range trackers.Items {
+		tracker := &trackers.Items[i]
+		featureName := tracker.FeatureName()
+		for _, phase := range trackedPhases {
+			value := 0.0
+			if tracker.Status.Phase == phase {
+				value = 1
+			}
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, value, featureName, phase)
+		}
+	}
+}
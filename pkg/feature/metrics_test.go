@@ -0,0 +1,68 @@
+package feature_test
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature phase metric", func() {
+
+	tracker := func(name, phase string) *featurev1.FeatureTracker {
+		featureTracker := featurev1.NewFeatureTracker(name, "opendatahub")
+		featureTracker.Spec.AppNamespace = "opendatahub"
+		featureTracker.Status.Phase = phase
+
+		return featureTracker
+	}
+
+	It("should report the current phase of each feature tracker", func() {
+		// given
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				tracker("mesh-control-plane-creation", status.PhaseReady),
+				tracker("mesh-metrics-collection", status.PhaseError),
+			).
+			Build()
+
+		// when
+		collector := feature.NewPhaseCollector(cli)
+
+		// then
+		expected := `
+# HELP feature_phase Phase of the feature applied by the operator, 1 for the current phase of the feature and 0 otherwise.
+# TYPE feature_phase gauge
+feature_phase{feature="mesh-control-plane-creation",phase="Error"} 0
+feature_phase{feature="mesh-control-plane-creation",phase="Progressing"} 0
+feature_phase{feature="mesh-control-plane-creation",phase="Ready"} 1
+feature_phase{feature="mesh-metrics-collection",phase="Error"} 1
+feature_phase{feature="mesh-metrics-collection",phase="Progressing"} 0
+feature_phase{feature="mesh-metrics-collection",phase="Ready"} 0
+`
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(expected), feature.PhaseMetricName)).To(Succeed())
+	})
+
+	It("should not report anything when feature trackers cannot be listed", func() {
+		// given
+		cli := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+
+		// when
+		collector := feature.NewPhaseCollector(cli)
+
+		// then
+		Expect(testutil.CollectAndCount(collector, feature.PhaseMetricName)).To(BeZero())
+	})
+})
@@ -0,0 +1,114 @@
+package feature_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Namespace defaults", func() {
+
+	const namespace = "istio-system"
+
+	defaults := &dsciv1.NamespaceDefaults{
+		ResourceQuota: &corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+		},
+		LimitRange: &corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{{
+				Type:           corev1.LimitTypeContainer,
+				DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			}},
+		},
+	}
+
+	newClient := func(objects ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+	}
+
+	applyFeature := func(ctx context.Context, cli client.Client, namespaceDefaults *dsciv1.NamespaceDefaults) {
+		f, err := feature.Define("mesh-control-plane-creation").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			PreConditions(
+				feature.CreateNamespaceIfNotExists(namespace),
+				feature.ApplyNamespaceDefaults(namespace, namespaceDefaults),
+			).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		Expect(f.Apply(ctx)).To(Succeed())
+	}
+
+	getDefaults := func(ctx context.Context, cli client.Client) (error, error) {
+		errQuota := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: feature.NamespaceQuotaName}, &corev1.ResourceQuota{})
+		errLimits := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: feature.NamespaceLimitRangeName}, &corev1.LimitRange{})
+
+		return errQuota, errLimits
+	}
+
+	It("should not apply defaults to the existing namespace the feature has not created", func(ctx context.Context) {
+		// given
+		cli := newClient(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})
+
+		// when
+		applyFeature(ctx, cli, defaults)
+
+		// then
+		errQuota, errLimits := getDefaults(ctx, cli)
+		Expect(k8serr.IsNotFound(errQuota)).To(BeTrue(), "workloads of the user should not be constrained")
+		Expect(k8serr.IsNotFound(errLimits)).To(BeTrue())
+	})
+
+	It("should delete defaults once they are no longer defined", func(ctx context.Context) {
+		// given
+		featureLabels := map[string]string{labels.ODH.Feature: "mesh-control-plane-creation"}
+		cli := newClient(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: featureLabels}},
+			&corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: feature.NamespaceQuotaName, Namespace: namespace, Labels: featureLabels}},
+			&corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: feature.NamespaceLimitRangeName, Namespace: namespace, Labels: featureLabels}},
+		)
+
+		// when
+		applyFeature(ctx, cli, nil)
+
+		// then
+		errQuota, errLimits := getDefaults(ctx, cli)
+		Expect(k8serr.IsNotFound(errQuota)).To(BeTrue())
+		Expect(k8serr.IsNotFound(errLimits)).To(BeTrue())
+	})
+
+	It("should keep the resource of the same name which has not been created by the feature", func(ctx context.Context) {
+		// given
+		userQuota := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: feature.NamespaceQuotaName, Namespace: namespace}}
+		cli := newClient(userQuota)
+
+		// when
+		applyFeature(ctx, cli, nil)
+
+		// then
+		errQuota, _ := getDefaults(ctx, cli)
+		Expect(errQuota).ToNot(HaveOccurred())
+	})
+})
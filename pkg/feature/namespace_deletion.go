@@ -0,0 +1,212 @@
+package feature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// defaultNamespaceDeletionTimeout bounds waiting for namespaces to terminate when DSCI spec.namespaceDeletion does not set it.
+const defaultNamespaceDeletionTimeout = 2 * time.Minute
+
+// namespaceTerminationCheckPeriod is the period the removal of a feature is retried with while its namespaces are terminating.
+const namespaceTerminationCheckPeriod = 10 * time.Second
+
+// NamespacesTerminatingError indicates that namespaces of the removed feature have been deleted with Foreground propagation, but they
+// are still terminating. Removal does not wait for them, it has to be retried after RequeueAfter instead, until they are gone.
+// Once they have been terminating longer than the timeout of the namespace deletion policy, TimedOut is set.
+type NamespacesTerminatingError struct {
+	featureName  string
+	Terminating  []string
+	Timeout      time.Duration
+	TimedOut     bool
+	RequeueAfter time.Duration
+}
+
+func (e *NamespacesTerminatingError) Error() string {
+	if e.TimedOut {
+		return fmt.Sprintf("namespaces of feature %s are still terminating after %s: %s", e.featureName, e.Timeout, strings.Join(e.Terminating, ", "))
+	}
+
+	return fmt.Sprintf("waiting for namespaces of feature %s to terminate: %s", e.featureName, strings.Join(e.Terminating, ", "))
+}
+
+// NamespacesTerminatingOnly checks if the error is caused solely by namespaces of removed features which are terminating within
+// the timeout, as opposed to actual failures. It returns the terminating namespaces which should be checked again first.
+func NamespacesTerminatingOnly(err error) (*NamespacesTerminatingError, bool) {
+	switch typedErr := err.(type) { //nolint:errorlint // Reason: wrapped errors are unwrapped recursively
+	case nil:
+		return nil, false
+	case *NamespacesTerminatingError:
+		return typedErr, !typedErr.TimedOut
+	case *multierror.Error:
+		var first *NamespacesTerminatingError
+		for _, nestedErr := range typedErr.Errors {
+			terminating, ok := NamespacesTerminatingOnly(nestedErr)
+			if !ok {
+				return nil, false
+			}
+			if first == nil || terminating.RequeueAfter < first.RequeueAfter {
+				first = terminating
+			}
+		}
+
+		return first, first != nil
+	default:
+		return NamespacesTerminatingOnly(errors.Unwrap(err))
+	}
+}
+
+// removeOwnedNamespaces deletes namespaces owned by the FeatureTracker of the feature according to its namespace deletion policy,
+// before the tracker itself is removed. With Background propagation namespaces are left to the garbage collector, with Foreground
+// they are deleted right away and NamespacesTerminatingError is returned until they have terminated, so that the tracker is kept
+// and the removal is retried, and with Orphan they are detached from the tracker and no longer labeled as created by the operator,
+// so that neither the garbage collector nor the clean-up of orphaned namespaces removes them.
+func removeOwnedNamespaces(f *Feature) CleanupFunc {
+	return func(ctx context.Context, cli client.Client) error {
+		propagation := namespacePropagationOf(f.namespaceDeletion)
+		if propagation == dsciv1.BackgroundNamespacePropagation {
+			return nil
+		}
+
+		tracker, errGet := getFeatureTracker(ctx, cli, f.Name, f.TargetNamespace)
+		if errGet != nil {
+			return client.IgnoreNotFound(errGet)
+		}
+
+		namespaces, errList := ownedNamespaces(ctx, cli, f.Name, tracker)
+		if errList != nil {
+			return errList
+		}
+
+		if propagation == dsciv1.OrphanNamespacePropagation {
+			return orphanNamespaces(ctx, cli, tracker, namespaces)
+		}
+
+		return f.deleteNamespacesInForeground(ctx, cli, namespaces)
+	}
+}
+
+func namespacePropagationOf(deletion *dsciv1.NamespaceDeletion) dsciv1.NamespacePropagation {
+	if deletion == nil || deletion.Propagation == "" {
+		return dsciv1.BackgroundNamespacePropagation
+	}
+
+	return deletion.Propagation
+}
+
+func namespaceDeletionTimeoutOf(deletion *dsciv1.NamespaceDeletion) time.Duration {
+	if deletion == nil || deletion.Timeout.Duration <= 0 {
+		return defaultNamespaceDeletionTimeout
+	}
+
+	return deletion.Timeout.Duration
+}
+
+// ownedNamespaces lists namespaces labeled with the feature which are owned by its FeatureTracker.
+func ownedNamespaces(ctx context.Context, cli client.Client, featureName string, tracker *featurev1.FeatureTracker) ([]corev1.Namespace, error) {
+	namespaces := &corev1.NamespaceList{}
+	if err := cli.List(ctx, namespaces, client.MatchingLabels{labels.ODH.Feature: featureName}); err != nil {
+		return nil, fmt.Errorf("failed listing namespaces of feature %s: %w", featureName, err)
+	}
+
+	return slices.DeleteFunc(namespaces.Items, func(namespace corev1.Namespace) bool {
+		return !slices.ContainsFunc(namespace.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+			return ref.UID == tracker.UID
+		})
+	}), nil
+}
+
+func orphanNamespaces(ctx context.Context, cli client.Client, tracker *featurev1.FeatureTracker, namespaces []corev1.Namespace) error {
+	for i := range namespaces {
+		namespace := &namespaces[i]
+		original := namespace.DeepCopy()
+		namespace.SetOwnerReferences(slices.DeleteFunc(namespace.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+			return ref.UID == tracker.UID
+		}))
+		namespaceLabels := namespace.GetLabels()
+		delete(namespaceLabels, labels.ODH.OwnedNamespace)
+		delete(namespaceLabels, labels.ODH.Feature)
+		namespace.SetLabels(namespaceLabels)
+		if err := cli.Patch(ctx, namespace, client.MergeFrom(original)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed detaching namespace %s from FeatureTracker %s: %w", namespace.Name, tracker.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteNamespacesInForeground deletes the namespaces and checks if they have terminated, without waiting for them.
+func (f *Feature) deleteNamespacesInForeground(ctx context.Context, cli client.Client, namespaces []corev1.Namespace) error {
+	for i := range namespaces {
+		if namespaces[i].GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := cli.Delete(ctx, &namespaces[i], client.PropagationPolicy(metav1.DeletePropagationForeground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting namespace %s of feature %s: %w", namespaces[i].Name, f.Name, err)
+		}
+	}
+
+	var terminating []string
+	var terminatingSince time.Time
+	for i := range namespaces {
+		current := &corev1.Namespace{}
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(&namespaces[i]), current); err != nil {
+			if k8serr.IsNotFound(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed checking if namespace %s has terminated: %w", namespaces[i].Name, err)
+		}
+		terminating = append(terminating, fmt.Sprintf("%s (%s)", current.Name, TerminationProgress(current)))
+		if deletedAt := current.GetDeletionTimestamp(); deletedAt != nil && (terminatingSince.IsZero() || deletedAt.Time.Before(terminatingSince)) {
+			terminatingSince = deletedAt.Time
+		}
+	}
+
+	if len(terminating) == 0 {
+		return nil
+	}
+
+	f.Log.Info("waiting for namespaces to terminate", "namespaces", terminating)
+	timeout := namespaceDeletionTimeoutOf(f.namespaceDeletion)
+
+	return &NamespacesTerminatingError{
+		featureName:  f.Name,
+		Terminating:  terminating,
+		Timeout:      timeout,
+		TimedOut:     !terminatingSince.IsZero() && time.Since(terminatingSince) >= timeout,
+		RequeueAfter: namespaceTerminationCheckPeriod,
+	}
+}
+
+// TerminationProgress describes what the namespace is waiting for to terminate, based on its status conditions,
+// e.g. resources which are still remaining in it.
+func TerminationProgress(namespace *corev1.Namespace) string {
+	var progress []string
+	for _, condition := range namespace.Status.Conditions {
+		remaining := condition.Type == corev1.NamespaceContentRemaining || condition.Type == corev1.NamespaceFinalizersRemaining
+		if remaining && condition.Status == corev1.ConditionTrue && condition.Message != "" {
+			progress = append(progress, condition.Message)
+		}
+	}
+
+	if len(progress) == 0 {
+		return "terminating"
+	}
+
+	return strings.Join(progress, "; ")
+}
@@ -0,0 +1,182 @@
+package feature_test
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/featuretesting"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Namespace deletion", func() {
+
+	const (
+		featureName   = "mesh-control-plane-external-authz"
+		authNamespace = "opendatahub-auth-provider"
+	)
+
+	var (
+		scheme  *runtime.Scheme
+		tracker *featurev1.FeatureTracker
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+
+		tracker = featurev1.NewFeatureTracker(featureName, "opendatahub")
+		tracker.UID = "tracker-uid"
+		tracker.Spec.AppNamespace = "opendatahub"
+	})
+
+	ownedNamespace := func(finalizers ...string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       authNamespace,
+				Labels:     map[string]string{labels.ODH.Feature: featureName, labels.ODH.OwnedNamespace: "true"},
+				Finalizers: finalizers,
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: featurev1.GroupVersion.String(), Kind: "FeatureTracker", Name: tracker.Name, UID: tracker.UID},
+				},
+			},
+			Status: corev1.NamespaceStatus{
+				Conditions: []corev1.NamespaceCondition{{
+					Type:    corev1.NamespaceContentRemaining,
+					Status:  corev1.ConditionTrue,
+					Message: "Some resources are remaining: pods. has 2 resource instances",
+				}},
+			},
+		}
+	}
+
+	createFeature := func(cli client.Client, deletion *dsciv1.NamespaceDeletion) *feature.Feature {
+		poller, _ := featuretesting.NewInstantPoller(feature.DefaultPoller())
+		f, err := feature.Define(featureName).
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			UsingPoller(poller).
+			NamespaceDeletion(deletion).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		return f
+	}
+
+	getNamespace := func(ctx context.Context, cli client.Client) (*corev1.Namespace, error) {
+		namespace := &corev1.Namespace{}
+		err := cli.Get(ctx, client.ObjectKey{Name: authNamespace}, namespace)
+
+		return namespace, err
+	}
+
+	It("should leave owned namespaces to the garbage collector with Background propagation", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tracker, ownedNamespace()).Build()
+
+		// when
+		err := createFeature(cli, nil).Cleanup(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		namespace, errGet := getNamespace(ctx, cli)
+		Expect(errGet).ToNot(HaveOccurred())
+		Expect(namespace.GetOwnerReferences()).To(HaveLen(1), "owner reference should be kept for the garbage collector")
+	})
+
+	It("should delete owned namespaces and remove the FeatureTracker once they have terminated with Foreground propagation", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tracker, ownedNamespace()).Build()
+
+		// when
+		err := createFeature(cli, &dsciv1.NamespaceDeletion{Propagation: dsciv1.ForegroundNamespacePropagation}).Cleanup(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		_, errGet := getNamespace(ctx, cli)
+		Expect(k8serr.IsNotFound(errGet)).To(BeTrue())
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKeyFromObject(tracker), &featurev1.FeatureTracker{}))).To(BeTrue())
+	})
+
+	It("should keep the FeatureTracker and request retry of the removal while owned namespaces are terminating", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tracker, ownedNamespace("example.com/hold")).Build()
+
+		// when
+		err := createFeature(cli, &dsciv1.NamespaceDeletion{Propagation: dsciv1.ForegroundNamespacePropagation}).Cleanup(ctx)
+
+		// then
+		terminating, isTerminating := feature.NamespacesTerminatingOnly(err)
+		Expect(isTerminating).To(BeTrue())
+		Expect(terminating.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(terminating.Terminating).To(ConsistOf(authNamespace + " (Some resources are remaining: pods. has 2 resource instances)"))
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(tracker), &featurev1.FeatureTracker{})).To(Succeed())
+	})
+
+	It("should report progress of namespaces which have not terminated within the timeout", func(ctx context.Context) {
+		// given
+		terminating := ownedNamespace("example.com/hold")
+		terminating.DeletionTimestamp = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tracker, terminating).Build()
+		deletion := &dsciv1.NamespaceDeletion{
+			Propagation: dsciv1.ForegroundNamespacePropagation,
+			Timeout:     metav1.Duration{Duration: 30 * time.Second},
+		}
+
+		// when
+		err := createFeature(cli, deletion).Cleanup(ctx)
+
+		// then
+		_, isTerminating := feature.NamespacesTerminatingOnly(err)
+		Expect(isTerminating).To(BeFalse())
+		Expect(err).To(MatchError(And(
+			ContainSubstring("still terminating after 30s"),
+			ContainSubstring(authNamespace+" (Some resources are remaining: pods. has 2 resource instances)"),
+		)))
+	})
+
+	It("should detach owned namespaces from the FeatureTracker with Orphan propagation", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tracker, ownedNamespace()).Build()
+
+		// when
+		err := createFeature(cli, &dsciv1.NamespaceDeletion{Propagation: dsciv1.OrphanNamespacePropagation}).Cleanup(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		namespace, errGet := getNamespace(ctx, cli)
+		Expect(errGet).ToNot(HaveOccurred())
+		Expect(namespace.GetOwnerReferences()).To(BeEmpty())
+		Expect(namespace.GetLabels()).ToNot(HaveKey(labels.ODH.OwnedNamespace), "namespace should not be reported, nor deleted as orphaned")
+		Expect(namespace.GetLabels()).ToNot(HaveKey(labels.ODH.Feature))
+	})
+
+	It("should not create namespace again while it is terminating", func(ctx context.Context) {
+		// given
+		terminating := ownedNamespace("example.com/hold")
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(terminating).Build()
+		Expect(cli.Delete(ctx, terminating)).To(Succeed())
+
+		// when
+		err := feature.CreateNamespace(ctx, createFeature(cli, nil), authNamespace)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("namespace " + authNamespace + " is still terminating")))
+	})
+})
@@ -0,0 +1,67 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+)
+
+// collectNamespace is a meta option recording the namespace of each resource applied from the manifests of the feature.
+func (f *Feature) collectNamespace(obj metav1.Object) error {
+	if namespace := obj.GetNamespace(); namespace != "" {
+		f.appliedNamespaces.Insert(namespace)
+	}
+
+	return nil
+}
+
+// recordAppliedNamespaces stages namespaces holding resources created by the feature, along with their UIDs, for the FeatureTracker
+// status. Namespaces removed in the meantime are left out, as they are recreated by the next apply pass anyway.
+func (f *Feature) recordAppliedNamespaces(ctx context.Context) error {
+	names := f.appliedNamespaces.UnsortedList()
+	sort.Strings(names)
+
+	var tracked []featurev1.TrackedNamespace
+	for _, name := range names {
+		namespace := &corev1.Namespace{}
+		if err := f.Client.Get(ctx, client.ObjectKey{Name: name}, namespace); err != nil {
+			if k8serr.IsNotFound(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed getting namespace %s of feature %s: %w", name, f.Name, err)
+		}
+
+		tracked = append(tracked, featurev1.TrackedNamespace{Name: name, UID: namespace.UID})
+	}
+
+	if reflect.DeepEqual(tracked, f.tracker.Status.Namespaces) {
+		return nil
+	}
+
+	f.stageStatus(func(saved *featurev1.FeatureTracker) {
+		saved.Status.Namespaces = tracked
+	})
+
+	return nil
+}
+
+// RecreatedNamespaceOf checks if the namespace has been recreated since the feature tracked by the FeatureTracker was applied,
+// meaning resources the feature created in it are gone.
+func RecreatedNamespaceOf(tracker *featurev1.FeatureTracker, namespace *corev1.Namespace) bool {
+	for _, tracked := range tracker.Status.Namespaces {
+		if tracked.Name == namespace.Name {
+			return tracked.UID != namespace.UID
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+package feature_test
+
+import (
+	"context"
+	"testing/fstest"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Namespaces of the feature", func() {
+
+	const meshMember = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: mesh-member
+  namespace: opendatahub-auth-provider
+`
+
+	namespace := func(uid types.UID) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-auth-provider", UID: uid}}
+	}
+
+	It("should record namespaces holding resources created by the feature", func(ctx context.Context) {
+		// given
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(namespace("uid-original")).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			Build()
+
+		f, err := feature.Define("mesh-control-plane-external-authz").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			Manifests(manifest.Location(fstest.MapFS{"authorino/mesh-member.yaml": {Data: []byte(meshMember)}}).Include("authorino")).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		tracker := featurev1.NewFeatureTracker(f.Name, f.TargetNamespace)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).To(Succeed())
+		Expect(tracker.Status.Namespaces).To(ConsistOf(featurev1.TrackedNamespace{Name: "opendatahub-auth-provider", UID: "uid-original"}))
+		Expect(feature.RecreatedNamespaceOf(tracker, namespace("uid-original"))).To(BeFalse())
+		Expect(feature.RecreatedNamespaceOf(tracker, namespace("uid-recreated"))).To(BeTrue())
+	})
+
+	It("should not consider namespaces the feature has not created resources in as recreated", func() {
+		// given
+		tracker := featurev1.NewFeatureTracker("mesh-control-plane-creation", "opendatahub")
+		tracker.Status.Namespaces = []featurev1.TrackedNamespace{{Name: "istio-system", UID: "uid-original"}}
+
+		// then
+		Expect(feature.RecreatedNamespaceOf(tracker, namespace("uid-recreated"))).To(BeFalse())
+	})
+})
+
+var _ = Describe("Namespaces created if not exist", func() {
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+	})
+
+	applyFeature := func(ctx context.Context) {
+		f, err := feature.Define("mesh-control-plane-creation").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			PolicyExemptions(&dsciv1.PolicyExemptions{Labels: map[string]string{"policy.example.com/exempt": "true"}}).
+			PreConditions(feature.CreateNamespaceIfNotExists("istio-system")).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		Expect(f.Apply(ctx)).To(Succeed())
+	}
+
+	getNamespace := func(ctx context.Context) *corev1.Namespace {
+		namespace := &corev1.Namespace{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "istio-system"}, namespace)).To(Succeed())
+
+		return namespace
+	}
+
+	It("should label the created namespace with the feature without owning it", func(ctx context.Context) {
+		// when
+		applyFeature(ctx)
+
+		// then
+		namespace := getNamespace(ctx)
+		Expect(namespace.GetLabels()).To(HaveKeyWithValue(labels.ODH.Feature, "mesh-control-plane-creation"))
+		Expect(namespace.GetLabels()).To(HaveKeyWithValue("policy.example.com/exempt", "true"))
+		Expect(namespace.GetOwnerReferences()).To(BeEmpty(), "namespace should not be removed together with the feature")
+	})
+
+	It("should repair labels removed from the namespace created by the feature", func(ctx context.Context) {
+		// given
+		applyFeature(ctx)
+		namespace := getNamespace(ctx)
+		delete(namespace.Labels, "policy.example.com/exempt")
+		Expect(cli.Update(ctx, namespace)).To(Succeed())
+
+		// when
+		applyFeature(ctx)
+
+		// then
+		Expect(getNamespace(ctx).GetLabels()).To(HaveKeyWithValue("policy.example.com/exempt", "true"))
+	})
+})
@@ -0,0 +1,108 @@
+package feature_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregate"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Applying features in parallel", func() {
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+	})
+
+	parallelHandler := func(provider feature.FeaturesProvider) *feature.FeaturesHandler {
+		gates, warnings := featuregate.Resolve(map[string]bool{string(featuregate.ParallelFeatureApply): true})
+		Expect(warnings).To(BeEmpty())
+
+		return feature.ComponentFeaturesHandler("servicemesh", "opendatahub", provider).
+			UsingClient(cli).
+			WithFeatureGates(gates)
+	}
+
+	It("should apply features only after the features they depend on", func(ctx context.Context) {
+		// given
+		var mu sync.Mutex
+		var events []string
+		record := func(event string) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		}
+
+		createControlPlane := func(_ context.Context, _ *feature.Feature) error {
+			time.Sleep(50 * time.Millisecond)
+			record("mesh-control-plane-creation")
+
+			return nil
+		}
+		recordApplied := func(_ context.Context, f *feature.Feature) error {
+			record(f.Name)
+
+			return nil
+		}
+
+		handler := parallelHandler(func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("mesh-metrics-collection").DependsOn("mesh-control-plane-creation").WithResources(recordApplied),
+				feature.Define("mesh-shared-configmap").DependsOn("mesh-control-plane-creation").WithResources(recordApplied),
+				feature.Define("mesh-control-plane-external-authz").DependsOn("mesh-control-plane-creation").WithResources(recordApplied),
+				feature.Define("mesh-control-plane-creation").WithResources(createControlPlane),
+			)
+		})
+
+		// when
+		err := handler.Apply(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(4))
+		Expect(events[0]).To(Equal("mesh-control-plane-creation"))
+		Expect(events[1:]).To(ConsistOf("mesh-metrics-collection", "mesh-shared-configmap", "mesh-control-plane-external-authz"))
+	})
+
+	It("should apply features which do not depend on each other concurrently", func(ctx context.Context) {
+		// given
+		var started sync.WaitGroup
+		started.Add(2)
+		waitForEachOther := func(_ context.Context, _ *feature.Feature) error {
+			started.Done()
+			started.Wait()
+
+			return nil
+		}
+
+		handler := parallelHandler(func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("mesh-metrics-collection").WithResources(waitForEachOther),
+				feature.Define("mesh-egress-tls").WithResources(waitForEachOther),
+			)
+		})
+
+		// when
+		applied := make(chan error, 1)
+		go func() {
+			applied <- handler.Apply(ctx)
+		}()
+
+		// then
+		Eventually(applied).WithTimeout(5 * time.Second).Should(Receive(BeNil()))
+	})
+})
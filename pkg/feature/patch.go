@@ -0,0 +1,179 @@
+package feature
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// Patch provides JSON merge patches of resources which are not owned by the feature, such as resources managed
+// by other operators. Each patch holds apiVersion, kind, name and namespace of the patched resource, and the fields to change.
+//
+// Unlike raw patch templates, changes made by the Patch are reverted when the feature is deleted. Original values of
+// the patched fields are recorded on the patched resource (see annotations.AppliedPatches), while the FeatureTracker
+// keeps the list of patched resources (see annotations.PatchedResources).
+type Patch func(f *Feature) ([]*unstructured.Unstructured, error)
+
+// PatchFromManifest creates a Patch from the manifest file. Templates are rendered using the feature data.
+func PatchFromManifest(fsys fs.FS, path string) Patch {
+	return func(f *Feature) ([]*unstructured.Unstructured, error) {
+		return manifest.Create(fsys, path).Process(f.data)
+	}
+}
+
+// applyPatches applies patches of the feature, including patch manifests declaring resource.OnDeleteRevertPatch policy,
+// and records patched resources on its FeatureTracker.
+func (f *Feature) applyPatches(ctx context.Context) error {
+	objects, errRender := f.revertiblePatches()
+	if errRender != nil {
+		return errRender
+	}
+
+	if len(objects) == 0 {
+		return nil
+	}
+
+	patched, err := patchedResources(f.tracker)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		optedOut, errOptOut := resource.MeshOptedOut(ctx, f.Client, obj)
+		if errOptOut != nil {
+			return errOptOut
+		}
+		if optedOut {
+			// Namespace opted out of the mesh after it has been enrolled, changes made so far are undone.
+			if errRevert := resource.RevertPatch(ctx, f.Client, resource.ReferenceOf(obj), f.tracker.Name); errRevert != nil {
+				return errRevert
+			}
+			patched = slices.DeleteFunc(patched, func(ref resource.Reference) bool { return ref == resource.ReferenceOf(obj) })
+
+			continue
+		}
+
+		if errApply := resource.ApplyRevertiblePatch(ctx, f.Client, obj, f.tracker.Name); errApply != nil {
+			return errApply
+		}
+
+		patched = appendReference(patched, resource.ReferenceOf(obj))
+	}
+
+	return f.recordPatchedResources(ctx, patched)
+}
+
+func (f *Feature) revertiblePatches() ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+
+	for _, patch := range f.patches {
+		rendered, errPatch := patch(f)
+		if errPatch != nil {
+			return nil, fmt.Errorf("failed rendering patch of feature %s: %w", f.Name, errPatch)
+		}
+
+		objects = append(objects, rendered...)
+	}
+
+	for _, applier := range f.appliers {
+		source, ok := applier.(resource.RevertiblePatchSource)
+		if !ok {
+			continue
+		}
+
+		rendered, errPatch := source.RevertiblePatches(f.data)
+		if errPatch != nil {
+			return nil, fmt.Errorf("failed rendering patch of feature %s: %w", f.Name, errPatch)
+		}
+
+		objects = append(objects, rendered...)
+	}
+
+	return objects, nil
+}
+
+func (f *Feature) recordPatchedResources(ctx context.Context, patched []resource.Reference) error {
+	patchedJSON, errJSON := json.Marshal(patched)
+	if errJSON != nil {
+		return fmt.Errorf("failed serializing resources patched by feature %s: %w", f.Name, errJSON)
+	}
+
+	if f.tracker.GetAnnotations()[annotations.PatchedResources] == string(patchedJSON) {
+		return nil
+	}
+
+	original := f.tracker.DeepCopy()
+	trackerAnnotations := f.tracker.GetAnnotations()
+	if trackerAnnotations == nil {
+		trackerAnnotations = map[string]string{}
+	}
+	trackerAnnotations[annotations.PatchedResources] = string(patchedJSON)
+	f.tracker.SetAnnotations(trackerAnnotations)
+
+	if errPatch := f.Client.Patch(ctx, f.tracker, client.MergeFrom(original)); errPatch != nil {
+		return fmt.Errorf("failed recording resources patched by feature %s: %w", f.Name, errPatch)
+	}
+
+	return nil
+}
+
+// revertPatches reverts changes made to the resources patched by the feature.
+func revertPatches(f *Feature) CleanupFunc {
+	return func(ctx context.Context, cli client.Client) error {
+		tracker := f.tracker
+		if tracker == nil {
+			var errGet error
+			if tracker, errGet = getFeatureTracker(ctx, cli, f.Name, f.TargetNamespace); errGet != nil {
+				return client.IgnoreNotFound(errGet)
+			}
+		}
+
+		patched, err := patchedResources(tracker)
+		if err != nil {
+			return err
+		}
+
+		var multiErr *multierror.Error
+		for _, ref := range patched {
+			multiErr = multierror.Append(multiErr, resource.RevertPatch(ctx, cli, ref, tracker.Name))
+		}
+
+		return multiErr.ErrorOrNil()
+	}
+}
+
+func patchedResources(tracker *featurev1.FeatureTracker) ([]resource.Reference, error) {
+	var patched []resource.Reference
+
+	recorded, found := tracker.GetAnnotations()[annotations.PatchedResources]
+	if !found {
+		return patched, nil
+	}
+
+	if err := json.Unmarshal([]byte(recorded), &patched); err != nil {
+		return nil, fmt.Errorf("failed reading resources patched by feature %s: %w", tracker.Name, err)
+	}
+
+	return patched, nil
+}
+
+func appendReference(refs []resource.Reference, ref resource.Reference) []resource.Reference {
+	for _, existing := range refs {
+		if existing == ref {
+			return refs
+		}
+	}
+
+	return append(refs, ref)
+}
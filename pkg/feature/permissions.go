@@ -0,0 +1,130 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Verbs commonly required by features.
+var (
+	// ApplyVerbs are needed to create, update and garbage collect resources rendered from manifests.
+	ApplyVerbs = []string{"get", "create", "patch", "delete"} //nolint:gochecknoglobals // Reason: read-only list of verbs
+	// PatchVerbs are needed to patch existing resources and revert the patches.
+	PatchVerbs = []string{"get", "patch"} //nolint:gochecknoglobals // Reason: read-only list of verbs
+)
+
+// Privilege classifies the access a permission grants.
+type Privilege string
+
+const (
+	// NamespacedPrivilege is access to resources in a single namespace, which can be granted by a Role.
+	NamespacedPrivilege Privilege = "Namespaced"
+	// ClusterPrivilege is access to cluster-scoped resources or to resources in all namespaces, which requires a ClusterRole,
+	// typically granted only by cluster administrators.
+	ClusterPrivilege Privilege = "Cluster"
+)
+
+// Permission is access to resources needed by the operations of a feature. Features declare their permissions using
+// RequiresPermissions, so that missing ones are reported upfront, see FeaturesHandler.MissingPermissions, instead of
+// the feature failing mid-apply with Forbidden errors.
+type Permission struct {
+	Group    string
+	Resource string
+	Verbs    []string
+	// Namespace in which the access is needed, empty for cluster-scoped resources or access in all namespaces.
+	Namespace string
+}
+
+// NamespacedPermission defines access to resources in the given namespace.
+func NamespacedPermission(namespace, group, resource string, verbs ...string) Permission {
+	return Permission{Group: group, Resource: resource, Verbs: verbs, Namespace: namespace}
+}
+
+// ClusterPermission defines access to cluster-scoped resources, or to namespaced resources in all namespaces.
+func ClusterPermission(group, resource string, verbs ...string) Permission {
+	return Permission{Group: group, Resource: resource, Verbs: verbs}
+}
+
+// Privilege classifies the permission.
+func (p Permission) Privilege() Privilege {
+	if p.Namespace == "" {
+		return ClusterPrivilege
+	}
+
+	return NamespacedPrivilege
+}
+
+func (p Permission) String() string {
+	resource := p.Resource
+	if p.Group != "" {
+		resource += "." + p.Group
+	}
+
+	scope := "cluster-wide"
+	if p.Namespace != "" {
+		scope = "in namespace " + p.Namespace
+	}
+
+	return fmt.Sprintf("%s %s %s", strings.Join(p.Verbs, ","), resource, scope)
+}
+
+// MissingPermission is a permission required by the feature which the operator has not been granted,
+// with only the verbs it lacks.
+type MissingPermission struct {
+	Feature string
+	Permission
+}
+
+func (m MissingPermission) String() string {
+	return fmt.Sprintf("%s (%s privilege, needed by %s)", m.Permission, m.Privilege(), m.Feature)
+}
+
+// MissingPermissions checks permissions required by the feature using SelfSubjectAccessReviews and returns the ones
+// the client is not allowed to.
+func (f *Feature) MissingPermissions(ctx context.Context) ([]MissingPermission, error) {
+	var missing []MissingPermission
+
+	for _, permission := range f.permissions {
+		var deniedVerbs []string
+		for _, verb := range permission.Verbs {
+			allowed, err := isAllowed(ctx, f.Client, permission, verb)
+			if err != nil {
+				return nil, fmt.Errorf("failed checking permission to %s %s: %w", verb, permission.Resource, err)
+			}
+			if !allowed {
+				deniedVerbs = append(deniedVerbs, verb)
+			}
+		}
+
+		if len(deniedVerbs) > 0 {
+			denied := permission
+			denied.Verbs = deniedVerbs
+			missing = append(missing, MissingPermission{Feature: f.Name, Permission: denied})
+		}
+	}
+
+	return missing, nil
+}
+
+func isAllowed(ctx context.Context, cli client.Client, permission Permission, verb string) (bool, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: permission.Namespace,
+				Verb:      verb,
+				Group:     permission.Group,
+				Resource:  permission.Resource,
+			},
+		},
+	}
+
+	if err := cli.Create(ctx, review); err != nil {
+		return false, err
+	}
+
+	return review.Status.Allowed, nil
+}
@@ -0,0 +1,101 @@
+package feature_test
+
+import (
+	"context"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature permissions", func() {
+
+	// allowing grants access in the given namespaces only, "" standing for cluster-wide access, and never allows to delete.
+	allowing := func(namespaces ...string) client.Client {
+		return interceptor.NewClient(fake.NewClientBuilder().Build(), interceptor.Funcs{
+			Create: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				review, isReview := obj.(*authorizationv1.SelfSubjectAccessReview)
+				if !isReview {
+					return cli.Create(ctx, obj, opts...)
+				}
+
+				attributes := review.Spec.ResourceAttributes
+				for _, namespace := range namespaces {
+					if attributes.Namespace == namespace && attributes.Verb != "delete" {
+						review.Status.Allowed = true
+					}
+				}
+
+				return nil
+			},
+		})
+	}
+
+	createFeature := func(permissions ...feature.Permission) *feature.Feature {
+		f, err := feature.Define("mesh-control-plane-creation").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			RequiresPermissions(permissions...).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+
+		return f
+	}
+
+	It("should report verbs the operator has not been granted", func(ctx context.Context) {
+		// given
+		f := createFeature(
+			feature.ClusterPermission("", "namespaces", "get", "create", "patch"),
+			feature.NamespacedPermission("istio-system", "maistra.io", "servicemeshcontrolplanes", feature.ApplyVerbs...),
+		)
+		f.Client = allowing("istio-system")
+
+		// when
+		missing, err := f.MissingPermissions(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(missing).To(ConsistOf(
+			feature.MissingPermission{
+				Feature:    "mesh-control-plane-creation",
+				Permission: feature.ClusterPermission("", "namespaces", "get", "create", "patch"),
+			},
+			feature.MissingPermission{
+				Feature:    "mesh-control-plane-creation",
+				Permission: feature.NamespacedPermission("istio-system", "maistra.io", "servicemeshcontrolplanes", "delete"),
+			},
+		))
+	})
+
+	It("should not report permissions which have been granted", func(ctx context.Context) {
+		// given
+		f := createFeature(feature.NamespacedPermission("opendatahub", "", "configmaps", "get", "create"))
+		f.Client = allowing("", "opendatahub")
+
+		// when
+		missing, err := f.MissingPermissions(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(missing).To(BeEmpty())
+	})
+
+	It("should classify and describe missing permissions", func() {
+		// given
+		clusterWide := feature.MissingPermission{Feature: "console-links", Permission: feature.ClusterPermission("console.openshift.io", "consolelinks", "create")}
+		namespaced := feature.MissingPermission{Feature: "mesh-shared-configmap", Permission: feature.NamespacedPermission("opendatahub", "", "configmaps", "get", "patch")}
+
+		// then
+		Expect(clusterWide.Privilege()).To(Equal(feature.ClusterPrivilege))
+		Expect(clusterWide.String()).To(Equal("create consolelinks.console.openshift.io cluster-wide (Cluster privilege, needed by console-links)"))
+		Expect(namespaced.Privilege()).To(Equal(feature.NamespacedPrivilege))
+		Expect(namespaced.String()).To(Equal("get,patch configmaps in namespace opendatahub (Namespaced privilege, needed by mesh-shared-configmap)"))
+	})
+})
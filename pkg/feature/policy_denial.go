@@ -0,0 +1,54 @@
+package feature
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var (
+	// admissionDenialPattern matches messages of admission webhook denials built by the API server.
+	admissionDenialPattern = regexp.MustCompile(`(?s)admission webhook "([^"]+)" denied the request:\s*(.*)`)
+	// violatedConstraintPattern matches violations reported by OPA Gatekeeper, one per line, prefixed with the constraint name.
+	violatedConstraintPattern = regexp.MustCompile(`(?m)^\[([^\]\s]+)\]`)
+)
+
+// PolicyDenial describes a change of the feature refused by an admission webhook enforcing cluster policies, such as OPA Gatekeeper.
+type PolicyDenial struct {
+	// Webhook which denied the change, e.g. "validation.gatekeeper.sh".
+	Webhook string
+	// Constraints violated by the change. Empty when the webhook does not report them in the format used by Gatekeeper.
+	Constraints []string
+}
+
+func (d *PolicyDenial) String() string {
+	if len(d.Constraints) == 0 {
+		return fmt.Sprintf("change denied by admission webhook %q", d.Webhook)
+	}
+
+	return fmt.Sprintf("change denied by admission webhook %q, violated constraints: %s", d.Webhook, strings.Join(d.Constraints, ", "))
+}
+
+// AsPolicyDenial checks if the error has been caused by an admission webhook denying the request,
+// and if so, returns the webhook along with the constraints the request violated.
+func AsPolicyDenial(err error) (*PolicyDenial, bool) {
+	var statusErr *k8serr.StatusError
+	if !errors.As(err, &statusErr) {
+		return nil, false
+	}
+
+	matches := admissionDenialPattern.FindStringSubmatch(statusErr.ErrStatus.Message)
+	if matches == nil {
+		return nil, false
+	}
+
+	denial := &PolicyDenial{Webhook: matches[1]}
+	for _, violation := range violatedConstraintPattern.FindAllStringSubmatch(matches[2], -1) {
+		denial.Constraints = append(denial.Constraints, violation[1])
+	}
+
+	return denial, true
+}
@@ -0,0 +1,96 @@
+package feature_test
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Admission policies", func() {
+
+	Context("detecting denials", func() {
+
+		denied := func(message string) error {
+			statusErr := k8serr.NewForbidden(corev1.Resource("configmaps"), "mesh-config", fmt.Errorf("placeholder"))
+			statusErr.ErrStatus.Message = message
+
+			return fmt.Errorf("failed to create source opendatahub/mesh-config: %w", statusErr)
+		}
+
+		DescribeTable("should report webhook and violated constraints",
+			func(err error, expectedDenial *feature.PolicyDenial) {
+				// when
+				denial, isDenied := feature.AsPolicyDenial(err)
+
+				// then
+				Expect(isDenied).To(Equal(expectedDenial != nil))
+				Expect(denial).To(Equal(expectedDenial))
+			},
+			Entry("gatekeeper violating single constraint",
+				denied(`admission webhook "validation.gatekeeper.sh" denied the request: [require-team-label] you must provide labels: {"team"}`),
+				&feature.PolicyDenial{Webhook: "validation.gatekeeper.sh", Constraints: []string{"require-team-label"}}),
+			Entry("gatekeeper violating multiple constraints",
+				denied("admission webhook \"validation.gatekeeper.sh\" denied the request: [require-team-label] you must provide labels: {\"team\"}\n"+
+					"[disallow-sidecar-injection] sidecar injection is not allowed"),
+				&feature.PolicyDenial{Webhook: "validation.gatekeeper.sh", Constraints: []string{"require-team-label", "disallow-sidecar-injection"}}),
+			Entry("webhook not reporting constraints",
+				denied(`admission webhook "validate.kyverno.svc-fail" denied the request: resource was blocked due to the following policies`),
+				&feature.PolicyDenial{Webhook: "validate.kyverno.svc-fail"}),
+			Entry("forbidden by RBAC",
+				k8serr.NewForbidden(corev1.Resource("configmaps"), "mesh-config", fmt.Errorf("user cannot create resource")),
+				nil),
+			Entry("not an API error",
+				fmt.Errorf(`admission webhook "validation.gatekeeper.sh" denied the request: [require-team-label] missing`),
+				nil),
+		)
+
+		It("should describe violated constraints", func() {
+			// given
+			denial := &feature.PolicyDenial{Webhook: "validation.gatekeeper.sh", Constraints: []string{"require-team-label", "disallow-sidecar-injection"}}
+
+			// then
+			Expect(denial.String()).To(Equal(`change denied by admission webhook "validation.gatekeeper.sh", violated constraints: require-team-label, disallow-sidecar-injection`))
+		})
+	})
+
+	Context("adding exemptions", func() {
+
+		It("should add exemption metadata without overriding labels set by the operator", func() {
+			// given
+			f, err := feature.Define("mesh-metrics-collection").
+				UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+				TargetNamespace("opendatahub").
+				PolicyExemptions(&dsciv1.PolicyExemptions{
+					Labels:      map[string]string{"admission.gatekeeper.sh/ignore": "true", labels.ODH.Feature: "exempted"},
+					Annotations: map[string]string{"policy.example.com/exempt": "sidecar-injection"},
+				}).
+				Create()
+			Expect(err).ToNot(HaveOccurred())
+
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:        "mesh-config",
+				Annotations: map[string]string{"policy.example.com/exempt": "all"},
+			}}
+
+			// when
+			err = cluster.ApplyMetaOptions(configMap, feature.WithFeatureLabels(f), feature.WithPolicyExemptions(f))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configMap.Labels).To(HaveKeyWithValue("admission.gatekeeper.sh/ignore", "true"))
+			Expect(configMap.Labels).To(HaveKeyWithValue(labels.ODH.Feature, "mesh-metrics-collection"))
+			Expect(configMap.Annotations).To(HaveKeyWithValue("policy.example.com/exempt", "all"))
+		})
+	})
+})
@@ -0,0 +1,140 @@
+package feature
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+)
+
+// Poller waits for conditions checked by features, e.g. pods becoming ready, by checking them repeatedly until the timeout
+// elapses. Time is measured using the given clock, so that tests can exercise timeouts and backoff using a fake one
+// instead of waiting for minutes.
+type Poller struct {
+	clock       clock.Clock
+	interval    time.Duration
+	factor      float64
+	maxInterval time.Duration
+	timeout     time.Duration
+	// once checks the condition a single time instead of waiting for it, see CheckOnce.
+	once bool
+}
+
+// NewPoller creates a poller checking conditions every interval until the timeout elapses.
+func NewPoller(clk clock.Clock, interval, timeout time.Duration) *Poller {
+	return &Poller{
+		clock:    clk,
+		interval: interval,
+		factor:   1,
+		timeout:  timeout,
+	}
+}
+
+// DefaultPoller checks conditions every 2 seconds for 5 minutes, using the real clock.
+func DefaultPoller() *Poller {
+	return NewPoller(clock.RealClock{}, interval, duration)
+}
+
+// WithBackoff returns a copy of the poller multiplying the interval by the factor after each check, up to maxInterval.
+func (p *Poller) WithBackoff(factor float64, maxInterval time.Duration) *Poller {
+	withBackoff := *p
+	withBackoff.factor = factor
+	withBackoff.maxInterval = maxInterval
+
+	return &withBackoff
+}
+
+// WithClock returns a copy of the poller measuring time using the given clock.
+func (p *Poller) WithClock(clk clock.Clock) *Poller {
+	withClock := *p
+	withClock.clock = clk
+
+	return &withClock
+}
+
+// WithTimeout returns a copy of the poller giving up after the given timeout.
+func (p *Poller) WithTimeout(timeout time.Duration) *Poller {
+	withTimeout := *p
+	withTimeout.timeout = timeout
+
+	return &withTimeout
+}
+
+// CheckOnce returns a copy of the poller checking the condition right away and giving up unless it is already done,
+// e.g. to evaluate conditions without waiting for them.
+func (p *Poller) CheckOnce() *Poller {
+	once := *p
+	once.once = true
+
+	return &once
+}
+
+// Timeout is the time after which the poller gives up.
+func (p *Poller) Timeout() time.Duration {
+	return p.timeout
+}
+
+// Poll checks the condition until it is done, fails, or the timeout elapses. The condition is checked right away when immediate
+// is true, otherwise after the first interval. Same as wait.PollUntilContextTimeout, it returns the error of the condition,
+// or the error of the context, i.e. context.DeadlineExceeded when the timeout has elapsed.
+func (p *Poller) Poll(ctx context.Context, immediate bool, condition wait.ConditionWithContextFunc) error {
+	if p.once {
+		if done, err := condition(ctx); err != nil || done {
+			return err
+		}
+
+		return context.DeadlineExceeded
+	}
+
+	start := p.clock.Now()
+	next := p.interval
+	for checked := false; ; checked = true {
+		if checked || immediate {
+			if done, err := condition(ctx); err != nil || done {
+				return err
+			}
+			next = p.nextInterval(next, checked)
+		}
+
+		remaining := p.timeout - p.clock.Since(start)
+		if remaining <= 0 {
+			return context.DeadlineExceeded
+		}
+		// condition is not checked once the timeout elapses before the next check, as there is no time left to act on it
+		timedOut := next >= remaining
+		if err := p.sleep(ctx, min(next, remaining)); err != nil {
+			return err
+		}
+		if timedOut {
+			return context.DeadlineExceeded
+		}
+	}
+}
+
+func (p *Poller) sleep(ctx context.Context, d time.Duration) error {
+	timer := p.clock.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C():
+		// context may be done while the timer fires as well, it takes precedence the same way as in wait.PollUntilContextTimeout
+		return ctx.Err()
+	}
+}
+
+// nextInterval grows the interval by the backoff factor, except after the immediate check, so the first interval is kept.
+func (p *Poller) nextInterval(current time.Duration, grow bool) time.Duration {
+	if !grow || p.factor <= 1 {
+		return current
+	}
+
+	next := time.Duration(float64(current) * p.factor)
+	if p.maxInterval > 0 && next > p.maxInterval {
+		return p.maxInterval
+	}
+
+	return next
+}
@@ -0,0 +1,113 @@
+package feature_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/featuretesting"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Polling wait conditions", func() {
+
+	var checks int
+
+	BeforeEach(func() {
+		checks = 0
+	})
+
+	never := func(_ context.Context) (bool, error) {
+		checks++
+
+		return false, nil
+	}
+
+	It("should give up once the timeout elapses", func(ctx context.Context) {
+		// given
+		poller, clock := featuretesting.NewInstantPoller(feature.DefaultPoller())
+		start := clock.Now()
+
+		// when
+		err := poller.Poll(ctx, false, never)
+
+		// then
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(clock.Since(start)).To(Equal(5 * time.Minute))
+		Expect(checks).To(Equal(149), "condition should be checked every 2 seconds, except when the timeout elapses")
+	})
+
+	It("should check the condition right away when immediate", func(ctx context.Context) {
+		// given
+		poller, clock := featuretesting.NewInstantPoller(feature.NewPoller(nil, time.Second, time.Minute))
+		doneOnFirstCheck := func(_ context.Context) (bool, error) {
+			checks++
+
+			return true, nil
+		}
+
+		// when
+		err := poller.Poll(ctx, true, doneOnFirstCheck)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(checks).To(Equal(1))
+		Expect(clock.Waits()).To(BeEmpty())
+	})
+
+	It("should grow intervals between checks up to the max interval", func(ctx context.Context) {
+		// given
+		poller, clock := featuretesting.NewInstantPoller(feature.NewPoller(nil, time.Second, 30*time.Second).WithBackoff(2, 8*time.Second))
+
+		// when
+		err := poller.Poll(ctx, true, never)
+
+		// then
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(clock.Waits()).To(Equal([]time.Duration{
+			time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second, 7 * time.Second,
+		}))
+		Expect(checks).To(Equal(6))
+	})
+
+	It("should stop polling on the first error of the condition", func(ctx context.Context) {
+		// given
+		poller, _ := featuretesting.NewInstantPoller(feature.DefaultPoller())
+		failing := func(_ context.Context) (bool, error) {
+			checks++
+
+			return false, errors.New("control plane not found")
+		}
+
+		// when
+		err := poller.Poll(ctx, false, failing)
+
+		// then
+		Expect(err).To(MatchError("control plane not found"))
+		Expect(checks).To(Equal(1))
+	})
+
+	It("should stop polling when the context is cancelled", func(ctx context.Context) {
+		// given
+		poller, _ := featuretesting.NewInstantPoller(feature.DefaultPoller())
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancelOnSecondCheck := func(_ context.Context) (bool, error) {
+			checks++
+			if checks == 2 {
+				cancel()
+			}
+
+			return false, nil
+		}
+
+		// when
+		err := poller.Poll(cancelledCtx, false, cancelOnSecondCheck)
+
+		// then
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(checks).To(Equal(2))
+	})
+})
@@ -0,0 +1,122 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+)
+
+// EvaluatePreconditions checks prerequisites of the feature without applying it: the required OpenShift version, the data
+// its manifests are rendered with and its preconditions. Writes made by the preconditions, such as creating namespaces, are
+// only sent as dry run, and conditions they wait for are checked once, so that the evaluation neither changes the cluster nor
+// blocks. Failures carry the reason the FeatureTracker would report them with.
+func (f *Feature) EvaluatePreconditions(ctx context.Context) error {
+	restore, errDryRun := f.useDryRun(ctx, client.NewDryRunClient(f.Client))
+	defer restore()
+	if errDryRun != nil {
+		return errDryRun
+	}
+
+	if errVersion := f.ensureClusterVersionSupported(ctx); errVersion != nil {
+		return &withConditionReasonError{reason: featurev1.ConditionReason.UnsupportedClusterVersion, err: errVersion}
+	}
+
+	var multiErr *multierror.Error
+	for _, dataProvider := range f.dataProviders {
+		multiErr = multierror.Append(multiErr, dataProvider(ctx, f))
+	}
+	if errDataLoad := multiErr.ErrorOrNil(); errDataLoad != nil {
+		return &withConditionReasonError{reason: featurev1.ConditionReason.LoadTemplateData, err: errDataLoad}
+	}
+
+	for _, precondition := range f.preconditions {
+		multiErr = multierror.Append(multiErr, precondition(ctx, f))
+	}
+	if preconditionsErr := multiErr.ErrorOrNil(); preconditionsErr != nil {
+		return &withConditionReasonError{reason: featurev1.ConditionReason.PreConditions, err: preconditionsErr}
+	}
+
+	return nil
+}
+
+// useDryRun switches the feature to the given dry-run client, checks conditions it waits for only once and attaches the
+// FeatureTracker for evaluation, see useTrackerForEvaluation. Returned function restores the original client, poller and tracker.
+func (f *Feature) useDryRun(ctx context.Context, dryRunClient client.Client) (func(), error) {
+	cli, poller, tracker := f.Client, f.poller, f.tracker
+	restore := func() {
+		f.Client, f.poller, f.tracker = cli, poller, tracker
+	}
+
+	f.Client = dryRunClient
+	f.poller = f.Poller().CheckOnce()
+
+	return restore, f.useTrackerForEvaluation(ctx)
+}
+
+// useTrackerForEvaluation attaches the FeatureTracker of the feature, so that preconditions can refer to it, e.g. as the owner
+// of the namespaces they create. Tracker of the feature which has never been applied is not created, only its stand-in is used.
+func (f *Feature) useTrackerForEvaluation(ctx context.Context) error {
+	tracker, errGet := getFeatureTracker(ctx, f.Client, f.Name, f.TargetNamespace)
+	if k8serr.IsNotFound(errGet) {
+		tracker = featurev1.NewFeatureTracker(f.Name, f.TargetNamespace)
+	} else if errGet != nil {
+		return errGet
+	}
+
+	if errGVK := ensureGVKSet(tracker, f.Client.Scheme()); errGVK != nil {
+		return errGVK
+	}
+
+	f.tracker = tracker
+
+	return nil
+}
+
+// EvaluatePreconditions evaluates prerequisites of all enabled features of the handler up front, see Feature.EvaluatePreconditions,
+// and reports the outcome for each of them sorted by capability and feature, so that every missing prerequisite is known at once
+// rather than one failed apply at a time. Nothing is applied to the cluster.
+func (fh *FeaturesHandler) EvaluatePreconditions(ctx context.Context) ([]dsciv1.PreconditionStatus, error) {
+	fh.features = make([]*Feature, 0)
+
+	for _, featuresProvider := range fh.featuresProviders {
+		if err := featuresProvider(fh); err != nil {
+			return nil, fmt.Errorf("failed adding features to the handler. cause: %w", err)
+		}
+	}
+
+	var evaluated []dsciv1.PreconditionStatus
+	for _, f := range fh.features {
+		enabled, err := f.Enabled(ctx, f)
+		if err != nil {
+			return nil, fmt.Errorf("failed checking if feature %s is enabled: %w", f.Name, err)
+		}
+		if !enabled {
+			continue
+		}
+
+		outcome := dsciv1.PreconditionStatus{Feature: f.Name, Capability: f.capability, Satisfied: true}
+		if errEval := f.EvaluatePreconditions(ctx); errEval != nil {
+			outcome.Satisfied = false
+			outcome.Reason = string(conditionReasonOf(errEval))
+			outcome.Message = errEval.Error()
+		}
+		evaluated = append(evaluated, outcome)
+	}
+
+	sort.SliceStable(evaluated, func(i, j int) bool {
+		if evaluated[i].Capability != evaluated[j].Capability {
+			return evaluated[i].Capability < evaluated[j].Capability
+		}
+
+		return evaluated[i].Feature < evaluated[j].Feature
+	})
+
+	return evaluated, nil
+}
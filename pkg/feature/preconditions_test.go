@@ -0,0 +1,96 @@
+package feature_test
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Evaluating preconditions", func() {
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().WithScheme(scheme).Build()
+	})
+
+	evaluate := func(ctx context.Context, provider feature.FeaturesProvider) []dsciv1.PreconditionStatus {
+		evaluated, err := feature.ComponentFeaturesHandler("kserve", "opendatahub", provider).
+			UsingClient(cli).
+			EvaluatePreconditions(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		return evaluated
+	}
+
+	It("should report prerequisites of all features at once", func(ctx context.Context) {
+		// given
+		missingData := func(_ context.Context, _ *feature.Feature) error {
+			return errors.New("failed to fetch OpenShift domain")
+		}
+
+		// when
+		evaluated := evaluate(ctx, func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("serverless-serving-gateways").WithData(missingData),
+				feature.Define("kserve-external-authz").PreConditions(feature.EnsureOperatorIsInstalled("authorino-operator")),
+				feature.Define("serverless-net-istio-secret-filtering"),
+			)
+		})
+
+		// then
+		Expect(evaluated).To(HaveExactElements(
+			And(
+				HaveField("Feature", "kserve-external-authz"),
+				HaveField("Capability", "kserve"),
+				HaveField("Satisfied", false),
+				HaveField("Reason", string(featurev1.ConditionReason.PreConditions)),
+				HaveField("Message", ContainSubstring(`operator subscription "authorino-operator"`)),
+			),
+			dsciv1.PreconditionStatus{Feature: "serverless-net-istio-secret-filtering", Capability: "kserve", Satisfied: true},
+			And(
+				HaveField("Feature", "serverless-serving-gateways"),
+				HaveField("Satisfied", false),
+				HaveField("Reason", string(featurev1.ConditionReason.LoadTemplateData)),
+				HaveField("Message", ContainSubstring("failed to fetch OpenShift domain")),
+			),
+		))
+	})
+
+	It("should not change the cluster nor wait for conditions", func(ctx context.Context) {
+		// when
+		evaluated := evaluate(ctx, func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("serverless-serving-deployment").
+					PreConditions(
+						feature.CreateNamespaceIfNotExists("knative-serving"),
+						feature.WaitForResourceToBeCreated("knative-serving", gvk.KnativeServing),
+					),
+			)
+		})
+
+		// then
+		Expect(evaluated).To(ConsistOf(HaveField("Satisfied", false)))
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: "knative-serving"}, &corev1.Namespace{}))).To(BeTrue())
+		trackers := &featurev1.FeatureTrackerList{}
+		Expect(cli.List(ctx, trackers)).To(Succeed())
+		Expect(trackers.Items).To(BeEmpty())
+	})
+})
@@ -0,0 +1,52 @@
+package feature
+
+import (
+	"context"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+)
+
+// ProfileResolver decides if the feature of a given name is part of the profile.
+type ProfileResolver func(featureName string) bool
+
+// profileFeatures lists features which are part of a given profile. Features not listed are disabled
+// when the profile is selected. ProfileFull is not listed as it includes all the features.
+var profileFeatures = map[dsciv1.Profile][]string{
+	dsciv1.ProfileMinimal: {},
+	dsciv1.ProfileServingOnly: {
+		"mesh-control-plane-creation",
+		"mesh-shared-configmap",
+		"mesh-control-plane-external-authz",
+		"mesh-control-plane-adopted-authz",
+		"enable-proxy-injection-in-authorino-deployment",
+		"mesh-lightweight-auth",
+		"mesh-lightweight-auth-plugin",
+		"serving-cert-refs",
+		"serving-certificates-openshift",
+		"serving-certificates-cert-manager",
+		"serving-certificates-self-signed",
+	},
+}
+
+// ResolveProfile returns a ProfileResolver for a given profile. Unknown or not set profile includes all the features.
+func ResolveProfile(profile dsciv1.Profile) ProfileResolver {
+	features, found := profileFeatures[profile]
+	if !found {
+		return func(string) bool {
+			return true
+		}
+	}
+
+	included := make(map[string]bool, len(features))
+	for _, name := range features {
+		included[name] = true
+	}
+
+	return func(featureName string) bool {
+		return included[featureName]
+	}
+}
+
+func excludedByProfile(_ context.Context, _ *Feature) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,31 @@
+package feature_test
+
+import (
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profiles", func() {
+
+	DescribeTable("resolving features included in the profile",
+		func(profile dsciv1.Profile, featureName string, expected bool) {
+			// when
+			included := feature.ResolveProfile(profile)(featureName)
+
+			// then
+			Expect(included).To(Equal(expected))
+		},
+		Entry("should include all features when profile is not set", dsciv1.Profile(""), "mesh-metrics-collection", true),
+		Entry("should include all features in Full profile", dsciv1.ProfileFull, "mesh-metrics-collection", true),
+		Entry("should exclude service mesh from Minimal profile", dsciv1.ProfileMinimal, "mesh-control-plane-creation", false),
+		Entry("should include service mesh in ServingOnly profile", dsciv1.ProfileServingOnly, "mesh-control-plane-creation", true),
+		Entry("should include authorization in ServingOnly profile", dsciv1.ProfileServingOnly, "mesh-control-plane-external-authz", true),
+		Entry("should include lightweight authorization in ServingOnly profile", dsciv1.ProfileServingOnly, "mesh-lightweight-auth", true),
+		Entry("should include adopted Authorino in ServingOnly profile", dsciv1.ProfileServingOnly, "mesh-control-plane-adopted-authz", true),
+		Entry("should include serving certificates in ServingOnly profile", dsciv1.ProfileServingOnly, "serving-certificates-openshift", true),
+		Entry("should exclude metrics collection from ServingOnly profile", dsciv1.ProfileServingOnly, "mesh-metrics-collection", false),
+	)
+})
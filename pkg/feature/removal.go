@@ -0,0 +1,207 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+)
+
+// RemovalOutcome tells what happened to a resource of the feature when it has been cleaned up.
+type RemovalOutcome string
+
+const (
+	// ResourceDeleted means the resource is gone, or it is being deleted, e.g. by the garbage collector.
+	ResourceDeleted RemovalOutcome = "Deleted"
+	// ResourceSkipped means the resource has intentionally been left intact, as it is not owned by the feature.
+	ResourceSkipped RemovalOutcome = "Skipped"
+	// ResourceFailed means the resource is still present, even though it should have been deleted.
+	ResourceFailed RemovalOutcome = "Failed"
+)
+
+// RemovedResource is a resource labeled as created by the feature, see WithFeatureLabels, and the outcome of its removal.
+type RemovedResource struct {
+	Feature string
+	resource.Reference
+	Outcome RemovalOutcome
+	// Reason explains how the resource is being deleted, why it has been skipped or why it failed to be deleted.
+	Reason string
+}
+
+// RemovalReport summarizes the clean-up of features, so that callers can verify the teardown is complete.
+type RemovalReport struct {
+	Resources []RemovedResource
+	// Errors of clean-up tasks which cannot be attributed to any of the resources, e.g. reverting patches.
+	Errors []string
+}
+
+// Complete checks if no resource failed to be deleted and all the clean-up tasks succeeded.
+func (r RemovalReport) Complete() bool {
+	return len(r.Errors) == 0 && len(r.WithOutcome(ResourceFailed)) == 0
+}
+
+// WithOutcome returns the resources of the report which ended up with the given outcome.
+func (r RemovalReport) WithOutcome(outcome RemovalOutcome) []RemovedResource {
+	var resources []RemovedResource
+	for _, removed := range r.Resources {
+		if removed.Outcome == outcome {
+			resources = append(resources, removed)
+		}
+	}
+
+	return resources
+}
+
+// Merge appends resources and errors of the other report.
+func (r *RemovalReport) Merge(other RemovalReport) {
+	r.Resources = append(r.Resources, other.Resources...)
+	r.Errors = append(r.Errors, other.Errors...)
+}
+
+// CleanupWithReport executes Cleanup and reports the outcome for the FeatureTracker of the feature and the resources it created.
+// Resources are looked up before the clean-up among the kinds the feature requires permission to delete, see RequiresPermissions,
+// and checked again afterwards.
+func (f *Feature) CleanupWithReport(ctx context.Context) (RemovalReport, error) {
+	unlock := lockFeature(featurev1.NewFeatureTracker(f.Name, f.TargetNamespace).Name)
+	defer unlock()
+
+	report := RemovalReport{}
+
+	tracker, errGet := getFeatureTracker(ctx, f.Client, f.Name, f.TargetNamespace)
+	if client.IgnoreNotFound(errGet) != nil {
+		return report, errGet
+	}
+
+	candidates, errList := f.removalCandidates(ctx)
+	if errList != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", f.Name, errList))
+	}
+
+	cleanupErr := f.cleanup(ctx)
+
+	trackerRemoved := true
+	if tracker != nil {
+		removed := RemovedResource{
+			Feature:   f.Name,
+			Reference: resource.Reference{APIVersion: featurev1.GroupVersion.String(), Kind: "FeatureTracker", Name: tracker.Name},
+			Outcome:   ResourceDeleted,
+		}
+
+		current := &featurev1.FeatureTracker{}
+		switch errGet := f.Client.Get(ctx, client.ObjectKeyFromObject(tracker), current); {
+		case k8serr.IsNotFound(errGet):
+		case errGet != nil:
+			trackerRemoved = false
+			removed.Outcome, removed.Reason = ResourceFailed, fmt.Sprintf("failed checking if the resource has been deleted: %v", errGet)
+		case current.GetDeletionTimestamp() != nil:
+			removed.Reason = fmt.Sprintf("deletion in progress, waiting for finalizers %v", current.GetFinalizers())
+		default:
+			trackerRemoved = false
+			removed.Outcome, removed.Reason = ResourceFailed, "the resource is still present"
+			if cleanupErr != nil {
+				removed.Reason = cleanupErr.Error()
+			}
+		}
+		report.Resources = append(report.Resources, removed)
+	}
+
+	failed := !trackerRemoved
+	for i := range candidates {
+		removed := f.removalOutcome(ctx, &candidates[i], tracker, trackerRemoved, cleanupErr)
+		failed = failed || removed.Outcome == ResourceFailed
+		report.Resources = append(report.Resources, removed)
+	}
+
+	// Errors of clean-up tasks not related to any of the listed resources, e.g. reverting patches, are reported on their own.
+	if cleanupErr != nil && !failed {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", f.Name, cleanupErr))
+	}
+
+	return report, cleanupErr
+}
+
+// removalCandidates lists resources labeled with the feature among the kinds it is permitted to delete.
+func (f *Feature) removalCandidates(ctx context.Context) ([]unstructured.Unstructured, error) {
+	var kinds []schema.GroupVersionKind
+	for _, permission := range f.permissions {
+		if !slices.Contains(permission.Verbs, "delete") {
+			continue
+		}
+
+		kind, err := f.Client.RESTMapper().KindFor(schema.GroupVersionResource{Group: permission.Group, Resource: permission.Resource})
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed resolving kind of %s: %w", permission.Resource, err)
+		}
+		if !slices.Contains(kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	return cluster.ListFeatureResources(ctx, f.Client, f.Name, kinds...)
+}
+
+func (f *Feature) removalOutcome(ctx context.Context, obj *unstructured.Unstructured, tracker *featurev1.FeatureTracker, trackerRemoved bool, cleanupErr error) RemovedResource { //nolint:lll // Reason: long signature
+	removed := RemovedResource{Feature: f.Name, Reference: resource.ReferenceOf(obj)}
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.GroupVersionKind())
+	if err := f.Client.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		if k8serr.IsNotFound(err) {
+			removed.Outcome = ResourceDeleted
+		} else {
+			removed.Outcome, removed.Reason = ResourceFailed, fmt.Sprintf("failed checking if the resource has been deleted: %v", err)
+		}
+
+		return removed
+	}
+
+	ownedByTracker := tracker != nil && slices.ContainsFunc(current.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+		return ref.UID == tracker.UID
+	})
+
+	switch {
+	case current.GetDeletionTimestamp() != nil && current.GetKind() == "Namespace":
+		removed.Outcome, removed.Reason = ResourceDeleted, "deletion in progress, "+namespaceProgressOf(current)
+	case current.GetDeletionTimestamp() != nil:
+		removed.Outcome, removed.Reason = ResourceDeleted, fmt.Sprintf("deletion in progress, waiting for finalizers %v", current.GetFinalizers())
+	case current.GetKind() == "Namespace" && namespacePropagationOf(f.namespaceDeletion) == dsciv1.OrphanNamespacePropagation && cleanupErr == nil:
+		removed.Outcome, removed.Reason = ResourceSkipped, "orphaned according to the namespace deletion policy, left intact"
+	case ownedByTracker && trackerRemoved:
+		removed.Outcome, removed.Reason = ResourceDeleted, "deleted by the garbage collector along with FeatureTracker "+tracker.Name
+	case ownedByTracker:
+		removed.Outcome, removed.Reason = ResourceFailed, "FeatureTracker "+tracker.Name+" owning the resource has not been removed"
+	case cleanupErr != nil:
+		removed.Outcome, removed.Reason = ResourceFailed, cleanupErr.Error()
+	default:
+		removed.Outcome, removed.Reason = ResourceSkipped, "not owned by the FeatureTracker of the feature, e.g. adopted or shared with other features, left intact"
+	}
+
+	return removed
+}
+
+// namespaceProgressOf describes what the terminating namespace is waiting for, see TerminationProgress.
+func namespaceProgressOf(obj *unstructured.Unstructured) string {
+	namespace := &corev1.Namespace{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, namespace); err != nil {
+		return fmt.Sprintf("waiting for finalizers %v", obj.GetFinalizers())
+	}
+
+	return TerminationProgress(namespace)
+}
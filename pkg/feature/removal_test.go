@@ -0,0 +1,146 @@
+package feature_test
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Removal report", func() {
+
+	const featureName = "mesh-shared-configmap"
+
+	var (
+		scheme  *runtime.Scheme
+		mapper  meta.RESTMapper
+		tracker *featurev1.FeatureTracker
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		restMapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+		mapper = restMapper
+
+		tracker = featurev1.NewFeatureTracker(featureName, "opendatahub")
+		tracker.UID = "tracker-uid"
+	})
+
+	configMap := func(name string, owners ...metav1.OwnerReference) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "opendatahub",
+			Labels:          map[string]string{labels.ODH.Feature: featureName},
+			OwnerReferences: owners,
+		}}
+	}
+
+	ownedByTracker := func() metav1.OwnerReference {
+		return metav1.OwnerReference{APIVersion: featurev1.GroupVersion.String(), Kind: "FeatureTracker", Name: tracker.Name, UID: tracker.UID}
+	}
+
+	createFeature := func(cli client.Client) *feature.Feature {
+		f, err := feature.Define(featureName).
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			RequiresPermissions(feature.NamespacedPermission("opendatahub", "", "configmaps", feature.ApplyVerbs...)).
+			CleanupResources(resource.Reference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "opendatahub", Name: "refs"}).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		return f
+	}
+
+	outcomes := func(report feature.RemovalReport) map[string]feature.RemovalOutcome {
+		result := make(map[string]feature.RemovalOutcome, len(report.Resources))
+		for _, removed := range report.Resources {
+			result[removed.Kind+" "+removed.Name] = removed.Outcome
+		}
+
+		return result
+	}
+
+	It("should report deleted resources and the ones left intact", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithRESTMapper(mapper).
+			WithObjects(tracker, configMap("refs"), configMap("owned", ownedByTracker()), configMap("adopted")).
+			Build()
+
+		// when
+		report, err := createFeature(cli).CleanupWithReport(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Complete()).To(BeTrue())
+		Expect(outcomes(report)).To(Equal(map[string]feature.RemovalOutcome{
+			"FeatureTracker " + tracker.Name: feature.ResourceDeleted,
+			"ConfigMap refs":                 feature.ResourceDeleted,
+			"ConfigMap owned":                feature.ResourceDeleted,
+			"ConfigMap adopted":              feature.ResourceSkipped,
+		}))
+	})
+
+	It("should report resources which failed to be deleted", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithRESTMapper(mapper).
+			WithObjects(tracker, configMap("refs")).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Delete: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					if obj.GetName() == "refs" {
+						return errors.New("admission webhook denied the request")
+					}
+
+					return cli.Delete(ctx, obj, opts...)
+				},
+			}).
+			Build()
+
+		// when
+		report, err := createFeature(cli).CleanupWithReport(ctx)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(report.Complete()).To(BeFalse())
+		Expect(report.WithOutcome(feature.ResourceFailed)).To(ConsistOf(
+			HaveField("Reason", ContainSubstring("admission webhook denied the request")),
+		))
+	})
+
+	It("should report nothing for features which have not been applied", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).Build()
+
+		// when
+		report, err := createFeature(cli).CleanupWithReport(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Resources).To(BeEmpty())
+		Expect(report.Complete()).To(BeTrue())
+	})
+})
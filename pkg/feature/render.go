@@ -0,0 +1,95 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+)
+
+// RenderedFeature holds resources of an enabled feature, see FeaturesHandler.Render.
+type RenderedFeature struct {
+	Name      string
+	Resources []*unstructured.Unstructured
+}
+
+// Render loads the data of the feature using its data providers and returns resources defined by its manifests, Helm charts
+// and patches, without applying anything to the cluster. Resources created by Go functions, e.g. those passed to WithResources,
+// as well as metadata added by the feature when applying resources, such as owner references, are not part of the result.
+func (f *Feature) Render(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	var multiErr *multierror.Error
+	for _, dataProvider := range f.dataProviders {
+		multiErr = multierror.Append(multiErr, dataProvider(ctx, f))
+	}
+	if errDataLoad := multiErr.ErrorOrNil(); errDataLoad != nil {
+		return nil, fmt.Errorf("failed loading data of feature %s: %w", f.Name, errDataLoad)
+	}
+
+	return f.renderLoaded()
+}
+
+// renderLoaded returns resources defined by manifests, Helm charts and patches of the feature whose data is already loaded.
+func (f *Feature) renderLoaded() ([]*unstructured.Unstructured, error) {
+	var rendered []*unstructured.Unstructured
+	for _, applier := range f.appliers {
+		renderer, canRender := applier.(resource.Renderer)
+		if !canRender {
+			continue
+		}
+
+		objects, err := renderer.Render(f.data)
+		if err != nil {
+			return nil, fmt.Errorf("failed rendering manifests of feature %s: %w", f.Name, err)
+		}
+		rendered = append(rendered, objects...)
+	}
+
+	for _, patch := range f.patches {
+		objects, err := patch(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed rendering patches of feature %s: %w", f.Name, err)
+		}
+		rendered = append(rendered, objects...)
+	}
+
+	return rendered, nil
+}
+
+// Render returns resources of the features enabled for the current configuration in the order they would be applied,
+// see Feature.Render. Features read the cluster only to load their data and check if they are enabled.
+func (fh *FeaturesHandler) Render(ctx context.Context) ([]RenderedFeature, error) {
+	fh.features = make([]*Feature, 0)
+
+	for _, featuresProvider := range fh.featuresProviders {
+		if err := featuresProvider(fh); err != nil {
+			return nil, fmt.Errorf("failed adding features to the handler. cause: %w", err)
+		}
+	}
+
+	features, errOrder := inDependencyOrder(fh.features)
+	if errOrder != nil {
+		return nil, errOrder
+	}
+
+	var rendered []RenderedFeature
+	for _, f := range features {
+		enabled, err := f.Enabled(ctx, f)
+		if err != nil {
+			return nil, fmt.Errorf("failed checking if feature %s is enabled: %w", f.Name, err)
+		}
+		if !enabled {
+			continue
+		}
+
+		resources, err := f.Render(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rendered = append(rendered, RenderedFeature{Name: f.Name, Resources: resources})
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,146 @@
+package feature_test
+
+import (
+	"context"
+	"testing/fstest"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rendering features", func() {
+
+	const authConfig = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: auth-refs
+  namespace: {{ .TargetNamespace }}
+data:
+  AUTH_AUDIENCE: {{ .Audience }}
+`
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-config", Namespace: "opendatahub"},
+				Data:       map[string]string{"audience": "https://kubernetes.default.svc"},
+			}).
+			Build()
+	})
+
+	audienceFromCluster := func(ctx context.Context, f *feature.Feature) error {
+		clusterConfig := &corev1.ConfigMap{}
+		if err := f.Client.Get(ctx, client.ObjectKey{Name: "cluster-config", Namespace: "opendatahub"}, clusterConfig); err != nil {
+			return err
+		}
+
+		return f.Set("Audience", clusterConfig.Data["audience"])
+	}
+
+	disabled := func(_ context.Context, _ *feature.Feature) (bool, error) {
+		return false, nil
+	}
+
+	It("should render manifests of enabled features using data read from the cluster without applying them", func(ctx context.Context) {
+		// given
+		templates := fstest.MapFS{"auth/auth-refs.tmpl.yaml": {Data: []byte(authConfig)}}
+		handler := feature.ComponentFeaturesHandler("kserve", "opendatahub", func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("auth-refs").
+					Manifests(manifest.Location(templates).Include("auth")).
+					WithData(audienceFromCluster),
+				feature.Define("disabled-auth-refs").
+					EnabledWhen(disabled).
+					Manifests(manifest.Location(templates).Include("auth")).
+					WithData(audienceFromCluster),
+			)
+		}).UsingClient(cli)
+
+		// when
+		rendered, err := handler.Render(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rendered).To(HaveLen(1))
+		Expect(rendered[0].Name).To(Equal("auth-refs"))
+		Expect(rendered[0].Resources).To(HaveLen(1))
+		Expect(rendered[0].Resources[0].Object).To(HaveKeyWithValue("data", HaveKeyWithValue("AUTH_AUDIENCE", "https://kubernetes.default.svc")))
+
+		Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: "auth-refs", Namespace: "opendatahub"}, &corev1.ConfigMap{}))).
+			To(BeTrue(), "rendered resources should not be applied")
+		trackers := &featurev1.FeatureTrackerList{}
+		Expect(cli.List(ctx, trackers)).To(Succeed())
+		Expect(trackers.Items).To(BeEmpty())
+	})
+
+	It("should fail rendering when the manifest refers to missing data", func(ctx context.Context) {
+		// given
+		handler := feature.ComponentFeaturesHandler("kserve", "opendatahub", func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("auth-refs").
+					Manifests(manifest.Location(fstest.MapFS{"auth/auth-refs.tmpl.yaml": {Data: []byte(authConfig)}}).Include("auth")),
+			)
+		}).UsingClient(cli)
+
+		// when
+		_, err := handler.Render(ctx)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("failed rendering manifests of feature auth-refs")))
+	})
+
+	It("should rewrite image references using mirrors of the handler", func(ctx context.Context) {
+		// given
+		const proxy = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: auth-proxy
+  namespace: {{ .TargetNamespace }}
+spec:
+  template:
+    spec:
+      containers:
+      - name: proxy
+        image: registry.redhat.io/openshift4/ose-oauth-proxy:v4.14
+`
+		handler := feature.ComponentFeaturesHandler("kserve", "opendatahub", func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("auth-proxy").
+					Manifests(manifest.Location(fstest.MapFS{"proxy/auth-proxy.tmpl.yaml": {Data: []byte(proxy)}}).Include("proxy")),
+			)
+		}).UsingClient(cli).
+			WithImageMirrors([]dsciv1.ImageMirror{{Source: "registry.redhat.io/openshift4", Mirror: "mirror.example.com:5000/openshift4"}})
+
+		// when
+		rendered, err := handler.Render(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rendered).To(HaveLen(1))
+		containers, _, errContainers := unstructured.NestedSlice(rendered[0].Resources[0].Object, "spec", "template", "spec", "containers")
+		Expect(errContainers).ToNot(HaveOccurred())
+		Expect(containers).To(ConsistOf(HaveKeyWithValue("image", "mirror.example.com:5000/openshift4/ose-oauth-proxy:v4.14")))
+	})
+})
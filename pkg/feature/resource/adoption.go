@@ -0,0 +1,231 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// AdoptionPolicy defines what happens when the resource rendered by the feature already exists in the cluster, but has not been
+// created by the feature, e.g. the user pre-created the namespace or ConfigMap on a brownfield cluster. It is set for the whole
+// feature using its builder, and can be overridden in the manifest using annotations.AdoptionPolicy.
+type AdoptionPolicy string
+
+const (
+	// SkipExisting leaves the existing resource as it is, it is neither owned nor removed by the feature. It is the default.
+	SkipExisting AdoptionPolicy = "skip"
+	// AdoptExisting takes ownership of the existing resource, adding labels and owner references of the rendered one,
+	// so that it is treated as created by the feature, including being removed together with it.
+	AdoptExisting AdoptionPolicy = "adopt"
+	// FailOnExisting fails the feature with ResourceConflictError, leaving it to the user to remove or rename the resource.
+	FailOnExisting AdoptionPolicy = "fail"
+)
+
+// AdoptionPolicyOf reads the AdoptionPolicy declared for the rendered resource, or SkipExisting when it is not declared.
+func AdoptionPolicyOf(obj *unstructured.Unstructured) (AdoptionPolicy, error) {
+	declared, found := obj.GetAnnotations()[annotations.AdoptionPolicy]
+	if !found {
+		return SkipExisting, nil
+	}
+
+	policy := AdoptionPolicy(declared)
+	if policy != SkipExisting && policy != AdoptExisting && policy != FailOnExisting {
+		return "", fmt.Errorf("unknown %s %q of %s, expected one of %s, %s, %s",
+			annotations.AdoptionPolicy, declared, ReferenceOf(obj), SkipExisting, AdoptExisting, FailOnExisting)
+	}
+
+	return policy, nil
+}
+
+// ResourceConflictError indicates that the resource rendered by the feature already exists, but has not been created by it,
+// and the adoption policy does not allow taking it over.
+type ResourceConflictError struct {
+	Resource Reference
+	// Feature which created the existing resource, empty when it has not been created by the operator.
+	Feature string
+}
+
+func (e *ResourceConflictError) Error() string {
+	if e.Feature != "" {
+		return fmt.Sprintf("%s already exists and belongs to feature %q", e.Resource, e.Feature)
+	}
+
+	return fmt.Sprintf("%s already exists and is not owned by the operator, remove it or change the adoption policy to %q or %q",
+		e.Resource, AdoptExisting, SkipExisting)
+}
+
+// IsResourceConflict checks if the error, possibly wrapped, is caused by the resource existing before the feature created it.
+func IsResourceConflict(err error) bool {
+	var conflictErr *ResourceConflictError
+
+	return errors.As(err, &conflictErr)
+}
+
+// handleExisting reconciles metadata of the resource which exists before the feature applies it. Resources created by the same
+// feature before are adopted as such, repairing their metadata, others according to the AdoptionPolicy of the rendered resource.
+func handleExisting(ctx context.Context, cli client.Client, source, target *unstructured.Unstructured) error {
+	if belongsToFeature(source, target) {
+		return adopt(ctx, cli, source, target)
+	}
+
+	policy, errPolicy := AdoptionPolicyOf(source)
+	if errPolicy != nil {
+		return errPolicy
+	}
+
+	switch policy {
+	case SkipExisting:
+		return nil
+	case FailOnExisting:
+		return &ResourceConflictError{Resource: ReferenceOf(source), Feature: featureOf(target)}
+	case AdoptExisting:
+		// Resources of other features are not taken over, as both features would then claim to own them.
+		if owner := featureOf(target); owner != "" {
+			return &ResourceConflictError{Resource: ReferenceOf(source), Feature: owner}
+		}
+
+		return takeOver(ctx, cli, source, target)
+	}
+
+	return nil
+}
+
+// adopt takes over the existing resource created by the same feature before, e.g. restored from the platform configuration export
+// on a rebuilt cluster, instead of leaving it as is:
+//   - owner references are migrated to the owners of the rendered resource, replacing references to the owner of the same kind
+//     and name, but a different UID, which the garbage collector would otherwise act upon,
+//   - labels and annotations removed in the meantime are repaired according to the MetadataRepairPolicy of the rendered resource,
+//   - values of Secrets redacted by the export are replaced with the rendered ones.
+//
+// Resources which have not been created by the same feature of this operator are left intact, see belongsToFeature.
+func adopt(ctx context.Context, cli client.Client, source, target *unstructured.Unstructured) error {
+	if !belongsToFeature(source, target) {
+		return nil
+	}
+
+	policy, errPolicy := MetadataRepairPolicyOf(source)
+	if errPolicy != nil {
+		return errPolicy
+	}
+
+	original := target.DeepCopy()
+
+	target.SetOwnerReferences(migratedOwnerReferences(target.GetOwnerReferences(), source.GetOwnerReferences()))
+	repairMetadata(source, target, policy)
+
+	if target.GetAnnotations()[annotations.ExportRedacted] == "true" {
+		for _, field := range []string{"data", "stringData"} {
+			if value, found := source.Object[field]; found {
+				target.Object[field] = value
+			}
+		}
+
+		targetAnnotations := target.GetAnnotations()
+		delete(targetAnnotations, annotations.ExportRedacted)
+		target.SetAnnotations(targetAnnotations)
+	}
+
+	if equality.Semantic.DeepEqual(original.Object, target.Object) {
+		return nil
+	}
+
+	if err := cli.Patch(ctx, target, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed adopting resource %s/%s: %w", target.GetNamespace(), target.GetName(), err)
+	}
+
+	return nil
+}
+
+// belongsToFeature checks if the existing resource has been created by the same feature, either by its labels or, when the labels
+// have been removed, by the owner references to the FeatureTracker of the feature.
+func belongsToFeature(source, target *unstructured.Unstructured) bool {
+	if createdBySameFeature(source, target) {
+		return true
+	}
+
+	return slices.ContainsFunc(source.GetOwnerReferences(), func(desired metav1.OwnerReference) bool {
+		return desired.UID != "" && slices.ContainsFunc(target.GetOwnerReferences(), func(existing metav1.OwnerReference) bool {
+			return existing.UID == desired.UID
+		})
+	})
+}
+
+func createdBySameFeature(source, target *unstructured.Unstructured) bool {
+	feature := source.GetLabels()[labels.ODH.Feature]
+
+	return feature != "" && featureOf(target) == feature
+}
+
+// takeOver sets labels and owner references of the rendered resource on the existing one, leaving its other metadata and content intact.
+func takeOver(ctx context.Context, cli client.Client, source, target *unstructured.Unstructured) error {
+	original := target.DeepCopy()
+
+	targetLabels := target.GetLabels()
+	if targetLabels == nil {
+		targetLabels = make(map[string]string, len(source.GetLabels()))
+	}
+	for key, value := range source.GetLabels() {
+		targetLabels[key] = value
+	}
+	target.SetLabels(targetLabels)
+	target.SetOwnerReferences(migratedOwnerReferences(target.GetOwnerReferences(), source.GetOwnerReferences()))
+
+	if equality.Semantic.DeepEqual(original.Object, target.Object) {
+		return nil
+	}
+
+	if err := cli.Patch(ctx, target, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed taking over existing resource %s: %w", ReferenceOf(source), err)
+	}
+
+	return nil
+}
+
+// migratedOwnerReferences keeps references to other owners and replaces, or adds, references to the desired ones.
+func migratedOwnerReferences(existing, desired []metav1.OwnerReference) []metav1.OwnerReference {
+	sameOwner := func(a, b metav1.OwnerReference) bool {
+		return groupOf(a.APIVersion) == groupOf(b.APIVersion) && a.Kind == b.Kind && a.Name == b.Name
+	}
+
+	migrated := make([]metav1.OwnerReference, 0, len(existing)+len(desired))
+	for _, ref := range existing {
+		replaced := false
+		for _, desiredRef := range desired {
+			replaced = replaced || sameOwner(ref, desiredRef)
+		}
+		if !replaced {
+			migrated = append(migrated, ref)
+		}
+	}
+
+	return append(migrated, desired...)
+}
+
+func groupOf(apiVersion string) string {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return apiVersion
+	}
+
+	return gv.Group
+}
+
+// featureOf returns the name of the feature of this operator which created the resource, if any.
+func featureOf(obj *unstructured.Unstructured) string {
+	if obj.GetLabels()[labels.K8SCommon.ManagedBy] != cluster.OperatorName {
+		return ""
+	}
+
+	return obj.GetLabels()[labels.ODH.Feature]
+}
@@ -0,0 +1,220 @@
+package resource_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Adoption policy for pre-existing resources", func() {
+
+	trackerRef := metav1.OwnerReference{
+		APIVersion: "features.opendatahub.io/v1",
+		Kind:       "FeatureTracker",
+		Name:       "opendatahub-mesh-shared-configmap",
+		UID:        "uid-tracker",
+	}
+
+	preCreated := func(objLabels map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "service-mesh-refs", Namespace: "opendatahub", Labels: objLabels},
+			Data:       map[string]string{"CONTROL_PLANE_NAME": "user-defined"},
+		}
+	}
+
+	rendered := func(policy resource.AdoptionPolicy) *unstructured.Unstructured {
+		source := preCreated(map[string]string{labels.ODH.Feature: "mesh-shared-configmap", labels.K8SCommon.ManagedBy: cluster.OperatorName})
+		source.OwnerReferences = []metav1.OwnerReference{trackerRef}
+		source.Data = map[string]string{"CONTROL_PLANE_NAME": "data-science-smcp"}
+		if policy != "" {
+			source.Annotations = map[string]string{annotations.AdoptionPolicy: string(policy)}
+		}
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(source)
+		Expect(err).ToNot(HaveOccurred())
+
+		return &unstructured.Unstructured{Object: content}
+	}
+
+	It("should leave the pre-existing resource intact by default", func(ctx context.Context) {
+		// given
+		existing := preCreated(nil)
+		cli := fake.NewClientBuilder().WithObjects(existing).Build()
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{rendered("")})).To(Succeed())
+
+		// then
+		found := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(existing), found)).To(Succeed())
+		Expect(found.OwnerReferences).To(BeEmpty())
+		Expect(found.Labels).To(BeEmpty())
+	})
+
+	It("should take ownership of the pre-existing resource keeping its content", func(ctx context.Context) {
+		// given
+		existing := preCreated(map[string]string{"app": "user-defined"})
+		cli := fake.NewClientBuilder().WithObjects(existing).Build()
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{rendered(resource.AdoptExisting)})).To(Succeed())
+
+		// then
+		adopted := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(existing), adopted)).To(Succeed())
+		Expect(adopted.OwnerReferences).To(ConsistOf(trackerRef))
+		Expect(adopted.Labels).To(And(
+			HaveKeyWithValue("app", "user-defined"),
+			HaveKeyWithValue(labels.ODH.Feature, "mesh-shared-configmap"),
+			HaveKeyWithValue(labels.K8SCommon.ManagedBy, cluster.OperatorName),
+		))
+		Expect(adopted.Data).To(HaveKeyWithValue("CONTROL_PLANE_NAME", "user-defined"))
+	})
+
+	It("should fail on the pre-existing resource", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(preCreated(nil)).Build()
+
+		// when
+		err := resource.Apply(ctx, cli, []*unstructured.Unstructured{rendered(resource.FailOnExisting)})
+
+		// then
+		Expect(resource.IsResourceConflict(err)).To(BeTrue())
+	})
+
+	It("should not take over the resource created by another feature", func(ctx context.Context) {
+		// given
+		existing := preCreated(map[string]string{labels.ODH.Feature: "mesh-control-plane-creation", labels.K8SCommon.ManagedBy: cluster.OperatorName})
+		cli := fake.NewClientBuilder().WithObjects(existing).Build()
+
+		// when
+		err := resource.Apply(ctx, cli, []*unstructured.Unstructured{rendered(resource.AdoptExisting)})
+
+		// then
+		Expect(resource.IsResourceConflict(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring(`belongs to feature "mesh-control-plane-creation"`)))
+	})
+
+	It("should reject unknown policy", func() {
+		// when
+		_, err := resource.AdoptionPolicyOf(rendered("replace"))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`unknown opendatahub.io/adoption-policy "replace"`)))
+	})
+})
+
+var _ = Describe("Adopting existing resources", func() {
+
+	featureLabels := map[string]string{labels.ODH.Feature: "mesh-shared-configmap", labels.K8SCommon.ManagedBy: cluster.OperatorName}
+
+	trackerRef := func(uid string) metav1.OwnerReference {
+		return metav1.OwnerReference{
+			APIVersion: "features.opendatahub.io/v1",
+			Kind:       "FeatureTracker",
+			Name:       "opendatahub-mesh-shared-configmap",
+			UID:        k8stypes.UID("uid-" + uid),
+		}
+	}
+
+	rendered := func(obj client.Object) *unstructured.Unstructured {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		Expect(err).ToNot(HaveOccurred())
+
+		return &unstructured.Unstructured{Object: content}
+	}
+
+	It("should migrate owner references of restored resources to the current owner", func(ctx context.Context) {
+		// given
+		restored := &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "service-mesh-refs", Namespace: "opendatahub", Labels: featureLabels,
+				OwnerReferences: []metav1.OwnerReference{
+					trackerRef("previous-cluster"),
+					{APIVersion: "v1", Kind: "ConfigMap", Name: "other-owner", UID: "uid-other-owner"},
+				},
+			},
+		}
+		cli := fake.NewClientBuilder().WithObjects(restored).Build()
+
+		source := restored.DeepCopy()
+		source.OwnerReferences = []metav1.OwnerReference{trackerRef("current")}
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{rendered(source)})).To(Succeed())
+
+		// then
+		adopted := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(restored), adopted)).To(Succeed())
+		Expect(adopted.OwnerReferences).To(ConsistOf(
+			trackerRef("current"),
+			metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "other-owner", UID: "uid-other-owner"},
+		))
+	})
+
+	It("should fill values of secrets redacted by the export", func(ctx context.Context) {
+		// given
+		redacted := &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "mesh-credentials", Namespace: "opendatahub", Labels: featureLabels,
+				Annotations: map[string]string{annotations.ExportRedacted: "true"},
+			},
+			Data: map[string][]byte{"token": {}},
+		}
+		cli := fake.NewClientBuilder().WithObjects(redacted).Build()
+
+		source := redacted.DeepCopy()
+		source.Annotations = nil
+		source.Data = map[string][]byte{"token": []byte("s3cr3t")}
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{rendered(source)})).To(Succeed())
+
+		// then
+		adopted := &corev1.Secret{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(redacted), adopted)).To(Succeed())
+		Expect(adopted.Data).To(HaveKeyWithValue("token", []byte("s3cr3t")))
+		Expect(adopted.Annotations).ToNot(HaveKey(annotations.ExportRedacted))
+	})
+
+	It("should leave resources not created by the feature intact", func(ctx context.Context) {
+		// given
+		existing := &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "service-mesh-refs", Namespace: "opendatahub",
+				OwnerReferences: []metav1.OwnerReference{trackerRef("previous-cluster")},
+			},
+		}
+		cli := fake.NewClientBuilder().WithObjects(existing).Build()
+
+		source := existing.DeepCopy()
+		source.Labels = featureLabels
+		source.OwnerReferences = []metav1.OwnerReference{trackerRef("current")}
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{rendered(source)})).To(Succeed())
+
+		// then
+		found := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(existing), found)).To(Succeed())
+		Expect(found.OwnerReferences).To(ConsistOf(trackerRef("previous-cluster")))
+	})
+})
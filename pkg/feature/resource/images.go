@@ -0,0 +1,80 @@
+package resource
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// imageField is the name of fields holding image references, e.g. of containers in a pod template
+// or of the proxy in a Service Mesh control plane.
+const imageField = "image"
+
+// ImageMirrors maps image repositories, or their parents, to the locations they are mirrored to, e.g. in the registry
+// of a disconnected cluster.
+type ImageMirrors map[string]string
+
+// Resolve returns the reference pointing to the mirror of the longest source the reference belongs to. Sources match
+// on path boundaries, i.e. quay.io/org matches quay.io/org/image:v1, but not quay.io/organization/image:v1.
+// References which do not belong to any source are returned as they are.
+func (m ImageMirrors) Resolve(reference string) string {
+	var matched string
+	for source := range m {
+		if len(source) > len(matched) && belongsTo(reference, source) {
+			matched = source
+		}
+	}
+
+	if matched == "" {
+		return reference
+	}
+
+	return strings.TrimSuffix(m[matched], "/") + reference[len(strings.TrimSuffix(matched, "/")):]
+}
+
+// Rewrite replaces image references held by fields named "image" anywhere in the resources with references
+// pointing to their mirrors.
+func (m ImageMirrors) Rewrite(objects ...*unstructured.Unstructured) {
+	if len(m) == 0 {
+		return
+	}
+
+	for _, obj := range objects {
+		m.rewriteIn(obj.Object)
+	}
+}
+
+func (m ImageMirrors) rewriteIn(value any) {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, nested := range typed {
+			if reference, isString := nested.(string); isString && key == imageField {
+				typed[key] = m.Resolve(reference)
+
+				continue
+			}
+			m.rewriteIn(nested)
+		}
+	case []any:
+		for _, item := range typed {
+			m.rewriteIn(item)
+		}
+	}
+}
+
+func belongsTo(reference, source string) bool {
+	source = strings.TrimSuffix(source, "/")
+	if source == "" || !strings.HasPrefix(reference, source) {
+		return false
+	}
+
+	switch rest := reference[len(source):]; {
+	case rest == "", rest[0] == '/', rest[0] == '@':
+		return true
+	case rest[0] == ':':
+		// tag of the repository, unless it is the port of the registry the source names
+		return !strings.Contains(rest, "/")
+	default:
+		return false
+	}
+}
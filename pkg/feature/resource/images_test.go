@@ -0,0 +1,81 @@
+package resource_test
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Image mirrors", func() {
+
+	mirrors := resource.ImageMirrors{
+		"registry.redhat.io/openshift-service-mesh":               "mirror.example.com:5000/ossm",
+		"registry.redhat.io/openshift-service-mesh/proxyv2-rhel8": "mirror.example.com:5000/proxies/proxyv2",
+		"registry.example.com:5000":                               "mirror.example.com:5000/example",
+		"quay.io/org":                                             "mirror.example.com:5000/org/",
+	}
+
+	DescribeTable("resolving image references",
+		func(reference, expected string) {
+			Expect(mirrors.Resolve(reference)).To(Equal(expected))
+		},
+		Entry("tagged image in mirrored repository",
+			"registry.redhat.io/openshift-service-mesh/pilot-rhel8:2.5", "mirror.example.com:5000/ossm/pilot-rhel8:2.5"),
+		Entry("longest matching source",
+			"registry.redhat.io/openshift-service-mesh/proxyv2-rhel8:2.5", "mirror.example.com:5000/proxies/proxyv2:2.5"),
+		Entry("image referenced by digest",
+			"quay.io/org@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+			"mirror.example.com:5000/org@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"),
+		Entry("image in registry with port", "registry.example.com:5000/team/image:v1", "mirror.example.com:5000/example/team/image:v1"),
+		Entry("source which is only a prefix of the repository", "quay.io/organization/image:v1", "quay.io/organization/image:v1"),
+		Entry("image which is not mirrored", "docker.io/library/busybox:1.36", "docker.io/library/busybox:1.36"),
+	)
+
+	It("should rewrite images of containers and custom resources", func() {
+		// given
+		deployment := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"spec": map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{
+							map[string]any{"name": "pilot", "image": "registry.redhat.io/openshift-service-mesh/pilot-rhel8:2.5"},
+							map[string]any{"name": "busybox", "image": "docker.io/library/busybox:1.36"},
+						},
+					},
+				},
+			},
+		}}
+		controlPlane := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "maistra.io/v2",
+			"kind":       "ServiceMeshControlPlane",
+			"spec": map[string]any{
+				"runtime": map[string]any{
+					"components": map[string]any{
+						"pilot": map[string]any{
+							"container": map[string]any{"image": "quay.io/org/pilot:v1"},
+						},
+					},
+				},
+			},
+		}}
+
+		// when
+		mirrors.Rewrite(deployment, controlPlane)
+
+		// then
+		containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(containers).To(ConsistOf(
+			HaveKeyWithValue("image", "mirror.example.com:5000/ossm/pilot-rhel8:2.5"),
+			HaveKeyWithValue("image", "docker.io/library/busybox:1.36"),
+		))
+		image, _, err := unstructured.NestedString(controlPlane.Object, "spec", "runtime", "components", "pilot", "container", "image")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(image).To(Equal("mirror.example.com:5000/org/pilot:v1"))
+	})
+})
@@ -0,0 +1,68 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// EnrollsInMesh checks if the manifest declares that the resource enrolls its namespace in the Service Mesh.
+func EnrollsInMesh(obj *unstructured.Unstructured) bool {
+	return obj.GetAnnotations()[annotations.MeshEnrollment] == "true"
+}
+
+// MeshOptedOut checks if the resource enrolls in the Service Mesh a namespace which has opted out of it. The namespace
+// is the one of the resource or, for a Namespace, the resource itself.
+func MeshOptedOut(ctx context.Context, cli client.Client, obj *unstructured.Unstructured) (bool, error) {
+	if !EnrollsInMesh(obj) {
+		return false, nil
+	}
+
+	namespace := obj.GetNamespace()
+	if obj.GetKind() == "Namespace" {
+		namespace = obj.GetName()
+	}
+
+	optedOut, err := cluster.MeshOptedOut(ctx, cli, namespace)
+	if err != nil {
+		return false, fmt.Errorf("failed checking if namespace %s opted out of Service Mesh for %s: %w", namespace, ReferenceOf(obj), err)
+	}
+
+	return optedOut, nil
+}
+
+// removeOptedOut deletes the resource enrolling a namespace which has opted out after it has been enrolled. Only resources
+// created by the same feature are removed.
+func removeOptedOut(ctx context.Context, cli client.Client, source *unstructured.Unstructured) error {
+	target := &unstructured.Unstructured{}
+	target.SetGroupVersionKind(source.GroupVersionKind())
+	if errGet := cli.Get(ctx, client.ObjectKeyFromObject(source), target); errGet != nil {
+		return client.IgnoreNotFound(errGet)
+	}
+
+	if !createdBySameFeature(source, target) {
+		return nil
+	}
+
+	if errDel := cli.Delete(ctx, target); client.IgnoreNotFound(errDel) != nil {
+		return fmt.Errorf("failed removing %s from namespace opted out of Service Mesh: %w", ReferenceOf(source), errDel)
+	}
+
+	return nil
+}
+
+// withoutMeshEnrollment removes the declaration from the patch, so that it is not set on the patched resource.
+func withoutMeshEnrollment(patch *unstructured.Unstructured) {
+	patchAnnotations := patch.GetAnnotations()
+	if _, found := patchAnnotations[annotations.MeshEnrollment]; !found {
+		return
+	}
+
+	delete(patchAnnotations, annotations.MeshEnrollment)
+	patch.SetAnnotations(patchAnnotations)
+}
@@ -0,0 +1,125 @@
+package resource_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Mesh opt-out of namespaces", func() {
+
+	namespace := func(name string, optedOut bool) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if optedOut {
+			ns.Labels = map[string]string{labels.ODH.ServiceMesh: labels.ServiceMeshDisabled}
+		}
+
+		return ns
+	}
+
+	configMap := func(namespace string, enrollment bool) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetName("mesh-member")
+		obj.SetNamespace(namespace)
+		obj.SetLabels(map[string]string{
+			labels.ODH.Feature:         "mesh-control-plane-external-authz",
+			labels.K8SCommon.ManagedBy: cluster.OperatorName,
+		})
+		if enrollment {
+			obj.SetAnnotations(map[string]string{annotations.MeshEnrollment: "true"})
+		}
+
+		return obj
+	}
+
+	It("should skip resources enrolling namespace which opted out", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(namespace("data-science-project", true)).Build()
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{configMap("data-science-project", true)})).To(Succeed())
+
+		// then
+		err := cli.Get(ctx, client.ObjectKey{Namespace: "data-science-project", Name: "mesh-member"}, &corev1.ConfigMap{})
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should remove resources created before namespace opted out", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(namespace("data-science-project", false)).Build()
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{configMap("data-science-project", true)})).To(Succeed())
+		Expect(cli.Update(ctx, namespace("data-science-project", true))).To(Succeed())
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{configMap("data-science-project", true)})).To(Succeed())
+
+		// then
+		err := cli.Get(ctx, client.ObjectKey{Namespace: "data-science-project", Name: "mesh-member"}, &corev1.ConfigMap{})
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should apply resources which do not enroll namespace in the mesh", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(namespace("data-science-project", true)).Build()
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{configMap("data-science-project", false)})).To(Succeed())
+
+		// then
+		Expect(cli.Get(ctx, client.ObjectKey{Namespace: "data-science-project", Name: "mesh-member"}, &corev1.ConfigMap{})).To(Succeed())
+	})
+
+	It("should skip patches setting injection labels of namespace which opted out", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(namespace("data-science-project", true)).Build()
+		patch := &unstructured.Unstructured{}
+		patch.SetAPIVersion("v1")
+		patch.SetKind("Namespace")
+		patch.SetName("data-science-project")
+		patch.SetAnnotations(map[string]string{annotations.MeshEnrollment: "true"})
+		patch.SetLabels(map[string]string{"istio.io/rev": "basic"})
+
+		// when
+		Expect(resource.Patch(ctx, cli, []*unstructured.Unstructured{patch})).To(Succeed())
+
+		// then
+		ns := &corev1.Namespace{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "data-science-project"}, ns)).To(Succeed())
+		Expect(ns.Labels).ToNot(HaveKey("istio.io/rev"))
+	})
+
+	It("should not set the declaration on the patched resource", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(namespace("data-science-project", false)).Build()
+		patch := &unstructured.Unstructured{}
+		patch.SetAPIVersion("v1")
+		patch.SetKind("Namespace")
+		patch.SetName("data-science-project")
+		patch.SetAnnotations(map[string]string{annotations.MeshEnrollment: "true"})
+		patch.SetLabels(map[string]string{"istio.io/rev": "basic"})
+
+		// when
+		Expect(resource.Patch(ctx, cli, []*unstructured.Unstructured{patch})).To(Succeed())
+
+		// then
+		ns := &corev1.Namespace{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "data-science-project"}, ns)).To(Succeed())
+		Expect(ns.Labels).To(HaveKeyWithValue("istio.io/rev", "basic"))
+		Expect(ns.Annotations).ToNot(HaveKey(annotations.MeshEnrollment))
+	})
+})
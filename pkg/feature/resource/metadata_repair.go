@@ -0,0 +1,72 @@
+package resource
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// MetadataRepairPolicy defines how labels and annotations of the resource are reconciled when it already exists and has been
+// created by the same feature, e.g. a namespace whose labels have been removed by the user or another tool. It is set for the whole
+// feature using its builder, and can be overridden in the manifest using annotations.MetadataRepair.
+type MetadataRepairPolicy string
+
+const (
+	// RepairMissing adds labels and annotations of the rendered resource which are missing on the existing one, keeping values
+	// which have been changed in the meantime. It is the default.
+	RepairMissing MetadataRepairPolicy = "missing"
+	// RepairOverwrite sets all labels and annotations of the rendered resource on the existing one, overriding changed values.
+	RepairOverwrite MetadataRepairPolicy = "overwrite"
+	// RepairNone leaves labels and annotations of the existing resource intact.
+	RepairNone MetadataRepairPolicy = "none"
+)
+
+// MetadataRepairPolicyOf reads the MetadataRepairPolicy declared for the rendered resource, or RepairMissing when it is not declared.
+func MetadataRepairPolicyOf(obj *unstructured.Unstructured) (MetadataRepairPolicy, error) {
+	declared, found := obj.GetAnnotations()[annotations.MetadataRepair]
+	if !found {
+		return RepairMissing, nil
+	}
+
+	policy := MetadataRepairPolicy(declared)
+	if policy != RepairMissing && policy != RepairOverwrite && policy != RepairNone {
+		return "", fmt.Errorf("unknown %s %q of %s, expected one of %s, %s, %s",
+			annotations.MetadataRepair, declared, ReferenceOf(obj), RepairMissing, RepairOverwrite, RepairNone)
+	}
+
+	return policy, nil
+}
+
+// repairMetadata reconciles labels and annotations of the existing resource with the rendered one according to the policy.
+func repairMetadata(source, target *unstructured.Unstructured, policy MetadataRepairPolicy) {
+	if policy == RepairNone {
+		return
+	}
+
+	overwrite := policy == RepairOverwrite
+	if repaired, changed := repairedValues(target.GetLabels(), source.GetLabels(), overwrite); changed {
+		target.SetLabels(repaired)
+	}
+	if repaired, changed := repairedValues(target.GetAnnotations(), source.GetAnnotations(), overwrite); changed {
+		target.SetAnnotations(repaired)
+	}
+}
+
+func repairedValues(existing, desired map[string]string, overwrite bool) (map[string]string, bool) {
+	changed := false
+	for key, value := range desired {
+		current, exists := existing[key]
+		if exists && (current == value || !overwrite) {
+			continue
+		}
+		if existing == nil {
+			existing = make(map[string]string, len(desired))
+		}
+		existing[key] = value
+		changed = true
+	}
+
+	return existing, changed
+}
@@ -0,0 +1,162 @@
+package resource_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Repairing metadata of existing resources", func() {
+
+	const (
+		featureName   = "mesh-control-plane-external-authz"
+		authNamespace = "opendatahub-auth-provider"
+	)
+
+	trackerRef := metav1.OwnerReference{
+		APIVersion: "features.opendatahub.io/v1",
+		Kind:       "FeatureTracker",
+		Name:       "opendatahub-" + featureName,
+		UID:        "uid-tracker",
+	}
+
+	renderedNamespace := func(policy resource.MetadataRepairPolicy) *unstructured.Unstructured {
+		namespace := &corev1.Namespace{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: authNamespace,
+				Labels: map[string]string{
+					labels.ODH.Feature:         featureName,
+					labels.K8SCommon.ManagedBy: cluster.OperatorName,
+					labels.ODH.OwnedNamespace:  "true",
+					"team":                     "ml-platform",
+				},
+				Annotations:     map[string]string{annotations.GeneratedFrom: authNamespace},
+				OwnerReferences: []metav1.OwnerReference{trackerRef},
+			},
+		}
+		if policy != "" {
+			namespace.Annotations[annotations.MetadataRepair] = string(policy)
+		}
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(namespace)
+		Expect(err).ToNot(HaveOccurred())
+
+		return &unstructured.Unstructured{Object: content}
+	}
+
+	existingNamespace := func(namespaceLabels map[string]string, owners ...metav1.OwnerReference) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: authNamespace, Labels: namespaceLabels, OwnerReferences: owners}}
+	}
+
+	applied := func(ctx context.Context, cli client.Client) *corev1.Namespace {
+		namespace := &corev1.Namespace{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: authNamespace}, namespace)).To(Succeed())
+
+		return namespace
+	}
+
+	It("should add missing labels and annotations, keeping values changed by the user", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(existingNamespace(map[string]string{
+			labels.ODH.Feature:         featureName,
+			labels.K8SCommon.ManagedBy: cluster.OperatorName,
+			"team":                     "data-science",
+		})).Build()
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{renderedNamespace("")})).To(Succeed())
+
+		// then
+		namespace := applied(ctx, cli)
+		Expect(namespace.Labels).To(HaveKeyWithValue(labels.ODH.OwnedNamespace, "true"))
+		Expect(namespace.Labels).To(HaveKeyWithValue("team", "data-science"))
+		Expect(namespace.Annotations).To(HaveKeyWithValue(annotations.GeneratedFrom, authNamespace))
+		Expect(namespace.OwnerReferences).To(ConsistOf(trackerRef))
+	})
+
+	It("should overwrite changed values when the policy says so", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(existingNamespace(map[string]string{
+			labels.ODH.Feature:         featureName,
+			labels.K8SCommon.ManagedBy: cluster.OperatorName,
+			"team":                     "data-science",
+		})).Build()
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{renderedNamespace(resource.RepairOverwrite)})).To(Succeed())
+
+		// then
+		Expect(applied(ctx, cli).Labels).To(HaveKeyWithValue("team", "ml-platform"))
+	})
+
+	It("should leave metadata intact when the policy says so", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(existingNamespace(map[string]string{
+			labels.ODH.Feature:         featureName,
+			labels.K8SCommon.ManagedBy: cluster.OperatorName,
+		})).Build()
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{renderedNamespace(resource.RepairNone)})).To(Succeed())
+
+		// then
+		namespace := applied(ctx, cli)
+		Expect(namespace.Labels).ToNot(HaveKey(labels.ODH.OwnedNamespace))
+		Expect(namespace.Annotations).ToNot(HaveKey(annotations.GeneratedFrom))
+	})
+
+	It("should restore feature labels of the namespace owned by the FeatureTracker", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(existingNamespace(nil, trackerRef)).Build()
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{renderedNamespace("")})).To(Succeed())
+
+		// then
+		Expect(applied(ctx, cli).Labels).To(And(
+			HaveKeyWithValue(labels.ODH.Feature, featureName),
+			HaveKeyWithValue(labels.ODH.OwnedNamespace, "true"),
+		))
+	})
+
+	It("should not repair namespaces which have not been created by the feature", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(existingNamespace(map[string]string{"team": "data-science"})).Build()
+
+		// when
+		Expect(resource.Apply(ctx, cli, []*unstructured.Unstructured{renderedNamespace(resource.RepairOverwrite)})).To(Succeed())
+
+		// then
+		namespace := applied(ctx, cli)
+		Expect(namespace.Labels).To(Equal(map[string]string{"team": "data-science"}))
+		Expect(namespace.OwnerReferences).To(BeEmpty())
+	})
+
+	It("should fail on unknown policy", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(existingNamespace(map[string]string{
+			labels.ODH.Feature:         featureName,
+			labels.K8SCommon.ManagedBy: cluster.OperatorName,
+		})).Build()
+
+		// when
+		err := resource.Apply(ctx, cli, []*unstructured.Unstructured{renderedNamespace("always")})
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`unknown opendatahub.io/metadata-repair "always"`)))
+	})
+})
@@ -0,0 +1,62 @@
+package resource
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// OnDeletePolicy defines what happens with a resource applied by the feature when the feature is deleted.
+// It is declared in the manifest using annotations.OnDelete, so that the cleanup lives beside the template introducing the change.
+type OnDeletePolicy string
+
+const (
+	// OnDeleteRemove removes the resource together with the feature, as it is owned by its FeatureTracker.
+	// It is the default for resources created by the feature and cannot be used for patches.
+	OnDeleteRemove OnDeletePolicy = "remove"
+	// OnDeleteRevertPatch restores original values of the fields changed by the patch (see ApplyRevertiblePatch).
+	// It can only be used for patches.
+	OnDeleteRevertPatch OnDeletePolicy = "revert-patch"
+	// OnDeleteOrphan leaves the resource, or the fields changed by the patch, intact. It is the default for patches.
+	OnDeleteOrphan OnDeletePolicy = "orphan"
+)
+
+// OnDeletePolicyOf reads the OnDeletePolicy declared in the manifest of the resource, or the default one
+// when it is not declared. Policies which do not apply to the kind of the manifest, e.g. removing a resource
+// which is only patched, are rejected.
+func OnDeletePolicyOf(obj *unstructured.Unstructured, patch bool) (OnDeletePolicy, error) {
+	declared, found := obj.GetAnnotations()[annotations.OnDelete]
+	if !found {
+		if patch {
+			return OnDeleteOrphan, nil
+		}
+
+		return OnDeleteRemove, nil
+	}
+
+	policy := OnDeletePolicy(declared)
+	switch {
+	case policy == OnDeleteRemove && patch:
+		return "", fmt.Errorf("%s of patch for %s is invalid, patched resource is not owned by the feature", annotations.OnDelete, ReferenceOf(obj))
+	case policy == OnDeleteRevertPatch && !patch:
+		return "", fmt.Errorf("%s of %s is invalid, %s applies to patches only", annotations.OnDelete, ReferenceOf(obj), OnDeleteRevertPatch)
+	case policy != OnDeleteRemove && policy != OnDeleteRevertPatch && policy != OnDeleteOrphan:
+		return "", fmt.Errorf("unknown %s %q of %s, expected one of %s, %s, %s",
+			annotations.OnDelete, declared, ReferenceOf(obj), OnDeleteRemove, OnDeleteRevertPatch, OnDeleteOrphan)
+	}
+
+	return policy, nil
+}
+
+// withoutOnDeletePolicy removes the policy from the patch, so that it is not set on the patched resource.
+func withoutOnDeletePolicy(patch *unstructured.Unstructured) {
+	patchAnnotations := patch.GetAnnotations()
+	if _, found := patchAnnotations[annotations.OnDelete]; !found {
+		return
+	}
+
+	delete(patchAnnotations, annotations.OnDelete)
+	patch.SetAnnotations(patchAnnotations)
+}
@@ -0,0 +1,77 @@
+package resource_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("On delete policy", func() {
+
+	configMap := func(onDelete string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetName("mesh-config")
+		obj.SetNamespace("istio-system")
+		if onDelete != "" {
+			obj.SetAnnotations(map[string]string{annotations.OnDelete: onDelete})
+		}
+
+		return obj
+	}
+
+	DescribeTable("should resolve policy declared in the manifest",
+		func(onDelete string, patch bool, expected resource.OnDeletePolicy) {
+			// when
+			policy, err := resource.OnDeletePolicyOf(configMap(onDelete), patch)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy).To(Equal(expected))
+		},
+		Entry("remove owned resource by default", "", false, resource.OnDeleteRemove),
+		Entry("orphan patch by default", "", true, resource.OnDeleteOrphan),
+		Entry("orphan owned resource", "orphan", false, resource.OnDeleteOrphan),
+		Entry("revert patch", "revert-patch", true, resource.OnDeleteRevertPatch),
+	)
+
+	DescribeTable("should reject policy which does not apply to the manifest",
+		func(onDelete string, patch bool) {
+			// when
+			_, err := resource.OnDeletePolicyOf(configMap(onDelete), patch)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring(annotations.OnDelete)))
+		},
+		Entry("removing patched resource", "remove", true),
+		Entry("reverting owned resource", "revert-patch", false),
+		Entry("unknown policy", "keep", false),
+	)
+
+	It("should not set owner of orphaned resource", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().Build()
+		owner := metav1.OwnerReference{APIVersion: "features.opendatahub.io/v1", Kind: "FeatureTracker", Name: "istio-system-mesh-config", UID: "uid"}
+
+		// when
+		err := resource.Apply(ctx, cli, []*unstructured.Unstructured{configMap("orphan")}, cluster.WithOwnerReference(owner))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		created := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "mesh-config", Namespace: "istio-system"}, created)).To(Succeed())
+		Expect(created.GetOwnerReferences()).To(BeEmpty())
+	})
+})
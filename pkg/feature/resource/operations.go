@@ -13,14 +13,51 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
+// Apply creates or reconciles given resources in the order defined by their Phase. Before instances of custom resources
+// are applied, it waits for all CustomResourceDefinitions which are part of the same set to be established.
 func Apply(ctx context.Context, cli client.Client, objects []*unstructured.Unstructured, metaOptions ...cluster.MetaOptions) error {
-	for _, source := range objects {
+	var crds []*unstructured.Unstructured
+
+	for _, source := range SortByPhase(objects) {
+		if len(crds) > 0 && PhaseOf(source) > CustomResourceDefinitionPhase {
+			if errWait := WaitForCRDsEstablished(ctx, cli, crds); errWait != nil {
+				return errWait
+			}
+
+			crds = nil
+		}
+
 		for _, opt := range metaOptions {
 			if err := opt(source); err != nil {
 				return err
 			}
 		}
 
+		optedOut, errOptOut := MeshOptedOut(ctx, cli, source)
+		if errOptOut != nil {
+			return errOptOut
+		}
+		if optedOut {
+			if errRemove := removeOptedOut(ctx, cli, source); errRemove != nil {
+				return errRemove
+			}
+
+			continue
+		}
+
+		policy, errPolicy := OnDeletePolicyOf(source, false)
+		if errPolicy != nil {
+			return errPolicy
+		}
+		if policy == OnDeleteOrphan {
+			// Resource outlives the feature, so it cannot be owned by its FeatureTracker.
+			source.SetOwnerReferences(nil)
+		}
+
+		if errValidate := validateFields(source); errValidate != nil {
+			return errValidate
+		}
+
 		target := source.DeepCopy()
 
 		name := source.GetName()
@@ -33,25 +70,62 @@ func Apply(ctx context.Context, cli client.Client, objects []*unstructured.Unstr
 
 		justCreated := false
 		if k8serr.IsNotFound(errGet) {
-			if errCreate := cli.Create(ctx, target); client.IgnoreAlreadyExists(errCreate) != nil {
+			if errCreate := cli.Create(ctx, target, StrictFieldValidation); client.IgnoreAlreadyExists(errCreate) != nil {
 				return fmt.Errorf("failed to create source %s/%s: %w", namespace, name, errCreate)
 			}
 
 			justCreated = true
 		}
 
+		if !justCreated {
+			if errExisting := handleExisting(ctx, cli, source, target); errExisting != nil {
+				return errExisting
+			}
+		}
+
 		if !justCreated && shouldReconcile(source) {
 			if errUpdate := patchUsingApplyStrategy(ctx, cli, source, target); errUpdate != nil {
 				return fmt.Errorf("failed to reconcile resource %s/%s: %w", namespace, name, errUpdate)
 			}
 		}
+
+		if PhaseOf(source) == CustomResourceDefinitionPhase {
+			crds = append(crds, source)
+		}
 	}
 
 	return nil
 }
 
+// Patch applies JSON merge patches to existing resources. Changes are left intact when the feature is deleted,
+// patches declaring OnDeleteRevertPatch policy have to be applied using ApplyRevertiblePatch instead.
+// Patches enrolling namespaces which opted out of the Service Mesh are skipped.
 func Patch(ctx context.Context, cli client.Client, patches []*unstructured.Unstructured) error {
 	for _, patch := range patches {
+		policy, errPolicy := OnDeletePolicyOf(patch, true)
+		if errPolicy != nil {
+			return errPolicy
+		}
+		if policy == OnDeleteRevertPatch {
+			return fmt.Errorf("patch for %s has to be applied as revertible, as it declares %s policy", ReferenceOf(patch), OnDeleteRevertPatch)
+		}
+
+		optedOut, errOptOut := MeshOptedOut(ctx, cli, patch)
+		if errOptOut != nil {
+			return errOptOut
+		}
+		if optedOut {
+			continue
+		}
+
+		patch = patch.DeepCopy()
+		withoutOnDeletePolicy(patch)
+		withoutMeshEnrollment(patch)
+
+		if errValidate := validateFields(patch); errValidate != nil {
+			return errValidate
+		}
+
 		if errPatch := patchUsingMergeStrategy(ctx, cli, patch); errPatch != nil {
 			return errPatch
 		}
@@ -69,7 +143,7 @@ func patchUsingApplyStrategy(ctx context.Context, cli client.Client, source, tar
 	if errJSON != nil {
 		return fmt.Errorf("error converting yaml to json: %w", errJSON)
 	}
-	return cli.Patch(ctx, target, client.RawPatch(k8stypes.ApplyPatchType, data), client.ForceOwnership, client.FieldOwner("rhods-operator"))
+	return cli.Patch(ctx, target, client.RawPatch(k8stypes.ApplyPatchType, data), client.ForceOwnership, client.FieldOwner("rhods-operator"), StrictFieldValidation)
 }
 
 // patchUsingMergeStrategy merges the specified fields into the existing resources.
@@ -80,7 +154,7 @@ func patchUsingMergeStrategy(ctx context.Context, cli client.Client, patch *unst
 		return fmt.Errorf("error converting yaml to json: %w", errJSON)
 	}
 
-	if errPatch := cli.Patch(ctx, patch, client.RawPatch(k8stypes.MergePatchType, data)); errPatch != nil {
+	if errPatch := cli.Patch(ctx, patch, client.RawPatch(k8stypes.MergePatchType, data), StrictFieldValidation); errPatch != nil {
 		return fmt.Errorf("failed patching resource: %w", errPatch)
 	}
 
@@ -0,0 +1,118 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	crdEstablishedInterval = 1 * time.Second
+	crdEstablishedTimeout  = 1 * time.Minute
+)
+
+// Phase groups resources which can be applied together. Phases are applied in ascending order,
+// so that resources are created only after everything they depend on already exists in the cluster.
+type Phase int
+
+const (
+	NamespacePhase Phase = iota
+	CustomResourceDefinitionPhase
+	RBACPhase
+	CorePhase
+	CustomResourcePhase
+	WebhookPhase
+)
+
+var rbacKinds = map[string]bool{
+	"ServiceAccount":     true,
+	"Role":               true,
+	"ClusterRole":        true,
+	"RoleBinding":        true,
+	"ClusterRoleBinding": true,
+}
+
+var webhookKinds = map[string]bool{
+	"MutatingWebhookConfiguration":   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+// PhaseOf determines in which phase given resource should be applied. Resources belonging to API groups
+// served by Kubernetes itself are considered core, everything else is treated as an instance of a custom resource.
+func PhaseOf(obj *unstructured.Unstructured) Phase {
+	gvk := obj.GroupVersionKind()
+
+	switch {
+	case gvk.Group == "" && gvk.Kind == "Namespace":
+		return NamespacePhase
+	case gvk.Group == apiextv1.GroupName && gvk.Kind == "CustomResourceDefinition":
+		return CustomResourceDefinitionPhase
+	case (gvk.Group == "" || gvk.Group == "rbac.authorization.k8s.io") && rbacKinds[gvk.Kind]:
+		return RBACPhase
+	case gvk.Group == "admissionregistration.k8s.io" && webhookKinds[gvk.Kind]:
+		return WebhookPhase
+	case isCoreGroup(gvk.Group):
+		return CorePhase
+	default:
+		return CustomResourcePhase
+	}
+}
+
+// SortByPhase orders resources by the phase they belong to. The sort is stable, so resources
+// within the same phase keep the order in which they have been defined in the manifests.
+func SortByPhase(objects []*unstructured.Unstructured) []*unstructured.Unstructured {
+	sorted := make([]*unstructured.Unstructured, len(objects))
+	copy(sorted, objects)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return PhaseOf(sorted[i]) < PhaseOf(sorted[j])
+	})
+
+	return sorted
+}
+
+// WaitForCRDsEstablished blocks until all given CustomResourceDefinitions report Established condition,
+// meaning their instances can be created.
+func WaitForCRDsEstablished(ctx context.Context, cli client.Client, crds []*unstructured.Unstructured) error {
+	for _, crd := range crds {
+		name := crd.GetName()
+
+		err := wait.PollUntilContextTimeout(ctx, crdEstablishedInterval, crdEstablishedTimeout, true, func(ctx context.Context) (bool, error) {
+			established := &apiextv1.CustomResourceDefinition{}
+			if errGet := cli.Get(ctx, k8stypes.NamespacedName{Name: name}, established); errGet != nil {
+				return false, client.IgnoreNotFound(errGet)
+			}
+
+			for _, condition := range established.Status.Conditions {
+				if condition.Type == apiextv1.Established {
+					return condition.Status == apiextv1.ConditionTrue, nil
+				}
+			}
+
+			return false, nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed waiting for CustomResourceDefinition %s to be established: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func isCoreGroup(group string) bool {
+	switch group {
+	case "", "apps", "batch", "autoscaling", "policy", "networking.k8s.io", "storage.k8s.io",
+		"scheduling.k8s.io", "coordination.k8s.io", "discovery.k8s.io", "node.k8s.io", "certificates.k8s.io",
+		"rbac.authorization.k8s.io", "admissionregistration.k8s.io":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+package resource_test
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ordering resources by kind", func() {
+
+	DescribeTable("should assign resource to the expected phase",
+		func(apiVersion, kind string, expected resource.Phase) {
+			Expect(resource.PhaseOf(newObject(apiVersion, kind, "any"))).To(Equal(expected))
+		},
+		Entry("Namespace", "v1", "Namespace", resource.NamespacePhase),
+		Entry("CustomResourceDefinition", "apiextensions.k8s.io/v1", "CustomResourceDefinition", resource.CustomResourceDefinitionPhase),
+		Entry("ServiceAccount", "v1", "ServiceAccount", resource.RBACPhase),
+		Entry("ClusterRoleBinding", "rbac.authorization.k8s.io/v1", "ClusterRoleBinding", resource.RBACPhase),
+		Entry("ConfigMap", "v1", "ConfigMap", resource.CorePhase),
+		Entry("Deployment", "apps/v1", "Deployment", resource.CorePhase),
+		Entry("custom resource", "maistra.io/v2", "ServiceMeshControlPlane", resource.CustomResourcePhase),
+		Entry("MutatingWebhookConfiguration", "admissionregistration.k8s.io/v1", "MutatingWebhookConfiguration", resource.WebhookPhase),
+	)
+
+	It("should sort resources by phase preserving order within the same phase", func() {
+		// given
+		objects := []*unstructured.Unstructured{
+			newObject("admissionregistration.k8s.io/v1", "ValidatingWebhookConfiguration", "webhook"),
+			newObject("maistra.io/v2", "ServiceMeshControlPlane", "smcp"),
+			newObject("v1", "ConfigMap", "first-cm"),
+			newObject("apiextensions.k8s.io/v1", "CustomResourceDefinition", "crd"),
+			newObject("rbac.authorization.k8s.io/v1", "Role", "role"),
+			newObject("v1", "ConfigMap", "second-cm"),
+			newObject("v1", "Namespace", "ns"),
+		}
+
+		// when
+		sorted := resource.SortByPhase(objects)
+
+		// then
+		names := make([]string, 0, len(sorted))
+		for _, obj := range sorted {
+			names = append(names, obj.GetName())
+		}
+		Expect(names).To(Equal([]string{"ns", "crd", "role", "first-cm", "second-cm", "smcp", "webhook"}))
+		Expect(objects[0].GetName()).To(Equal("webhook"), "original slice should not be modified")
+	})
+})
+
+func newObject(apiVersion, kind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+
+	return obj
+}
@@ -0,0 +1,207 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// Reference identifies a resource in the cluster.
+type Reference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func ReferenceOf(obj *unstructured.Unstructured) Reference {
+	return Reference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+func (r Reference) String() string {
+	return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+// ApplyRevertiblePatch applies JSON merge patch to an existing resource which is owned by someone else, e.g. other operator.
+// Original values of the patched fields are recorded in the annotation of the patched resource under the given owner,
+// so that they can be restored using RevertPatch. Values recorded by previous applications take precedence, so the
+// original state is preserved when the patch is applied repeatedly.
+func ApplyRevertiblePatch(ctx context.Context, cli client.Client, patch *unstructured.Unstructured, owner string) error {
+	if errValidate := validateFields(patch); errValidate != nil {
+		return errValidate
+	}
+
+	target := &unstructured.Unstructured{}
+	target.SetGroupVersionKind(patch.GroupVersionKind())
+	if errGet := cli.Get(ctx, client.ObjectKeyFromObject(patch), target); errGet != nil {
+		return fmt.Errorf("failed getting %s to patch: %w", ReferenceOf(patch), errGet)
+	}
+
+	records, errRecords := appliedPatches(target)
+	if errRecords != nil {
+		return errRecords
+	}
+
+	changes := patchContent(patch)
+
+	revert := revertOf(changes, target.Object)
+	if recorded, found := records[owner]; found {
+		revert = mergeMissing(recorded, revert)
+	}
+	records[owner] = revert
+
+	return patchWithRecords(ctx, cli, target, changes, records)
+}
+
+// RevertPatch restores values of the fields patched by the given owner using ApplyRevertiblePatch.
+// It has no effect if the resource does not exist or has not been patched by the owner.
+func RevertPatch(ctx context.Context, cli client.Client, ref Reference, owner string) error {
+	target := &unstructured.Unstructured{}
+	target.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	if errGet := cli.Get(ctx, k8stypes.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, target); errGet != nil {
+		return client.IgnoreNotFound(errGet)
+	}
+
+	records, errRecords := appliedPatches(target)
+	if errRecords != nil {
+		return errRecords
+	}
+
+	revert, found := records[owner]
+	if !found {
+		return nil
+	}
+	delete(records, owner)
+
+	return patchWithRecords(ctx, cli, target, revert, records)
+}
+
+func appliedPatches(obj *unstructured.Unstructured) (map[string]map[string]any, error) {
+	records := map[string]map[string]any{}
+
+	recorded, found := obj.GetAnnotations()[annotations.AppliedPatches]
+	if !found {
+		return records, nil
+	}
+
+	if err := json.Unmarshal([]byte(recorded), &records); err != nil {
+		return nil, fmt.Errorf("failed reading %s annotation of %s: %w", annotations.AppliedPatches, ReferenceOf(obj), err)
+	}
+
+	return records, nil
+}
+
+// patchWithRecords applies the changes to the target together with the updated records of applied patches.
+func patchWithRecords(ctx context.Context, cli client.Client, target *unstructured.Unstructured, changes map[string]any, records map[string]map[string]any) error {
+	var recordsValue any
+	if len(records) > 0 {
+		recordsJSON, errJSON := json.Marshal(records)
+		if errJSON != nil {
+			return fmt.Errorf("failed serializing applied patches of %s: %w", ReferenceOf(target), errJSON)
+		}
+		recordsValue = string(recordsJSON)
+	}
+
+	mergePatch := deepCopyMap(changes)
+	if err := unstructured.SetNestedField(mergePatch, recordsValue, "metadata", "annotations", annotations.AppliedPatches); err != nil {
+		return fmt.Errorf("failed recording applied patches of %s: %w", ReferenceOf(target), err)
+	}
+
+	data, errJSON := json.Marshal(mergePatch)
+	if errJSON != nil {
+		return fmt.Errorf("error converting patch to json: %w", errJSON)
+	}
+
+	if errPatch := cli.Patch(ctx, target, client.RawPatch(k8stypes.MergePatchType, data), StrictFieldValidation); errPatch != nil {
+		return fmt.Errorf("failed patching %s: %w", ReferenceOf(target), errPatch)
+	}
+
+	return nil
+}
+
+// patchContent strips identity of the resource and the declarations of the manifest from the patch, leaving only the fields to change.
+func patchContent(patch *unstructured.Unstructured) map[string]any {
+	stripped := patch.DeepCopy()
+	withoutOnDeletePolicy(stripped)
+	withoutMeshEnrollment(stripped)
+
+	content := stripped.Object
+	delete(content, "apiVersion")
+	delete(content, "kind")
+
+	if metadata, ok := content["metadata"].(map[string]any); ok {
+		delete(metadata, "name")
+		delete(metadata, "namespace")
+		if len(metadata) == 0 {
+			delete(content, "metadata")
+		}
+	}
+
+	return content
+}
+
+// revertOf creates a merge patch restoring current values of the fields changed by the given merge patch.
+// Fields which do not exist are reverted to null, so that they are removed, leaving fields not touched by the patch intact.
+func revertOf(changes, current map[string]any) map[string]any {
+	revert := make(map[string]any, len(changes))
+	for key, changed := range changes {
+		currentValue, exists := current[key]
+
+		changedMap, changedIsMap := changed.(map[string]any)
+		currentMap, currentIsMap := currentValue.(map[string]any)
+		switch {
+		case changedIsMap && (currentIsMap || !exists):
+			revert[key] = revertOf(changedMap, currentMap)
+		case exists:
+			revert[key] = deepCopyValue(currentValue)
+		default:
+			revert[key] = nil
+		}
+	}
+
+	return revert
+}
+
+// mergeMissing adds fields from the additional merge patch which are not present in the base one.
+func mergeMissing(base, additional map[string]any) map[string]any {
+	merged := deepCopyMap(base)
+	for key, value := range additional {
+		existing, found := merged[key]
+		if !found {
+			merged[key] = value
+			continue
+		}
+
+		existingMap, existingIsMap := existing.(map[string]any)
+		valueMap, valueIsMap := value.(map[string]any)
+		if existingIsMap && valueIsMap {
+			merged[key] = mergeMissing(existingMap, valueMap)
+		}
+	}
+
+	return merged
+}
+
+func deepCopyMap(value map[string]any) map[string]any {
+	if value == nil {
+		return map[string]any{}
+	}
+
+	return (&unstructured.Unstructured{Object: value}).DeepCopy().Object
+}
+
+func deepCopyValue(value any) any {
+	return deepCopyMap(map[string]any{"value": value})["value"]
+}
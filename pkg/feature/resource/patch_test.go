@@ -0,0 +1,137 @@
+package resource_test
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Revertible patches", func() {
+
+	const owner = "test-ns-patching-feature"
+
+	var (
+		cli        client.Client
+		deployment *appsv1.Deployment
+	)
+
+	BeforeEach(func() {
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "authorino",
+				Namespace: "auth-provider",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      map[string]string{"app": "authorino", "istio.io/rev": "basic"},
+						Annotations: map[string]string{"owned-by": "authorino-operator"},
+					},
+				},
+			},
+		}
+		cli = fake.NewClientBuilder().WithObjects(deployment).Build()
+	})
+
+	injectionPatch := func() *unstructured.Unstructured {
+		patch := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"template": map[string]any{
+					"metadata": map[string]any{
+						"labels":      map[string]any{"istio.io/rev": nil},
+						"annotations": map[string]any{"sidecar.istio.io/inject": "true"},
+					},
+				},
+			},
+		}}
+		patch.SetAPIVersion("apps/v1")
+		patch.SetKind("Deployment")
+		patch.SetName(deployment.Name)
+		patch.SetNamespace(deployment.Namespace)
+
+		return patch
+	}
+
+	getDeployment := func(ctx context.Context) *appsv1.Deployment {
+		found := &appsv1.Deployment{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(deployment), found)).To(Succeed())
+
+		return found
+	}
+
+	It("should apply patch and record original values", func(ctx context.Context) {
+		// when
+		Expect(resource.ApplyRevertiblePatch(ctx, cli, injectionPatch(), owner)).To(Succeed())
+
+		// then
+		patched := getDeployment(ctx)
+		Expect(patched.Spec.Template.Labels).To(Equal(map[string]string{"app": "authorino"}))
+		Expect(patched.Spec.Template.Annotations).To(HaveKeyWithValue("sidecar.istio.io/inject", "true"))
+		Expect(patched.Annotations).To(HaveKey(annotations.AppliedPatches))
+	})
+
+	It("should restore original state when reverted", func(ctx context.Context) {
+		// given
+		Expect(resource.ApplyRevertiblePatch(ctx, cli, injectionPatch(), owner)).To(Succeed())
+
+		// when
+		Expect(resource.RevertPatch(ctx, cli, resource.ReferenceOf(injectionPatch()), owner)).To(Succeed())
+
+		// then
+		reverted := getDeployment(ctx)
+		Expect(reverted.Spec.Template.Labels).To(Equal(deployment.Spec.Template.Labels))
+		Expect(reverted.Spec.Template.Annotations).To(Equal(deployment.Spec.Template.Annotations))
+		Expect(reverted.Annotations).ToNot(HaveKey(annotations.AppliedPatches))
+	})
+
+	It("should keep original values when patch is applied repeatedly", func(ctx context.Context) {
+		// given
+		Expect(resource.ApplyRevertiblePatch(ctx, cli, injectionPatch(), owner)).To(Succeed())
+		firstRecord := getDeployment(ctx).Annotations[annotations.AppliedPatches]
+
+		// when
+		Expect(resource.ApplyRevertiblePatch(ctx, cli, injectionPatch(), owner)).To(Succeed())
+
+		// then
+		Expect(getDeployment(ctx).Annotations).To(HaveKeyWithValue(annotations.AppliedPatches, firstRecord))
+
+		Expect(resource.RevertPatch(ctx, cli, resource.ReferenceOf(injectionPatch()), owner)).To(Succeed())
+		Expect(getDeployment(ctx).Spec.Template.Labels).To(HaveKeyWithValue("istio.io/rev", "basic"))
+	})
+
+	It("should keep changes of other owners when reverted", func(ctx context.Context) {
+		// given
+		otherPatch := injectionPatch()
+		otherPatch.Object["spec"] = map[string]any{"replicas": int64(3)}
+		Expect(resource.ApplyRevertiblePatch(ctx, cli, injectionPatch(), owner)).To(Succeed())
+		Expect(resource.ApplyRevertiblePatch(ctx, cli, otherPatch, "other-owner")).To(Succeed())
+
+		// when
+		Expect(resource.RevertPatch(ctx, cli, resource.ReferenceOf(injectionPatch()), owner)).To(Succeed())
+
+		// then
+		reverted := getDeployment(ctx)
+		Expect(reverted.Spec.Replicas).To(HaveValue(Equal(int32(3))))
+		Expect(reverted.Annotations[annotations.AppliedPatches]).To(ContainSubstring("other-owner"))
+		Expect(reverted.Annotations[annotations.AppliedPatches]).ToNot(ContainSubstring(owner))
+	})
+
+	It("should ignore reverting patch of resource which no longer exists", func(ctx context.Context) {
+		// given
+		Expect(cli.Delete(ctx, deployment)).To(Succeed())
+
+		// then
+		Expect(resource.RevertPatch(ctx, cli, resource.ReferenceOf(injectionPatch()), owner)).To(Succeed())
+	})
+})
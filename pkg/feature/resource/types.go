@@ -3,6 +3,7 @@ package resource
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
@@ -17,3 +18,21 @@ type Applier interface {
 type Creator interface {
 	Create() ([]Applier, error)
 }
+
+// RevertiblePatchSource is implemented by Appliers holding patches which declare OnDeleteRevertPatch policy.
+// Such patches are not applied by the Applier itself, but by the feature, which records them so that they are reverted when it is deleted.
+type RevertiblePatchSource interface {
+	RevertiblePatches(data map[string]any) ([]*unstructured.Unstructured, error)
+}
+
+// Renderer is implemented by Appliers which can render their resources without applying them, e.g. to review them offline.
+type Renderer interface {
+	Render(data map[string]any) ([]*unstructured.Unstructured, error)
+}
+
+// ChartSource is implemented by Appliers rendering resources from Helm charts, so that the feature can record
+// the versions of the charts it applied.
+type ChartSource interface {
+	// AppliedChart returns the chart rendered by the last Apply call, ok is false if nothing has been rendered yet.
+	AppliedChart() (name, version, source string, ok bool)
+}
@@ -0,0 +1,294 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/openapi"
+	"k8s.io/client-go/openapi3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StrictFieldValidation makes the API server reject requests declaring unknown or duplicate fields,
+// instead of silently dropping them, e.g. when a template misspells a field of the ServiceMeshControlPlane.
+var StrictFieldValidation = strictFieldValidation{} //nolint:gochecknoglobals // Reason: stateless client option
+
+type strictFieldValidation struct{}
+
+func (strictFieldValidation) ApplyToCreate(opts *client.CreateOptions) {
+	if opts.Raw == nil {
+		opts.Raw = &metav1.CreateOptions{}
+	}
+	opts.Raw.FieldValidation = metav1.FieldValidationStrict
+}
+
+func (strictFieldValidation) ApplyToPatch(opts *client.PatchOptions) {
+	if opts.Raw == nil {
+		opts.Raw = &metav1.PatchOptions{}
+	}
+	opts.Raw.FieldValidation = metav1.FieldValidationStrict
+}
+
+// UnknownFieldsError reports fields of the resource which are not defined by its schema.
+type UnknownFieldsError struct {
+	Resource Reference
+	Fields   []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("%s declares fields unknown to the API server: %s", e.Resource, strings.Join(e.Fields, ", "))
+}
+
+// IsUnknownFields checks if the error reports fields unknown to the API server.
+func IsUnknownFields(err error) bool {
+	var unknownErr *UnknownFieldsError
+
+	return errors.As(err, &unknownErr)
+}
+
+// schemaCache is used to validate resources before they are sent to the API server, nil disables the validation.
+var schemaCache *SchemaCache //nolint:gochecknoglobals // Reason: cache is configured once on startup and shared by all features
+
+// SetSchemaCache sets the cache of OpenAPI schemas used to validate fields of applied resources and patches.
+// It has to be called before any feature is applied.
+func SetSchemaCache(cache *SchemaCache) {
+	schemaCache = cache
+}
+
+// validateFields checks fields of the resource against the cached schema. Resources without a known schema are not
+// validated, the API server still rejects their unknown fields, as requests use StrictFieldValidation.
+func validateFields(obj *unstructured.Unstructured) error {
+	if schemaCache == nil {
+		return nil
+	}
+
+	unknown := schemaCache.UnknownFields(obj)
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	return &UnknownFieldsError{Resource: ReferenceOf(obj), Fields: unknown}
+}
+
+// SchemaCache holds OpenAPI v3 schemas published by the API server, so that fields of resources can be validated
+// without fetching schemas on every apply. Schemas of a group version are fetched on first use, or upfront using Warm.
+//
+// Cached schema can be outdated, e.g. when a CRD has been upgraded by its operator. Resources declaring unknown fields
+// are therefore validated again against a freshly fetched schema before they are reported.
+type SchemaCache struct {
+	root openapi3.Root
+
+	mu    sync.Mutex
+	specs map[schema.GroupVersion]*gvSchemas
+}
+
+// NewSchemaCache creates a cache of schemas served by the given client, e.g. discovery client's OpenAPIV3().
+func NewSchemaCache(client openapi.Client) *SchemaCache {
+	return &SchemaCache{
+		root:  openapi3.NewRoot(client),
+		specs: map[schema.GroupVersion]*gvSchemas{},
+	}
+}
+
+// Warm fetches schemas of the given group versions. Group versions which are not served, e.g. because the operator
+// installing them is missing, are skipped and fetched on first use instead.
+func (c *SchemaCache) Warm(gvs ...schema.GroupVersion) error {
+	var multiErr *multierror.Error
+	for _, gv := range gvs {
+		if _, err := c.fetch(gv); err != nil && !isGroupVersionNotFound(err) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("failed fetching schema of %s: %w", gv, err))
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+// UnknownFields returns paths of the fields of the object which are not defined by the schema of its kind, sorted.
+// Objects of kinds without available schema have no unknown fields.
+func (c *SchemaCache) UnknownFields(obj *unstructured.Unstructured) []string {
+	gvk := obj.GroupVersionKind()
+
+	c.mu.Lock()
+	specs, cached := c.specs[gvk.GroupVersion()]
+	c.mu.Unlock()
+
+	if !cached {
+		var err error
+		if specs, err = c.fetch(gvk.GroupVersion()); err != nil {
+			return nil
+		}
+	}
+
+	unknown := specs.unknownFields(gvk.Kind, obj.Object)
+	if len(unknown) == 0 || !cached {
+		return unknown
+	}
+
+	refreshed, err := c.fetch(gvk.GroupVersion())
+	if err != nil {
+		return unknown
+	}
+
+	return refreshed.unknownFields(gvk.Kind, obj.Object)
+}
+
+func (c *SchemaCache) fetch(gv schema.GroupVersion) (*gvSchemas, error) {
+	spec, err := c.root.GVSpecAsMap(gv)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := newGVSchemas(gv, spec)
+
+	c.mu.Lock()
+	c.specs[gv] = specs
+	c.mu.Unlock()
+
+	return specs, nil
+}
+
+func isGroupVersionNotFound(err error) bool {
+	var notFoundErr *openapi3.GroupVersionNotFoundError
+
+	return errors.As(err, &notFoundErr)
+}
+
+// gvSchemas holds schemas of a group version, indexed by the kind they define.
+type gvSchemas struct {
+	components map[string]any
+	kinds      map[string]map[string]any
+}
+
+func newGVSchemas(gv schema.GroupVersion, spec map[string]any) *gvSchemas {
+	components, _, _ := unstructured.NestedMap(spec, "components", "schemas")
+	specs := &gvSchemas{components: components, kinds: map[string]map[string]any{}}
+
+	for _, component := range components {
+		componentSchema, ok := component.(map[string]any)
+		if !ok {
+			continue
+		}
+		gvks, _ := componentSchema["x-kubernetes-group-version-kind"].([]any)
+		for _, v := range gvks {
+			gvk, _ := v.(map[string]any)
+			if gvk["group"] == gv.Group && gvk["version"] == gv.Version {
+				if kind, isString := gvk["kind"].(string); isString {
+					specs.kinds[kind] = componentSchema
+				}
+			}
+		}
+	}
+
+	return specs
+}
+
+func (s *gvSchemas) unknownFields(kind string, obj map[string]any) []string {
+	kindSchema, found := s.kinds[kind]
+	if !found {
+		return nil
+	}
+
+	var unknown []string
+	s.collectUnknown(kindSchema, obj, "", &unknown)
+	sort.Strings(unknown)
+
+	return unknown
+}
+
+func (s *gvSchemas) collectUnknown(fieldSchema map[string]any, value any, path string, unknown *[]string) {
+	schemas := s.resolve(fieldSchema)
+
+	properties := map[string]map[string]any{}
+	var items, additional map[string]any
+	openObject := false
+
+	for _, candidate := range schemas {
+		if preserve, _ := candidate["x-kubernetes-preserve-unknown-fields"].(bool); preserve {
+			return
+		}
+		if props, ok := candidate["properties"].(map[string]any); ok {
+			for name, prop := range props {
+				if propSchema, isMap := prop.(map[string]any); isMap {
+					properties[name] = propSchema
+				}
+			}
+		}
+		switch additionalProperties := candidate["additionalProperties"].(type) {
+		case map[string]any:
+			additional = additionalProperties
+		case bool:
+			openObject = openObject || additionalProperties
+		}
+		if itemsSchema, ok := candidate["items"].(map[string]any); ok {
+			items = itemsSchema
+		}
+	}
+
+	switch typed := value.(type) {
+	case map[string]any:
+		if openObject || (len(properties) == 0 && additional == nil) {
+			return
+		}
+		for name, fieldValue := range typed {
+			fieldPath := name
+			if path != "" {
+				fieldPath = path + "." + name
+			}
+			if propSchema, known := properties[name]; known {
+				s.collectUnknown(propSchema, fieldValue, fieldPath, unknown)
+			} else if additional != nil {
+				s.collectUnknown(additional, fieldValue, fieldPath, unknown)
+			} else {
+				*unknown = append(*unknown, fieldPath)
+			}
+		}
+	case []any:
+		if items == nil {
+			return
+		}
+		for i, item := range typed {
+			s.collectUnknown(items, item, path+"["+strconv.Itoa(i)+"]", unknown)
+		}
+	}
+}
+
+// resolve follows references and flattens allOf, returning all the schemas the value has to conform to.
+func (s *gvSchemas) resolve(fieldSchema map[string]any) []map[string]any {
+	var resolved []map[string]any
+	pending := []map[string]any{fieldSchema}
+	visited := map[string]bool{}
+
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+
+		if ref, ok := current["$ref"].(string); ok {
+			name := strings.TrimPrefix(ref, "#/components/schemas/")
+			if target, found := s.components[name].(map[string]any); found && !visited[name] {
+				visited[name] = true
+				pending = append(pending, target)
+			}
+
+			continue
+		}
+
+		resolved = append(resolved, current)
+		if allOf, ok := current["allOf"].([]any); ok {
+			for _, member := range allOf {
+				if memberSchema, isMap := member.(map[string]any); isMap {
+					pending = append(pending, memberSchema)
+				}
+			}
+		}
+	}
+
+	return resolved
+}
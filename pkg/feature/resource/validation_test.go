@@ -0,0 +1,204 @@
+package resource_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/openapi"
+	"k8s.io/client-go/openapi/openapitest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const smcpSpec = `{
+  "components": {
+    "schemas": {
+      "io.maistra.v2.ServiceMeshControlPlane": {
+        "type": "object",
+        "x-kubernetes-group-version-kind": [{"group": "maistra.io", "version": "v2", "kind": "ServiceMeshControlPlane"}],
+        "properties": {
+          "apiVersion": {"type": "string"},
+          "kind": {"type": "string"},
+          "metadata": {"allOf": [{"$ref": "#/components/schemas/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"}]},
+          "spec": {
+            "type": "object",
+            "properties": {
+              "techPreview": {"type": "object", "x-kubernetes-preserve-unknown-fields": true},
+              "meshConfig": {
+                "type": "object",
+                "properties": {
+                  "extensionProviders": {
+                    "type": "array",
+                    "items": {
+                      "type": "object",
+                      "properties": {
+                        "name": {"type": "string"},
+                        "envoyExtAuthzHttp": {"type": "object", "properties": {"service": {"type": "string"}, "port": {"type": "integer"}}}
+                      }
+                    }
+                  }
+                }
+              },
+              "addons": {"type": "object", "additionalProperties": {"type": "object", "properties": {"enabled": {"type": "boolean"}}}}
+            }
+          }
+        }
+      },
+      "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "namespace": {"type": "string"},
+          "annotations": {"type": "object", "additionalProperties": {"type": "string"}}
+        }
+      }
+    }
+  }
+}`
+
+var _ = Describe("Field validation", func() {
+
+	var (
+		fetched map[string]int
+		cache   *resource.SchemaCache
+	)
+
+	BeforeEach(func() {
+		fetched = map[string]int{}
+		cache = resource.NewSchemaCache(&openapitest.FakeClient{
+			PathsMap: map[string]openapi.GroupVersion{
+				"apis/maistra.io/v2": countingGroupVersion{spec: smcpSpec, fetched: fetched, path: "apis/maistra.io/v2"},
+			},
+		})
+	})
+
+	createSMCP := func(spec map[string]any) *unstructured.Unstructured {
+		smcp := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+		smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		smcp.SetName("data-science-smcp")
+		smcp.SetNamespace("istio-system")
+		smcp.SetAnnotations(map[string]string{"opendatahub.io/managed": "true"})
+
+		return smcp
+	}
+
+	It("should report misspelled fields of the patch", func() {
+		// given
+		smcp := createSMCP(map[string]any{
+			"meshConfig": map[string]any{
+				"extensionProviders": []any{
+					map[string]any{
+						"name":              "opendatahub-auth-provider",
+						"envoyExtAuthzHTTP": map[string]any{"service": "authorino-authorino-authorization.opendatahub-auth-provider.svc.cluster.local"},
+					},
+				},
+			},
+		})
+
+		// when
+		unknown := cache.UnknownFields(smcp)
+
+		// then
+		Expect(unknown).To(ConsistOf("spec.meshConfig.extensionProviders[0].envoyExtAuthzHTTP"))
+	})
+
+	It("should accept fields defined by properties, additional properties and preserved unknown fields", func() {
+		// given
+		smcp := createSMCP(map[string]any{
+			"techPreview": map[string]any{"anything": map[string]any{"goes": true}},
+			"addons":      map[string]any{"kiali": map[string]any{"enabled": false}},
+			"meshConfig": map[string]any{
+				"extensionProviders": []any{
+					map[string]any{
+						"name":              "opendatahub-auth-provider",
+						"envoyExtAuthzHttp": map[string]any{"service": "authorino", "port": int64(50051)},
+					},
+				},
+			},
+		})
+
+		// when
+		unknown := cache.UnknownFields(smcp)
+
+		// then
+		Expect(unknown).To(BeEmpty())
+	})
+
+	It("should report unknown fields nested in additional properties", func() {
+		// given
+		smcp := createSMCP(map[string]any{
+			"addons": map[string]any{"kiali": map[string]any{"enable": false}},
+		})
+
+		// when
+		unknown := cache.UnknownFields(smcp)
+
+		// then
+		Expect(unknown).To(ConsistOf("spec.addons.kiali.enable"))
+	})
+
+	It("should not validate resources of group versions which are not served", func() {
+		// given
+		authorino := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"replicaz": int64(1)}}}
+		authorino.SetGroupVersionKind(gvk.Authorino)
+
+		// when
+		unknown := cache.UnknownFields(authorino)
+
+		// then
+		Expect(unknown).To(BeEmpty())
+		Expect(cache.Warm(gvk.Authorino.GroupVersion(), gvk.ServiceMeshControlPlane.GroupVersion())).To(Succeed())
+	})
+
+	It("should only refetch pre-warmed schema when unknown fields are found", func() {
+		// given
+		Expect(cache.Warm(schema.GroupVersion{Group: "maistra.io", Version: "v2"})).To(Succeed())
+
+		// when
+		Expect(cache.UnknownFields(createSMCP(map[string]any{"addons": map[string]any{}}))).To(BeEmpty())
+		Expect(cache.UnknownFields(createSMCP(map[string]any{"adons": map[string]any{}}))).To(ConsistOf("spec.adons"))
+
+		// then
+		Expect(fetched["apis/maistra.io/v2"]).To(Equal(2))
+	})
+
+	When("schema cache is set", func() {
+
+		BeforeEach(func() {
+			resource.SetSchemaCache(cache)
+			DeferCleanup(resource.SetSchemaCache, (*resource.SchemaCache)(nil))
+		})
+
+		It("should fail to apply a resource with unknown fields", func() {
+			// given
+			cli := fake.NewClientBuilder().Build()
+			smcp := createSMCP(map[string]any{"meshConfig": map[string]any{"extensionProvider": []any{}}})
+
+			// when
+			err := resource.ApplyRevertiblePatch(context.Background(), cli, smcp, "test-feature")
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(resource.IsUnknownFields(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.meshConfig.extensionProvider"))
+		})
+	})
+})
+
+type countingGroupVersion struct {
+	spec    string
+	path    string
+	fetched map[string]int
+}
+
+func (c countingGroupVersion) Schema(string) ([]byte, error) {
+	c.fetched[c.path]++
+
+	return []byte(c.spec), nil
+}
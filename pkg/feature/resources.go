@@ -2,16 +2,149 @@ package feature
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/resource"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// NamespaceQuotaName is the name of the ResourceQuota created from DSCI spec.namespaceDefaults.
+	NamespaceQuotaName = "odh-namespace-quota"
+	// NamespaceLimitRangeName is the name of the LimitRange created from DSCI spec.namespaceDefaults.
+	NamespaceLimitRangeName = "odh-namespace-limits"
 )
 
-// CreateNamespaceIfNotExists will create a namespace with the given name if it does not exist yet.
-// It does not set ownership nor apply extra metadata to the existing namespace.
+// CreateNamespaceIfNotExists will create a namespace with the given name if it does not exist yet, labeled with the feature.
+// It does not set ownership, so that the namespace, e.g. the one of the control plane, is not removed together with the feature.
+// The existing namespace is handled according to the adoption policy of the feature, while labels and annotations of the namespace
+// created by the feature before, as identified by its labels, are repaired according to its metadata repair policy.
 func CreateNamespaceIfNotExists(namespace string) Action {
 	return func(ctx context.Context, f *Feature) error {
-		_, err := cluster.CreateNamespace(ctx, f.Client, namespace)
+		return CreateNamespace(ctx, f, namespace, WithFeatureLabels(f), WithPolicyExemptions(f))
+	}
+}
+
+// CreateNamespace creates the namespace with the given metadata. When the namespace already exists, but has not been created
+// by the feature, it is skipped, adopted or fails the feature according to the adoption policy of the feature. Metadata of the
+// namespace created by the feature before, e.g. labels removed by the user, is repaired according to the metadata repair policy.
+func CreateNamespace(ctx context.Context, f *Feature, namespace string, metaOptions ...cluster.MetaOptions) error {
+	existing := &corev1.Namespace{}
+	if errGet := f.Client.Get(ctx, client.ObjectKey{Name: namespace}, existing); client.IgnoreNotFound(errGet) != nil {
+		return fmt.Errorf("failed getting namespace %s: %w", namespace, errGet)
+	} else if errGet == nil && existing.GetDeletionTimestamp() != nil {
+		// Applying to the terminating namespace would fail anyway, the namespace is created again once it is gone.
+		return fmt.Errorf("namespace %s is still terminating after being removed: %s", namespace, TerminationProgress(existing))
+	}
+
+	desired := &corev1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: namespace},
+	}
+	if err := cluster.ApplyMetaOptions(desired, append(metaOptions, WithCostAttribution(f), WithAdoptionPolicy(f), WithMetadataRepairPolicy(f))...); err != nil {
+		return err
+	}
 
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
 		return err
 	}
+
+	return resource.Apply(ctx, f.Client, []*unstructured.Unstructured{{Object: content}})
+}
+
+// ApplyNamespaceDefaults creates ResourceQuota and LimitRange defined in DSCI spec.namespaceDefaults in the given namespace.
+// Defaults are applied only to the namespace created, or adopted, by the feature, as identified by its labels, so that workloads
+// already running in namespaces of the user, e.g. a pre-existing control plane namespace, are not constrained. Both resources
+// are owned by the Feature, so they are garbage collected together with it, and deleted once they are no longer defined.
+func ApplyNamespaceDefaults(namespace string, defaults *dsciv1.NamespaceDefaults) Action {
+	return func(ctx context.Context, f *Feature) error {
+		existing := &corev1.Namespace{}
+		if err := f.Client.Get(ctx, client.ObjectKey{Name: namespace}, existing); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed getting namespace %s: %w", namespace, err)
+		}
+
+		var quota *corev1.ResourceQuotaSpec
+		var limits *corev1.LimitRangeSpec
+		if defaults != nil && existing.GetLabels()[labels.ODH.Feature] == f.Name {
+			quota, limits = defaults.ResourceQuota, defaults.LimitRange
+		}
+
+		if quota != nil {
+			if err := cluster.CreateOrUpdateResourceQuota(ctx, f.Client, NamespaceQuotaName, namespace, *quota, OwnedBy(f), WithFeatureLabels(f)); err != nil {
+				return err
+			}
+		} else if err := deleteNamespaceDefault(ctx, f, &corev1.ResourceQuota{}, namespace, NamespaceQuotaName); err != nil {
+			return err
+		}
+
+		if limits != nil {
+			if err := cluster.CreateOrUpdateLimitRange(ctx, f.Client, NamespaceLimitRangeName, namespace, *limits, OwnedBy(f), WithFeatureLabels(f)); err != nil {
+				return err
+			}
+		} else if err := deleteNamespaceDefault(ctx, f, &corev1.LimitRange{}, namespace, NamespaceLimitRangeName); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// deleteNamespaceDefault deletes the ResourceQuota or LimitRange created by the feature from namespace defaults, leaving
+// the resource of the same name which has not been created by it untouched.
+func deleteNamespaceDefault(ctx context.Context, f *Feature, obj client.Object, namespace, name string) error {
+	if err := f.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if obj.GetLabels()[labels.ODH.Feature] != f.Name {
+		return nil
+	}
+
+	if err := f.Client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting %s from namespace defaults in namespace %s: %w", name, namespace, err)
+	}
+
+	return nil
+}
+
+// deleteResources deletes the referenced resources, skipping the ones which do not exist, including those of kinds unknown
+// to the cluster, and the ones labeled as belonging to another feature.
+func deleteResources(f *Feature, references ...resource.Reference) CleanupFunc {
+	return func(ctx context.Context, cli client.Client) error {
+		var multiErr *multierror.Error
+		for _, reference := range references {
+			existing := &unstructured.Unstructured{}
+			existing.SetAPIVersion(reference.APIVersion)
+			existing.SetKind(reference.Kind)
+			if err := cli.Get(ctx, client.ObjectKey{Namespace: reference.Namespace, Name: reference.Name}, existing); err != nil {
+				if !k8serr.IsNotFound(err) && !meta.IsNoMatchError(err) {
+					multiErr = multierror.Append(multiErr, fmt.Errorf("failed getting %s to delete: %w", reference, err))
+				}
+
+				continue
+			}
+
+			if owner := existing.GetLabels()[labels.ODH.Feature]; owner != "" && owner != f.Name {
+				continue
+			}
+
+			if err := cli.Delete(ctx, existing); client.IgnoreNotFound(err) != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("failed deleting %s: %w", reference, err))
+			}
+		}
+
+		return multiErr.ErrorOrNil()
+	}
 }
@@ -0,0 +1,134 @@
+package serverless
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// EnsureGatewayCertificateValid verifies that the certificate presented by the serving gateway can be used to establish
+// TLS connections for the ingress domain. This way misconfiguration is reported through feature conditions
+// instead of failing TLS handshakes at runtime.
+func EnsureGatewayCertificateValid(ctx context.Context, f *feature.Feature) error {
+	secretData, err := getSecretParams(f)
+	if err != nil {
+		return err
+	}
+
+	secret, err := cluster.GetSecret(ctx, f.Client, secretData.Namespace, secretData.Name)
+	if err != nil {
+		return fmt.Errorf("failed getting gateway certificate secret %s/%s: %w", secretData.Namespace, secretData.Name, err)
+	}
+
+	if err := ValidateCertificate(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey], secretData.Domain, time.Now()); err != nil {
+		return fmt.Errorf("gateway certificate %s/%s is not valid: %w", secretData.Namespace, secretData.Name, err)
+	}
+
+	return nil
+}
+
+// ValidateCertificate checks PEM encoded certificate chain and its private key. It reports all the problems found:
+//   - certificates which are expired or not yet valid at the given time,
+//   - private key not matching the leaf certificate,
+//   - incomplete chain, i.e. certificates not issued by the next one in the chain, or the last one
+//     being neither self-signed nor issued by a CA trusted by the system,
+//   - leaf certificate not covering the given domain in its Subject Alternative Names.
+func ValidateCertificate(certPEM, keyPEM []byte, domain string, now time.Time) error {
+	chain, err := parseCertificates(certPEM)
+	if err != nil {
+		return err
+	}
+
+	var multiErr *multierror.Error
+
+	for _, cert := range chain {
+		if now.Before(cert.NotBefore) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("certificate %q is not valid before %s", cert.Subject, cert.NotBefore.Format(time.RFC3339)))
+		}
+		if now.After(cert.NotAfter) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("certificate %q expired at %s", cert.Subject, cert.NotAfter.Format(time.RFC3339)))
+		}
+	}
+
+	if _, errKeyPair := tls.X509KeyPair(certPEM, keyPEM); errKeyPair != nil {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("private key does not match certificate: %w", errKeyPair))
+	}
+
+	multiErr = multierror.Append(multiErr, verifyChainComplete(chain))
+
+	if errHost := chain[0].VerifyHostname(domain); errHost != nil {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("certificate %q does not cover domain %q, SANs: %v", chain[0].Subject, domain, chain[0].DNSNames))
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+func parseCertificates(certPEM []byte) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+
+	rest := certPEM
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing certificate: %w", err)
+		}
+		chain = append(chain, cert)
+	}
+
+	if len(chain) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+
+	return chain, nil
+}
+
+func verifyChainComplete(chain []*x509.Certificate) error {
+	for i := 0; i < len(chain)-1; i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			return fmt.Errorf("chain is incomplete: certificate %q is not issued by %q", chain[i].Subject, chain[i+1].Subject)
+		}
+	}
+
+	last := chain[len(chain)-1]
+	if isSelfSigned(last) {
+		return nil
+	}
+
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		return fmt.Errorf("failed loading system trusted certificates: %w", err)
+	}
+
+	// Other verification failures, such as expiry, are reported separately.
+	_, errVerify := last.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}})
+	if unknownAuthority := (x509.UnknownAuthorityError{}); errors.As(errVerify, &unknownAuthority) {
+		return fmt.Errorf("chain is incomplete: issuer %q of certificate %q is neither included in the chain nor trusted", last.Issuer, last.Subject)
+	}
+
+	return nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) &&
+		cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil
+}
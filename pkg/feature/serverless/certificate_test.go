@@ -0,0 +1,184 @@
+package serverless_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/serverless"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Gateway certificate validation", func() {
+
+	var (
+		now    time.Time
+		domain = "*.apps.example.com"
+		rootCA *testCert
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		rootCA = newTestCert("root-ca", nil, now, true)
+	})
+
+	It("should accept self-signed certificate covering the domain", func() {
+		// given
+		leaf := newTestCert("gateway", nil, now, false, domain)
+
+		// then
+		Expect(serverless.ValidateCertificate(leaf.certPEM(), leaf.keyPEM(), domain, now)).To(Succeed())
+	})
+
+	It("should accept complete chain up to self-signed root", func() {
+		// given
+		intermediate := newTestCert("intermediate-ca", rootCA, now, true)
+		leaf := newTestCert("gateway", intermediate, now, false, domain)
+
+		// when
+		chain := concat(leaf.certPEM(), intermediate.certPEM(), rootCA.certPEM())
+
+		// then
+		Expect(serverless.ValidateCertificate(chain, leaf.keyPEM(), domain, now)).To(Succeed())
+	})
+
+	It("should report expired certificate", func() {
+		// given
+		leaf := newTestCert("gateway", nil, now.AddDate(-2, 0, 0), false, domain)
+
+		// when
+		err := serverless.ValidateCertificate(leaf.certPEM(), leaf.keyPEM(), domain, now)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`certificate "CN=gateway" expired at`)))
+	})
+
+	It("should report private key not matching certificate", func() {
+		// given
+		leaf := newTestCert("gateway", nil, now, false, domain)
+		other := newTestCert("other", nil, now, false, domain)
+
+		// when
+		err := serverless.ValidateCertificate(leaf.certPEM(), other.keyPEM(), domain, now)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("private key does not match certificate")))
+	})
+
+	It("should report chain missing intermediate certificate", func() {
+		// given
+		intermediate := newTestCert("intermediate-ca", rootCA, now, true)
+		leaf := newTestCert("gateway", intermediate, now, false, domain)
+
+		// when
+		err := serverless.ValidateCertificate(concat(leaf.certPEM(), rootCA.certPEM()), leaf.keyPEM(), domain, now)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`chain is incomplete: certificate "CN=gateway" is not issued by "CN=root-ca"`)))
+	})
+
+	It("should report chain ending with untrusted issuer", func() {
+		// given
+		intermediate := newTestCert("intermediate-ca", rootCA, now, true)
+		leaf := newTestCert("gateway", intermediate, now, false, domain)
+
+		// when
+		err := serverless.ValidateCertificate(concat(leaf.certPEM(), intermediate.certPEM()), leaf.keyPEM(), domain, now)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`chain is incomplete: issuer "CN=root-ca" of certificate "CN=intermediate-ca"`)))
+	})
+
+	It("should report certificate not covering the domain", func() {
+		// given
+		leaf := newTestCert("gateway", nil, now, false, "*.other.example.com")
+
+		// when
+		err := serverless.ValidateCertificate(leaf.certPEM(), leaf.keyPEM(), domain, now)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`does not cover domain "*.apps.example.com"`)))
+	})
+
+	It("should report all problems at once", func() {
+		// given
+		leaf := newTestCert("gateway", nil, now.AddDate(-2, 0, 0), false, "*.other.example.com")
+		other := newTestCert("other", nil, now, false, domain)
+
+		// when
+		err := serverless.ValidateCertificate(leaf.certPEM(), other.keyPEM(), domain, now)
+
+		// then
+		Expect(err).To(MatchError(And(
+			ContainSubstring("expired at"),
+			ContainSubstring("private key does not match certificate"),
+			ContainSubstring("does not cover domain"),
+		)))
+	})
+
+	It("should fail when no certificate is provided", func() {
+		Expect(serverless.ValidateCertificate(nil, nil, domain, now)).To(MatchError(ContainSubstring("no PEM encoded certificate found")))
+	})
+})
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCert creates a certificate valid for a year starting at notBefore. It is self-signed when issuer is nil.
+func newTestCert(commonName string, issuer *testCert, notBefore time.Time, isCA bool, dnsNames ...string) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notBefore.Add(-time.Minute),
+		NotAfter:              notBefore.AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		DNSNames:              dnsNames,
+	}
+
+	parent, signer := tmpl, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	Expect(err).ToNot(HaveOccurred())
+
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) certPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw})
+}
+
+func (c *testCert) keyPEM() []byte {
+	der, err := x509.MarshalECPrivateKey(c.key)
+	Expect(err).ToNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func concat(blocks ...[]byte) []byte {
+	var result []byte
+	for _, block := range blocks {
+		result = append(result, block...)
+	}
+
+	return result
+}
@@ -0,0 +1,43 @@
+package serverless
+
+import (
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+)
+
+const (
+	// IngressCertificateCapability is the capability provisioning the certificate of the KNative ingress gateway.
+	IngressCertificateCapability = "serverless-ingress-certificate"
+	// IngressCertificateFeatureName is the feature creating the certificate Secret, which features serving KNative routes depend on.
+	IngressCertificateFeatureName = "serverless-ingress-certificate"
+)
+
+// IngressCertificateData defines the data describing the certificate of the KNative ingress gateway, i.e. the name of its Secret,
+// the domain it covers, its type and the namespace of the control plane, whose ingress gateway serves the knative-serving Gateway.
+// It is the same for all features referencing the certificate, so that they resolve the same Secret.
+func IngressCertificateData(serving *infrav1.ServingSpec, dsciSpec *dsciv1.DSCInitializationSpec) []feature.Action {
+	return []feature.Action{
+		FeatureData.IngressDomain.Define(serving).AsAction(),
+		gatewayCertificateName(serving, dsciSpec).AsAction(),
+		FeatureData.Serving.Define(serving).AsAction(),
+		servicemesh.FeatureData.ControlPlane.Define(dsciSpec).AsAction(),
+	}
+}
+
+// IngressCertificate bootstraps the certificate of the KNative ingress gateway from the certificate configuration of the serving
+// ingress gateway. The Secret is created in the namespace of the control plane, as the credentialName of the knative-ingress-gateway
+// Gateway in knative-serving is resolved by the Istio ingress gateway running there. Secrets generated by the operator can be
+// created under a versioned name (see CertificateSecretName), so features referencing them should depend on IngressCertificateFeatureName
+// and complete the rotation using CompleteGatewayCertificateSwap once they have switched to the new one.
+func IngressCertificate(serving *infrav1.ServingSpec, dsciSpec *dsciv1.DSCInitializationSpec) feature.FeaturesProvider {
+	return feature.ForCapability(IngressCertificateCapability, func(registry feature.FeaturesRegistry) error {
+		return registry.Add(
+			feature.Define(IngressCertificateFeatureName).
+				WithData(IngressCertificateData(serving, dsciSpec)...).
+				PreConditions(servicemesh.EnsureServiceMeshInstalled).
+				WithResources(ServingCertificateResource),
+		)
+	})
+}
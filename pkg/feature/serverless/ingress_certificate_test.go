@@ -0,0 +1,62 @@
+package serverless_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/serverless"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ingress certificate", func() {
+
+	It("should generate the certificate in the control plane namespace under the name referenced by the gateway", func(ctx context.Context) {
+		// given
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+
+		serving := &infrav1.ServingSpec{
+			IngressGateway: infrav1.GatewaySpec{
+				Domain:      "*.apps.example.com",
+				Certificate: infrav1.CertificateSpec{SecretName: "kserve-ingress-cert", Type: infrav1.SelfSigned},
+			},
+		}
+		dsciSpec := &dsciv1.DSCInitializationSpec{
+			ServiceMesh: &infrav1.ServiceMeshSpec{ControlPlane: infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"}},
+		}
+
+		// Preconditions of the bootstrapped feature check the control plane, which is out of scope here.
+		f, err := feature.Define(serverless.IngressCertificateFeatureName).
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			WithData(serverless.IngressCertificateData(serving, dsciSpec)...).
+			WithResources(serverless.ServingCertificateResource).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		// when
+		Expect(f.Apply(ctx)).To(Succeed())
+
+		// then
+		secret := &corev1.Secret{}
+		Expect(cli.Get(ctx, client.ObjectKey{Namespace: "istio-system", Name: "kserve-ingress-cert"}, secret)).To(Succeed())
+		Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+		Expect(secret.Labels).To(HaveKeyWithValue(labels.ODH.Feature, serverless.IngressCertificateFeatureName))
+	})
+})
@@ -21,7 +21,7 @@ func ServingCertificateResource(ctx context.Context, f *feature.Feature) error {
 			secretData.Name,
 			secretData.Domain,
 			secretData.Namespace,
-			feature.OwnedBy(f))
+			feature.OwnedBy(f), feature.WithFeatureLabels(f))
 	case infrav1.Provided:
 		return nil
 	default:
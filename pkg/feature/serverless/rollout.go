@@ -0,0 +1,212 @@
+package serverless
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/naming"
+)
+
+const (
+	// GatewayServiceName is the Service of the Istio ingress gateway created with the control plane, serving KNative routes.
+	GatewayServiceName = "istio-ingressgateway"
+
+	selfSignedSuffix = "self-signed"
+
+	rolloutInterval = 2 * time.Second
+	rolloutTimeout  = 5 * time.Minute
+	dialTimeout     = 5 * time.Second
+)
+
+// CertificateSecretName returns the name of the Secret referenced by the serving gateway. Certificates generated by the operator
+// keep the configured name, as in existing installations, unless the Secret of that name still holds the certificate provided
+// by the user before the type changed. The generated certificate is then created under a versioned name next to it, so that
+// the gateway keeps serving the provided one until it switches over, instead of replacing it in place and dropping TLS connections.
+// Provided Secrets are used as named by the user.
+func CertificateSecretName(ctx context.Context, cli client.Client, namespace, name string, certType infrav1.CertType) (string, error) {
+	if certType != infrav1.SelfSigned {
+		return name, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+		if k8serr.IsNotFound(err) {
+			return name, nil
+		}
+
+		return "", fmt.Errorf("failed getting gateway certificate secret %s/%s: %w", namespace, name, err)
+	}
+
+	if secret.GetLabels()[labels.K8SCommon.ManagedBy] == cluster.OperatorName {
+		return name, nil
+	}
+
+	return naming.Resource(name, selfSignedSuffix), nil
+}
+
+// gatewayCertificateName defines the name of the Secret referenced by the serving gateway in the namespace of the control plane,
+// see CertificateSecretName, under the same key as FeatureData.CertificateName.
+func gatewayCertificateName(serving *infrav1.ServingSpec, dsciSpec *dsciv1.DSCInitializationSpec) feature.DataEntry[string] {
+	configured := FeatureData.CertificateName.Define(serving)
+
+	return feature.DataEntry[string]{
+		Key: configured.Key,
+		Value: func(ctx context.Context, cli client.Client) (string, error) {
+			name, err := configured.Value(ctx, cli)
+			if err != nil {
+				return "", err
+			}
+
+			return CertificateSecretName(ctx, cli, dsciSpec.ServiceMesh.ControlPlane.Namespace, name, serving.IngressGateway.Certificate.Type)
+		},
+	}
+}
+
+// CompleteGatewayCertificateSwap finishes the overlapping rollout of the gateway certificate. Once the gateway references
+// the new Secret, it waits until the gateway listener presents its certificate, and only then removes the Secrets generated
+// for the previous certificate by IngressCertificate. When there is nothing to remove, the listener is not probed.
+func CompleteGatewayCertificateSwap(ctx context.Context, f *feature.Feature) error {
+	secretData, err := getSecretParams(f)
+	if err != nil {
+		return err
+	}
+
+	superseded, err := SupersededCertificates(ctx, f.Client, secretData.Namespace, IngressCertificateFeatureName, secretData.Name)
+	if err != nil {
+		return err
+	}
+
+	// Secrets generated before the certificate was provisioned by IngressCertificate are labeled with the gateway feature.
+	generatedByGateway, err := SupersededCertificates(ctx, f.Client, secretData.Namespace, f.Name, secretData.Name)
+	if err != nil {
+		return err
+	}
+
+	superseded = append(superseded, generatedByGateway...)
+	if len(superseded) == 0 {
+		return nil
+	}
+
+	address := net.JoinHostPort(GatewayServiceName+"."+secretData.Namespace+".svc", "443")
+	serverName := probeServerName(secretData.Domain)
+
+	f.Log.Info("waiting for gateway to serve the new certificate", "secret", secretData.Name, "gateway", address, "duration (s)", rolloutTimeout.Seconds())
+
+	var lastResult string
+	errWait := wait.PollUntilContextTimeout(ctx, rolloutInterval, rolloutTimeout, true, func(ctx context.Context) (bool, error) {
+		secret, errGet := cluster.GetSecret(ctx, f.Client, secretData.Namespace, secretData.Name)
+		if errGet != nil {
+			// Provided Secret can be created by the user after the certificate type has been changed
+			lastResult = errGet.Error()
+
+			return false, client.IgnoreNotFound(errGet)
+		}
+
+		serves, errProbe := GatewayServesCertificate(ctx, address, serverName, secret.Data[corev1.TLSCertKey])
+		if errProbe != nil {
+			// listener can be unavailable while the gateway loads the new configuration
+			lastResult = errProbe.Error()
+
+			return false, nil
+		}
+
+		lastResult = "previous certificate presented"
+
+		return serves, nil
+	})
+	if errWait != nil {
+		return fmt.Errorf("gateway %s did not serve certificate of secret %s/%s, keeping previous certificates, last result: %s: %w",
+			address, secretData.Namespace, secretData.Name, lastResult, errWait)
+	}
+
+	for i := range superseded {
+		if errDel := f.Client.Delete(ctx, &superseded[i]); client.IgnoreNotFound(errDel) != nil {
+			return fmt.Errorf("failed removing previous gateway certificate secret %s/%s: %w", superseded[i].Namespace, superseded[i].Name, errDel)
+		}
+	}
+
+	return nil
+}
+
+// SupersededCertificates lists the certificate Secrets the feature generated in the namespace, other than the active one.
+func SupersededCertificates(ctx context.Context, cli client.Client, namespace, featureName, active string) ([]corev1.Secret, error) {
+	secrets := &corev1.SecretList{}
+	if err := cli.List(ctx, secrets, client.InNamespace(namespace), client.MatchingLabels{
+		labels.K8SCommon.ManagedBy: cluster.OperatorName,
+		labels.ODH.Feature:         featureName,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing gateway certificate secrets in namespace %s: %w", namespace, err)
+	}
+
+	superseded := make([]corev1.Secret, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		if secret.Name != active && secret.Type == corev1.SecretTypeTLS {
+			superseded = append(superseded, secret)
+		}
+	}
+
+	return superseded, nil
+}
+
+// GatewayServesCertificate checks if the TLS listener at the address presents the leaf certificate of the PEM encoded chain.
+func GatewayServesCertificate(ctx context.Context, address, serverName string, certPEM []byte) (bool, error) {
+	chain, err := parseCertificates(certPEM)
+	if err != nil {
+		return false, err
+	}
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: dialTimeout},
+		Config: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ServerName: serverName,
+			// Only the identity of the presented certificate matters, its validity is verified by EnsureGatewayCertificateValid.
+			InsecureSkipVerify: true, //nolint:gosec // Reason: certificate is compared with the expected one instead
+		},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return false, errors.New("unexpected connection type")
+	}
+
+	var presented *x509.Certificate
+	if certs := tlsConn.ConnectionState().PeerCertificates; len(certs) > 0 {
+		presented = certs[0]
+	}
+
+	return presented != nil && bytes.Equal(presented.Raw, chain[0].Raw), nil
+}
+
+// probeServerName returns the host name sent in SNI, so that the gateway selects the listener of the ingress domain.
+// Wildcard domains are probed using an arbitrary host they cover.
+func probeServerName(domain string) string {
+	if strings.HasPrefix(domain, "*.") {
+		return "gateway-probe" + domain[1:]
+	}
+
+	return domain
+}
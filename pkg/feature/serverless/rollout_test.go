@@ -0,0 +1,183 @@
+package serverless_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/serverless"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Gateway certificate rollout", func() {
+
+	Context("secret name", func() {
+
+		secretIn := func(name string, secretLabels map[string]string) *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "istio-system", Labels: secretLabels},
+				Type:       corev1.SecretTypeTLS,
+			}
+		}
+
+		It("should keep the configured name for the certificate generated by the operator", func(ctx context.Context) {
+			// given
+			cli := fake.NewClientBuilder().WithObjects(
+				secretIn("knative-serving-cert", map[string]string{labels.K8SCommon.ManagedBy: cluster.OperatorName}),
+			).Build()
+
+			// when
+			name, err := serverless.CertificateSecretName(ctx, cli, "istio-system", "knative-serving-cert", infrav1.SelfSigned)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("knative-serving-cert"))
+		})
+
+		It("should use the configured name when there is no certificate yet", func(ctx context.Context) {
+			// given
+			cli := fake.NewClientBuilder().Build()
+
+			// when
+			name, err := serverless.CertificateSecretName(ctx, cli, "istio-system", "knative-serving-cert", infrav1.SelfSigned)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("knative-serving-cert"))
+		})
+
+		It("should version the generated certificate while the provided one occupies the configured name", func(ctx context.Context) {
+			// given
+			cli := fake.NewClientBuilder().WithObjects(secretIn("knative-serving-cert", nil)).Build()
+
+			// when
+			name, err := serverless.CertificateSecretName(ctx, cli, "istio-system", "knative-serving-cert", infrav1.SelfSigned)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("knative-serving-cert-self-signed"))
+		})
+
+		It("should use the provided secret as named by the user", func(ctx context.Context) {
+			// given
+			cli := fake.NewClientBuilder().WithObjects(secretIn("knative-serving-cert", nil)).Build()
+
+			// when
+			name, err := serverless.CertificateSecretName(ctx, cli, "istio-system", "knative-serving-cert", infrav1.Provided)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("knative-serving-cert"))
+		})
+	})
+
+	Context("superseded secrets", func() {
+
+		secret := func(name string, secretLabels map[string]string) *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "istio-system", Labels: secretLabels},
+				Type:       corev1.SecretTypeTLS,
+			}
+		}
+
+		featureLabels := map[string]string{
+			labels.K8SCommon.ManagedBy: cluster.OperatorName,
+			labels.ODH.Feature:         "serverless-serving-gateways",
+		}
+
+		It("should list secrets generated for the previous certificate only", func(ctx context.Context) {
+			// given
+			cli := fake.NewClientBuilder().WithObjects(
+				secret("knative-serving-cert", nil),
+				secret("knative-serving-cert-self-signed", featureLabels),
+				secret("knative-serving-cert-legacy", featureLabels),
+			).Build()
+
+			// when
+			superseded, err := serverless.SupersededCertificates(ctx, cli, "istio-system", "serverless-serving-gateways", "knative-serving-cert")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(superseded).To(ConsistOf(
+				HaveField("Name", "knative-serving-cert-self-signed"),
+				HaveField("Name", "knative-serving-cert-legacy"),
+			))
+		})
+
+		It("should not consider the active secret superseded", func(ctx context.Context) {
+			// given
+			cli := fake.NewClientBuilder().WithObjects(secret("knative-serving-cert-self-signed", featureLabels)).Build()
+
+			// when
+			superseded, err := serverless.SupersededCertificates(ctx, cli, "istio-system", "serverless-serving-gateways", "knative-serving-cert-self-signed")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(superseded).To(BeEmpty())
+		})
+	})
+
+	Context("gateway listener", func() {
+
+		var (
+			served   *testCert
+			listener net.Listener
+		)
+
+		BeforeEach(func() {
+			served = newTestCert("gateway", nil, time.Now(), false, "*.apps.example.com")
+
+			keyPair, err := tls.X509KeyPair(served.certPEM(), served.keyPEM())
+			Expect(err).ToNot(HaveOccurred())
+
+			listener, err = tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{keyPair}, MinVersion: tls.VersionTLS12})
+			Expect(err).ToNot(HaveOccurred())
+
+			go func() {
+				for {
+					conn, errAccept := listener.Accept()
+					if errAccept != nil {
+						return
+					}
+					_ = conn.(*tls.Conn).Handshake()
+					_ = conn.Close()
+				}
+			}()
+		})
+
+		AfterEach(func() {
+			Expect(listener.Close()).To(Succeed())
+		})
+
+		It("should confirm the new certificate is served", func(ctx context.Context) {
+			// when
+			serves, err := serverless.GatewayServesCertificate(ctx, listener.Addr().String(), "probe.apps.example.com", served.certPEM())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(serves).To(BeTrue())
+		})
+
+		It("should report the previous certificate is still served", func(ctx context.Context) {
+			// given
+			next := newTestCert("gateway", nil, time.Now(), false, "*.apps.example.com")
+
+			// when
+			serves, err := serverless.GatewayServesCertificate(ctx, listener.Addr().String(), "probe.apps.example.com", next.certPEM())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(serves).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,233 @@
+package servicemesh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// DefaultAuthorinoGrpcPort is the port of the authorization service unless the Authorino instance configures another one.
+const DefaultAuthorinoGrpcPort int64 = 50051
+
+// AdoptedAuthorino identifies an Authorino instance installed by other means than the operator, which is used as the external
+// authorization provider of the mesh instead of deploying the operator-managed instance. Its zero value means no instance is adopted.
+type AdoptedAuthorino struct {
+	Name      string
+	Namespace string
+	// Port of the gRPC authorization service.
+	Port int64
+}
+
+// IsZero checks if no instance is adopted.
+func (a AdoptedAuthorino) IsZero() bool {
+	return a.Name == ""
+}
+
+func (a AdoptedAuthorino) String() string {
+	return a.Namespace + "/" + a.Name
+}
+
+// AuthorinoAdoptionError is returned when Authorino instances exist on the cluster, but none of them can be adopted.
+type AuthorinoAdoptionError struct {
+	// Rejected holds the reason for each of the instances, keyed by namespace/name.
+	Rejected map[string]string
+}
+
+func (e *AuthorinoAdoptionError) Error() string {
+	instances := make([]string, 0, len(e.Rejected))
+	for instance := range e.Rejected {
+		instances = append(instances, instance)
+	}
+	sort.Strings(instances)
+
+	reasons := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", instance, e.Rejected[instance]))
+	}
+
+	return "none of the existing Authorino instances can be adopted (" + strings.Join(reasons, "; ") + ")"
+}
+
+// IsAuthorinoAdoptionError checks if the error is caused by existing Authorino instances which cannot be adopted.
+func IsAuthorinoAdoptionError(err error) bool {
+	var adoptionErr *AuthorinoAdoptionError
+
+	return errors.As(err, &adoptionErr)
+}
+
+// AdoptsAuthorino checks if the configuration asks for adopting an existing Authorino instance.
+func AdoptsAuthorino(serviceMesh *infrav1.ServiceMeshSpec) bool {
+	return serviceMesh != nil && !UsesLightweightAuth(serviceMesh) && serviceMesh.Auth.Authorino.Adoption == infrav1.AdoptAuthorinoIfPresent
+}
+
+// FindAdoptableAuthorino looks up Authorino instances which have not been created by features of the operator and returns
+// the first one, by namespace and name, satisfying VerifyAuthorino. Zero value is returned when adoption is not configured,
+// or there are no such instances, so that the operator-managed instance is deployed instead.
+func FindAdoptableAuthorino(ctx context.Context, cli client.Client, serviceMesh *infrav1.ServiceMeshSpec) (AdoptedAuthorino, error) {
+	if !AdoptsAuthorino(serviceMesh) {
+		return AdoptedAuthorino{}, nil
+	}
+
+	authorinos := &unstructured.UnstructuredList{}
+	authorinos.SetGroupVersionKind(gvk.Authorino)
+	if err := cli.List(ctx, authorinos); err != nil {
+		if meta.IsNoMatchError(err) {
+			return AdoptedAuthorino{}, nil
+		}
+
+		return AdoptedAuthorino{}, fmt.Errorf("failed listing Authorino instances: %w", err)
+	}
+
+	candidates := make([]unstructured.Unstructured, 0, len(authorinos.Items))
+	for _, authorino := range authorinos.Items {
+		if _, managed := authorino.GetLabels()[labels.ODH.Feature]; !managed {
+			candidates = append(candidates, authorino)
+		}
+	}
+	if len(candidates) == 0 {
+		return AdoptedAuthorino{}, nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].GetNamespace() != candidates[j].GetNamespace() {
+			return candidates[i].GetNamespace() < candidates[j].GetNamespace()
+		}
+
+		return candidates[i].GetName() < candidates[j].GetName()
+	})
+
+	selector := ResolveAuthorino(serviceMesh.Auth.Authorino).AuthConfigSelector
+	rejected := make(map[string]string, len(candidates))
+	for i := range candidates {
+		authorino := &candidates[i]
+		if err := VerifyAuthorino(authorino, selector); err != nil {
+			rejected[authorino.GetNamespace()+"/"+authorino.GetName()] = err.Error()
+
+			continue
+		}
+
+		port, _, err := unstructured.NestedInt64(authorino.Object, "spec", "listener", "ports", "grpc")
+		if err != nil {
+			return AdoptedAuthorino{}, fmt.Errorf("failed reading gRPC port of Authorino %s/%s: %w", authorino.GetNamespace(), authorino.GetName(), err)
+		}
+		if port == 0 {
+			port = DefaultAuthorinoGrpcPort
+		}
+
+		return AdoptedAuthorino{Name: authorino.GetName(), Namespace: authorino.GetNamespace(), Port: port}, nil
+	}
+
+	return AdoptedAuthorino{}, &AuthorinoAdoptionError{Rejected: rejected}
+}
+
+// VerifyAuthorino checks if the Authorino instance can authorize requests of all the mesh workloads. It has to watch AuthConfigs
+// in all namespaces, which is the default of Authorino, and its AuthConfig label selector has to be equivalent to the
+// selector, i.e. the sharding label AuthConfigs created for the platform are labeled with.
+func VerifyAuthorino(authorino *unstructured.Unstructured, selector string) error {
+	clusterWide, found, err := unstructured.NestedBool(authorino.Object, "spec", "clusterWide")
+	if err != nil {
+		return fmt.Errorf("invalid clusterWide setting: %w", err)
+	}
+	if found && !clusterWide {
+		return errors.New("it is namespaced, but has to watch AuthConfigs cluster-wide")
+	}
+
+	instanceSelector, _, err := unstructured.NestedString(authorino.Object, "spec", "authConfigLabelSelectors")
+	if err != nil {
+		return fmt.Errorf("invalid authConfigLabelSelectors setting: %w", err)
+	}
+
+	expected, err := k8slabels.Parse(selector)
+	if err != nil {
+		return fmt.Errorf("invalid AuthConfig selector %q: %w", selector, err)
+	}
+	actual, err := k8slabels.Parse(instanceSelector)
+	if err != nil {
+		return fmt.Errorf("invalid authConfigLabelSelectors %q: %w", instanceSelector, err)
+	}
+	if actual.String() != expected.String() {
+		return fmt.Errorf("it handles AuthConfigs selected by %q instead of %q", instanceSelector, selector)
+	}
+
+	return nil
+}
+
+// AuthorinoLookup memoizes the Authorino instance to adopt, so that features deciding between the adopted and the operator-managed
+// instance list Authorino instances at most once. It does not observe instances changed after the first lookup, so it is meant
+// to be shared by the features of a single reconcile pass and then discarded.
+type AuthorinoLookup struct {
+	serviceMesh *infrav1.ServiceMeshSpec
+
+	mu       sync.Mutex
+	resolved bool
+	adopted  AdoptedAuthorino
+	rejected *AuthorinoAdoptionError
+}
+
+func NewAuthorinoLookup(serviceMesh *infrav1.ServiceMeshSpec) *AuthorinoLookup {
+	return &AuthorinoLookup{serviceMesh: serviceMesh}
+}
+
+// Find returns the instance to adopt, see FindAdoptableAuthorino. When existing instances cannot be adopted, zero value is returned
+// as well, so that the operator-managed instance is deployed instead, and the reasons are available from Rejected.
+// Instances are listed until the first successful lookup.
+func (l *AuthorinoLookup) Find(ctx context.Context, cli client.Client) (AdoptedAuthorino, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.resolved {
+		adopted, err := FindAdoptableAuthorino(ctx, cli, l.serviceMesh)
+		if err != nil && !errors.As(err, &l.rejected) {
+			return AdoptedAuthorino{}, err
+		}
+		l.adopted = adopted
+		l.resolved = true
+	}
+
+	return l.adopted, nil
+}
+
+// Rejected returns why existing instances have not been adopted by the last Find, nil when they have not been rejected.
+func (l *AuthorinoLookup) Rejected() *AuthorinoAdoptionError {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rejected
+}
+
+// AuthorinoAdopted returns feature.EnabledFunc enabling features which register the adopted Authorino instance.
+func AuthorinoAdopted(lookup *AuthorinoLookup) feature.EnabledFunc {
+	return func(ctx context.Context, f *feature.Feature) (bool, error) {
+		adopted, err := lookup.Find(ctx, f.Client)
+
+		return !adopted.IsZero(), err
+	}
+}
+
+// AuthorinoNotAdopted returns feature.EnabledFunc enabling features which deploy and configure the operator-managed Authorino instance,
+// which is the case also when existing instances cannot be adopted.
+func AuthorinoNotAdopted(lookup *AuthorinoLookup) feature.EnabledFunc {
+	return func(ctx context.Context, f *feature.Feature) (bool, error) {
+		adopted, err := lookup.Find(ctx, f.Client)
+
+		return adopted.IsZero(), err
+	}
+}
+
+var adoptedAuthorino = feature.DataDefinitionOf(adoptedAuthorinoData,
+	func(ctx context.Context, cli client.Client, source *AuthorinoLookup) (AdoptedAuthorino, error) {
+		return source.Find(ctx, cli)
+	})
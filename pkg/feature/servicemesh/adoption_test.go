@@ -0,0 +1,184 @@
+package servicemesh_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorino adoption", func() {
+
+	adoptingMesh := func() *infrav1.ServiceMeshSpec {
+		return &infrav1.ServiceMeshSpec{
+			Auth: infrav1.AuthSpec{
+				Authorino: infrav1.AuthorinoSpec{Adoption: infrav1.AdoptAuthorinoIfPresent},
+			},
+		}
+	}
+
+	createAuthorino := func(namespace, name string, spec map[string]any) *unstructured.Unstructured {
+		authorino := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+		authorino.SetGroupVersionKind(gvk.Authorino)
+		authorino.SetNamespace(namespace)
+		authorino.SetName(name)
+
+		return authorino
+	}
+
+	newClient := func(objects ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithObjects(objects...).Build()
+	}
+
+	It("should adopt cluster-wide instance handling AuthConfigs of the configured selector", func(ctx context.Context) {
+		// given
+		cli := newClient(createAuthorino("kuadrant", "authorino", map[string]any{
+			"clusterWide":              true,
+			"authConfigLabelSelectors": infrav1.DefaultAuthConfigSelector,
+		}))
+
+		// when
+		adopted, err := servicemesh.FindAdoptableAuthorino(ctx, cli, adoptingMesh())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(adopted).To(Equal(servicemesh.AdoptedAuthorino{Name: "authorino", Namespace: "kuadrant", Port: servicemesh.DefaultAuthorinoGrpcPort}))
+	})
+
+	It("should use gRPC port configured in the adopted instance", func(ctx context.Context) {
+		// given
+		cli := newClient(createAuthorino("kuadrant", "authorino", map[string]any{
+			"authConfigLabelSelectors": infrav1.DefaultAuthConfigSelector,
+			"listener":                 map[string]any{"ports": map[string]any{"grpc": int64(50052)}},
+		}))
+
+		// when
+		adopted, err := servicemesh.FindAdoptableAuthorino(ctx, cli, adoptingMesh())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(adopted.Port).To(Equal(int64(50052)))
+	})
+
+	It("should not adopt instance created by the operator", func(ctx context.Context) {
+		// given
+		managed := createAuthorino("opendatahub-auth-provider", "authorino", map[string]any{
+			"authConfigLabelSelectors": infrav1.DefaultAuthConfigSelector,
+		})
+		managed.SetLabels(map[string]string{labels.ODH.Feature: "mesh-control-plane-external-authz"})
+		cli := newClient(managed)
+
+		// when
+		adopted, err := servicemesh.FindAdoptableAuthorino(ctx, cli, adoptingMesh())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(adopted.IsZero()).To(BeTrue())
+	})
+
+	It("should not adopt when adoption is not configured", func(ctx context.Context) {
+		// given
+		cli := newClient(createAuthorino("kuadrant", "authorino", map[string]any{
+			"authConfigLabelSelectors": infrav1.DefaultAuthConfigSelector,
+		}))
+
+		// when
+		adopted, err := servicemesh.FindAdoptableAuthorino(ctx, cli, &infrav1.ServiceMeshSpec{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(adopted.IsZero()).To(BeTrue())
+	})
+
+	It("should adopt first valid instance and skip the ones which cannot be adopted", func(ctx context.Context) {
+		// given
+		cli := newClient(
+			createAuthorino("a-team", "authorino", map[string]any{"clusterWide": false, "authConfigLabelSelectors": infrav1.DefaultAuthConfigSelector}),
+			createAuthorino("b-team", "authorino", map[string]any{"authConfigLabelSelectors": infrav1.DefaultAuthConfigSelector}),
+		)
+
+		// when
+		adopted, err := servicemesh.FindAdoptableAuthorino(ctx, cli, adoptingMesh())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(adopted.String()).To(Equal("b-team/authorino"))
+	})
+
+	It("should report why instances cannot be adopted", func(ctx context.Context) {
+		// given
+		cli := newClient(
+			createAuthorino("a-team", "authorino", map[string]any{"clusterWide": false, "authConfigLabelSelectors": infrav1.DefaultAuthConfigSelector}),
+			createAuthorino("b-team", "authorino", map[string]any{"authConfigLabelSelectors": "security.opendatahub.io/authorization-group=b-team"}),
+		)
+
+		// when
+		_, err := servicemesh.FindAdoptableAuthorino(ctx, cli, adoptingMesh())
+
+		// then
+		Expect(servicemesh.IsAuthorinoAdoptionError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("a-team/authorino: it is namespaced"))
+		Expect(err.Error()).To(ContainSubstring(`b-team/authorino: it handles AuthConfigs selected by "security.opendatahub.io/authorization-group=b-team"`))
+	})
+
+	It("should consider equivalent selectors matching regardless of their order", func() {
+		// given
+		authorino := createAuthorino("kuadrant", "authorino", map[string]any{"authConfigLabelSelectors": "shard=1,group=default"})
+
+		// when
+		err := servicemesh.VerifyAuthorino(authorino, "group=default,shard=1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fall back to operator-managed instance when existing ones cannot be adopted", func(ctx context.Context) {
+		// given
+		cli := newClient(createAuthorino("a-team", "authorino", map[string]any{"clusterWide": false}))
+		lookup := servicemesh.NewAuthorinoLookup(adoptingMesh())
+
+		// when
+		adopted, err := lookup.Find(ctx, cli)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(adopted.IsZero()).To(BeTrue())
+		Expect(lookup.Rejected()).To(MatchError(ContainSubstring("a-team/authorino: it is namespaced")))
+	})
+
+	It("should list Authorino instances only once", func(ctx context.Context) {
+		// given
+		lists := 0
+		cli := fake.NewClientBuilder().
+			WithObjects(createAuthorino("kuadrant", "authorino", map[string]any{"authConfigLabelSelectors": infrav1.DefaultAuthConfigSelector})).
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, cli client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					lists++
+
+					return cli.List(ctx, list, opts...)
+				},
+			}).
+			Build()
+		lookup := servicemesh.NewAuthorinoLookup(adoptingMesh())
+
+		// when
+		first, errFirst := lookup.Find(ctx, cli)
+		second, errSecond := lookup.Find(ctx, cli)
+
+		// then
+		Expect(errFirst).ToNot(HaveOccurred())
+		Expect(errSecond).ToNot(HaveOccurred())
+		Expect(second).To(Equal(first))
+		Expect(lists).To(Equal(1))
+	})
+})
@@ -0,0 +1,97 @@
+package servicemesh
+
+import (
+	"context"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/refs"
+)
+
+// ValidateAuthConfigSelector checks that the AuthConfig selector consists of label equalities only, e.g. "key=value,other=value".
+// Such selector is not only set on the Authorino instance, but also used to label AuthConfigs, so that they are handled by it.
+func ValidateAuthConfigSelector(selector string) error {
+	if _, err := k8slabels.ConvertSelectorToLabelsMap(selector); err != nil {
+		return fmt.Errorf("AuthConfig selector %q has to consist of label equalities: %w", selector, err)
+	}
+
+	return nil
+}
+
+// MigrateAuthConfigSelector relabels AuthConfigs when the AuthConfig selector of Authorino changes, so that AuthConfigs created
+// for the previous selector, e.g. by components, do not silently stop being enforced. The previous selector is read from
+// the AuthRefs ConfigMap, therefore it has to run before AuthRefs publishes the new one.
+func MigrateAuthConfigSelector(ctx context.Context, f *feature.Feature) error {
+	auth, err := FeatureData.Authorization.Spec.Extract(f)
+	if err != nil {
+		return fmt.Errorf("could not get auth from feature: %w", err)
+	}
+	selector := ResolveAuthorino(auth.Authorino).AuthConfigSelector
+
+	published, errRefs := refs.GetAuthRefs(ctx, f.Client, f.TargetNamespace)
+	if k8serr.IsNotFound(errRefs) {
+		return nil
+	}
+	if errRefs != nil {
+		return errRefs
+	}
+
+	if published.AuthorinoLabel == "" || published.AuthorinoLabel == selector {
+		return nil
+	}
+
+	f.Log.Info("AuthConfig selector changed, relabeling AuthConfigs", "previous", published.AuthorinoLabel, "selector", selector)
+
+	return RelabelAuthConfigs(ctx, f.Client, published.AuthorinoLabel, selector)
+}
+
+// RelabelAuthConfigs replaces labels of the previous selector with the ones of the new selector on all AuthConfigs matching
+// the previous one. Other labels of the AuthConfigs are left intact.
+func RelabelAuthConfigs(ctx context.Context, cli client.Client, previousSelector, selector string) error {
+	previousLabels, err := k8slabels.ConvertSelectorToLabelsMap(previousSelector)
+	if err != nil {
+		return fmt.Errorf("previous AuthConfig selector %q cannot be migrated: %w", previousSelector, err)
+	}
+	selectorLabels, err := k8slabels.ConvertSelectorToLabelsMap(selector)
+	if err != nil {
+		return fmt.Errorf("AuthConfig selector %q cannot be migrated to: %w", selector, err)
+	}
+
+	authConfigs := &unstructured.UnstructuredList{}
+	authConfigs.SetGroupVersionKind(gvk.AuthConfig)
+	if errList := cli.List(ctx, authConfigs, client.MatchingLabels(previousLabels)); errList != nil {
+		if meta.IsNoMatchError(errList) {
+			// Authorino is not installed, there are no AuthConfigs to migrate.
+			return nil
+		}
+
+		return fmt.Errorf("failed listing AuthConfigs matching %q: %w", previousSelector, errList)
+	}
+
+	for i := range authConfigs.Items {
+		authConfig := &authConfigs.Items[i]
+		original := authConfig.DeepCopy()
+
+		authConfigLabels := authConfig.GetLabels()
+		for key := range previousLabels {
+			delete(authConfigLabels, key)
+		}
+		for key, value := range selectorLabels {
+			authConfigLabels[key] = value
+		}
+		authConfig.SetLabels(authConfigLabels)
+
+		if errPatch := cli.Patch(ctx, authConfig, client.MergeFrom(original)); errPatch != nil {
+			return fmt.Errorf("failed relabeling AuthConfig %s/%s: %w", authConfig.GetNamespace(), authConfig.GetName(), errPatch)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package servicemesh_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuthConfig selector", func() {
+
+	authConfig := func(name string, authConfigLabels map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk.AuthConfig)
+		obj.SetNamespace("kserve-demo")
+		obj.SetName(name)
+		obj.SetLabels(authConfigLabels)
+
+		return obj
+	}
+
+	It("should accept label equalities", func() {
+		Expect(servicemesh.ValidateAuthConfigSelector("security.opendatahub.io/authorization-group=shard-1,app=kserve")).To(Succeed())
+	})
+
+	It("should reject set-based selector which cannot be set as labels", func() {
+		Expect(servicemesh.ValidateAuthConfigSelector("security.opendatahub.io/authorization-group in (shard-1,shard-2)")).
+			To(MatchError(ContainSubstring("has to consist of label equalities")))
+	})
+
+	It("should relabel AuthConfigs matching the previous selector", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(
+			authConfig("predictor", map[string]string{"security.opendatahub.io/authorization-group": "default", "app": "kserve"}),
+			authConfig("other-shard", map[string]string{"security.opendatahub.io/authorization-group": "shard-2"}),
+		).Build()
+
+		// when
+		Expect(servicemesh.RelabelAuthConfigs(ctx, cli,
+			"security.opendatahub.io/authorization-group=default",
+			"security.opendatahub.io/authorization-group=shard-1,tier=gold",
+		)).To(Succeed())
+
+		// then
+		relabeled := authConfig("predictor", nil)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(relabeled), relabeled)).To(Succeed())
+		Expect(relabeled.GetLabels()).To(Equal(map[string]string{
+			"security.opendatahub.io/authorization-group": "shard-1",
+			"tier": "gold",
+			"app":  "kserve",
+		}))
+
+		untouched := authConfig("other-shard", nil)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(untouched), untouched)).To(Succeed())
+		Expect(untouched.GetLabels()).To(HaveKeyWithValue("security.opendatahub.io/authorization-group", "shard-2"))
+	})
+
+	It("should remove labels of the previous selector not used by the new one", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(
+			authConfig("predictor", map[string]string{"shard": "blue"}),
+		).Build()
+
+		// when
+		Expect(servicemesh.RelabelAuthConfigs(ctx, cli, "shard=blue", "security.opendatahub.io/authorization-group=green")).To(Succeed())
+
+		// then
+		relabeled := authConfig("predictor", nil)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(relabeled), relabeled)).To(Succeed())
+		Expect(relabeled.GetLabels()).To(Equal(map[string]string{"security.opendatahub.io/authorization-group": "green"}))
+	})
+})
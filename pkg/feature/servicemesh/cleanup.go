@@ -2,62 +2,33 @@ package servicemesh
 
 import (
 	"context"
+	"fmt"
 
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh/smcp"
 )
 
+// RemoveExtensionProvider removes the extension provider from the ServiceMeshControlPlane when the feature is deleted.
+// Missing control plane is not considered an error, as there is nothing to clean up.
 func RemoveExtensionProvider(controlPlane infrav1.ControlPlaneSpec, extensionName string) feature.CleanupFunc {
 	return func(ctx context.Context, cli client.Client) error {
-		smcp := &unstructured.Unstructured{}
-		smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
-
-		if err := cli.Get(ctx, client.ObjectKey{
-			Namespace: controlPlane.Namespace,
-			Name:      controlPlane.Name,
-		}, smcp); err != nil {
-			return client.IgnoreNotFound(err)
-		}
+		return client.IgnoreNotFound(smcp.Mutate(ctx, cli, controlPlane, smcp.RemoveExtensionProvider(extensionName)))
+	}
+}
 
-		extensionProviders, found, err := unstructured.NestedSlice(smcp.Object, "spec", "techPreview", "meshConfig", "extensionProviders")
+// RemoveAuthExtensionProvider removes the extension provider delegating authorization to Authorino from the control plane,
+// named the same way as when it has been registered, see FeatureData.Authorization.ExtensionProviderName.
+func RemoveAuthExtensionProvider(source *dsciv1.DSCInitializationSpec) feature.CleanupFunc {
+	return func(ctx context.Context, cli client.Client) error {
+		extensionName, err := authExtensionName.Define(source).Value(ctx, cli)
 		if err != nil {
-			return err
-		}
-		if !found {
-			return nil
-		}
-
-		removed := false
-
-		for i, v := range extensionProviders {
-			extensionProvider, ok := v.(map[string]interface{})
-			if !ok {
-				continue
-			}
-
-			currentExtensionName, isString := extensionProvider["name"].(string)
-			if !isString {
-				continue
-			}
-			if currentExtensionName == extensionName {
-				extensionProviders = append(extensionProviders[:i], extensionProviders[i+1:]...)
-				err = unstructured.SetNestedSlice(smcp.Object, extensionProviders, "spec", "techPreview", "meshConfig", "extensionProviders")
-				if err != nil {
-					return err
-				}
-				removed = true
-				break
-			}
-		}
-
-		if removed {
-			return cli.Update(ctx, smcp)
+			return fmt.Errorf("failed resolving name of the authorization extension provider: %w", err)
 		}
 
-		return nil
+		return RemoveExtensionProvider(source.ServiceMesh.ControlPlane, extensionName)(ctx, cli)
 	}
 }
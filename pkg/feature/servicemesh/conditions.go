@@ -3,34 +3,41 @@ package servicemesh
 import (
 	"context"
 	"fmt"
-	"time"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
-)
-
-const (
-	interval = 2 * time.Second
-	duration = 5 * time.Minute
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/naming"
 )
 
 // EnsureAuthNamespaceExists creates a namespace for the Authorization provider and set ownership so it will be garbage collected when the operator is uninstalled.
+// Namespace derived from the applications namespace is annotated with the full name it stands for, see naming.Namespace.
 func EnsureAuthNamespaceExists(ctx context.Context, f *feature.Feature) error {
 	authNs, err := FeatureData.Authorization.Namespace.Extract(f)
 	if err != nil {
 		return fmt.Errorf("could not get auth from feature: %w", err)
 	}
 
-	_, err = cluster.CreateNamespace(ctx, f.Client, authNs, feature.OwnedBy(f), cluster.WithLabels(labels.ODH.OwnedNamespace, "true"))
-	return err
+	authSpec, err := FeatureData.Authorization.Spec.Extract(f)
+	if err != nil {
+		return fmt.Errorf("could not get auth from feature: %w", err)
+	}
+
+	metaOptions := []cluster.MetaOptions{feature.OwnedBy(f), cluster.WithLabels(labels.ODH.OwnedNamespace, "true"), feature.WithFeatureLabels(f), feature.WithPolicyExemptions(f)}
+	if strings.TrimSpace(authSpec.Namespace) == "" {
+		metaOptions = append(metaOptions, cluster.WithAnnotations(annotations.GeneratedFrom, naming.Join(f.TargetNamespace, AuthNamespaceSuffix)))
+	}
+
+	return feature.CreateNamespace(ctx, f, authNs, metaOptions...)
 }
 
 func EnsureServiceMeshOperatorInstalled(ctx context.Context, f *feature.Feature) error {
@@ -41,18 +48,21 @@ func EnsureServiceMeshOperatorInstalled(ctx context.Context, f *feature.Feature)
 	return nil
 }
 
+// EnsureServiceMeshInstalled checks the control plane is ready without waiting for it. Features failing the check are retried
+// by the reconciliation applying them, DSCInitialization additionally watches the control plane status to retry as soon as it becomes ready.
 func EnsureServiceMeshInstalled(ctx context.Context, f *feature.Feature) error {
 	if err := EnsureServiceMeshOperatorInstalled(ctx, f); err != nil {
 		return err
 	}
 
-	if err := WaitForControlPlaneToBeReady(ctx, f); err != nil {
-		controlPlane, errGet := FeatureData.ControlPlane.Extract(f)
-		if errGet != nil {
-			return fmt.Errorf("failed to get control plane struct: %w", err)
-		}
+	controlPlane, err := FeatureData.ControlPlane.Extract(f)
+	if err != nil {
+		return fmt.Errorf("failed to get control plane struct: %w", err)
+	}
 
-		f.Log.Error(err, "failed waiting for control plane being ready", "control-plane", controlPlane.Name, "namespace", controlPlane.Namespace)
+	ready, err := CheckControlPlaneComponentReadiness(ctx, f.Client, controlPlane.Name, controlPlane.Namespace)
+	if err != nil || !ready {
+		f.Log.Info("control plane is not ready yet", "control-plane", controlPlane.Name, "namespace", controlPlane.Namespace)
 
 		return multierror.Append(err, errors.New("service mesh control plane is not ready")).ErrorOrNil()
 	}
@@ -69,9 +79,9 @@ func WaitForControlPlaneToBeReady(ctx context.Context, f *feature.Feature) error
 	smcp := controlPlane.Name
 	smcpNs := controlPlane.Namespace
 
-	f.Log.Info("waiting for control plane components to be ready", "control-plane", smcp, "namespace", smcpNs, "duration (s)", duration.Seconds())
+	f.Log.Info("waiting for control plane components to be ready", "control-plane", smcp, "namespace", smcpNs, "duration (s)", f.Poller().Timeout().Seconds())
 
-	return wait.PollUntilContextTimeout(ctx, interval, duration, false, func(ctx context.Context) (bool, error) {
+	return f.Poller().Poll(ctx, false, func(ctx context.Context) (bool, error) {
 		ready, err := CheckControlPlaneComponentReadiness(ctx, f.Client, smcp, smcpNs)
 
 		if ready {
@@ -105,3 +115,58 @@ func CheckControlPlaneComponentReadiness(ctx context.Context, c client.Client, s
 
 	return pendingComponents == 0 && unreadyComponents == 0 && readyComponents > 0, nil
 }
+
+// EnsureServiceMeshMemberReady checks the namespace of the authorization provider has been enrolled in the mesh, i.e. its
+// ServiceMeshMember is ready, without waiting for it.
+func EnsureServiceMeshMemberReady(ctx context.Context, f *feature.Feature) error {
+	authNs, err := FeatureData.Authorization.Namespace.Extract(f)
+	if err != nil {
+		return fmt.Errorf("could not get auth from feature: %w", err)
+	}
+
+	return ensureReady(ctx, f.Client, gvk.ServiceMeshMember, authNs, "default")
+}
+
+// EnsureAuthorizationProviderReady checks the Authorino instance used as the authorization provider is ready, without waiting for it.
+func EnsureAuthorizationProviderReady(ctx context.Context, f *feature.Feature) error {
+	authNs, err := FeatureData.Authorization.Namespace.Extract(f)
+	if err != nil {
+		return fmt.Errorf("could not get auth from feature: %w", err)
+	}
+
+	authProviderName, err := FeatureData.Authorization.Provider.Extract(f)
+	if err != nil {
+		return fmt.Errorf("could not get auth provider name from feature: %w", err)
+	}
+
+	return ensureReady(ctx, f.Client, gvk.Authorino, authNs, authProviderName)
+}
+
+// ensureReady checks the Ready condition of the resource is true, which both ServiceMeshMember and Authorino report.
+func ensureReady(ctx context.Context, c client.Client, objectGVK schema.GroupVersionKind, namespace, name string) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(objectGVK)
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		return fmt.Errorf("failed to find %s %s/%s: %w", objectGVK.Kind, namespace, name, err)
+	}
+
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return fmt.Errorf("status conditions of %s %s/%s could not be parsed: %w", objectGVK.Kind, namespace, name, err)
+	}
+
+	for _, item := range conditions {
+		condition, isMap := item.(map[string]interface{})
+		if !isMap || condition["type"] != "Ready" {
+			continue
+		}
+
+		if condition["status"] == "True" {
+			return nil
+		}
+
+		return fmt.Errorf("%s %s/%s is not ready: %v", objectGVK.Kind, namespace, name, condition["message"])
+	}
+
+	return fmt.Errorf("%s %s/%s is not ready yet", objectGVK.Kind, namespace, name)
+}
@@ -0,0 +1,185 @@
+package servicemesh_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/featuretesting"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Waiting for control plane", func() {
+
+	controlPlane := infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"}
+
+	controlPlaneWithPending := func(pending ...interface{}) *unstructured.Unstructured {
+		smcp := &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"readiness": map[string]interface{}{
+					"components": map[string]interface{}{
+						"ready":   []interface{}{"istiod"},
+						"pending": pending,
+						"unready": []interface{}{},
+					},
+				},
+			},
+		}}
+		smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		smcp.SetName(controlPlane.Name)
+		smcp.SetNamespace(controlPlane.Namespace)
+
+		return smcp
+	}
+
+	createFeature := func(cli client.Client, poller *feature.Poller) *feature.Feature {
+		f, err := feature.Define("control-plane-readiness").
+			TargetNamespace("opendatahub").
+			UsingClient(cli).
+			UsingPoller(poller).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Set("ControlPlane", controlPlane)).To(Succeed())
+
+		return f
+	}
+
+	It("should time out instantly using the instant clock when components stay pending", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(controlPlaneWithPending("ingress-gateway")).Build()
+		poller, clock := featuretesting.NewInstantPoller(feature.DefaultPoller())
+		start := clock.Now()
+
+		// when
+		err := servicemesh.WaitForControlPlaneToBeReady(ctx, createFeature(cli, poller))
+
+		// then
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(clock.Since(start)).To(Equal(poller.Timeout()))
+	})
+
+	It("should succeed once pending components become ready", func(ctx context.Context) {
+		// given
+		smcp := controlPlaneWithPending("ingress-gateway")
+		checks := 0
+		cli := fake.NewClientBuilder().
+			WithObjects(smcp).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if checks++; checks == 3 {
+						Expect(unstructured.SetNestedSlice(smcp.Object, []interface{}{}, "status", "readiness", "components", "pending")).To(Succeed())
+						Expect(c.Update(ctx, smcp)).To(Succeed())
+					}
+
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).
+			Build()
+		poller, clock := featuretesting.NewInstantPoller(feature.DefaultPoller())
+
+		// when
+		err := servicemesh.WaitForControlPlaneToBeReady(ctx, createFeature(cli, poller))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clock.Waits()).To(HaveLen(3))
+	})
+
+	It("should fail right away when the control plane does not exist", func(ctx context.Context) {
+		// given
+		poller, clock := featuretesting.NewInstantPoller(feature.DefaultPoller())
+
+		// when
+		err := servicemesh.WaitForControlPlaneToBeReady(ctx, createFeature(fake.NewClientBuilder().Build(), poller))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("failed to find Service Mesh Control Plane")))
+		Expect(clock.Waits()).To(HaveLen(1))
+	})
+})
+
+var _ = Describe("Checking readiness of authorization provider", func() {
+
+	withReadyCondition := func(objectGVK schema.GroupVersionKind, name, status, message string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": status, "message": message},
+				},
+			},
+		}}
+		obj.SetGroupVersionKind(objectGVK)
+		obj.SetName(name)
+		obj.SetNamespace("opendatahub-auth-provider")
+
+		return obj
+	}
+
+	createFeature := func(objects ...client.Object) *feature.Feature {
+		f, err := feature.Define("mesh-authorization-observation").
+			TargetNamespace("opendatahub").
+			UsingClient(fake.NewClientBuilder().WithObjects(objects...).Build()).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Set("AuthNamespace", "opendatahub-auth-provider")).To(Succeed())
+		Expect(f.Set("AuthProviderName", "authorino")).To(Succeed())
+
+		return f
+	}
+
+	It("should succeed when namespace is a ready member of the mesh", func(ctx context.Context) {
+		// given
+		f := createFeature(withReadyCondition(gvk.ServiceMeshMember, "default", "True", ""))
+
+		// when
+		err := servicemesh.EnsureServiceMeshMemberReady(ctx, f)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report why Authorino is not ready", func(ctx context.Context) {
+		// given
+		f := createFeature(withReadyCondition(gvk.Authorino, "authorino", "False", "Authorino deployment is not available"))
+
+		// when
+		err := servicemesh.EnsureAuthorizationProviderReady(ctx, f)
+
+		// then
+		Expect(err).To(MatchError("Authorino opendatahub-auth-provider/authorino is not ready: Authorino deployment is not available"))
+	})
+
+	It("should fail when Authorino has not reported its readiness yet", func(ctx context.Context) {
+		// given
+		authorino := withReadyCondition(gvk.Authorino, "authorino", "True", "")
+		unstructured.RemoveNestedField(authorino.Object, "status")
+		f := createFeature(authorino)
+
+		// when
+		err := servicemesh.EnsureAuthorizationProviderReady(ctx, f)
+
+		// then
+		Expect(err).To(MatchError("Authorino opendatahub-auth-provider/authorino is not ready yet"))
+	})
+
+	It("should fail when namespace is not a member of the mesh", func(ctx context.Context) {
+		// given
+		f := createFeature()
+
+		// when
+		err := servicemesh.EnsureServiceMeshMemberReady(ctx, f)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("failed to find ServiceMeshMember opendatahub-auth-provider/default")))
+	})
+})
@@ -1,6 +1,14 @@
 package servicemesh
 
+import "github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/refs"
+
 const (
-	ConfigMapAuthRef = "auth-refs"
-	ConfigMapMeshRef = "service-mesh-refs"
+	ConfigMapAuthRef = refs.AuthRefsName
+	ConfigMapMeshRef = refs.MeshRefsName
 )
+
+// AuthNamespaceSuffix is appended to the applications namespace to derive the namespace of the authorization provider.
+const AuthNamespaceSuffix = "auth-provider"
+
+// SupportedArchitectures lists CPU architectures for which Service Mesh and Authorino images are published.
+var SupportedArchitectures = []string{"amd64", "arm64", "ppc64le", "s390x"}
@@ -9,46 +9,62 @@ import (
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/naming"
 )
 
-// These keys are used in FeatureData struct, as fields of a struct are not accessible in closures which we define for
-// creating and fetching the data.
-const (
-	controlPlaneKey      string = "ControlPlane"
-	authKey              string = "Auth"
-	authProviderNsKey    string = "AuthNamespace"
-	authProviderNameKey  string = "AuthProviderName"
-	authExtensionNameKey string = "AuthExtensionName"
+// Keys under which the data of the Service Mesh features is stored, as referred to in templates. They are used in FeatureData
+// struct, as fields of a struct are not accessible in closures which we define for creating and fetching the data.
+var (
+	controlPlaneData      = feature.DefineData[infrav1.ControlPlaneSpec]("ControlPlane")
+	authData              = feature.DefineData[infrav1.AuthSpec]("Auth")
+	authProviderNsData    = feature.DefineData[string]("AuthNamespace")
+	authProviderNameData  = feature.DefineData[string]("AuthProviderName")
+	authExtensionNameData = feature.DefineData[string]("AuthExtensionName")
+	authorinoData         = feature.DefineData[infrav1.AuthorinoSpec]("Authorino")
+	lightweightAuthData   = feature.DefineData[infrav1.LightweightAuthSpec]("LightweightAuth")
+	authAudiencesData     = feature.DefineData[map[string][]string]("AuthAudiences")
+	injectionData         = feature.DefineData[infrav1.InjectionSpec]("Injection")
+	authPoliciesData      = feature.DefineData[AuthorizationPolicies]("AuthorizationPolicies")
+	adoptedAuthorinoData  = feature.DefineData[AdoptedAuthorino]("AdoptedAuthorino")
+	egressTLSData         = feature.DefineData[EgressTLS]("EgressTLS")
 )
 
 // FeatureData is a convention to simplify how the data for the Service Mesh features is Defined and accessed.
 // Being a "singleton" it is based on anonymous struct concept.
 var FeatureData = struct {
 	ControlPlane  feature.DataDefinition[dsciv1.DSCInitializationSpec, infrav1.ControlPlaneSpec]
+	Injection     feature.DataDefinition[dsciv1.DSCInitializationSpec, infrav1.InjectionSpec]
+	EgressTLS     feature.DataDefinition[dsciv1.DSCInitializationSpec, EgressTLS]
 	Authorization AuthorizationData
 }{
-	ControlPlane: feature.DataDefinition[dsciv1.DSCInitializationSpec, infrav1.ControlPlaneSpec]{
-		Define: func(source *dsciv1.DSCInitializationSpec) feature.DataEntry[infrav1.ControlPlaneSpec] {
-			return feature.DataEntry[infrav1.ControlPlaneSpec]{
-				Key: controlPlaneKey,
-				Value: func(_ context.Context, _ client.Client) (infrav1.ControlPlaneSpec, error) {
-					return source.ServiceMesh.ControlPlane, nil
-				},
-			}
-		},
-		Extract: feature.ExtractEntry[infrav1.ControlPlaneSpec](controlPlaneKey),
-	},
+	ControlPlane: feature.DataDefinitionOf(controlPlaneData,
+		func(_ context.Context, _ client.Client, source *dsciv1.DSCInitializationSpec) (infrav1.ControlPlaneSpec, error) {
+			return source.ServiceMesh.ControlPlane, nil
+		}),
+	Injection: feature.DataDefinitionOf(injectionData,
+		func(_ context.Context, _ client.Client, source *dsciv1.DSCInitializationSpec) (infrav1.InjectionSpec, error) {
+			return ResolveInjection(source.ServiceMesh), nil
+		}),
+	EgressTLS: egressTLS,
 	Authorization: AuthorizationData{
 		Spec:                  authSpec,
 		Namespace:             authNs,
 		Provider:              authProvider,
 		ExtensionProviderName: authExtensionName,
+		Authorino:             authorino,
+		Lightweight:           lightweightAuth,
+		Audiences:             authAudiences,
+		Policies:              authPolicies,
+		Adopted:               adoptedAuthorino,
 		All: func(source *dsciv1.DSCInitializationSpec) []feature.Action {
 			return []feature.Action{
 				authSpec.Define(source).AsAction(),
 				authNs.Define(source).AsAction(),
 				authProvider.Define(source).AsAction(),
 				authExtensionName.Define(source).AsAction(),
+				authorino.Define(source).AsAction(),
+				lightweightAuth.Define(source).AsAction(),
+				authAudiences.Define(source).AsAction(),
 			}
 		},
 	},
@@ -59,58 +75,156 @@ type AuthorizationData struct {
 	Namespace             feature.DataDefinition[dsciv1.DSCInitializationSpec, string]
 	Provider              feature.DataDefinition[dsciv1.DSCInitializationSpec, string]
 	ExtensionProviderName feature.DataDefinition[dsciv1.DSCInitializationSpec, string]
-	All                   func(source *dsciv1.DSCInitializationSpec) []feature.Action
+	Authorino             feature.DataDefinition[dsciv1.DSCInitializationSpec, infrav1.AuthorinoSpec]
+	Lightweight           feature.DataDefinition[dsciv1.DSCInitializationSpec, infrav1.LightweightAuthSpec]
+	Audiences             feature.DataDefinition[dsciv1.DSCInitializationSpec, map[string][]string]
+	Policies              feature.DataDefinition[dsciv1.DSCInitializationSpec, AuthorizationPolicies]
+	// Adopted is the existing Authorino instance used instead of the operator-managed one, see AuthorinoLookup.
+	// It is not part of All, as it lists Authorino instances on the cluster.
+	Adopted feature.DataDefinition[AuthorinoLookup, AdoptedAuthorino]
+	All     func(source *dsciv1.DSCInitializationSpec) []feature.Action
 }
 
-var authSpec = feature.DataDefinition[dsciv1.DSCInitializationSpec, infrav1.AuthSpec]{
-	Define: func(source *dsciv1.DSCInitializationSpec) feature.DataEntry[infrav1.AuthSpec] {
-		return feature.DataEntry[infrav1.AuthSpec]{
-			Key: authKey,
-			Value: func(_ context.Context, _ client.Client) (infrav1.AuthSpec, error) {
-				return source.ServiceMesh.Auth, nil
-			},
-		}
-	},
-	Extract: feature.ExtractEntry[infrav1.AuthSpec](authKey),
+var authSpec = feature.DataDefinitionOf(authData,
+	func(_ context.Context, _ client.Client, source *dsciv1.DSCInitializationSpec) (infrav1.AuthSpec, error) {
+		return source.ServiceMesh.Auth, nil
+	})
+
+var authNs = feature.DataDefinitionOf(authProviderNsData,
+	func(_ context.Context, _ client.Client, source *dsciv1.DSCInitializationSpec) (string, error) {
+		return AuthNamespace(source), nil
+	})
+
+var authProvider = feature.DataDefinitionOf(authProviderNameData,
+	func(_ context.Context, _ client.Client, _ *dsciv1.DSCInitializationSpec) (string, error) {
+		return "authorino", nil
+	})
+
+var authExtensionName = feature.DataDefinitionOf(authExtensionNameData,
+	func(_ context.Context, _ client.Client, source *dsciv1.DSCInitializationSpec) (string, error) {
+		return AuthExtensionProviderName(source.ApplicationsNamespace), nil
+	})
+
+var authorino = feature.DataDefinitionOf(authorinoData,
+	func(_ context.Context, _ client.Client, source *dsciv1.DSCInitializationSpec) (infrav1.AuthorinoSpec, error) {
+		return ResolveAuthorino(source.ServiceMesh.Auth.Authorino), nil
+	})
+
+var lightweightAuth = feature.DataDefinitionOf(lightweightAuthData,
+	func(_ context.Context, _ client.Client, source *dsciv1.DSCInitializationSpec) (infrav1.LightweightAuthSpec, error) {
+		return ResolveLightweightAuth(source.ServiceMesh.Auth.Lightweight), nil
+	})
+
+var authAudiences = feature.DataDefinitionOf(authAudiencesData,
+	func(_ context.Context, _ client.Client, source *dsciv1.DSCInitializationSpec) (map[string][]string, error) {
+		return ResolveAudiences(source.ServiceMesh.Auth), nil
+	})
+
+// authPolicies are resolved from annotated Services. They are not part of All, as only the feature generating
+// the policies needs them and listing Services is not for free.
+var authPolicies = feature.DataDefinitionOf(authPoliciesData,
+	func(ctx context.Context, cli client.Client, source *dsciv1.DSCInitializationSpec) (AuthorizationPolicies, error) {
+		return ResolveAuthorizationPolicies(ctx, cli, source.ServiceMesh.Auth)
+	})
+
+// AuthNamespace resolves the namespace in which authorization provider is deployed. Unless it is configured, it is derived
+// from the applications namespace, shortened if needed (see naming.Namespace).
+func AuthNamespace(source *dsciv1.DSCInitializationSpec) string {
+	ns := strings.TrimSpace(source.ServiceMesh.Auth.Namespace)
+	if len(ns) == 0 {
+		ns = naming.Namespace(source.ApplicationsNamespace, AuthNamespaceSuffix)
+	}
+
+	return ns
 }
 
-var authNs = feature.DataDefinition[dsciv1.DSCInitializationSpec, string]{
-	Define: func(source *dsciv1.DSCInitializationSpec) feature.DataEntry[string] {
-		return feature.DataEntry[string]{
-			Key: authProviderNsKey,
-			Value: func(_ context.Context, _ client.Client) (string, error) {
-				ns := strings.TrimSpace(source.ServiceMesh.Auth.Namespace)
-				if len(ns) == 0 {
-					ns = source.ApplicationsNamespace + "-auth-provider"
-				}
-
-				return ns, nil
-			},
-		}
-	},
-	Extract: feature.ExtractEntry[string](authProviderNsKey),
+// ResolveInjection returns injection configuration with defaults applied, so that templates
+// can rely on both strategy and revision being set.
+func ResolveInjection(serviceMesh *infrav1.ServiceMeshSpec) infrav1.InjectionSpec {
+	injection := serviceMesh.Injection
+	if injection.Strategy == "" {
+		injection.Strategy = infrav1.AnnotationInjectionStrategy
+	}
+
+	if strings.TrimSpace(injection.Revision) == "" {
+		injection.Revision = serviceMesh.ControlPlane.Name
+	}
+
+	return injection
 }
 
-var authProvider = feature.DataDefinition[dsciv1.DSCInitializationSpec, string]{
-	Define: func(source *dsciv1.DSCInitializationSpec) feature.DataEntry[string] {
-		return feature.DataEntry[string]{
-			Key: authProviderNameKey,
-			Value: func(_ context.Context, _ client.Client) (string, error) {
-				return "authorino", nil
-			},
-		}
-	},
-	Extract: feature.ExtractEntry[string](authProviderNameKey),
+// ResolveAuthorino returns Authorino configuration with defaults applied, so that templates
+// can rely on all the fields being set.
+func ResolveAuthorino(spec infrav1.AuthorinoSpec) infrav1.AuthorinoSpec {
+	resolved := *spec.DeepCopy()
+	if resolved.Replicas == nil {
+		replicas := int32(1)
+		resolved.Replicas = &replicas
+	}
+
+	if resolved.LogLevel == "" {
+		resolved.LogLevel = infrav1.AuthorinoLogLevelInfo
+	}
+
+	if strings.TrimSpace(resolved.AuthConfigSelector) == "" {
+		resolved.AuthConfigSelector = infrav1.DefaultAuthConfigSelector
+	}
+
+	return resolved
+}
+
+// DefaultTokenHeader is the request header checked by the lightweight authorization, unless configured otherwise.
+const DefaultTokenHeader = "Authorization"
+
+// DefaultTokenIssuer is the issuer of tokens validated by the lightweight authorization, unless configured otherwise.
+const DefaultTokenIssuer = "https://kubernetes.default.svc"
+
+// UsesLightweightAuth checks if requests in the mesh are authorized by the lightweight token checks instead of Authorino.
+func UsesLightweightAuth(serviceMesh *infrav1.ServiceMeshSpec) bool {
+	return serviceMesh != nil && serviceMesh.Auth.Mode == infrav1.LightweightAuthMode
 }
 
-var authExtensionName = feature.DataDefinition[dsciv1.DSCInitializationSpec, string]{
-	Define: func(source *dsciv1.DSCInitializationSpec) feature.DataEntry[string] {
-		return feature.DataEntry[string]{
-			Key: authExtensionNameKey,
-			Value: func(_ context.Context, _ client.Client) (string, error) {
-				return source.ApplicationsNamespace + "-auth-provider", nil
-			},
+// ResolveLightweightAuth returns lightweight authorization configuration with defaults applied, so that templates
+// can rely on the token header and issuer being set.
+func ResolveLightweightAuth(spec infrav1.LightweightAuthSpec) infrav1.LightweightAuthSpec {
+	resolved := spec
+	if strings.TrimSpace(resolved.TokenHeader) == "" {
+		resolved.TokenHeader = DefaultTokenHeader
+	}
+	if strings.TrimSpace(resolved.Issuer) == "" {
+		resolved.Issuer = DefaultTokenIssuer
+	}
+
+	return resolved
+}
+
+// DefaultAudienceConsumers lists components consuming tokens for which audiences are always published,
+// even if they are not configured explicitly.
+var DefaultAudienceConsumers = []string{"dashboard", "kserve", "modelmeshserving"}
+
+// DefaultAudiences are used when no audiences are configured. It is the audience of the Kubernetes apiserver.
+var DefaultAudiences = []string{"https://kubernetes.default.svc"}
+
+// ResolveAudiences returns audiences for each of the consuming components. Components without explicitly configured
+// audiences, including DefaultAudienceConsumers, fall back to the audiences defined for all of them.
+func ResolveAudiences(auth infrav1.AuthSpec) map[string][]string {
+	defaults := DefaultAudiences
+	if auth.Audiences != nil && len(*auth.Audiences) > 0 {
+		defaults = *auth.Audiences
+	}
+
+	audiences := make(map[string][]string, len(DefaultAudienceConsumers)+len(auth.ComponentAudiences))
+	for _, component := range DefaultAudienceConsumers {
+		audiences[component] = append([]string{}, defaults...)
+	}
+
+	for component, componentAudiences := range auth.ComponentAudiences {
+		if len(componentAudiences) == 0 {
+			audiences[component] = append([]string{}, defaults...)
+			continue
 		}
-	},
-	Extract: feature.ExtractEntry[string](authExtensionNameKey),
+		audiences[component] = append([]string{}, componentAudiences...)
+	}
+
+	return audiences
 }
@@ -0,0 +1,141 @@
+package servicemesh_test
+
+import (
+	"k8s.io/utils/ptr"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorino configuration", func() {
+
+	It("should apply defaults when not configured", func() {
+		// when
+		resolved := servicemesh.ResolveAuthorino(infrav1.AuthorinoSpec{})
+
+		// then
+		Expect(resolved.Replicas).To(HaveValue(Equal(int32(1))))
+		Expect(resolved.LogLevel).To(Equal(infrav1.AuthorinoLogLevelInfo))
+		Expect(resolved.AuthConfigSelector).To(Equal(infrav1.DefaultAuthConfigSelector))
+		Expect(resolved.ListenerTLS.Enabled).To(BeFalse())
+	})
+
+	It("should keep configured values", func() {
+		// given
+		spec := infrav1.AuthorinoSpec{
+			Replicas:           ptr.To(int32(3)),
+			LogLevel:           infrav1.AuthorinoLogLevelDebug,
+			AuthConfigSelector: "security.opendatahub.io/authorization-group=shard-1",
+			ListenerTLS: infrav1.AuthorinoTLSSpec{
+				Enabled:        true,
+				CertSecretName: "authorino-server-cert",
+			},
+		}
+
+		// when
+		resolved := servicemesh.ResolveAuthorino(spec)
+
+		// then
+		Expect(resolved).To(Equal(spec))
+	})
+
+	It("should not modify the source spec", func() {
+		// given
+		spec := infrav1.AuthorinoSpec{}
+
+		// when
+		servicemesh.ResolveAuthorino(spec)
+
+		// then
+		Expect(spec.Replicas).To(BeNil())
+	})
+})
+
+var _ = Describe("Authorization audiences", func() {
+
+	It("should use Kubernetes apiserver audience for all default consumers when not configured", func() {
+		// when
+		audiences := servicemesh.ResolveAudiences(infrav1.AuthSpec{})
+
+		// then
+		Expect(audiences).To(HaveLen(len(servicemesh.DefaultAudienceConsumers)))
+		for _, component := range servicemesh.DefaultAudienceConsumers {
+			Expect(audiences).To(HaveKeyWithValue(component, servicemesh.DefaultAudiences))
+		}
+	})
+
+	It("should use shared audiences for components without their own", func() {
+		// given
+		auth := infrav1.AuthSpec{
+			Audiences: &[]string{"https://shared.example.com"},
+			ComponentAudiences: map[string][]string{
+				"kserve": {"https://serving.example.com", "https://inference.example.com"},
+			},
+		}
+
+		// when
+		audiences := servicemesh.ResolveAudiences(auth)
+
+		// then
+		Expect(audiences).To(HaveKeyWithValue("dashboard", []string{"https://shared.example.com"}))
+		Expect(audiences).To(HaveKeyWithValue("kserve", []string{"https://serving.example.com", "https://inference.example.com"}))
+	})
+
+	It("should publish audiences of components which are not consumers by default", func() {
+		// given
+		auth := infrav1.AuthSpec{
+			ComponentAudiences: map[string][]string{
+				"custom-component": {"https://custom.example.com"},
+				"empty-component":  {},
+			},
+		}
+
+		// when
+		audiences := servicemesh.ResolveAudiences(auth)
+
+		// then
+		Expect(audiences).To(HaveKeyWithValue("custom-component", []string{"https://custom.example.com"}))
+		Expect(audiences).To(HaveKeyWithValue("empty-component", servicemesh.DefaultAudiences))
+	})
+})
+
+var _ = Describe("Lightweight authorization", func() {
+
+	It("should validate service account tokens from Authorization header when not configured otherwise", func() {
+		// when
+		resolved := servicemesh.ResolveLightweightAuth(infrav1.LightweightAuthSpec{})
+
+		// then
+		Expect(resolved.TokenHeader).To(Equal("Authorization"))
+		Expect(resolved.Issuer).To(Equal("https://kubernetes.default.svc"))
+		Expect(resolved.PluginImage).To(BeEmpty())
+	})
+
+	It("should keep configured values", func() {
+		// given
+		spec := infrav1.LightweightAuthSpec{
+			TokenHeader: "X-Model-Token",
+			PluginImage: "oci://quay.io/opendatahub/token-check:v1",
+			Issuer:      "https://oidc.example.com",
+		}
+
+		// when
+		resolved := servicemesh.ResolveLightweightAuth(spec)
+
+		// then
+		Expect(resolved).To(Equal(spec))
+	})
+
+	DescribeTable("should be used only when selected by the auth mode",
+		func(serviceMesh *infrav1.ServiceMeshSpec, expected bool) {
+			Expect(servicemesh.UsesLightweightAuth(serviceMesh)).To(Equal(expected))
+		},
+		Entry("not configured mesh", nil, false),
+		Entry("default mode", &infrav1.ServiceMeshSpec{}, false),
+		Entry("Authorino mode", &infrav1.ServiceMeshSpec{Auth: infrav1.AuthSpec{Mode: infrav1.AuthorinoAuthMode}}, false),
+		Entry("Lightweight mode", &infrav1.ServiceMeshSpec{Auth: infrav1.AuthSpec{Mode: infrav1.LightweightAuthMode}}, true),
+	)
+})
@@ -0,0 +1,95 @@
+package servicemesh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/naming"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/trustedcabundle"
+)
+
+// EgressTLSDestination is a host outside the mesh to which proxies originate TLS, see infrav1.EgressTLSSpec.
+type EgressTLSDestination struct {
+	// Name of the ServiceEntry and DestinationRule resources created for the host.
+	Name string
+	Host string
+}
+
+// EgressTLS holds the data of the templates originating TLS.
+type EgressTLS struct {
+	Destinations []EgressTLSDestination
+	// CASecretName is the Secret holding the trusted CA bundle in namespaces of the mesh, kept by the mesh CA bundle controller
+	// along with the DestinationRules referencing it.
+	CASecretName string
+}
+
+// ResolveEgressTLS returns destinations of TLS origination sorted by the host, without duplicates.
+func ResolveEgressTLS(serviceMesh *infrav1.ServiceMeshSpec) EgressTLS {
+	hosts := sets.New[string]()
+	for _, host := range serviceMesh.EgressTLS.Hosts {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts.Insert(strings.ToLower(host))
+		}
+	}
+
+	destinations := make([]EgressTLSDestination, 0, hosts.Len())
+	for _, host := range sets.List(hosts) {
+		destinations = append(destinations, EgressTLSDestination{Name: naming.Resource(host, "egress-tls"), Host: host})
+	}
+
+	return EgressTLS{Destinations: destinations, CASecretName: trustedcabundle.MeshCASecretName}
+}
+
+var egressTLS = feature.DataDefinitionOf(egressTLSData,
+	func(_ context.Context, _ client.Client, source *dsciv1.DSCInitializationSpec) (EgressTLS, error) {
+		return ResolveEgressTLS(source.ServiceMesh), nil
+	})
+
+// PruneEgressTLS deletes ServiceEntries created by the feature for hosts which are no longer configured, as well as DestinationRules
+// it created in the control plane namespace before they were moved to the namespaces of the mesh, see meshcabundle controller.
+func PruneEgressTLS(ctx context.Context, f *feature.Feature) error {
+	egress, err := FeatureData.EgressTLS.Extract(f)
+	if err != nil {
+		return fmt.Errorf("failed to get egress TLS configuration: %w", err)
+	}
+
+	desired := map[schema.GroupVersionKind]sets.Set[string]{
+		gvk.ServiceEntry:    sets.New[string](),
+		gvk.DestinationRule: sets.New[string](),
+	}
+	for _, destination := range egress.Destinations {
+		desired[gvk.ServiceEntry].Insert(destination.Name)
+	}
+
+	for _, kind := range []schema.GroupVersionKind{gvk.ServiceEntry, gvk.DestinationRule} {
+		existing := &unstructured.UnstructuredList{}
+		existing.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+		if errList := f.Client.List(ctx, existing, client.MatchingLabels{labels.ODH.Feature: f.Name}); errList != nil {
+			return fmt.Errorf("failed listing %s resources: %w", kind.Kind, errList)
+		}
+
+		for i := range existing.Items {
+			obj := &existing.Items[i]
+			if desired[kind].Has(obj.GetName()) {
+				continue
+			}
+
+			if errDel := f.Client.Delete(ctx, obj); client.IgnoreNotFound(errDel) != nil {
+				return fmt.Errorf("failed deleting stale %s %s/%s: %w", kind.Kind, obj.GetNamespace(), obj.GetName(), errDel)
+			}
+		}
+	}
+
+	return nil
+}
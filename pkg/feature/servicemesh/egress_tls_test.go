@@ -0,0 +1,30 @@
+package servicemesh_test
+
+import (
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/trustedcabundle"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Egress TLS", func() {
+
+	It("should resolve destinations sorted by host without duplicates", func() {
+		// given
+		serviceMesh := &infrav1.ServiceMeshSpec{
+			EgressTLS: infrav1.EgressTLSSpec{Hosts: []string{"S3.corp.example.com", "minio.corp.example.com", " s3.corp.example.com "}},
+		}
+
+		// when
+		egress := servicemesh.ResolveEgressTLS(serviceMesh)
+
+		// then
+		Expect(egress.CASecretName).To(Equal(trustedcabundle.MeshCASecretName))
+		Expect(egress.Destinations).To(Equal([]servicemesh.EgressTLSDestination{
+			{Name: "minio.corp.example.com-egress-tls", Host: "minio.corp.example.com"},
+			{Name: "s3.corp.example.com-egress-tls", Host: "s3.corp.example.com"},
+		}))
+	})
+})
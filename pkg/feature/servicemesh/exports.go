@@ -0,0 +1,85 @@
+package servicemesh
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// Names of the values exported by Service Mesh features, reported in the status of DSCInitialization.
+const (
+	GatewayHostnameExport     = "gatewayHostname"
+	ControlPlaneVersionExport = "controlPlaneVersion"
+	AuthProviderURLExport     = "authProviderURL"
+)
+
+// gatewayRouteName is the name of the Route exposing the ingress gateway of the control plane outside the cluster.
+const gatewayRouteName = "istio-ingressgateway"
+
+// GatewayHostname resolves the hostname the ingress gateway of the control plane is reachable at from outside the cluster,
+// i.e. the host of its Route. Until the Route is created, the host OpenShift assigns to it by default in the cluster domain is used.
+func GatewayHostname(ctx context.Context, f *feature.Feature) (string, error) {
+	controlPlane, err := FeatureData.ControlPlane.Extract(f)
+	if err != nil {
+		return "", fmt.Errorf("failed to get control plane struct: %w", err)
+	}
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(gvk.Route)
+	errGet := f.Client.Get(ctx, client.ObjectKey{Namespace: controlPlane.Namespace, Name: gatewayRouteName}, route)
+	if client.IgnoreNotFound(errGet) != nil {
+		return "", fmt.Errorf("failed to find Route of the ingress gateway: %w", errGet)
+	}
+
+	if host, _, _ := unstructured.NestedString(route.Object, "spec", "host"); errGet == nil && host != "" {
+		return host, nil
+	}
+
+	domain, errDomain := cluster.GetDomain(ctx, f.Client)
+	if errDomain != nil {
+		return "", errDomain
+	}
+
+	return gatewayRouteName + "-" + controlPlane.Namespace + "." + domain, nil
+}
+
+// AuthProviderURL resolves the address of the Authorino authorization service registered as the extension provider of the mesh.
+func AuthProviderURL(ctx context.Context, f *feature.Feature) (string, error) {
+	return feature.ExportTemplate("grpc://{{ .AuthProviderName }}-authorino-authorization.{{ .AuthNamespace }}.svc.cluster.local:50051")(ctx, f)
+}
+
+// AdoptedAuthProviderURL resolves the address of the authorization service of the adopted Authorino instance, see FindAdoptableAuthorino.
+func AdoptedAuthProviderURL(ctx context.Context, f *feature.Feature) (string, error) {
+	return feature.ExportTemplate(
+		"grpc://{{ .AdoptedAuthorino.Name }}-authorino-authorization.{{ .AdoptedAuthorino.Namespace }}.svc.cluster.local:{{ .AdoptedAuthorino.Port }}",
+	)(ctx, f)
+}
+
+// ControlPlaneVersion resolves the version of the control plane reported in its status, falling back to the requested one
+// when the status does not report it yet.
+func ControlPlaneVersion(ctx context.Context, f *feature.Feature) (string, error) {
+	controlPlane, err := FeatureData.ControlPlane.Extract(f)
+	if err != nil {
+		return "", fmt.Errorf("failed to get control plane struct: %w", err)
+	}
+
+	smcp := &unstructured.Unstructured{}
+	smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+	if errGet := f.Client.Get(ctx, client.ObjectKey{Namespace: controlPlane.Namespace, Name: controlPlane.Name}, smcp); errGet != nil {
+		return "", fmt.Errorf("failed to find Service Mesh Control Plane: %w", errGet)
+	}
+
+	if version, _, _ := unstructured.NestedString(smcp.Object, "status", "chartVersion"); version != "" {
+		return version, nil
+	}
+
+	version, _, err := unstructured.NestedString(smcp.Object, "spec", "version")
+
+	return version, err
+}
@@ -0,0 +1,123 @@
+package servicemesh_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Control plane version export", func() {
+
+	controlPlane := infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"}
+
+	smcpWith := func(fields map[string]interface{}) *unstructured.Unstructured {
+		smcp := &unstructured.Unstructured{Object: fields}
+		smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		smcp.SetName(controlPlane.Name)
+		smcp.SetNamespace(controlPlane.Namespace)
+
+		return smcp
+	}
+
+	exportedVersion := func(ctx context.Context, smcp *unstructured.Unstructured) (string, error) {
+		f, err := feature.Define("mesh-control-plane-creation").
+			TargetNamespace("opendatahub").
+			UsingClient(fake.NewClientBuilder().WithObjects(smcp).Build()).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Set("ControlPlane", controlPlane)).To(Succeed())
+
+		return servicemesh.ControlPlaneVersion(ctx, f)
+	}
+
+	It("should export the version reported in the status of the control plane", func(ctx context.Context) {
+		// given
+		smcp := smcpWith(map[string]interface{}{
+			"spec":   map[string]interface{}{"version": "v2.6"},
+			"status": map[string]interface{}{"chartVersion": "2.6.1"},
+		})
+
+		// when
+		version, err := exportedVersion(ctx, smcp)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal("2.6.1"))
+	})
+
+	It("should export the requested version until the status reports it", func(ctx context.Context) {
+		// given
+		smcp := smcpWith(map[string]interface{}{
+			"spec": map[string]interface{}{"version": "v2.6"},
+		})
+
+		// when
+		version, err := exportedVersion(ctx, smcp)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal("v2.6"))
+	})
+})
+
+var _ = Describe("Gateway hostname export", func() {
+
+	controlPlane := infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"}
+
+	clusterIngress := func() *unstructured.Unstructured {
+		ingress := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{"domain": "apps.example.com"},
+		}}
+		ingress.SetGroupVersionKind(gvk.OpenshiftIngress)
+		ingress.SetName("cluster")
+
+		return ingress
+	}
+
+	exportedHostname := func(ctx context.Context, objects ...client.Object) (string, error) {
+		f, err := feature.Define("mesh-control-plane-creation").
+			TargetNamespace("opendatahub").
+			UsingClient(fake.NewClientBuilder().WithObjects(objects...).Build()).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Set("ControlPlane", controlPlane)).To(Succeed())
+
+		return servicemesh.GatewayHostname(ctx, f)
+	}
+
+	It("should export the host of the Route of the ingress gateway", func(ctx context.Context) {
+		// given
+		route := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{"host": "gateway.data-science.example.com"},
+		}}
+		route.SetGroupVersionKind(gvk.Route)
+		route.SetName("istio-ingressgateway")
+		route.SetNamespace(controlPlane.Namespace)
+
+		// when
+		hostname, err := exportedHostname(ctx, route, clusterIngress())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hostname).To(Equal("gateway.data-science.example.com"))
+	})
+
+	It("should export the default host in the cluster domain until the Route is created", func(ctx context.Context) {
+		// when
+		hostname, err := exportedHostname(ctx, clusterIngress())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hostname).To(Equal("istio-ingressgateway-istio-system.apps.example.com"))
+	})
+})
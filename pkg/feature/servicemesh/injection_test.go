@@ -0,0 +1,97 @@
+package servicemesh_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/kserve"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/manifest"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/serverless"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar injection of component namespaces", func() {
+
+	renderServing := func(ctx context.Context, injection infrav1.InjectionSpec) map[string]*unstructured.Unstructured {
+		dsciSpec := &dsciv1.DSCInitializationSpec{
+			ServiceMesh: &infrav1.ServiceMeshSpec{
+				ControlPlane: infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"},
+				Injection:    injection,
+			},
+		}
+		serving := &infrav1.ServingSpec{Name: "knative-serving"}
+
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+
+		rendered, err := feature.ComponentFeaturesHandler("kserve", "opendatahub", func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("serverless-serving-deployment").
+					Manifests(manifest.Location(kserve.Resources.Location).Include(kserve.Resources.InstallDir)).
+					WithData(
+						serverless.FeatureData.Serving.Define(serving).AsAction(),
+						servicemesh.FeatureData.ControlPlane.Define(dsciSpec).AsAction(),
+						servicemesh.FeatureData.Injection.Define(dsciSpec).AsAction(),
+					),
+			)
+		}).UsingClient(fake.NewClientBuilder().WithScheme(scheme).Build()).Render(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rendered).To(HaveLen(1))
+
+		byKind := map[string]*unstructured.Unstructured{}
+		for _, obj := range rendered[0].Resources {
+			byKind[obj.GetKind()] = obj
+		}
+
+		return byKind
+	}
+
+	workloadsOf := func(knativeServing *unstructured.Unstructured) []any {
+		workloads, found, err := unstructured.NestedSlice(knativeServing.Object, "spec", "workloads")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		return workloads
+	}
+
+	It("should label the namespace and workloads with the revision when using Revision strategy", func(ctx context.Context) {
+		// when
+		rendered := renderServing(ctx, infrav1.InjectionSpec{Strategy: infrav1.RevisionInjectionStrategy, Revision: "canary"})
+
+		// then
+		Expect(rendered).To(HaveKey("Namespace"))
+		Expect(rendered["Namespace"].GetName()).To(Equal(serverless.KnativeServingNamespace))
+		Expect(rendered["Namespace"].GetLabels()).To(HaveKeyWithValue("istio.io/rev", "canary"))
+
+		for _, workload := range workloadsOf(rendered["KnativeServing"]) {
+			Expect(workload).To(HaveKeyWithValue("labels", HaveKeyWithValue("istio.io/rev", "canary")))
+			Expect(workload).To(HaveKeyWithValue("annotations", Not(HaveKey("sidecar.istio.io/inject"))))
+		}
+	})
+
+	It("should request injection using annotation and clear the revision label by default", func(ctx context.Context) {
+		// when
+		rendered := renderServing(ctx, infrav1.InjectionSpec{})
+
+		// then
+		Expect(rendered["Namespace"].Object).To(HaveKeyWithValue("metadata", HaveKeyWithValue("labels", HaveKeyWithValue("istio.io/rev", BeNil()))))
+
+		for _, workload := range workloadsOf(rendered["KnativeServing"]) {
+			Expect(workload).ToNot(HaveKey("labels"))
+			Expect(workload).To(HaveKeyWithValue("annotations", HaveKeyWithValue("sidecar.istio.io/inject", "true")))
+		}
+	})
+})
@@ -0,0 +1,289 @@
+package servicemesh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// Patterns of authorization policies which can be requested for a Service through annotations.AuthorizationPolicy.
+const (
+	// AllowAuthenticated lets through requests with a token of any authenticated user, verified by Authorino.
+	AllowAuthenticated = "allow-authenticated"
+	// AllowServiceAccount lets through mTLS requests of workloads running as service accounts listed in annotations.AllowedServiceAccounts.
+	AllowServiceAccount = "allow-serviceaccount"
+	// AllowGroup lets through requests with a token of users belonging to groups listed in annotations.AllowedGroups.
+	AllowGroup = "allow-group"
+	// AnonymousMetrics exempts the /metrics endpoint from the other patterns, so it can be scraped without credentials.
+	AnonymousMetrics = "anonymous-metrics"
+)
+
+// ProtectedService is a Service which requested authorization policies through annotations.AuthorizationPolicy.
+type ProtectedService struct {
+	Name      string
+	Namespace string
+	// Selector of the workloads backing the Service, which the policies are enforced for.
+	Selector map[string]string
+	// Hosts under which the Service is reachable in the cluster, matched by AuthConfigs.
+	Hosts []string
+	// Principals of the service accounts allowed by AllowServiceAccount, e.g. "cluster.local/ns/opendatahub/sa/dashboard".
+	Principals []string
+	// Groups allowed by AllowGroup.
+	Groups []string
+	// AnonymousMetrics is set when the Service requested AnonymousMetrics along with other patterns.
+	AnonymousMetrics bool
+}
+
+// AuthorizationPolicies holds the Services protected by each of the patterns, rendered by the templates
+// of the authorization-policies feature.
+type AuthorizationPolicies struct {
+	AllowAuthenticated  []ProtectedService
+	AllowServiceAccount []ProtectedService
+	AllowGroup          []ProtectedService
+	// Audiences accepted in tokens of AllowAuthenticated and AllowGroup requests.
+	Audiences []string
+	// AuthConfigLabels make generated AuthConfigs handled by the Authorino instance configured in DSCInitialization.
+	AuthConfigLabels map[string]string
+	// Rejected lists Services whose annotations cannot be satisfied, no policies are generated for them.
+	Rejected []RejectedService
+}
+
+// RejectedService is a Service which requested authorization policies that cannot be generated.
+type RejectedService struct {
+	Service corev1.ObjectReference
+	Reason  string
+}
+
+// PolicyName is the name of the AuthorizationPolicy, and AuthConfig if any, generated for the Service protected by the pattern.
+func PolicyName(serviceName, pattern string) string {
+	return serviceName + "-" + pattern
+}
+
+// ResolveAuthorizationPolicies builds AuthorizationPolicies from the Services annotated with annotations.AuthorizationPolicy.
+// Services with annotations which cannot be satisfied, e.g. allow-group without any group, are listed as rejected, so that
+// they do not prevent policies of other Services from being applied. Services in namespaces opted out of the Service Mesh
+// are rejected as well, and policies generated before the namespace opted out are pruned. Rejections are reported on the Services by ReportRejectedServices.
+func ResolveAuthorizationPolicies(ctx context.Context, cli client.Client, auth infrav1.AuthSpec) (AuthorizationPolicies, error) {
+	authConfigLabels, err := k8slabels.ConvertSelectorToLabelsMap(ResolveAuthorino(auth.Authorino).AuthConfigSelector)
+	if err != nil {
+		return AuthorizationPolicies{}, fmt.Errorf("AuthConfig selector has to consist of label equalities to generate AuthConfigs: %w", err)
+	}
+
+	policies := AuthorizationPolicies{
+		Audiences:        DefaultAudiences,
+		AuthConfigLabels: authConfigLabels,
+	}
+	if auth.Audiences != nil && len(*auth.Audiences) > 0 {
+		policies.Audiences = *auth.Audiences
+	}
+
+	services := &corev1.ServiceList{}
+	if err := cli.List(ctx, services); err != nil {
+		return AuthorizationPolicies{}, fmt.Errorf("failed listing services: %w", err)
+	}
+
+	optedOut := map[string]bool{}
+	for i := range services.Items {
+		svc := &services.Items[i]
+		if _, found := svc.GetAnnotations()[annotations.AuthorizationPolicy]; !found {
+			continue
+		}
+
+		if _, checked := optedOut[svc.Namespace]; !checked {
+			namespaceOptedOut, errOptOut := cluster.MeshOptedOut(ctx, cli, svc.Namespace)
+			if errOptOut != nil {
+				return AuthorizationPolicies{}, fmt.Errorf("failed checking if namespace %s opted out of Service Mesh: %w", svc.Namespace, errOptOut)
+			}
+			optedOut[svc.Namespace] = namespaceOptedOut
+		}
+
+		var errAdd error
+		if optedOut[svc.Namespace] {
+			// Policies are enforced by sidecars, which are not injected in namespaces opted out of the mesh.
+			errAdd = fmt.Errorf("namespace is labeled %s=%s, policies would not be enforced without sidecars",
+				labels.ODH.ServiceMesh, labels.ServiceMeshDisabled)
+		} else {
+			errAdd = policies.add(svc)
+		}
+		if errAdd != nil {
+			policies.Rejected = append(policies.Rejected, RejectedService{
+				Service: corev1.ObjectReference{APIVersion: "v1", Kind: "Service", Namespace: svc.Namespace, Name: svc.Name, UID: svc.UID},
+				Reason:  errAdd.Error(),
+			})
+		}
+	}
+
+	return policies, nil
+}
+
+// ReportRejectedServices emits a warning event on each Service whose authorization policies have been rejected.
+func ReportRejectedServices(recorder record.EventRecorder) feature.Action {
+	return func(_ context.Context, f *feature.Feature) error {
+		policies, err := FeatureData.Authorization.Policies.Extract(f)
+		if err != nil {
+			return fmt.Errorf("failed to get authorization policies: %w", err)
+		}
+
+		for i := range policies.Rejected {
+			rejected := &policies.Rejected[i]
+			f.Log.Info("authorization policies of service rejected", "service", rejected.Service.Namespace+"/"+rejected.Service.Name, "reason", rejected.Reason)
+			recorder.Eventf(&rejected.Service, corev1.EventTypeWarning, "AuthorizationPolicyRejected",
+				"Authorization policies requested in %s annotation cannot be generated: %s", annotations.AuthorizationPolicy, rejected.Reason)
+		}
+
+		return nil
+	}
+}
+
+func (p *AuthorizationPolicies) add(svc *corev1.Service) error {
+	patterns := sets.New(splitList(svc.GetAnnotations()[annotations.AuthorizationPolicy])...)
+	if patterns.Len() == 0 {
+		return fmt.Errorf("no pattern set in %s annotation", annotations.AuthorizationPolicy)
+	}
+
+	if unknown := patterns.Difference(sets.New(AllowAuthenticated, AllowServiceAccount, AllowGroup, AnonymousMetrics)); unknown.Len() > 0 {
+		return fmt.Errorf("unknown patterns %v", sets.List(unknown))
+	}
+
+	if patterns.HasAll(AllowAuthenticated, AllowGroup) {
+		return fmt.Errorf("%s and %s patterns are exclusive, as %s already requires authentication", AllowAuthenticated, AllowGroup, AllowGroup)
+	}
+
+	if len(svc.Spec.Selector) == 0 {
+		return fmt.Errorf("service without selector cannot be protected, as policies apply to the workloads it selects")
+	}
+
+	protected := ProtectedService{
+		Name:      svc.Name,
+		Namespace: svc.Namespace,
+		Selector:  svc.Spec.Selector,
+		Hosts: []string{
+			fmt.Sprintf("%s.%s.svc.cluster.local", svc.Name, svc.Namespace),
+			fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace),
+		},
+		AnonymousMetrics: patterns.Has(AnonymousMetrics),
+	}
+
+	if patterns.Has(AllowServiceAccount) {
+		principals, err := serviceAccountPrincipals(svc.GetAnnotations()[annotations.AllowedServiceAccounts])
+		if err != nil {
+			return err
+		}
+		protected.Principals = principals
+	}
+
+	if patterns.Has(AllowGroup) {
+		protected.Groups = splitList(svc.GetAnnotations()[annotations.AllowedGroups])
+		if len(protected.Groups) == 0 {
+			return fmt.Errorf("%s pattern requires groups listed in %s annotation", AllowGroup, annotations.AllowedGroups)
+		}
+	}
+
+	if patterns.Has(AllowAuthenticated) {
+		p.AllowAuthenticated = append(p.AllowAuthenticated, protected)
+	}
+	if patterns.Has(AllowServiceAccount) {
+		p.AllowServiceAccount = append(p.AllowServiceAccount, protected)
+	}
+	if patterns.Has(AllowGroup) {
+		p.AllowGroup = append(p.AllowGroup, protected)
+	}
+
+	return nil
+}
+
+func serviceAccountPrincipals(serviceAccounts string) ([]string, error) {
+	entries := splitList(serviceAccounts)
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%s pattern requires service accounts listed in %s annotation", AllowServiceAccount, annotations.AllowedServiceAccounts)
+	}
+
+	principals := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		namespace, name, found := strings.Cut(entry, "/")
+		if !found || namespace == "" || name == "" {
+			return nil, fmt.Errorf("service account %q has to be defined as namespace/name", entry)
+		}
+		principals = append(principals, fmt.Sprintf("cluster.local/ns/%s/sa/%s", namespace, name))
+	}
+
+	return principals, nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			items = append(items, trimmed)
+		}
+	}
+
+	return items
+}
+
+// generated lists resources rendered for the policies, keyed by their kind.
+func (p AuthorizationPolicies) generated() map[schema.GroupVersionKind]sets.Set[types.NamespacedName] {
+	resources := map[schema.GroupVersionKind]sets.Set[types.NamespacedName]{
+		gvk.AuthorizationPolicy: sets.New[types.NamespacedName](),
+		gvk.AuthConfig:          sets.New[types.NamespacedName](),
+	}
+
+	add := func(services []ProtectedService, pattern string, kinds ...schema.GroupVersionKind) {
+		for _, svc := range services {
+			for _, kind := range kinds {
+				resources[kind].Insert(types.NamespacedName{Namespace: svc.Namespace, Name: PolicyName(svc.Name, pattern)})
+			}
+		}
+	}
+	add(p.AllowAuthenticated, AllowAuthenticated, gvk.AuthorizationPolicy, gvk.AuthConfig)
+	add(p.AllowServiceAccount, AllowServiceAccount, gvk.AuthorizationPolicy)
+	add(p.AllowGroup, AllowGroup, gvk.AuthorizationPolicy, gvk.AuthConfig)
+
+	return resources
+}
+
+// PruneAuthorizationPolicies deletes AuthorizationPolicies and AuthConfigs previously generated by the feature
+// for Services which no longer request them, e.g. when the annotation has been removed or the Service deleted.
+func PruneAuthorizationPolicies(ctx context.Context, f *feature.Feature) error {
+	policies, err := FeatureData.Authorization.Policies.Extract(f)
+	if err != nil {
+		return fmt.Errorf("failed to get authorization policies: %w", err)
+	}
+
+	for kind, desired := range policies.generated() {
+		existing := &unstructured.UnstructuredList{}
+		existing.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+		if errList := f.Client.List(ctx, existing, client.MatchingLabels{labels.ODH.Feature: f.Name}); errList != nil {
+			return fmt.Errorf("failed listing generated %s resources: %w", kind.Kind, errList)
+		}
+
+		for i := range existing.Items {
+			obj := &existing.Items[i]
+			if desired.Has(client.ObjectKeyFromObject(obj)) {
+				continue
+			}
+
+			if errDel := f.Client.Delete(ctx, obj); client.IgnoreNotFound(errDel) != nil {
+				return fmt.Errorf("failed deleting stale %s %s/%s: %w", kind.Kind, obj.GetNamespace(), obj.GetName(), errDel)
+			}
+		}
+	}
+
+	return nil
+}
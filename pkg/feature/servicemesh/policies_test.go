@@ -0,0 +1,249 @@
+package servicemesh_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorization policies", func() {
+
+	newClient := func(objs ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	}
+
+	annotatedService := func(name string, serviceAnnotations map[string]string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "opendatahub", Annotations: serviceAnnotations},
+			Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": name}},
+		}
+	}
+
+	Context("resolving from annotated services", func() {
+
+		It("should only include services requesting policies", func(ctx context.Context) {
+			// given
+			cli := newClient(
+				annotatedService("dashboard", map[string]string{annotations.AuthorizationPolicy: "allow-authenticated, anonymous-metrics"}),
+				annotatedService("unprotected", nil),
+			)
+
+			// when
+			policies, err := servicemesh.ResolveAuthorizationPolicies(ctx, cli, infrav1.AuthSpec{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies.AllowAuthenticated).To(ConsistOf(servicemesh.ProtectedService{
+				Name:             "dashboard",
+				Namespace:        "opendatahub",
+				Selector:         map[string]string{"app": "dashboard"},
+				Hosts:            []string{"dashboard.opendatahub.svc.cluster.local", "dashboard.opendatahub.svc"},
+				AnonymousMetrics: true,
+			}))
+			Expect(policies.AllowServiceAccount).To(BeEmpty())
+			Expect(policies.AllowGroup).To(BeEmpty())
+			Expect(policies.Audiences).To(Equal(servicemesh.DefaultAudiences))
+			Expect(policies.AuthConfigLabels).To(Equal(map[string]string{"security.opendatahub.io/authorization-group": "default"}))
+		})
+
+		It("should resolve principals and groups of the service", func(ctx context.Context) {
+			// given
+			cli := newClient(annotatedService("model-registry", map[string]string{
+				annotations.AuthorizationPolicy:    "allow-serviceaccount,allow-group",
+				annotations.AllowedServiceAccounts: "opendatahub/dashboard, monitoring/prometheus",
+				annotations.AllowedGroups:          "odh-admins",
+			}))
+
+			// when
+			policies, err := servicemesh.ResolveAuthorizationPolicies(ctx, cli, infrav1.AuthSpec{Audiences: ptr.To([]string{"odh"})})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies.AllowServiceAccount).To(HaveLen(1))
+			Expect(policies.AllowServiceAccount[0].Principals).To(ConsistOf(
+				"cluster.local/ns/opendatahub/sa/dashboard",
+				"cluster.local/ns/monitoring/sa/prometheus",
+			))
+			Expect(policies.AllowGroup).To(HaveLen(1))
+			Expect(policies.AllowGroup[0].Groups).To(ConsistOf("odh-admins"))
+			Expect(policies.Audiences).To(ConsistOf("odh"))
+		})
+
+		DescribeTable("should reject annotations which cannot be satisfied",
+			func(ctx context.Context, serviceAnnotations map[string]string, expectedReason string) {
+				// given
+				cli := newClient(annotatedService("dashboard", serviceAnnotations))
+
+				// when
+				policies, err := servicemesh.ResolveAuthorizationPolicies(ctx, cli, infrav1.AuthSpec{})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policies.Rejected).To(ConsistOf(And(
+					HaveField("Service.Name", "dashboard"),
+					HaveField("Reason", ContainSubstring(expectedReason)),
+				)))
+			},
+			Entry("unknown pattern",
+				map[string]string{annotations.AuthorizationPolicy: "allow-everyone"},
+				"unknown patterns [allow-everyone]"),
+			Entry("no pattern",
+				map[string]string{annotations.AuthorizationPolicy: " "},
+				"no pattern set"),
+			Entry("exclusive patterns",
+				map[string]string{annotations.AuthorizationPolicy: "allow-authenticated,allow-group", annotations.AllowedGroups: "odh-admins"},
+				"patterns are exclusive"),
+			Entry("service accounts missing",
+				map[string]string{annotations.AuthorizationPolicy: "allow-serviceaccount"},
+				"requires service accounts"),
+			Entry("service account without namespace",
+				map[string]string{annotations.AuthorizationPolicy: "allow-serviceaccount", annotations.AllowedServiceAccounts: "dashboard"},
+				`service account "dashboard" has to be defined as namespace/name`),
+			Entry("groups missing",
+				map[string]string{annotations.AuthorizationPolicy: "allow-group"},
+				"requires groups"),
+		)
+
+		It("should reject service without selector", func(ctx context.Context) {
+			// given
+			svc := annotatedService("external", map[string]string{annotations.AuthorizationPolicy: "allow-authenticated"})
+			svc.Spec.Selector = nil
+			cli := newClient(svc)
+
+			// when
+			policies, err := servicemesh.ResolveAuthorizationPolicies(ctx, cli, infrav1.AuthSpec{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies.Rejected).To(ConsistOf(HaveField("Reason", ContainSubstring("service without selector cannot be protected"))))
+		})
+
+		It("should keep policies of other services when one is rejected", func(ctx context.Context) {
+			// given
+			cli := newClient(
+				annotatedService("dashboard", map[string]string{annotations.AuthorizationPolicy: "allow-authenticated"}),
+				annotatedService("model-registry", map[string]string{annotations.AuthorizationPolicy: "allow-group"}),
+			)
+
+			// when
+			policies, err := servicemesh.ResolveAuthorizationPolicies(ctx, cli, infrav1.AuthSpec{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies.AllowAuthenticated).To(ConsistOf(HaveField("Name", "dashboard")))
+			Expect(policies.AllowGroup).To(BeEmpty())
+			Expect(policies.Rejected).To(ConsistOf(HaveField("Service.Name", "model-registry")))
+		})
+
+		It("should reject services in namespaces opted out of Service Mesh", func(ctx context.Context) {
+			// given
+			optedOut := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   "ds-project",
+				Labels: map[string]string{labels.ODH.ServiceMesh: labels.ServiceMeshDisabled},
+			}}
+			notebook := annotatedService("notebook", map[string]string{annotations.AuthorizationPolicy: "allow-authenticated"})
+			notebook.Namespace = optedOut.Name
+			cli := newClient(
+				optedOut,
+				notebook,
+				annotatedService("dashboard", map[string]string{annotations.AuthorizationPolicy: "allow-authenticated"}),
+			)
+
+			// when
+			policies, err := servicemesh.ResolveAuthorizationPolicies(ctx, cli, infrav1.AuthSpec{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies.AllowAuthenticated).To(ConsistOf(HaveField("Name", "dashboard")))
+			Expect(policies.Rejected).To(ConsistOf(And(
+				HaveField("Service.Name", "notebook"),
+				HaveField("Reason", ContainSubstring("policies would not be enforced without sidecars")),
+			)))
+		})
+
+		It("should report rejected services with a warning event", func(ctx context.Context) {
+			// given
+			cli := newClient(annotatedService("model-registry", map[string]string{annotations.AuthorizationPolicy: "allow-group"}))
+			f := &feature.Feature{Name: "authorization-policies", Client: cli}
+			spec := &dsciv1.DSCInitializationSpec{ServiceMesh: &infrav1.ServiceMeshSpec{}}
+			Expect(servicemesh.FeatureData.Authorization.Policies.Define(spec).AsAction()(ctx, f)).To(Succeed())
+			recorder := record.NewFakeRecorder(10)
+
+			// when
+			err := servicemesh.ReportRejectedServices(recorder)(ctx, f)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recorder.Events).To(Receive(And(
+				ContainSubstring("AuthorizationPolicyRejected"),
+				ContainSubstring("requires groups"),
+			)))
+		})
+	})
+
+	Context("pruning generated resources", func() {
+
+		generatedResource := func(kind schema.GroupVersionKind, name, featureName string) *unstructured.Unstructured {
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(kind)
+			obj.SetName(name)
+			obj.SetNamespace("opendatahub")
+			obj.SetLabels(map[string]string{labels.ODH.Feature: featureName})
+
+			return obj
+		}
+
+		It("should delete resources of services which no longer request them", func(ctx context.Context) {
+			// given
+			desiredPolicy := generatedResource(gvk.AuthorizationPolicy, "dashboard-allow-authenticated", "authorization-policies")
+			desiredAuthConfig := generatedResource(gvk.AuthConfig, "dashboard-allow-authenticated", "authorization-policies")
+			stalePolicy := generatedResource(gvk.AuthorizationPolicy, "removed-allow-group", "authorization-policies")
+			staleAuthConfig := generatedResource(gvk.AuthConfig, "removed-allow-group", "authorization-policies")
+			otherFeaturePolicy := generatedResource(gvk.AuthorizationPolicy, "kserve-predictor", "kserve-external-authz")
+
+			cli := newClient(
+				annotatedService("dashboard", map[string]string{annotations.AuthorizationPolicy: "allow-authenticated"}),
+				desiredPolicy, desiredAuthConfig, stalePolicy, staleAuthConfig, otherFeaturePolicy,
+			)
+			f := &feature.Feature{Name: "authorization-policies", Client: cli}
+			spec := &dsciv1.DSCInitializationSpec{ServiceMesh: &infrav1.ServiceMeshSpec{}}
+			Expect(servicemesh.FeatureData.Authorization.Policies.Define(spec).AsAction()(ctx, f)).To(Succeed())
+
+			// when
+			err := servicemesh.PruneAuthorizationPolicies(ctx, f)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			for _, kept := range []*unstructured.Unstructured{desiredPolicy, desiredAuthConfig, otherFeaturePolicy} {
+				Expect(cli.Get(ctx, client.ObjectKeyFromObject(kept), kept.DeepCopy())).To(Succeed())
+			}
+			for _, pruned := range []*unstructured.Unstructured{stalePolicy, staleAuthConfig} {
+				Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKeyFromObject(pruned), pruned.DeepCopy()))).To(BeTrue())
+			}
+		})
+	})
+})
@@ -2,6 +2,7 @@ package servicemesh
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/refs"
 )
 
 // MeshRefs stores service mesh configuration in the config map, so it can
@@ -22,8 +24,8 @@ func MeshRefs(ctx context.Context, f *feature.Feature) error {
 	namespace := f.TargetNamespace
 
 	data := map[string]string{
-		"CONTROL_PLANE_NAME": meshConfig.Name,
-		"MESH_NAMESPACE":     meshConfig.Namespace,
+		refs.ControlPlaneNameKey: meshConfig.Name,
+		refs.MeshNamespaceKey:    meshConfig.Namespace,
 	}
 
 	return cluster.CreateOrUpdateConfigMap(
@@ -36,7 +38,7 @@ func MeshRefs(ctx context.Context, f *feature.Feature) error {
 			},
 			Data: data,
 		},
-		feature.OwnedBy(f),
+		feature.OwnedBy(f), feature.WithFeatureLabels(f),
 	)
 }
 
@@ -59,16 +61,36 @@ func AuthRefs(ctx context.Context, f *feature.Feature) error {
 		return fmt.Errorf("could not get auth provider name from feature: %w", err)
 	}
 
+	// Adopted Authorino instance is published instead of the operator-managed one. Features not loading
+	// FeatureData.Authorization.Adopted publish the operator-managed instance.
+	if adopted, errAdopted := FeatureData.Authorization.Adopted.Extract(f); errAdopted == nil && !adopted.IsZero() {
+		authNamespace, authProviderName = adopted.Namespace, adopted.Name
+	}
+
+	authorinoSpec := ResolveAuthorino(auth.Authorino)
+
+	componentAudiences, errAudiences := FeatureData.Authorization.Audiences.Extract(f)
+	if errAudiences != nil {
+		return fmt.Errorf("could not get auth audiences from feature: %w", errAudiences)
+	}
+
+	componentAudiencesJSON, errMarshal := json.Marshal(componentAudiences)
+	if errMarshal != nil {
+		return fmt.Errorf("failed serializing auth audiences: %w", errMarshal)
+	}
+
+	// AUTH_AUDIENCE is kept for consumers which do not read per-component AUTH_AUDIENCES yet.
 	audiences := auth.Audiences
 	audiencesList := ""
 	if audiences != nil && len(*audiences) > 0 {
 		audiencesList = strings.Join(*audiences, ",")
 	}
 	data := map[string]string{
-		"AUTH_AUDIENCE":   audiencesList,
-		"AUTH_PROVIDER":   authProviderName,
-		"AUTH_NAMESPACE":  authNamespace,
-		"AUTHORINO_LABEL": "security.opendatahub.io/authorization-group=default",
+		refs.AuthAudienceKey:   audiencesList,
+		refs.AuthAudiencesKey:  string(componentAudiencesJSON),
+		refs.AuthProviderKey:   authProviderName,
+		refs.AuthNamespaceKey:  authNamespace,
+		refs.AuthorinoLabelKey: authorinoSpec.AuthConfigSelector,
 	}
 
 	return cluster.CreateOrUpdateConfigMap(
@@ -81,6 +103,6 @@ func AuthRefs(ctx context.Context, f *feature.Feature) error {
 			},
 			Data: data,
 		},
-		feature.OwnedBy(f),
+		feature.OwnedBy(f), feature.WithFeatureLabels(f),
 	)
 }
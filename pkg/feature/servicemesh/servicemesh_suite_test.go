@@ -0,0 +1,13 @@
+package servicemesh_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestServiceMesh(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Service Mesh Features Suite")
+}
@@ -0,0 +1,308 @@
+package smcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+var gatewaysPath = []string{"spec", "gateways"}
+
+// renderedFields holds values of the fields of the SMCP managed by the operator. Extension providers are keyed by their name,
+// only those registered by the operator are recorded.
+type renderedFields struct {
+	Addons             map[string]interface{}            `json:"addons,omitempty"`
+	Gateways           map[string]interface{}            `json:"gateways,omitempty"`
+	ExtensionProviders map[string]map[string]interface{} `json:"extensionProviders,omitempty"`
+}
+
+// FieldDrift is a field of the SMCP managed by the operator, whose value has been changed outside the operator.
+type FieldDrift struct {
+	// Path of the field, e.g. "spec.addons.kiali.enabled". Fields of extension providers are prefixed with the provider name
+	// in brackets, e.g. "spec.techPreview.meshConfig.extensionProviders[opendatahub-auth-provider].envoyExtAuthzGrpc.port".
+	Path string
+	// Expected is the value recorded by the operator, nil when the field has been added.
+	Expected interface{}
+	// Actual is the current value, nil when the field has been removed.
+	Actual interface{}
+
+	fields   []string
+	provider string
+}
+
+func (d FieldDrift) String() string {
+	return fmt.Sprintf("%s: expected %s, found %s", d.Path, formatValue(d.Expected), formatValue(d.Actual))
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(encoded)
+}
+
+// RecordRenderedFields stores the current values of the fields managed by the operator, i.e. addons, gateways and the extension
+// providers of the given names, in the annotation of the SMCP. It is meant to be applied right after the operator configured
+// the control plane. Drifts which have not been corrected keep their expected values, so that they are reported until resolved.
+func RecordRenderedFields(providers []string, unresolved []FieldDrift) Mutation {
+	return func(smcp *unstructured.Unstructured) (bool, error) {
+		expected := smcp.DeepCopy()
+		for _, drift := range unresolved {
+			if _, err := drift.correct(expected); err != nil {
+				return false, err
+			}
+		}
+
+		fields, err := managedFields(expected, providers)
+		if err != nil {
+			return false, err
+		}
+
+		encoded, err := json.Marshal(fields)
+		if err != nil {
+			return false, fmt.Errorf("failed encoding rendered fields: %w", err)
+		}
+
+		smcpAnnotations := smcp.GetAnnotations()
+		if smcpAnnotations[annotations.RenderedFields] == string(encoded) {
+			return false, nil
+		}
+		if smcpAnnotations == nil {
+			smcpAnnotations = map[string]string{}
+		}
+		smcpAnnotations[annotations.RenderedFields] = string(encoded)
+		smcp.SetAnnotations(smcpAnnotations)
+
+		return true, nil
+	}
+}
+
+// DetectDrift compares the fields of the SMCP managed by the operator with the values recorded using RecordRenderedFields,
+// and returns the changed fields sorted by their path. Nothing is reported for SMCPs the values have not been recorded for yet.
+func DetectDrift(smcp *unstructured.Unstructured) ([]FieldDrift, error) {
+	recorded, found := smcp.GetAnnotations()[annotations.RenderedFields]
+	if !found {
+		return nil, nil
+	}
+
+	expected, err := decodeRenderedFields(recorded)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading %s annotation of ServiceMeshControlPlane %s/%s: %w",
+			annotations.RenderedFields, smcp.GetNamespace(), smcp.GetName(), err)
+	}
+
+	providerNames := make([]string, 0, len(expected.ExtensionProviders))
+	for name := range expected.ExtensionProviders {
+		providerNames = append(providerNames, name)
+	}
+
+	actual, err := managedFields(smcp, providerNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifts []FieldDrift
+	drifts = append(drifts, diffFields(addonsPath, expected.Addons, actual.Addons)...)
+	drifts = append(drifts, diffFields(gatewaysPath, expected.Gateways, actual.Gateways)...)
+	for _, name := range providerNames {
+		expectedProvider, actualProvider := expected.ExtensionProviders[name], actual.ExtensionProviders[name]
+		providerPath := strings.Join(extensionProvidersPath, ".") + "[" + name + "]"
+		if actualProvider == nil {
+			drifts = append(drifts, FieldDrift{Path: providerPath, Expected: expectedProvider, provider: name})
+
+			continue
+		}
+		for _, drift := range diffFields(nil, expectedProvider, actualProvider) {
+			drift.Path = providerPath + "." + drift.Path
+			drift.provider = name
+			drifts = append(drifts, drift)
+		}
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].Path < drifts[j].Path
+	})
+
+	return drifts, nil
+}
+
+// CorrectDrift restores the values of the changed fields recorded by the operator.
+func CorrectDrift(drifts []FieldDrift) Mutation {
+	return func(smcp *unstructured.Unstructured) (bool, error) {
+		changed := false
+		for _, drift := range drifts {
+			corrected, err := drift.correct(smcp)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || corrected
+		}
+
+		return changed, nil
+	}
+}
+
+func (d FieldDrift) correct(smcp *unstructured.Unstructured) (bool, error) {
+	if d.provider != "" {
+		recorded, err := recordedExtensionProvider(smcp, d)
+		if err != nil {
+			return false, err
+		}
+
+		return AddExtensionProvider(recorded)(smcp)
+	}
+
+	if d.Expected == nil {
+		if _, found, err := unstructured.NestedFieldNoCopy(smcp.Object, d.fields...); err != nil || !found {
+			return false, err
+		}
+		unstructured.RemoveNestedField(smcp.Object, d.fields...)
+
+		return true, nil
+	}
+
+	return setField(d.Expected, d.fields...)(smcp)
+}
+
+// recordedExtensionProvider resolves the recorded provider from the drift of the provider itself or of any of its fields.
+func recordedExtensionProvider(smcp *unstructured.Unstructured, d FieldDrift) (map[string]interface{}, error) {
+	if d.fields == nil {
+		provider, isMap := d.Expected.(map[string]interface{})
+		if !isMap {
+			return nil, fmt.Errorf("invalid recorded extension provider %s", d.provider)
+		}
+
+		return runtime.DeepCopyJSON(provider), nil
+	}
+
+	extensionProviders, _, err := unstructured.NestedSlice(smcp.Object, extensionProvidersPath...)
+	if err != nil {
+		return nil, err
+	}
+	index := indexOfExtensionProvider(extensionProviders, d.provider)
+	if index < 0 {
+		return nil, fmt.Errorf("extension provider %s not found", d.provider)
+	}
+
+	provider, _ := extensionProviders[index].(map[string]interface{})
+	if d.Expected == nil {
+		unstructured.RemoveNestedField(provider, d.fields...)
+	} else if err := unstructured.SetNestedField(provider, runtime.DeepCopyJSONValue(d.Expected), d.fields...); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// decodeRenderedFields reads the recorded fields the same way as the SMCP itself is decoded, so that integers are not turned into floats.
+func decodeRenderedFields(recorded string) (renderedFields, error) {
+	fields := renderedFields{}
+
+	decoded := map[string]interface{}{}
+	if err := utiljson.Unmarshal([]byte(recorded), &decoded); err != nil {
+		return fields, err
+	}
+
+	var err error
+	if fields.Addons, _, err = unstructured.NestedMap(decoded, "addons"); err != nil {
+		return fields, err
+	}
+	if fields.Gateways, _, err = unstructured.NestedMap(decoded, "gateways"); err != nil {
+		return fields, err
+	}
+	providers, _, err := unstructured.NestedMap(decoded, "extensionProviders")
+	if err != nil {
+		return fields, err
+	}
+	for name, provider := range providers {
+		providerFields, isMap := provider.(map[string]interface{})
+		if !isMap {
+			return fields, fmt.Errorf("invalid extension provider %s", name)
+		}
+		if fields.ExtensionProviders == nil {
+			fields.ExtensionProviders = map[string]map[string]interface{}{}
+		}
+		fields.ExtensionProviders[name] = providerFields
+	}
+
+	return fields, nil
+}
+
+func managedFields(smcp *unstructured.Unstructured, providers []string) (renderedFields, error) {
+	fields := renderedFields{}
+
+	addons, _, err := unstructured.NestedMap(smcp.Object, addonsPath...)
+	if err != nil {
+		return fields, err
+	}
+	gateways, _, err := unstructured.NestedMap(smcp.Object, gatewaysPath...)
+	if err != nil {
+		return fields, err
+	}
+	extensionProviders, _, err := unstructured.NestedSlice(smcp.Object, extensionProvidersPath...)
+	if err != nil {
+		return fields, err
+	}
+
+	fields.Addons, fields.Gateways = addons, gateways
+	for _, name := range providers {
+		index := indexOfExtensionProvider(extensionProviders, name)
+		if index < 0 {
+			continue
+		}
+		if fields.ExtensionProviders == nil {
+			fields.ExtensionProviders = map[string]map[string]interface{}{}
+		}
+		fields.ExtensionProviders[name], _ = extensionProviders[index].(map[string]interface{})
+	}
+
+	return fields, nil
+}
+
+// diffFields compares nested maps field by field. Values other than maps, including lists, are compared as a whole.
+func diffFields(base []string, expected, actual map[string]interface{}) []FieldDrift {
+	var drifts []FieldDrift
+
+	keys := map[string]struct{}{}
+	for key := range expected {
+		keys[key] = struct{}{}
+	}
+	for key := range actual {
+		keys[key] = struct{}{}
+	}
+
+	for key := range keys {
+		fields := fieldPath(base, key)
+		expectedValue, expectedFound := expected[key]
+		actualValue, actualFound := actual[key]
+
+		expectedMap, expectedIsMap := expectedValue.(map[string]interface{})
+		actualMap, actualIsMap := actualValue.(map[string]interface{})
+		switch {
+		case expectedIsMap && actualIsMap:
+			drifts = append(drifts, diffFields(fields, expectedMap, actualMap)...)
+		case !expectedFound:
+			drifts = append(drifts, FieldDrift{Path: strings.Join(fields, "."), Actual: actualValue, fields: fields})
+		case !actualFound:
+			drifts = append(drifts, FieldDrift{Path: strings.Join(fields, "."), Expected: expectedValue, fields: fields})
+		case !reflect.DeepEqual(expectedValue, actualValue):
+			drifts = append(drifts, FieldDrift{Path: strings.Join(fields, "."), Expected: expectedValue, Actual: actualValue, fields: fields})
+		}
+	}
+
+	return drifts
+}
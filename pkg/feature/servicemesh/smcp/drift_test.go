@@ -0,0 +1,119 @@
+package smcp_test
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh/smcp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceMeshControlPlane drift", func() {
+
+	const authProvider = "opendatahub-auth-provider"
+
+	var controlPlane *unstructured.Unstructured
+
+	configured := func() *unstructured.Unstructured {
+		controlPlaneObj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"addons": map[string]interface{}{
+					"kiali": map[string]interface{}{"name": "kiali", "enabled": false},
+				},
+				"gateways": map[string]interface{}{
+					"openshiftRoute": map[string]interface{}{"enabled": false},
+				},
+				"techPreview": map[string]interface{}{
+					"meshConfig": map[string]interface{}{
+						"extensionProviders": []interface{}{
+							map[string]interface{}{
+								"name": authProvider,
+								"envoyExtAuthzGrpc": map[string]interface{}{
+									"service": "authorino-authorino-authorization.opendatahub-auth-provider.svc.cluster.local",
+									"port":    int64(50051),
+								},
+							},
+							map[string]interface{}{"name": "admin-tracing", "zipkin": map[string]interface{}{"port": int64(9411)}},
+						},
+					},
+				},
+			},
+		}}
+		controlPlaneObj.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+
+		return controlPlaneObj
+	}
+
+	record := func(unresolved ...smcp.FieldDrift) {
+		_, err := smcp.RecordRenderedFields([]string{authProvider}, unresolved)(controlPlane)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	detect := func() []smcp.FieldDrift {
+		drifts, err := smcp.DetectDrift(controlPlane)
+		Expect(err).ToNot(HaveOccurred())
+
+		return drifts
+	}
+
+	BeforeEach(func() {
+		controlPlane = configured()
+		record()
+	})
+
+	It("should not report drift of unchanged control plane", func() {
+		Expect(detect()).To(BeEmpty())
+	})
+
+	It("should not report drift of control plane without recorded fields", func() {
+		Expect(smcp.DetectDrift(configured())).To(BeEmpty())
+	})
+
+	It("should report changed, added and removed fields managed by the operator", func() {
+		// given
+		Expect(unstructured.SetNestedField(controlPlane.Object, true, "spec", "addons", "kiali", "enabled")).To(Succeed())
+		Expect(unstructured.SetNestedField(controlPlane.Object, true, "spec", "gateways", "egress", "enabled")).To(Succeed())
+		providers, _, _ := unstructured.NestedSlice(controlPlane.Object, "spec", "techPreview", "meshConfig", "extensionProviders")
+		unstructured.RemoveNestedField(providers[0].(map[string]interface{}), "envoyExtAuthzGrpc", "port")
+		providers[1] = map[string]interface{}{"name": "admin-tracing", "zipkin": map[string]interface{}{"port": int64(9412)}}
+		Expect(unstructured.SetNestedSlice(controlPlane.Object, providers, "spec", "techPreview", "meshConfig", "extensionProviders")).To(Succeed())
+
+		// when
+		drifts := detect()
+
+		// then
+		Expect(drifts).To(HaveLen(3), "changes of providers not registered by the operator should be ignored")
+		Expect(drifts[0].String()).To(Equal("spec.addons.kiali.enabled: expected false, found true"))
+		Expect(drifts[1].String()).To(Equal("spec.gateways.egress: expected <none>, found {\"enabled\":true}"))
+		Expect(drifts[2].String()).To(Equal("spec.techPreview.meshConfig.extensionProviders[opendatahub-auth-provider].envoyExtAuthzGrpc.port: expected 50051, found <none>"))
+	})
+
+	It("should restore values recorded by the operator", func() {
+		// given
+		Expect(unstructured.SetNestedField(controlPlane.Object, true, "spec", "addons", "kiali", "enabled")).To(Succeed())
+		Expect(unstructured.SetNestedSlice(controlPlane.Object, []interface{}{}, "spec", "techPreview", "meshConfig", "extensionProviders")).To(Succeed())
+
+		// when
+		changed, err := smcp.CorrectDrift(detect())(controlPlane)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(detect()).To(BeEmpty())
+		Expect(controlPlane.Object["spec"]).To(HaveKeyWithValue("addons", HaveKeyWithValue("kiali", HaveKeyWithValue("enabled", false))))
+	})
+
+	It("should keep reporting drift which has not been corrected after recording fields again", func() {
+		// given
+		Expect(unstructured.SetNestedField(controlPlane.Object, true, "spec", "addons", "kiali", "enabled")).To(Succeed())
+		unresolved := detect()
+
+		// when
+		record(unresolved...)
+
+		// then
+		Expect(detect()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,144 @@
+package smcp
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	meshConfigPath         = []string{"spec", "techPreview", "meshConfig"}
+	extensionProvidersPath = fieldPath(meshConfigPath, "extensionProviders")
+	addonsPath             = []string{"spec", "addons"}
+)
+
+// AddExtensionProvider adds the extension provider to the mesh config. The provider is identified by its name,
+// an existing provider with the same name is replaced. Values of the provider have to be JSON compatible, e.g. int64 instead of int.
+func AddExtensionProvider(provider map[string]interface{}) Mutation {
+	return func(smcp *unstructured.Unstructured) (bool, error) {
+		extensionProviders, _, err := unstructured.NestedSlice(smcp.Object, extensionProvidersPath...)
+		if err != nil {
+			return false, err
+		}
+
+		providerName, _ := provider["name"].(string)
+		index := indexOfExtensionProvider(extensionProviders, providerName)
+		switch {
+		case index < 0:
+			extensionProviders = append(extensionProviders, provider)
+		case reflect.DeepEqual(extensionProviders[index], provider):
+			return false, nil
+		default:
+			extensionProviders[index] = provider
+		}
+
+		return true, unstructured.SetNestedSlice(smcp.Object, extensionProviders, extensionProvidersPath...)
+	}
+}
+
+// RemoveExtensionProvider removes the extension provider with the given name from the mesh config, if present.
+func RemoveExtensionProvider(name string) Mutation {
+	return func(smcp *unstructured.Unstructured) (bool, error) {
+		extensionProviders, found, err := unstructured.NestedSlice(smcp.Object, extensionProvidersPath...)
+		if err != nil || !found {
+			return false, err
+		}
+
+		index := indexOfExtensionProvider(extensionProviders, name)
+		if index < 0 {
+			return false, nil
+		}
+
+		extensionProviders = append(extensionProviders[:index], extensionProviders[index+1:]...)
+
+		return true, unstructured.SetNestedSlice(smcp.Object, extensionProviders, extensionProvidersPath...)
+	}
+}
+
+// RemoveExtensionProviders removes all extension providers of the mesh config matching the predicate. Removed providers
+// are reported through removed, which is reset on every invocation, so it reflects the providers removed from the SMCP
+// that has been eventually updated.
+func RemoveExtensionProviders(matches func(provider map[string]interface{}) bool, removed *[]map[string]interface{}) Mutation {
+	return func(smcp *unstructured.Unstructured) (bool, error) {
+		*removed = nil
+
+		extensionProviders, found, err := unstructured.NestedSlice(smcp.Object, extensionProvidersPath...)
+		if err != nil || !found {
+			return false, err
+		}
+
+		kept := make([]interface{}, 0, len(extensionProviders))
+		for _, v := range extensionProviders {
+			if extensionProvider, ok := v.(map[string]interface{}); ok && matches(extensionProvider) {
+				*removed = append(*removed, extensionProvider)
+				continue
+			}
+			kept = append(kept, v)
+		}
+
+		if len(*removed) == 0 {
+			return false, nil
+		}
+
+		return true, unstructured.SetNestedSlice(smcp.Object, kept, extensionProvidersPath...)
+	}
+}
+
+// SetMeshConfig sets the field of the mesh config defined by its path, e.g. "defaultConfig", "tracing".
+// Value has to be JSON compatible, e.g. int64 instead of int.
+func SetMeshConfig(value interface{}, fields ...string) Mutation {
+	return setField(value, fieldPath(meshConfigPath, fields...)...)
+}
+
+// UnsetMeshConfig removes the field of the mesh config defined by its path, if present.
+func UnsetMeshConfig(fields ...string) Mutation {
+	path := fieldPath(meshConfigPath, fields...)
+
+	return func(smcp *unstructured.Unstructured) (bool, error) {
+		if _, found, err := unstructured.NestedFieldNoCopy(smcp.Object, path...); err != nil || !found {
+			return false, err
+		}
+		unstructured.RemoveNestedField(smcp.Object, path...)
+
+		return true, nil
+	}
+}
+
+// ToggleAddon enables or disables the addon of the control plane, e.g. "prometheus" or "kiali".
+func ToggleAddon(addon string, enabled bool) Mutation {
+	return setField(enabled, fieldPath(addonsPath, addon, "enabled")...)
+}
+
+func setField(value interface{}, path ...string) Mutation {
+	return func(smcp *unstructured.Unstructured) (bool, error) {
+		current, found, err := unstructured.NestedFieldNoCopy(smcp.Object, path...)
+		if err != nil {
+			return false, err
+		}
+		if found && reflect.DeepEqual(current, value) {
+			return false, nil
+		}
+
+		return true, unstructured.SetNestedField(smcp.Object, runtime.DeepCopyJSONValue(value), path...)
+	}
+}
+
+func fieldPath(base []string, fields ...string) []string {
+	return append(append([]string{}, base...), fields...)
+}
+
+func indexOfExtensionProvider(extensionProviders []interface{}, name string) int {
+	for i, v := range extensionProviders {
+		extensionProvider, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if currentName, isString := extensionProvider["name"].(string); isString && currentName == name {
+			return i
+		}
+	}
+
+	return -1
+}
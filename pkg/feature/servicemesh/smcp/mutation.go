@@ -0,0 +1,51 @@
+// Package smcp provides mutations of the ServiceMeshControlPlane shared by the capabilities configuring
+// the mesh, such as authorization, tracing or WASM plugins.
+//
+// The SMCP is owned by the cluster admin and edited by several capabilities, so it is never overwritten.
+// Instead, mutations are applied to its latest version and the update is retried when it has been changed in the meantime.
+package smcp
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// Mutation changes the ServiceMeshControlPlane in place and reports whether it has been changed.
+// It can be invoked more than once, as it is applied again to the latest version of the SMCP on conflict.
+type Mutation func(smcp *unstructured.Unstructured) (bool, error)
+
+// Mutate applies mutations to the ServiceMeshControlPlane defined by the control plane spec. The SMCP is only
+// updated when any of the mutations changed it. The update relies on the resource version of the fetched SMCP,
+// so concurrent changes are not lost, and it is retried with the latest version on conflict.
+func Mutate(ctx context.Context, cli client.Client, controlPlane infrav1.ControlPlaneSpec, mutations ...Mutation) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		smcp := &unstructured.Unstructured{}
+		smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: controlPlane.Namespace, Name: controlPlane.Name}, smcp); err != nil {
+			return err
+		}
+
+		changed := false
+		for _, mutate := range mutations {
+			mutated, err := mutate(smcp)
+			if err != nil {
+				return fmt.Errorf("failed mutating ServiceMeshControlPlane %s/%s: %w", controlPlane.Namespace, controlPlane.Name, err)
+			}
+			changed = changed || mutated
+		}
+
+		if !changed {
+			return nil
+		}
+
+		return cli.Update(ctx, smcp)
+	})
+}
@@ -0,0 +1,218 @@
+package smcp_test
+
+import (
+	"context"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh/smcp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceMeshControlPlane mutations", func() {
+
+	controlPlane := infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"}
+
+	var cli client.Client
+
+	newSMCP := func(spec map[string]interface{}) *unstructured.Unstructured {
+		controlPlaneObj := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+		controlPlaneObj.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		controlPlaneObj.SetName(controlPlane.Name)
+		controlPlaneObj.SetNamespace(controlPlane.Namespace)
+
+		return controlPlaneObj
+	}
+
+	extensionProvider := func(name, service string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": name,
+			"envoyExtAuthzGrpc": map[string]interface{}{
+				"service": service,
+				"port":    int64(50051),
+			},
+		}
+	}
+
+	fetchSMCP := func(ctx context.Context) *unstructured.Unstructured {
+		controlPlaneObj := &unstructured.Unstructured{}
+		controlPlaneObj.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		Expect(cli.Get(ctx, client.ObjectKey{Name: controlPlane.Name, Namespace: controlPlane.Namespace}, controlPlaneObj)).To(Succeed())
+
+		return controlPlaneObj
+	}
+
+	extensionProviderNames := func(ctx context.Context) []string {
+		providers, _, err := unstructured.NestedSlice(fetchSMCP(ctx).Object, "spec", "techPreview", "meshConfig", "extensionProviders")
+		Expect(err).ToNot(HaveOccurred())
+
+		names := make([]string, 0, len(providers))
+		for _, provider := range providers {
+			name, _, _ := unstructured.NestedString(provider.(map[string]interface{}), "name")
+			names = append(names, name)
+		}
+
+		return names
+	}
+
+	BeforeEach(func() {
+		cli = fake.NewClientBuilder().
+			WithScheme(runtime.NewScheme()).
+			WithObjects(newSMCP(map[string]interface{}{
+				"techPreview": map[string]interface{}{
+					"meshConfig": map[string]interface{}{
+						"extensionProviders": []interface{}{
+							extensionProvider("opendatahub-auth-provider", "authorino-authorino-authorization.opendatahub-auth-provider.svc.cluster.local"),
+							extensionProvider("custom-provider", "custom.svc.cluster.local"),
+						},
+					},
+				},
+			})).
+			Build()
+	})
+
+	Context("extension providers", func() {
+
+		It("should add extension provider", func(ctx context.Context) {
+			// when
+			err := smcp.Mutate(ctx, cli, controlPlane, smcp.AddExtensionProvider(extensionProvider("tracing-provider", "tempo.svc.cluster.local")))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(extensionProviderNames(ctx)).To(Equal([]string{"opendatahub-auth-provider", "custom-provider", "tracing-provider"}))
+		})
+
+		It("should replace extension provider with the same name", func(ctx context.Context) {
+			// when
+			err := smcp.Mutate(ctx, cli, controlPlane, smcp.AddExtensionProvider(extensionProvider("custom-provider", "changed.svc.cluster.local")))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(extensionProviderNames(ctx)).To(Equal([]string{"opendatahub-auth-provider", "custom-provider"}))
+			providers, _, _ := unstructured.NestedSlice(fetchSMCP(ctx).Object, "spec", "techPreview", "meshConfig", "extensionProviders")
+			Expect(providers[1]).To(HaveKeyWithValue("envoyExtAuthzGrpc", HaveKeyWithValue("service", "changed.svc.cluster.local")))
+		})
+
+		It("should remove extension provider and keep the others", func(ctx context.Context) {
+			// when
+			err := smcp.Mutate(ctx, cli, controlPlane, smcp.RemoveExtensionProvider("opendatahub-auth-provider"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(extensionProviderNames(ctx)).To(Equal([]string{"custom-provider"}))
+		})
+
+		It("should remove extension providers matching the predicate and report them", func(ctx context.Context) {
+			// given
+			var removed []map[string]interface{}
+			isAuthProvider := func(provider map[string]interface{}) bool {
+				return provider["name"] == "opendatahub-auth-provider"
+			}
+
+			// when
+			err := smcp.Mutate(ctx, cli, controlPlane, smcp.RemoveExtensionProviders(isAuthProvider, &removed))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(extensionProviderNames(ctx)).To(Equal([]string{"custom-provider"}))
+			Expect(removed).To(ConsistOf(HaveKeyWithValue("name", "opendatahub-auth-provider")))
+		})
+
+		It("should not update control plane when nothing changed", func(ctx context.Context) {
+			// given
+			resourceVersion := fetchSMCP(ctx).GetResourceVersion()
+
+			// when
+			err := smcp.Mutate(ctx, cli, controlPlane,
+				smcp.RemoveExtensionProvider("non-existing-provider"),
+				smcp.AddExtensionProvider(extensionProvider("custom-provider", "custom.svc.cluster.local")),
+			)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetchSMCP(ctx).GetResourceVersion()).To(Equal(resourceVersion))
+		})
+	})
+
+	Context("addons and mesh config", func() {
+
+		It("should toggle addon", func(ctx context.Context) {
+			// when
+			err := smcp.Mutate(ctx, cli, controlPlane, smcp.ToggleAddon("prometheus", false))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			enabled, found, err := unstructured.NestedBool(fetchSMCP(ctx).Object, "spec", "addons", "prometheus", "enabled")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(enabled).To(BeFalse())
+		})
+
+		It("should set and unset mesh config field", func(ctx context.Context) {
+			// when
+			err := smcp.Mutate(ctx, cli, controlPlane, smcp.SetMeshConfig(int64(100), "defaultConfig", "tracing", "sampling"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			sampling, found, err := unstructured.NestedInt64(fetchSMCP(ctx).Object, "spec", "techPreview", "meshConfig", "defaultConfig", "tracing", "sampling")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(sampling).To(Equal(int64(100)))
+
+			// when
+			err = smcp.Mutate(ctx, cli, controlPlane, smcp.UnsetMeshConfig("defaultConfig", "tracing"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			_, found, err = unstructured.NestedMap(fetchSMCP(ctx).Object, "spec", "techPreview", "meshConfig", "defaultConfig", "tracing")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+			Expect(extensionProviderNames(ctx)).To(HaveLen(2))
+		})
+	})
+
+	It("should apply mutations to the latest control plane when it has been changed concurrently", func(ctx context.Context) {
+		// given
+		conflicts := 0
+		conflictingCli := interceptor.NewClient(cli.(client.WithWatch), interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				if conflicts == 0 {
+					conflicts++
+					// someone else changes the control plane in the meantime
+					concurrent := fetchSMCP(ctx)
+					Expect(unstructured.SetNestedField(concurrent.Object, true, "spec", "addons", "kiali", "enabled")).To(Succeed())
+					Expect(c.Update(ctx, concurrent)).To(Succeed())
+				}
+
+				return c.Update(ctx, obj, opts...)
+			},
+		})
+
+		// when
+		err := smcp.Mutate(ctx, conflictingCli, controlPlane, smcp.RemoveExtensionProvider("custom-provider"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conflicts).To(Equal(1))
+		Expect(extensionProviderNames(ctx)).To(Equal([]string{"opendatahub-auth-provider"}))
+		kiali, _, _ := unstructured.NestedBool(fetchSMCP(ctx).Object, "spec", "addons", "kiali", "enabled")
+		Expect(kiali).To(BeTrue())
+	})
+
+	It("should fail when control plane does not exist", func(ctx context.Context) {
+		// when
+		err := smcp.Mutate(ctx, cli, infrav1.ControlPlaneSpec{Name: "missing", Namespace: "istio-system"}, smcp.ToggleAddon("kiali", true))
+
+		// then
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,13 @@
+package smcp_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSMCP(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ServiceMeshControlPlane Mutations Suite")
+}
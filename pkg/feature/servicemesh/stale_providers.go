@@ -0,0 +1,111 @@
+package servicemesh
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh/smcp"
+)
+
+const authExtensionProviderSuffix = "-auth-provider"
+
+// AuthExtensionProviderName is the name of the extension provider through which the mesh delegates authorization to Authorino,
+// registered in the control plane for the applications namespace.
+func AuthExtensionProviderName(applicationsNamespace string) string {
+	return applicationsNamespace + authExtensionProviderSuffix
+}
+
+// authorinoServicePattern matches the authorization service of the Authorino instance deployed by the operator,
+// see mesh-authz-ext-provider patch.
+var authorinoServicePattern = regexp.MustCompile(`^authorino-authorino-authorization\.[a-z0-9]([-a-z0-9]*[a-z0-9])?\.svc\.cluster\.local$`)
+
+// ExtensionProvider identifies the extension provider of the mesh config.
+type ExtensionProvider struct {
+	Name    string
+	Service string
+}
+
+// AuthExtensionProvider returns the extension provider the operator registers in the control plane to delegate authorization
+// to Authorino, pointing to the adopted instance when there is one. registered is false with lightweight authorization,
+// which does not use an extension provider.
+func AuthExtensionProvider(ctx context.Context, cli client.Client, source *dsciv1.DSCInitializationSpec, lookup *AuthorinoLookup) (ExtensionProvider, bool, error) {
+	if UsesLightweightAuth(source.ServiceMesh) {
+		return ExtensionProvider{}, false, nil
+	}
+
+	name, err := authExtensionName.Define(source).Value(ctx, cli)
+	if err != nil {
+		return ExtensionProvider{}, false, fmt.Errorf("failed resolving name of the authorization extension provider: %w", err)
+	}
+
+	adopted, err := lookup.Find(ctx, cli)
+	if err != nil {
+		return ExtensionProvider{}, false, err
+	}
+
+	if !adopted.IsZero() {
+		return ExtensionProvider{Name: name, Service: authorizationService(adopted.Name, adopted.Namespace)}, true, nil
+	}
+
+	providerName, err := authProvider.Define(source).Value(ctx, cli)
+	if err != nil {
+		return ExtensionProvider{}, false, fmt.Errorf("failed resolving name of the authorization provider: %w", err)
+	}
+
+	return ExtensionProvider{Name: name, Service: authorizationService(providerName, AuthNamespace(source))}, true, nil
+}
+
+// authorizationService is the address of the gRPC authorization service of the Authorino instance.
+func authorizationService(authorinoName, namespace string) string {
+	return authorinoName + "-authorino-authorization." + namespace + ".svc.cluster.local"
+}
+
+// IsStaleAuthExtensionProvider reports whether the extension provider has been registered by the operator for
+// an applications namespace other than the one of the current provider, e.g. before it has been renamed. Providers added by
+// the cluster admin are never considered stale, as their service points neither to the operator-managed Authorino,
+// nor to the adopted one. The current provider is zero value when the operator does not register any.
+func IsStaleAuthExtensionProvider(provider map[string]interface{}, current ExtensionProvider) bool {
+	name, _, _ := unstructured.NestedString(provider, "name")
+	if name == current.Name || !strings.HasSuffix(name, authExtensionProviderSuffix) {
+		return false
+	}
+
+	service, _, _ := unstructured.NestedString(provider, "envoyExtAuthzGrpc", "service")
+
+	return authorinoServicePattern.MatchString(service) || (current.Service != "" && service == current.Service)
+}
+
+// PruneStaleExtensionProviders removes stale authorization extension providers (see IsStaleAuthExtensionProvider)
+// from the ServiceMeshControlPlane and returns the removed ones. Missing control plane is not considered an error,
+// as there is nothing to prune.
+func PruneStaleExtensionProviders(ctx context.Context, cli client.Client, controlPlane infrav1.ControlPlaneSpec, current ExtensionProvider) ([]ExtensionProvider, error) {
+	var removed []map[string]interface{}
+	isStale := func(provider map[string]interface{}) bool {
+		return IsStaleAuthExtensionProvider(provider, current)
+	}
+
+	if err := smcp.Mutate(ctx, cli, controlPlane, smcp.RemoveExtensionProviders(isStale, &removed)); err != nil {
+		if client.IgnoreNotFound(err) == nil || meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	pruned := make([]ExtensionProvider, 0, len(removed))
+	for _, provider := range removed {
+		name, _, _ := unstructured.NestedString(provider, "name")
+		service, _, _ := unstructured.NestedString(provider, "envoyExtAuthzGrpc", "service")
+		pruned = append(pruned, ExtensionProvider{Name: name, Service: service})
+	}
+
+	return pruned, nil
+}
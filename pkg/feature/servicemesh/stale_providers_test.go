@@ -0,0 +1,113 @@
+package servicemesh_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stale extension providers", func() {
+
+	controlPlane := infrav1.ControlPlaneSpec{Name: "data-science-smcp", Namespace: "istio-system"}
+	current := servicemesh.ExtensionProvider{
+		Name:    "opendatahub-auth-provider",
+		Service: "authorino-authorino-authorization.opendatahub-auth-provider.svc.cluster.local",
+	}
+
+	extensionProvider := func(name, service string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": name,
+			"envoyExtAuthzGrpc": map[string]interface{}{
+				"service": service,
+				"port":    int64(50051),
+			},
+		}
+	}
+
+	It("should consider operator-owned provider of previous applications namespace stale", func() {
+		Expect(servicemesh.IsStaleAuthExtensionProvider(
+			extensionProvider("odh-old-auth-provider", "authorino-authorino-authorization.odh-old-auth-provider.svc.cluster.local"),
+			current,
+		)).To(BeTrue())
+	})
+
+	It("should not consider current provider stale", func() {
+		Expect(servicemesh.IsStaleAuthExtensionProvider(
+			extensionProvider("opendatahub-auth-provider", "authorino-authorino-authorization.opendatahub-auth-provider.svc.cluster.local"),
+			current,
+		)).To(BeFalse())
+	})
+
+	It("should not consider provider pointing to custom service stale", func() {
+		Expect(servicemesh.IsStaleAuthExtensionProvider(
+			extensionProvider("team-auth-provider", "custom-authz.team.svc.cluster.local"),
+			current,
+		)).To(BeFalse())
+	})
+
+	It("should consider provider of previous applications namespace pointing to adopted Authorino stale", func() {
+		adoptedService := "platform-authz-authorino-authorization.kuadrant.svc.cluster.local"
+		Expect(servicemesh.IsStaleAuthExtensionProvider(
+			extensionProvider("odh-old-auth-provider", adoptedService),
+			servicemesh.ExtensionProvider{Name: "opendatahub-auth-provider", Service: adoptedService},
+		)).To(BeTrue())
+	})
+
+	It("should prune stale providers and keep the others", func(ctx context.Context) {
+		// given
+		smcp := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"techPreview": map[string]interface{}{
+					"meshConfig": map[string]interface{}{
+						"extensionProviders": []interface{}{
+							extensionProvider("odh-old-auth-provider", "authorino-authorino-authorization.odh-old-auth-provider.svc.cluster.local"),
+							extensionProvider("opendatahub-auth-provider", "authorino-authorino-authorization.opendatahub-auth-provider.svc.cluster.local"),
+							extensionProvider("tracing-provider", "tempo.svc.cluster.local"),
+						},
+					},
+				},
+			},
+		}}
+		smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		smcp.SetName(controlPlane.Name)
+		smcp.SetNamespace(controlPlane.Namespace)
+		cli := fake.NewClientBuilder().WithObjects(smcp).Build()
+
+		// when
+		pruned, err := servicemesh.PruneStaleExtensionProviders(ctx, cli, controlPlane, current)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pruned).To(ConsistOf(servicemesh.ExtensionProvider{
+			Name:    "odh-old-auth-provider",
+			Service: "authorino-authorino-authorization.odh-old-auth-provider.svc.cluster.local",
+		}))
+
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(smcp), smcp)).To(Succeed())
+		providers, _, err := unstructured.NestedSlice(smcp.Object, "spec", "techPreview", "meshConfig", "extensionProviders")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(providers).To(HaveLen(2))
+		Expect(providers).To(HaveEach(HaveKeyWithValue("name", Not(Equal("odh-old-auth-provider")))))
+	})
+
+	It("should not fail when control plane does not exist", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().Build()
+
+		// when
+		pruned, err := servicemesh.PruneStaleExtensionProviders(ctx, cli, controlPlane, current)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pruned).To(BeEmpty())
+	})
+})
@@ -0,0 +1,62 @@
+package feature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// defaultApplyTimeout bounds applying a single feature, unless the feature defines its own. Zero means no limit.
+var defaultApplyTimeout time.Duration //nolint:gochecknoglobals // Reason: timeout is configured once on startup and shared by all handlers
+
+// SetDefaultApplyTimeout sets the time in which features have to be applied, unless they define their own using ApplyTimeout.
+// It has to be called before any feature is applied.
+func SetDefaultApplyTimeout(timeout time.Duration) {
+	defaultApplyTimeout = timeout
+}
+
+// ApplyTimeoutError indicates that the feature has not been applied within its timeout, e.g. because pods it waits for never
+// became ready. Steps of the feature in progress are cancelled, the feature is applied again in the next reconciliation.
+type ApplyTimeoutError struct {
+	featureName string
+	Timeout     time.Duration
+	err         error
+}
+
+func (e *ApplyTimeoutError) Error() string {
+	return fmt.Sprintf("feature %q has not been applied within %s: %v", e.featureName, e.Timeout, e.err)
+}
+
+func (e *ApplyTimeoutError) Unwrap() error {
+	return e.err
+}
+
+// IsApplyTimeout checks if the error, possibly wrapped, is caused by the feature not being applied within its timeout.
+func IsApplyTimeout(err error) bool {
+	var timeoutErr *ApplyTimeoutError
+
+	return errors.As(err, &timeoutErr)
+}
+
+// applyFeatureWithTimeout applies the feature with the context cancelled once the timeout elapses, so that steps waiting
+// for the cluster, such as post-conditions, give up. The caller context is kept for reporting the result.
+func (f *Feature) applyFeatureWithTimeout(ctx context.Context) error {
+	timeout := f.applyTimeout
+	if timeout <= 0 {
+		timeout = defaultApplyTimeout
+	}
+	if timeout <= 0 {
+		return f.applyFeature(ctx)
+	}
+
+	applyCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	applyErr := f.applyFeature(applyCtx)
+	if applyErr != nil && errors.Is(applyCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return &ApplyTimeoutError{featureName: f.Name, Timeout: timeout, err: applyErr}
+	}
+
+	return applyErr
+}
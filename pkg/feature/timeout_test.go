@@ -0,0 +1,98 @@
+package feature_test
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Apply timeout", func() {
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&featurev1.FeatureTracker{}).Build()
+	})
+
+	AfterEach(func() {
+		feature.SetDefaultApplyTimeout(0)
+	})
+
+	defineFeature := func(timeout time.Duration, postcondition feature.Action) *feature.Feature {
+		builder := feature.Define("mesh-control-plane-creation").
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			PostConditions(postcondition)
+		if timeout > 0 {
+			builder = builder.ApplyTimeout(timeout)
+		}
+
+		f, err := builder.Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		return f
+	}
+
+	waitForCancellation := func(ctx context.Context, _ *feature.Feature) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+
+	It("should cancel the feature stuck in its post-conditions and report the timeout", func(ctx context.Context) {
+		// given
+		f := defineFeature(50*time.Millisecond, waitForCancellation)
+
+		// when
+		err := f.Apply(ctx)
+
+		// then
+		Expect(feature.IsApplyTimeout(err)).To(BeTrue())
+
+		tracker := featurev1.NewFeatureTracker(f.Name, f.TargetNamespace)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).To(Succeed())
+		Expect(tracker.Status.Phase).To(Equal(status.PhaseError))
+		Expect(tracker.Status.Conditions).To(ContainElement(HaveField("Reason", string(featurev1.ConditionReason.ApplyTimeout))))
+	})
+
+	It("should use the operator default when the feature does not define its timeout", func(ctx context.Context) {
+		// given
+		feature.SetDefaultApplyTimeout(50 * time.Millisecond)
+		f := defineFeature(0, waitForCancellation)
+
+		// when
+		err := f.Apply(ctx)
+
+		// then
+		Expect(feature.IsApplyTimeout(err)).To(BeTrue())
+	})
+
+	It("should not report timeout for features failing in time", func(ctx context.Context) {
+		// given
+		f := defineFeature(time.Minute, func(_ context.Context, _ *feature.Feature) error {
+			return context.DeadlineExceeded
+		})
+
+		// when
+		err := f.Apply(ctx)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(feature.IsApplyTimeout(err)).To(BeFalse())
+	})
+})
@@ -0,0 +1,77 @@
+package feature_test
+
+import (
+	"fmt"
+	"time"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FeatureTracker history", func() {
+
+	var (
+		tracker *featurev1.FeatureTracker
+		now     time.Time
+	)
+
+	phases := func() []string {
+		recorded := make([]string, 0, len(tracker.Status.History))
+		for _, transition := range tracker.Status.History {
+			recorded = append(recorded, transition.Phase+"/"+transition.Reason)
+		}
+
+		return recorded
+	}
+
+	BeforeEach(func() {
+		tracker = featurev1.NewFeatureTracker("mesh-control-plane-creation", "opendatahub")
+		now = time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	})
+
+	It("should record flapping between outcomes", func() {
+		// when
+		tracker.RecordTransition(status.PhaseReady, "FeatureCreated", "Applied feature", now)
+		tracker.RecordTransition(status.PhaseError, "PostConditions", "Failed applying: pods not ready", now.Add(time.Minute))
+		tracker.RecordTransition(status.PhaseReady, "FeatureCreated", "Applied feature", now.Add(2*time.Minute))
+
+		// then
+		Expect(phases()).To(Equal([]string{"Ready/FeatureCreated", "Error/PostConditions", "Ready/FeatureCreated"}))
+		Expect(tracker.Status.History[1].Time.Time).To(Equal(now.Add(time.Minute)))
+		Expect(tracker.Status.History[1].Message).To(Equal("Failed applying: pods not ready"))
+	})
+
+	It("should not record repeated outcome", func() {
+		// when
+		tracker.RecordTransition(status.PhaseError, "PreConditions", "Failed applying: operator missing", now)
+		tracker.RecordTransition(status.PhaseError, "PreConditions", "Failed applying: operator missing", now.Add(time.Minute))
+
+		// then
+		Expect(phases()).To(Equal([]string{"Error/PreConditions"}))
+		Expect(tracker.Status.History[0].Time.Time).To(Equal(now))
+	})
+
+	It("should record change of the failure reason", func() {
+		// when
+		tracker.RecordTransition(status.PhaseError, "PreConditions", "Failed applying: operator missing", now)
+		tracker.RecordTransition(status.PhaseError, "PostConditions", "Failed applying: pods not ready", now.Add(time.Minute))
+
+		// then
+		Expect(phases()).To(Equal([]string{"Error/PreConditions", "Error/PostConditions"}))
+	})
+
+	It("should keep only the latest transitions", func() {
+		// when
+		for i := 0; i < featurev1.MaxHistory+3; i++ {
+			tracker.RecordTransition(status.PhaseError, fmt.Sprintf("Reason%d", i), "Failed applying", now.Add(time.Duration(i)*time.Minute))
+		}
+
+		// then
+		Expect(tracker.Status.History).To(HaveLen(featurev1.MaxHistory))
+		Expect(tracker.Status.History[0].Reason).To(Equal("Reason3"))
+		Expect(tracker.Status.History[featurev1.MaxHistory-1].Reason).To(Equal(fmt.Sprintf("Reason%d", featurev1.MaxHistory+2)))
+	})
+})
@@ -0,0 +1,113 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+)
+
+// trackerStatusInterval is the minimal time between status writes of the same FeatureTracker which do not change its state,
+// such as marking it progressing, or refreshing heartbeats of conditions. Zero writes the status on every apply.
+var trackerStatusInterval = 10 * time.Second //nolint:gochecknoglobals // Reason: interval is configured once on startup and shared by all handlers
+
+// trackerStatusWrites holds the time of the last status write of each FeatureTracker, keyed by its name.
+var trackerStatusWrites sync.Map //nolint:gochecknoglobals // Reason: write times have to be shared by all handlers of the process
+
+// SetTrackerStatusInterval sets the minimal time between status writes of the same FeatureTracker which do not change its state.
+// It has to be called before any feature is applied.
+func SetTrackerStatusInterval(interval time.Duration) {
+	trackerStatusInterval = interval
+}
+
+// stageStatus defers the update of the FeatureTracker status until the outcome of the apply pass is written by writeStatus,
+// so that all changes made by the pass are written at once.
+func (f *Feature) stageStatus(update status.SaveStatusFunc[*featurev1.FeatureTracker]) {
+	f.stagedStatus = append(f.stagedStatus, update)
+}
+
+// markProgressing writes the progressing state of the FeatureTracker at the start of the apply pass, together with the lease on it.
+// When the lease is held already and the status has been written recently, e.g. by the previous pass, it is staged instead,
+// so that frequent re-applies write the status once per pass. The staged update is returned, nil when it has been written.
+func (f *Feature) markProgressing(ctx context.Context) (status.SaveStatusFunc[*featurev1.FeatureTracker], error) {
+	progressing := func(saved *featurev1.FeatureTracker) {
+		status.SetProgressingCondition(&saved.Status.Conditions, string(featurev1.ConditionReason.FeatureCreated), fmt.Sprintf("Applying feature [%s]", f.Name))
+		saved.Status.Phase = status.PhaseProgressing
+		if version := recordedOperatorVersion(); version != "" {
+			saved.Status.OperatorVersion = version
+		}
+		saved.Summarize()
+	}
+
+	staged, err := f.acquireLease(ctx, progressing)
+	if err != nil || !staged {
+		return nil, err
+	}
+
+	return progressing, nil
+}
+
+// writeStatus writes the staged updates of the FeatureTracker status followed by the given one in a single update. The write is
+// skipped when it would not change the state of the tracker, apart from condition timestamps, and the status has been written recently.
+func (f *Feature) writeStatus(ctx context.Context, update status.SaveStatusFunc[*featurev1.FeatureTracker]) error {
+	updates := append(f.stagedStatus, update) //nolint:gocritic // Reason: staged updates are dropped right after
+	f.stagedStatus = nil
+
+	apply := func(saved *featurev1.FeatureTracker) {
+		for _, stagedUpdate := range updates {
+			stagedUpdate(saved)
+		}
+	}
+
+	expected := f.tracker.DeepCopy()
+	apply(expected)
+	if f.statusWrittenRecently() && sameTrackerState(f.tracker.Status, expected.Status) {
+		return nil
+	}
+
+	saved, err := status.UpdateWithRetry(ctx, f.Client, f.tracker, apply)
+	if err != nil {
+		return err
+	}
+
+	trackerStatusWrites.Store(f.tracker.Name, time.Now())
+
+	return f.attachTracker(saved)
+}
+
+func (f *Feature) statusWrittenRecently() bool {
+	if trackerStatusInterval <= 0 || f.tracker == nil {
+		return false
+	}
+
+	lastWrite, found := trackerStatusWrites.Load(f.tracker.Name)
+	if !found {
+		return false
+	}
+
+	writtenAt, isTime := lastWrite.(time.Time)
+
+	return isTime && time.Since(writtenAt) < trackerStatusInterval
+}
+
+// sameTrackerState compares statuses of FeatureTracker, ignoring timestamps of conditions, which change on every update.
+func sameTrackerState(current, expected featurev1.FeatureTrackerStatus) bool {
+	return reflect.DeepEqual(withoutTimestamps(current), withoutTimestamps(expected))
+}
+
+func withoutTimestamps(trackerStatus featurev1.FeatureTrackerStatus) featurev1.FeatureTrackerStatus {
+	stripped := *trackerStatus.DeepCopy()
+	for i := range stripped.Conditions {
+		stripped.Conditions[i].LastHeartbeatTime = metav1.Time{}
+		stripped.Conditions[i].LastTransitionTime = metav1.Time{}
+	}
+	stripped.Summary.LastTransitionTime = nil
+
+	return stripped
+}
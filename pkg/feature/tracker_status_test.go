@@ -0,0 +1,183 @@
+package feature_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FeatureTracker status writes", func() {
+
+	var (
+		cli          client.Client
+		statusWrites int
+	)
+
+	BeforeEach(func() {
+		statusWrites = 0
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&featurev1.FeatureTracker{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					statusWrites++
+
+					return c.SubResource(subResource).Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+	})
+
+	AfterEach(func() {
+		feature.SetTrackerStatusInterval(10 * time.Second)
+	})
+
+	defineFeature := func(name string, precondition feature.Action) *feature.Feature {
+		f, err := feature.Define(name).
+			UsingConfig(&rest.Config{Host: "https://localhost:6443"}).
+			TargetNamespace("opendatahub").
+			PreConditions(precondition).
+			Create()
+		Expect(err).ToNot(HaveOccurred())
+		f.Client = cli
+
+		return f
+	}
+
+	succeeding := func(_ context.Context, _ *feature.Feature) error {
+		return nil
+	}
+
+	getTracker := func(ctx context.Context, name string) *featurev1.FeatureTracker {
+		tracker := featurev1.NewFeatureTracker(name, "opendatahub")
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).To(Succeed())
+
+		return tracker
+	}
+
+	It("should skip writes which do not change the status of recently written tracker", func(ctx context.Context) {
+		// given
+		Expect(defineFeature("status-throttled", succeeding).Apply(ctx)).To(Succeed())
+		Expect(statusWrites).To(Equal(2))
+
+		// when
+		err := defineFeature("status-throttled", succeeding).Apply(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statusWrites).To(Equal(2))
+		Expect(getTracker(ctx, "status-throttled").Status.Phase).To(Equal(status.PhaseReady))
+	})
+
+	It("should take the lease together with the progressing state and keep it for the next apply", func(ctx context.Context) {
+		// given
+		Expect(defineFeature("status-leased", succeeding).Apply(ctx)).To(Succeed())
+		lease := getTracker(ctx, "status-leased").Status.ApplyLease
+		Expect(lease).ToNot(BeNil())
+		Expect(lease.ExpiresAt.Time).To(BeTemporally(">", time.Now()))
+
+		// when
+		err := defineFeature("status-leased", succeeding).Apply(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statusWrites).To(Equal(2))
+		Expect(getTracker(ctx, "status-leased").Status.ApplyLease).To(Equal(lease))
+	})
+
+	It("should write the staged progressing state when the apply lasts longer than the status interval", func(ctx context.Context) {
+		// given
+		feature.SetTrackerStatusInterval(200 * time.Millisecond)
+		Expect(defineFeature("status-long-apply", succeeding).Apply(ctx)).To(Succeed())
+		Expect(statusWrites).To(Equal(2))
+
+		var phaseDuringApply string
+		waitingOnControlPlane := func(ctx context.Context, _ *feature.Feature) error {
+			time.Sleep(500 * time.Millisecond)
+			phaseDuringApply = getTracker(ctx, "status-long-apply").Status.Phase
+
+			return nil
+		}
+
+		// when
+		err := defineFeature("status-long-apply", waitingOnControlPlane).Apply(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(phaseDuringApply).To(Equal(status.PhaseProgressing))
+		Expect(statusWrites).To(Equal(4))
+		Expect(getTracker(ctx, "status-long-apply").Status.Phase).To(Equal(status.PhaseReady))
+	})
+
+	It("should not apply the feature while another operator instance holds the lease", func(ctx context.Context) {
+		// given
+		Expect(defineFeature("status-leased-elsewhere", succeeding).Apply(ctx)).To(Succeed())
+		tracker := getTracker(ctx, "status-leased-elsewhere")
+		tracker.Status.ApplyLease = &featurev1.ApplyLease{
+			Holder:    "another-operator-instance",
+			ExpiresAt: metav1.NewTime(time.Now().Add(time.Minute).UTC().Truncate(time.Second)),
+		}
+		Expect(cli.Status().Update(ctx, tracker)).To(Succeed())
+		feature.SetTrackerStatusInterval(0)
+
+		// when
+		err := defineFeature("status-leased-elsewhere", func(_ context.Context, _ *feature.Feature) error {
+			return errors.New("should not be applied")
+		}).Apply(ctx)
+
+		// then
+		Expect(feature.IsApplyInProgress(err)).To(BeTrue())
+		Expect(getTracker(ctx, "status-leased-elsewhere").Status.Phase).To(Equal(status.PhaseReady))
+	})
+
+	It("should write the outcome of recently written tracker in a single update when it changes", func(ctx context.Context) {
+		// given
+		Expect(defineFeature("status-changed", succeeding).Apply(ctx)).To(Succeed())
+		Expect(statusWrites).To(Equal(2))
+
+		// when
+		err := defineFeature("status-changed", func(_ context.Context, _ *feature.Feature) error {
+			return errors.New("operator is not installed")
+		}).Apply(ctx)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(statusWrites).To(Equal(3))
+		tracker := getTracker(ctx, "status-changed")
+		Expect(tracker.Status.Phase).To(Equal(status.PhaseError))
+		Expect(tracker.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", BeEquivalentTo("Progressing")),
+			HaveField("Status", BeEquivalentTo("False")),
+		)))
+	})
+
+	It("should write the status on every apply when throttling is disabled", func(ctx context.Context) {
+		// given
+		feature.SetTrackerStatusInterval(0)
+		Expect(defineFeature("status-not-throttled", succeeding).Apply(ctx)).To(Succeed())
+
+		// when
+		err := defineFeature("status-not-throttled", succeeding).Apply(ctx)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statusWrites).To(Equal(4))
+	})
+})
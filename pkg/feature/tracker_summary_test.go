@@ -0,0 +1,74 @@
+package feature_test
+
+import (
+	"strings"
+	"time"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FeatureTracker summary", func() {
+
+	var tracker *featurev1.FeatureTracker
+
+	condition := func(conditionType conditionsv1.ConditionType, message string, transition time.Time) conditionsv1.Condition {
+		return conditionsv1.Condition{
+			Type:               conditionType,
+			Status:             corev1.ConditionTrue,
+			Message:            message,
+			LastTransitionTime: metav1.NewTime(transition),
+		}
+	}
+
+	BeforeEach(func() {
+		tracker = featurev1.NewFeatureTracker("mesh-control-plane-creation", "opendatahub")
+		tracker.Spec.Source = featurev1.Source{Type: featurev1.DSCIType, Name: "default-dsci"}
+	})
+
+	It("should summarize the most recently changed condition", func() {
+		// given
+		transition := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+		tracker.Status.Conditions = []conditionsv1.Condition{
+			condition(conditionsv1.ConditionAvailable, "Applied feature [mesh-control-plane-creation] successfully", transition.Add(-time.Hour)),
+			condition(conditionsv1.ConditionDegraded, "Failed applying [mesh-control-plane-creation]: smcp not ready", transition),
+		}
+
+		// when
+		tracker.Summarize()
+
+		// then
+		Expect(tracker.Status.Summary.Source).To(Equal("DSCI/default-dsci"))
+		Expect(tracker.Status.Summary.LastTransitionTime.Time).To(Equal(transition))
+		Expect(tracker.Status.Summary.Message).To(Equal("Failed applying [mesh-control-plane-creation]: smcp not ready"))
+	})
+
+	It("should shorten message to its first line fitting a terminal", func() {
+		// given
+		tracker.Status.Conditions = []conditionsv1.Condition{
+			condition(conditionsv1.ConditionDegraded, strings.Repeat("x", 100)+"\nstacktrace", time.Now()),
+		}
+
+		// when
+		tracker.Summarize()
+
+		// then
+		Expect(tracker.Status.Summary.Message).To(HaveLen(80))
+		Expect(tracker.Status.Summary.Message).To(HaveSuffix("..."))
+		Expect(tracker.Status.Summary.Message).ToNot(ContainSubstring("stacktrace"))
+	})
+
+	It("should leave time and message empty without conditions", func() {
+		// when
+		tracker.Summarize()
+
+		// then
+		Expect(tracker.Status.Summary).To(Equal(featurev1.FeatureTrackerSummary{Source: "DSCI/default-dsci"}))
+	})
+})
@@ -0,0 +1,296 @@
+package feature
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// ResourceRequestsMetricName is the name of the gauge reporting CPU (cores) and memory (bytes) requested by workloads of each feature.
+	ResourceRequestsMetricName = "feature_resource_requests"
+	// ResourceUsageMetricName is the name of the gauge reporting CPU (cores) and memory (bytes) used by workloads of each feature,
+	// as reported by the metrics API.
+	ResourceUsageMetricName = "feature_resource_usage"
+
+	usageCollectTimeout = 20 * time.Second
+)
+
+// UsageCollector reports resources requested and used by the workloads of each feature, so that the cost of the cluster
+// can be attributed to the capabilities, e.g. Service Mesh, authorization provider and serving gateways. Pods belong
+// to the feature set in their opendatahub.io/feature label or, when not set, in the label of their namespace, e.g.
+// the namespace of the authorization provider created by the feature.
+//
+// Pods and namespaces are read from informers, see NewUsageCache, so scrapes do not list them from the API server.
+// Usage is read from the metrics API when the metrics are scraped, only for namespaces running pods of features, as
+// it does not support watches. It is not reported when the metrics API is not available in the cluster.
+type UsageCollector struct {
+	cache    client.Reader
+	metrics  client.Reader
+	requests *prometheus.Desc
+	usage    *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*UsageCollector)(nil)
+
+// NewUsageCollector creates the collector reading pods and namespaces from the given cache, e.g. the one created by
+// NewUsageCache, and usage of pods using the metrics client, e.g. mgr.GetAPIReader().
+func NewUsageCollector(podCache, metricsClient client.Reader) *UsageCollector {
+	return &UsageCollector{
+		cache:   podCache,
+		metrics: metricsClient,
+		requests: prometheus.NewDesc(
+			ResourceRequestsMetricName,
+			"Resources requested by the running pods of the feature, in cores for cpu and bytes for memory.",
+			[]string{"feature", "resource"},
+			nil,
+		),
+		usage: prometheus.NewDesc(
+			ResourceUsageMetricName,
+			"Resources used by the running pods of the feature as reported by the metrics API, in cores for cpu and bytes for memory.",
+			[]string{"feature", "resource"},
+			nil,
+		),
+	}
+}
+
+func (c *UsageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.requests
+	ch <- c.usage
+}
+
+func (c *UsageCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), usageCollectTimeout)
+	defer cancel()
+
+	log := ctrl.Log.WithName("metrics")
+
+	pods, err := c.featurePods(ctx)
+	if err != nil {
+		log.Error(err, "failed listing pods of features, skipping "+ResourceRequestsMetricName)
+
+		return
+	}
+
+	requests := map[string]corev1.ResourceList{}
+	for _, pod := range pods {
+		addResources(requests, pod.feature, pod.requests)
+	}
+	emitResources(ch, c.requests, requests)
+
+	usage, errUsage := c.featureUsage(ctx, pods)
+	if errUsage != nil {
+		log.V(1).Info("metrics API not available, skipping "+ResourceUsageMetricName, "error", errUsage.Error())
+
+		return
+	}
+	emitResources(ch, c.usage, usage)
+}
+
+// NewUsageCache creates informers for pods and namespaces read by the UsageCollector. As pods of the whole cluster
+// are watched, only the ones which are not finished are cached, and only with the fields needed to sum their requests.
+// The cache has to be added to the manager to be started, e.g. mgr.Add(usageCache).
+func NewUsageCache(cfg *rest.Config, scheme *runtime.Scheme) (cache.Cache, error) {
+	featureLabeled, err := k8slabels.NewRequirement(labels.ODH.Feature, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return cache.New(cfg, cache.Options{
+		Scheme: scheme,
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.Pod{}: {
+				Field: fields.AndSelectors(
+					fields.OneTermNotEqualSelector("status.phase", string(corev1.PodSucceeded)),
+					fields.OneTermNotEqualSelector("status.phase", string(corev1.PodFailed)),
+				),
+				Transform: trimPod,
+			},
+			&corev1.Namespace{}: {
+				Label: k8slabels.NewSelector().Add(*featureLabeled),
+			},
+		},
+	})
+}
+
+// trimPod keeps only the fields of the pod read by the UsageCollector.
+func trimPod(obj any) (any, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return obj, nil
+	}
+
+	containers := make([]corev1.Container, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		containers = append(containers, corev1.Container{
+			Name:      container.Name,
+			Resources: corev1.ResourceRequirements{Requests: container.Resources.Requests},
+		})
+	}
+
+	return &corev1.Pod{
+		TypeMeta: pod.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            pod.Name,
+			Namespace:       pod.Namespace,
+			UID:             pod.UID,
+			ResourceVersion: pod.ResourceVersion,
+			Labels:          pod.Labels,
+		},
+		Spec:   corev1.PodSpec{Containers: containers},
+		Status: corev1.PodStatus{Phase: pod.Status.Phase},
+	}, nil
+}
+
+// featurePod holds the feature the running pod belongs to and resources requested by its containers.
+type featurePod struct {
+	feature  string
+	requests corev1.ResourceList
+}
+
+// featurePods finds running pods belonging to features.
+func (c *UsageCollector) featurePods(ctx context.Context) (map[types.NamespacedName]featurePod, error) {
+	pods := map[types.NamespacedName]featurePod{}
+	record := func(pod *corev1.Pod, featureName string) {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			return
+		}
+		// A label of the pod itself takes precedence over the one of its namespace.
+		if ownFeature := pod.Labels[labels.ODH.Feature]; ownFeature != "" {
+			featureName = ownFeature
+		}
+		pods[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}] = featurePod{feature: featureName, requests: requestsOf(pod)}
+	}
+
+	labeledPods := &corev1.PodList{}
+	if err := c.cache.List(ctx, labeledPods, client.HasLabels{labels.ODH.Feature}); err != nil {
+		return nil, err
+	}
+	for i := range labeledPods.Items {
+		record(&labeledPods.Items[i], "")
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := c.cache.List(ctx, namespaces, client.HasLabels{labels.ODH.Feature}); err != nil {
+		return nil, err
+	}
+	for _, namespace := range namespaces.Items {
+		namespacePods := &corev1.PodList{}
+		if err := c.cache.List(ctx, namespacePods, client.InNamespace(namespace.Name)); err != nil {
+			return nil, err
+		}
+		for i := range namespacePods.Items {
+			record(&namespacePods.Items[i], namespace.Labels[labels.ODH.Feature])
+		}
+	}
+
+	return pods, nil
+}
+
+// featureUsage sums usage reported by the metrics API for the pods of each feature.
+func (c *UsageCollector) featureUsage(ctx context.Context, pods map[types.NamespacedName]featurePod) (map[string]corev1.ResourceList, error) {
+	namespaces := map[string]struct{}{}
+	for key := range pods {
+		namespaces[key.Namespace] = struct{}{}
+	}
+
+	usage := map[string]corev1.ResourceList{}
+	for namespace := range namespaces {
+		podMetrics := &unstructured.UnstructuredList{}
+		podMetrics.SetGroupVersionKind(gvk.PodMetrics)
+		if err := c.metrics.List(ctx, podMetrics, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+
+		for _, metrics := range podMetrics.Items {
+			pod, found := pods[types.NamespacedName{Namespace: metrics.GetNamespace(), Name: metrics.GetName()}]
+			if !found {
+				continue
+			}
+			addResources(usage, pod.feature, usageOf(&metrics))
+		}
+	}
+
+	return usage, nil
+}
+
+func requestsOf(pod *corev1.Pod) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addQuantity(total, corev1.ResourceCPU, container.Resources.Requests.Cpu())
+		addQuantity(total, corev1.ResourceMemory, container.Resources.Requests.Memory())
+	}
+
+	return total
+}
+
+// usageOf sums usage of the containers of the PodMetrics resource. Quantities which cannot be parsed are skipped.
+func usageOf(podMetrics *unstructured.Unstructured) corev1.ResourceList {
+	total := corev1.ResourceList{}
+
+	containers, _, _ := unstructured.NestedSlice(podMetrics.Object, "containers")
+	for _, container := range containers {
+		containerUsage, ok := container.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			value, _, _ := unstructured.NestedString(containerUsage, "usage", string(name))
+			if quantity, err := resource.ParseQuantity(value); err == nil {
+				addQuantity(total, name, &quantity)
+			}
+		}
+	}
+
+	return total
+}
+
+func addResources(totals map[string]corev1.ResourceList, featureName string, resources corev1.ResourceList) {
+	if _, found := totals[featureName]; !found {
+		totals[featureName] = corev1.ResourceList{}
+	}
+	for name, quantity := range resources {
+		quantity := quantity
+		addQuantity(totals[featureName], name, &quantity)
+	}
+}
+
+func addQuantity(resources corev1.ResourceList, name corev1.ResourceName, quantity *resource.Quantity) {
+	total := resources[name]
+	total.Add(*quantity)
+	resources[name] = total
+}
+
+// emitResources reports cpu and memory of each feature, in a stable order.
+func emitResources(ch chan<- prometheus.Metric, desc *prometheus.Desc, totals map[string]corev1.ResourceList) {
+	features := make([]string, 0, len(totals))
+	for featureName := range totals {
+		features = append(features, featureName)
+	}
+	sort.Strings(features)
+
+	for _, featureName := range features {
+		cpu := totals[featureName][corev1.ResourceCPU]
+		memory := totals[featureName][corev1.ResourceMemory]
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, cpu.AsApproximateFloat64(), featureName, string(corev1.ResourceCPU))
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, memory.AsApproximateFloat64(), featureName, string(corev1.ResourceMemory))
+	}
+}
@@ -0,0 +1,113 @@
+package feature_test
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature resource usage metrics", func() {
+
+	featureLabels := func(featureName string) map[string]string {
+		return map[string]string{labels.ODH.Feature: featureName}
+	}
+
+	pod := func(namespace, name string, podLabels map[string]string, cpu, memory string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: podLabels},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name: "main",
+					Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(cpu),
+						corev1.ResourceMemory: resource.MustParse(memory),
+					}},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	podMetrics := func(namespace, name, cpu, memory string) *unstructured.Unstructured {
+		metrics := &unstructured.Unstructured{Object: map[string]any{
+			"containers": []any{
+				map[string]any{"name": "main", "usage": map[string]any{"cpu": cpu, "memory": memory}},
+			},
+		}}
+		metrics.SetGroupVersionKind(gvk.PodMetrics)
+		metrics.SetNamespace(namespace)
+		metrics.SetName(name)
+
+		return metrics
+	}
+
+	objects := func() []client.Object {
+		completed := pod("opendatahub", "mesh-job", featureLabels("mesh-control-plane-creation"), "1", "1Gi")
+		completed.Status.Phase = corev1.PodSucceeded
+
+		return []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-auth-provider", Labels: featureLabels("mesh-control-plane-external-authz")}},
+			pod("opendatahub-auth-provider", "authorino-1", nil, "250m", "128Mi"),
+			pod("opendatahub-auth-provider", "authorino-2", nil, "250m", "128Mi"),
+			pod("istio-system", "istiod", featureLabels("mesh-control-plane-creation"), "500m", "1Gi"),
+			pod("istio-system", "unrelated", nil, "2", "2Gi"),
+			completed,
+		}
+	}
+
+	It("should report resources requested by running pods of each feature", func() {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(objects()...).Build()
+
+		// when
+		collector := feature.NewUsageCollector(cli, cli)
+
+		// then
+		expected := `
+# HELP feature_resource_requests Resources requested by the running pods of the feature, in cores for cpu and bytes for memory.
+# TYPE feature_resource_requests gauge
+feature_resource_requests{feature="mesh-control-plane-creation",resource="cpu"} 0.5
+feature_resource_requests{feature="mesh-control-plane-creation",resource="memory"} 1.073741824e+09
+feature_resource_requests{feature="mesh-control-plane-external-authz",resource="cpu"} 0.5
+feature_resource_requests{feature="mesh-control-plane-external-authz",resource="memory"} 2.68435456e+08
+`
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(expected), feature.ResourceRequestsMetricName)).To(Succeed())
+	})
+
+	It("should report resources used by pods of each feature", func() {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(append(objects(),
+			podMetrics("opendatahub-auth-provider", "authorino-1", "10m", "64Mi"),
+			podMetrics("opendatahub-auth-provider", "authorino-2", "20m", "64Mi"),
+			podMetrics("istio-system", "istiod", "100m", "512Mi"),
+			podMetrics("istio-system", "unrelated", "1", "1Gi"),
+		)...).Build()
+
+		// when
+		collector := feature.NewUsageCollector(cli, cli)
+
+		// then
+		expected := `
+# HELP feature_resource_usage Resources used by the running pods of the feature as reported by the metrics API, in cores for cpu and bytes for memory.
+# TYPE feature_resource_usage gauge
+feature_resource_usage{feature="mesh-control-plane-creation",resource="cpu"} 0.1
+feature_resource_usage{feature="mesh-control-plane-creation",resource="memory"} 5.36870912e+08
+feature_resource_usage{feature="mesh-control-plane-external-authz",resource="cpu"} 0.03
+feature_resource_usage{feature="mesh-control-plane-external-authz",resource="memory"} 1.34217728e+08
+`
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(expected), feature.ResourceUsageMetricName)).To(Succeed())
+	})
+})
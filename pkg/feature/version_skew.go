@@ -0,0 +1,91 @@
+package feature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+)
+
+// operatorVersion is the version of the running operator, recorded in FeatureTrackers of applied features.
+// It is zero when unknown, e.g. when the operator is not installed through OLM, which disables version skew checks.
+var operatorVersion semver.Version //nolint:gochecknoglobals // Reason: version is resolved once on startup and shared by all handlers
+
+// SetOperatorVersion sets the version of the running operator. It has to be called before any feature is applied.
+func SetOperatorVersion(version semver.Version) {
+	operatorVersion = version
+}
+
+// VersionSkewError indicates that the feature has been applied by a newer version of the operator, e.g. before
+// an accidental downgrade. Changes to such a feature are refused, as they would unwind newer resources.
+type VersionSkewError struct {
+	featureName     string
+	TrackerVersion  semver.Version
+	OperatorVersion semver.Version
+}
+
+func (e *VersionSkewError) Error() string {
+	return fmt.Sprintf("feature %q has been applied by newer operator version %s than the running %s, refusing to change it",
+		e.featureName, e.TrackerVersion, e.OperatorVersion)
+}
+
+// IsVersionSkew checks if the error, possibly wrapped, is caused by the feature being applied by a newer operator version.
+func IsVersionSkew(err error) bool {
+	var skewErr *VersionSkewError
+
+	return errors.As(err, &skewErr)
+}
+
+// checkVersionSkew fails when the FeatureTracker has been written by a newer version of the operator than the running one.
+// Trackers without the version, written before it has been recorded, are not considered skewed.
+func checkVersionSkew(featureName string, tracker *featurev1.FeatureTracker) error {
+	trackerVersion, skewed := versionAhead(tracker)
+	if !skewed {
+		return nil
+	}
+
+	return &VersionSkewError{featureName: featureName, TrackerVersion: trackerVersion, OperatorVersion: operatorVersion}
+}
+
+func versionAhead(tracker *featurev1.FeatureTracker) (semver.Version, bool) {
+	if operatorVersion.Equals(semver.Version{}) || tracker.Status.OperatorVersion == "" {
+		return semver.Version{}, false
+	}
+
+	trackerVersion, err := semver.ParseTolerant(tracker.Status.OperatorVersion)
+	if err != nil {
+		return semver.Version{}, false
+	}
+
+	return trackerVersion, trackerVersion.GT(operatorVersion)
+}
+
+// TrackersAheadOfOperator lists FeatureTrackers written by a newer version of the operator than the running one.
+func TrackersAheadOfOperator(ctx context.Context, cli client.Reader) ([]featurev1.FeatureTracker, error) {
+	trackers := &featurev1.FeatureTrackerList{}
+	if err := cli.List(ctx, trackers); err != nil {
+		return nil, fmt.Errorf("failed listing feature trackers: %w", err)
+	}
+
+	var ahead []featurev1.FeatureTracker
+	for i := range trackers.Items {
+		if _, skewed := versionAhead(&trackers.Items[i]); skewed {
+			ahead = append(ahead, trackers.Items[i])
+		}
+	}
+
+	return ahead, nil
+}
+
+// recordedOperatorVersion is the version recorded in FeatureTrackers of applied features, empty when unknown.
+func recordedOperatorVersion() string {
+	if operatorVersion.Equals(semver.Version{}) {
+		return ""
+	}
+
+	return operatorVersion.String()
+}
@@ -0,0 +1,74 @@
+package feature_test
+
+import (
+	"context"
+
+	"github.com/blang/semver/v4"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Version skew", func() {
+
+	tracker := func(name, operatorVersion string) *featurev1.FeatureTracker {
+		featureTracker := featurev1.NewFeatureTracker(name, "opendatahub")
+		featureTracker.Spec.AppNamespace = "opendatahub"
+		featureTracker.Status.OperatorVersion = operatorVersion
+
+		return featureTracker
+	}
+
+	trackersAhead := func(ctx context.Context) []string {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				tracker("mesh-control-plane-creation", "2.10.0"),
+				tracker("mesh-metrics-collection", "2.9.0"),
+				tracker("mesh-shared-configmap", "2.8.1"),
+				tracker("feature-alerts", ""),
+			).
+			Build()
+
+		trackers, err := feature.TrackersAheadOfOperator(ctx, cli)
+		Expect(err).ToNot(HaveOccurred())
+
+		names := make([]string, 0, len(trackers))
+		for i := range trackers {
+			names = append(names, trackers[i].FeatureName())
+		}
+
+		return names
+	}
+
+	AfterEach(func() {
+		feature.SetOperatorVersion(semver.Version{})
+	})
+
+	It("should find trackers written by a newer operator version", func(ctx context.Context) {
+		// given
+		feature.SetOperatorVersion(semver.MustParse("2.9.0"))
+
+		// when
+		ahead := trackersAhead(ctx)
+
+		// then
+		Expect(ahead).To(ConsistOf("mesh-control-plane-creation"))
+	})
+
+	It("should not consider trackers skewed when operator version is unknown", func(ctx context.Context) {
+		// when
+		ahead := trackersAhead(ctx)
+
+		// then
+		Expect(ahead).To(BeEmpty())
+	})
+})
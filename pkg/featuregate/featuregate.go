@@ -0,0 +1,97 @@
+// Package featuregate gates experimental code paths of the operator, so that redesigns can ship disabled
+// and be enabled selectively through spec.featureGates of DSCInitialization.
+package featuregate
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Gate is the name of the gated operator behavior, as used in spec.featureGates.
+type Gate string
+
+// Maturity defines the default state of the gate and whether it can be changed.
+type Maturity string
+
+const (
+	// Alpha gates are disabled unless enabled explicitly. Their behavior can change, or they can be removed, in any release.
+	Alpha Maturity = "Alpha"
+	// Beta gates are enabled unless disabled explicitly, e.g. when the new behavior causes problems in the cluster.
+	Beta Maturity = "Beta"
+	// GA gates are always enabled. They are kept until the gated code path becomes the only one.
+	GA Maturity = "GA"
+)
+
+// Spec describes the gate.
+type Spec struct {
+	Maturity    Maturity
+	Description string
+}
+
+const (
+	// ParallelFeatureApply applies features of a handler which do not depend on each other concurrently, instead of one by one.
+	ParallelFeatureApply Gate = "ParallelFeatureApply"
+)
+
+// Known lists all the gates of the operator. Promoting a gate only changes its maturity here.
+var Known = map[Gate]Spec{
+	ParallelFeatureApply: {
+		Maturity:    Alpha,
+		Description: "Features which do not depend on each other are applied concurrently, features have to declare DependsOn to be applied in order.",
+	},
+}
+
+// Gates holds the state of the known gates. Zero value has all of them in their default state.
+type Gates struct {
+	enabled map[Gate]bool
+}
+
+// Resolve applies the requested states on top of the defaults given by maturity of the gates. Unknown gates,
+// and GA gates requested to be disabled, are ignored and reported as warnings, so that a typo does not block reconciliation.
+func Resolve(requested map[string]bool) (Gates, []string) {
+	gates := Gates{enabled: make(map[Gate]bool, len(Known))}
+	for gate, spec := range Known {
+		gates.enabled[gate] = spec.Maturity != Alpha
+	}
+
+	var warnings []string
+	for name, enabled := range requested {
+		gate := Gate(name)
+		spec, known := Known[gate]
+		switch {
+		case !known:
+			warnings = append(warnings, fmt.Sprintf("unknown feature gate %q is ignored", name))
+		case spec.Maturity == GA && !enabled:
+			warnings = append(warnings, fmt.Sprintf("feature gate %q is GA and cannot be disabled", name))
+		default:
+			gates.enabled[gate] = enabled
+		}
+	}
+	sort.Strings(warnings)
+
+	return gates, warnings
+}
+
+// Enabled tells whether the gated behavior is turned on.
+func (g Gates) Enabled(gate Gate) bool {
+	if enabled, found := g.enabled[gate]; found {
+		return enabled
+	}
+
+	spec, known := Known[gate]
+
+	return known && spec.Maturity != Alpha
+}
+
+// EnabledGates lists the gates which are turned on, sorted by name.
+func (g Gates) EnabledGates() []string {
+	var enabled []string
+	for gate := range Known {
+		if g.Enabled(gate) {
+			enabled = append(enabled, string(gate))
+		}
+	}
+	sort.Strings(enabled)
+
+	return enabled
+}
@@ -0,0 +1,59 @@
+package featuregate_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregate"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature gates", func() {
+
+	const (
+		betaGate featuregate.Gate = "BetaBehavior"
+		gaGate   featuregate.Gate = "GABehavior"
+	)
+
+	BeforeEach(func() {
+		featuregate.Known[betaGate] = featuregate.Spec{Maturity: featuregate.Beta}
+		featuregate.Known[gaGate] = featuregate.Spec{Maturity: featuregate.GA}
+		DeferCleanup(func() {
+			delete(featuregate.Known, betaGate)
+			delete(featuregate.Known, gaGate)
+		})
+	})
+
+	It("should default gates by their maturity", func() {
+		// when
+		gates, warnings := featuregate.Resolve(nil)
+
+		// then
+		Expect(warnings).To(BeEmpty())
+		Expect(gates.Enabled(featuregate.ParallelFeatureApply)).To(BeFalse())
+		Expect(gates.Enabled(betaGate)).To(BeTrue())
+		Expect(gates.Enabled(gaGate)).To(BeTrue())
+		Expect(featuregate.Gates{}.EnabledGates()).To(Equal(gates.EnabledGates()))
+	})
+
+	It("should apply requested gates", func() {
+		// when
+		gates, warnings := featuregate.Resolve(map[string]bool{string(featuregate.ParallelFeatureApply): true, string(betaGate): false})
+
+		// then
+		Expect(warnings).To(BeEmpty())
+		Expect(gates.EnabledGates()).To(Equal([]string{string(gaGate), string(featuregate.ParallelFeatureApply)}))
+	})
+
+	It("should ignore unknown gates and disabling GA gates", func() {
+		// when
+		gates, warnings := featuregate.Resolve(map[string]bool{"ParalelFeatureApply": true, string(gaGate): false})
+
+		// then
+		Expect(warnings).To(ConsistOf(
+			`unknown feature gate "ParalelFeatureApply" is ignored`,
+			`feature gate "GABehavior" is GA and cannot be disabled`,
+		))
+		Expect(gates.Enabled(featuregate.ParallelFeatureApply)).To(BeFalse())
+		Expect(gates.Enabled(gaGate)).To(BeTrue())
+	})
+})
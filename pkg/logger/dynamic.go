@@ -0,0 +1,242 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap/zapcore"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	// FormatJSON logs entries as JSON objects, as in prod and default log modes.
+	FormatJSON = "json"
+	// FormatConsole logs entries in human-readable form, as in devel log mode.
+	FormatConsole = "console"
+)
+
+const (
+	// LevelError logs errors only.
+	LevelError = "error"
+	// LevelInfo logs errors and info messages, it is the level of all log modes.
+	LevelInfo = "info"
+	// LevelDebug logs messages of all verbosity levels.
+	LevelDebug = "debug"
+)
+
+var levelVerbosity = map[string]int{
+	LevelError: -1,
+	LevelInfo:  0,
+	LevelDebug: math.MaxInt8,
+}
+
+// Config is the logging configuration of the operator which can be changed at runtime. Empty values keep the defaults of the log mode.
+type Config struct {
+	// Format of the log entries, FormatJSON or FormatConsole.
+	Format string
+	// Level of all the loggers, LevelError, LevelInfo or LevelDebug.
+	Level string
+	// Levels override Level for the loggers of particular controllers, keyed by lowercase logger name, e.g. "dscinitialization",
+	// "datasciencecluster" or "features". Logger matches the key when any part of its name does.
+	Levels map[string]string
+}
+
+// resolvedConfig is Config with defaults applied and levels translated to logr verbosity.
+type resolvedConfig struct {
+	console     bool
+	verbosity   int
+	verbosities map[string]int
+}
+
+// Dynamic provides the operator logger whose format and levels can be changed using Update, without restarting the operator.
+// Loggers derived from it, e.g. using WithName, follow the changes as well.
+type Dynamic struct {
+	defaultFormat string
+	json, console logr.LogSink
+	config        atomic.Pointer[resolvedConfig]
+}
+
+// NewDynamic creates Dynamic logger writing to destWriter, configured by the log mode until Update is called.
+func NewDynamic(mode string, destWriter io.Writer) *Dynamic {
+	logAll := func(opts zap.Options) logr.LogSink {
+		// levels are enforced by the Dynamic logger, so that they can be changed at runtime
+		opts.Level = zapcore.Level(math.MinInt8)
+		opts.DestWriter = destWriter
+
+		sink := zap.New(zap.UseFlagOptions(&opts)).GetSink()
+		// dynamicSink adds a frame between the caller and the actual sink
+		if callDepthSink, ok := sink.(logr.CallDepthLogSink); ok {
+			return callDepthSink.WithCallDepth(1)
+		}
+
+		return sink
+	}
+
+	jsonMode := mode
+	defaultFormat := FormatJSON
+	if mode == "devel" || mode == "development" {
+		jsonMode = ""
+		defaultFormat = FormatConsole
+	}
+
+	dynamic := &Dynamic{
+		defaultFormat: defaultFormat,
+		json:          logAll(modeOptions(jsonMode)),
+		console:       logAll(modeOptions("devel")),
+	}
+	if err := dynamic.Update(Config{}); err != nil {
+		panic(err) // default configuration is always valid
+	}
+
+	return dynamic
+}
+
+// Logger returns the logger to be set as the root logger of the operator.
+func (d *Dynamic) Logger() logr.Logger {
+	return logr.New(&dynamicSink{dynamic: d, json: d.json, console: d.console})
+}
+
+// Update replaces the current configuration. Invalid configuration is rejected and the current one is kept.
+func (d *Dynamic) Update(config Config) error {
+	format := config.Format
+	if format == "" {
+		format = d.defaultFormat
+	}
+	if format != FormatJSON && format != FormatConsole {
+		return fmt.Errorf("unknown log format %q, expected %s or %s", format, FormatJSON, FormatConsole)
+	}
+
+	resolved := &resolvedConfig{console: format == FormatConsole, verbosities: make(map[string]int, len(config.Levels))}
+
+	var err error
+	if resolved.verbosity, err = verbosityOf(config.Level); err != nil {
+		return err
+	}
+	for name, level := range config.Levels {
+		if resolved.verbosities[strings.ToLower(name)], err = verbosityOf(level); err != nil {
+			return fmt.Errorf("invalid level of %s logger: %w", name, err)
+		}
+	}
+
+	d.config.Store(resolved)
+
+	return nil
+}
+
+func verbosityOf(level string) (int, error) {
+	if level == "" {
+		return levelVerbosity[LevelInfo], nil
+	}
+
+	verbosity, found := levelVerbosity[level]
+	if !found {
+		return 0, fmt.Errorf("unknown log level %q, expected %s, %s or %s", level, LevelError, LevelInfo, LevelDebug)
+	}
+
+	return verbosity, nil
+}
+
+// dynamicSink holds the logger derived for each of the formats, and delegates to the one of the current format.
+type dynamicSink struct {
+	dynamic       *Dynamic
+	names         []string
+	json, console logr.LogSink
+}
+
+var _ logr.CallDepthLogSink = (*dynamicSink)(nil)
+
+// Init has no effect, as sinks of the formats have already been initialized when created.
+func (s *dynamicSink) Init(logr.RuntimeInfo) {}
+
+func (s *dynamicSink) Enabled(level int) bool {
+	config := s.dynamic.config.Load()
+	for i := len(s.names) - 1; i >= 0; i-- {
+		if verbosity, found := config.verbosities[strings.ToLower(s.names[i])]; found {
+			return level <= verbosity
+		}
+	}
+
+	return level <= config.verbosity
+}
+
+func (s *dynamicSink) Info(level int, msg string, keysAndValues ...any) {
+	s.current().Info(level, msg, keysAndValues...)
+}
+
+func (s *dynamicSink) Error(err error, msg string, keysAndValues ...any) {
+	s.current().Error(err, msg, keysAndValues...)
+}
+
+func (s *dynamicSink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &dynamicSink{
+		dynamic: s.dynamic,
+		names:   s.names,
+		json:    s.json.WithValues(keysAndValues...),
+		console: s.console.WithValues(keysAndValues...),
+	}
+}
+
+func (s *dynamicSink) WithName(name string) logr.LogSink {
+	names := make([]string, 0, len(s.names)+1)
+	names = append(append(names, s.names...), strings.Split(name, ".")...)
+
+	return &dynamicSink{
+		dynamic: s.dynamic,
+		names:   names,
+		json:    s.json.WithName(name),
+		console: s.console.WithName(name),
+	}
+}
+
+func (s *dynamicSink) WithCallDepth(depth int) logr.LogSink {
+	withCallDepth := func(sink logr.LogSink) logr.LogSink {
+		if callDepthSink, ok := sink.(logr.CallDepthLogSink); ok {
+			return callDepthSink.WithCallDepth(depth)
+		}
+
+		return sink
+	}
+
+	return &dynamicSink{
+		dynamic: s.dynamic,
+		names:   s.names,
+		json:    withCallDepth(s.json),
+		console: withCallDepth(s.console),
+	}
+}
+
+func (s *dynamicSink) current() logr.LogSink {
+	if s.dynamic.config.Load().console {
+		return s.console
+	}
+
+	return s.json
+}
+
+// ConfigMapName is the name of the ConfigMap in the operator namespace holding the logging configuration of the operator.
+// It is read using ConfigFromData.
+const ConfigMapName = "operator-logging-config"
+
+const levelKeyPrefix = "level."
+
+// ConfigFromData reads Config from the data of ConfigMapName. Format is set using "format" key, level of all the loggers
+// using "level" key, and levels of particular controllers using "level.<name>" keys, e.g. "level.features".
+func ConfigFromData(data map[string]string) Config {
+	config := Config{
+		Format: strings.TrimSpace(data["format"]),
+		Level:  strings.TrimSpace(data["level"]),
+		Levels: map[string]string{},
+	}
+
+	for key, value := range data {
+		if name, found := strings.CutPrefix(key, levelKeyPrefix); found && name != "" {
+			config.Levels[name] = strings.TrimSpace(value)
+		}
+	}
+
+	return config
+}
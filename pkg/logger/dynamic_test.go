@@ -0,0 +1,104 @@
+package logger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dynamic logger", func() {
+
+	var (
+		out     *bytes.Buffer
+		dynamic *logger.Dynamic
+	)
+
+	lines := func() []string {
+		return strings.Split(strings.TrimSpace(out.String()), "\n")
+	}
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		dynamic = logger.NewDynamic("", out)
+	})
+
+	It("should log info messages as JSON by default", func() {
+		// given
+		log := dynamic.Logger().WithName("controllers").WithName("DSCInitialization")
+
+		// when
+		log.Info("reconciling")
+		log.V(1).Info("details")
+
+		// then
+		Expect(lines()).To(HaveLen(1))
+		Expect(json.Valid([]byte(lines()[0]))).To(BeTrue())
+		Expect(lines()[0]).To(ContainSubstring("reconciling"))
+	})
+
+	It("should apply level of the controller to loggers created before the update", func() {
+		// given
+		dsciLog := dynamic.Logger().WithName("controllers").WithName("DSCInitialization").WithValues("request", "default-dsci")
+		dscLog := dynamic.Logger().WithName("controllers").WithName("DataScienceCluster")
+
+		// when
+		Expect(dynamic.Update(logger.Config{Levels: map[string]string{"dscinitialization": logger.LevelDebug}})).To(Succeed())
+		dsciLog.V(1).Info("dsci details")
+		dscLog.V(1).Info("dsc details")
+
+		// then
+		Expect(lines()).To(HaveLen(1))
+		Expect(lines()[0]).To(And(ContainSubstring("dsci details"), ContainSubstring("default-dsci")))
+	})
+
+	It("should switch format and keep logging errors only", func() {
+		// given
+		log := dynamic.Logger().WithName("features")
+
+		// when
+		Expect(dynamic.Update(logger.Config{Format: logger.FormatConsole, Level: logger.LevelError})).To(Succeed())
+		log.Info("applying feature")
+		log.Error(errors.New("boom"), "failed applying feature")
+
+		// then
+		Expect(out.String()).ToNot(ContainSubstring("INFO"))
+		Expect(json.Valid([]byte(lines()[0]))).To(BeFalse())
+		Expect(lines()[0]).To(And(ContainSubstring("ERROR"), ContainSubstring("failed applying feature")))
+	})
+
+	It("should keep current configuration when update is invalid", func() {
+		// given
+		Expect(dynamic.Update(logger.Config{Level: logger.LevelError})).To(Succeed())
+
+		// when
+		err := dynamic.Update(logger.Config{Levels: map[string]string{"features": "verbose"}})
+		dynamic.Logger().Info("still filtered")
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`unknown log level "verbose"`)))
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("should read configuration from ConfigMap data", func() {
+		// when
+		config := logger.ConfigFromData(map[string]string{
+			"format":           "console",
+			"level":            "error",
+			"level.features":   " debug ",
+			"unrelated.option": "value",
+		})
+
+		// then
+		Expect(config).To(Equal(logger.Config{
+			Format: logger.FormatConsole,
+			Level:  logger.LevelError,
+			Levels: map[string]string{"features": logger.LevelDebug},
+		}))
+	})
+})
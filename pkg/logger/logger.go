@@ -28,6 +28,12 @@ func LogWithLevel(logger logr.Logger, level string) logr.Logger {
 // in DSC component, to use different mode for logging, e.g. development, production
 // when not set mode it falls to "default" which is used by startup main.go.
 func ConfigLoggers(mode string) logr.Logger {
+	opts := modeOptions(mode)
+
+	return zap.New(zap.UseFlagOptions(&opts))
+}
+
+func modeOptions(mode string) zap.Options {
 	var opts zap.Options
 	switch mode {
 	case "devel", "development": //  the most logging verbosity
@@ -62,5 +68,6 @@ func ConfigLoggers(mode string) logr.Logger {
 			DestWriter:      os.Stdout,
 		}
 	}
-	return zap.New(zap.UseFlagOptions(&opts))
+
+	return opts
 }
@@ -0,0 +1,46 @@
+package maintenance
+
+import (
+	"fmt"
+	"time"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+)
+
+// Window is a recurring period of time, starting as defined by the schedule and lasting for the given duration.
+type Window struct {
+	schedule *Schedule
+	duration time.Duration
+}
+
+// NewWindow creates a Window based on the DSCInitialization spec.
+func NewWindow(spec *dsciv1.MaintenanceWindow) (*Window, error) {
+	schedule, err := ParseSchedule(spec.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Duration.Duration <= 0 {
+		return nil, fmt.Errorf("invalid maintenance window duration %q: must be positive", spec.Duration.Duration)
+	}
+
+	return &Window{schedule: schedule, duration: spec.Duration.Duration}, nil
+}
+
+// IsOpen checks if the given time falls into any occurrence of the window.
+func (w *Window) IsOpen(now time.Time) bool {
+	// The most recent opening which could still be in progress is the first one after now - duration.
+	opening := w.schedule.Next(now.Add(-w.duration))
+
+	return !opening.IsZero() && !opening.After(now)
+}
+
+// NextOpening returns the time at which the window opens next, or the given time if the window is already open.
+// Zero time is returned if the window never opens.
+func (w *Window) NextOpening(now time.Time) time.Time {
+	if w.IsOpen(now) {
+		return now
+	}
+
+	return w.schedule.Next(now)
+}
@@ -0,0 +1,108 @@
+package maintenance_test
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/maintenance"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func at(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	Expect(err).ToNot(HaveOccurred())
+
+	return t
+}
+
+var _ = Describe("Maintenance window", func() {
+
+	Context("parsing schedule", func() {
+
+		DescribeTable("should reject invalid expressions",
+			func(expression string) {
+				_, err := maintenance.ParseSchedule(expression)
+
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("missing fields", "0 2 * *"),
+			Entry("value out of range", "60 2 * * *"),
+			Entry("inverted range", "0 5-2 * * *"),
+			Entry("invalid step", "*/0 * * * *"),
+			Entry("not a number", "0 two * * *"),
+		)
+
+		DescribeTable("should find next scheduled time",
+			func(expression, after, expected string) {
+				// given
+				schedule, err := maintenance.ParseSchedule(expression)
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				next := schedule.Next(at(after))
+
+				// then
+				Expect(next).To(Equal(at(expected)))
+			},
+			Entry("later the same day", "30 2 * * *", "2024-05-01T01:00:00Z", "2024-05-01T02:30:00Z"),
+			Entry("the next day", "30 2 * * *", "2024-05-01T02:30:00Z", "2024-05-02T02:30:00Z"),
+			Entry("on a given weekday", "0 2 * * 6", "2024-05-01T00:00:00Z", "2024-05-04T02:00:00Z"),
+			Entry("sunday as 7", "0 2 * * 7", "2024-05-01T00:00:00Z", "2024-05-05T02:00:00Z"),
+			Entry("using steps", "*/15 * * * *", "2024-05-01T00:16:00Z", "2024-05-01T00:30:00Z"),
+			Entry("using lists", "0 1,13 * * *", "2024-05-01T02:00:00Z", "2024-05-01T13:00:00Z"),
+			Entry("in the next year", "0 0 1 1 *", "2024-05-01T00:00:00Z", "2025-01-01T00:00:00Z"),
+			Entry("either day of month or day of week", "0 0 15 * 1", "2024-05-01T00:00:00Z", "2024-05-06T00:00:00Z"),
+		)
+
+		It("should not find next time for schedule which never fires", func() {
+			schedule, err := maintenance.ParseSchedule("0 0 30 2 *")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(schedule.Next(at("2024-05-01T00:00:00Z")).IsZero()).To(BeTrue())
+		})
+	})
+
+	Context("evaluating window", func() {
+
+		// every Saturday 2am-6am
+		window, err := maintenance.NewWindow(&dsciv1.MaintenanceWindow{
+			Schedule: "0 2 * * 6",
+			Duration: metav1.Duration{Duration: 4 * time.Hour},
+		})
+
+		It("should be created from spec", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		DescribeTable("checking if window is open",
+			func(now string, expected bool) {
+				Expect(window.IsOpen(at(now))).To(Equal(expected))
+			},
+			Entry("before the window", "2024-05-04T01:59:00Z", false),
+			Entry("when the window opens", "2024-05-04T02:00:00Z", true),
+			Entry("within the window", "2024-05-04T05:59:59Z", true),
+			Entry("when the window closes", "2024-05-04T06:00:00Z", false),
+			Entry("on another day", "2024-05-05T03:00:00Z", false),
+		)
+
+		It("should report next opening when closed", func() {
+			Expect(window.NextOpening(at("2024-05-01T00:00:00Z"))).To(Equal(at("2024-05-04T02:00:00Z")))
+		})
+
+		It("should report now as next opening when open", func() {
+			now := at("2024-05-04T03:00:00Z")
+
+			Expect(window.NextOpening(now)).To(Equal(now))
+		})
+
+		It("should reject non-positive duration", func() {
+			_, err := maintenance.NewWindow(&dsciv1.MaintenanceWindow{Schedule: "0 2 * * 6"})
+
+			Expect(err).To(MatchError(ContainSubstring("must be positive")))
+		})
+	})
+})
@@ -13,3 +13,81 @@ const (
 	SecretLengthAnnotation      = "secret-generator.opendatahub.io/complexity"
 	SecretOauthClientAnnotation = "secret-generator.opendatahub.io/oauth-client-route"
 )
+
+// Simulate holds a JSON merge patch of DSCInitialization spec for which the impact is computed and reported
+// in the status without applying it.
+const Simulate = "opendatahub.io/simulate"
+
+// AllowDeletion set to "true" on a namespace owned by the operator lifts the protection against its deletion
+// while the capability owning it is still Managed.
+const AllowDeletion = "opendatahub.io/allow-deletion"
+
+// AppliedDataDigest holds a digest of the data a disruptive feature has been last applied with. It is set on
+// the FeatureTracker and used to detect changes which have to wait for the maintenance window.
+const AppliedDataDigest = "features.opendatahub.io/applied-data-digest"
+
+// AppliedPatches holds original values of the fields of a resource not owned by the operator, which have been changed by
+// the features patching it. It is a JSON map keyed by the FeatureTracker name, used to revert the changes when the feature is removed.
+const AppliedPatches = "features.opendatahub.io/applied-patches"
+
+// PatchedResources lists resources not owned by the operator which have been patched by the feature. It is set on the FeatureTracker.
+const PatchedResources = "features.opendatahub.io/patched-resources"
+
+// Reapply set to "true" on a FeatureTracker re-applies just its feature, out of band of the reconciliation of the resource
+// defining it. The annotation is removed once the feature has been applied, the result is reported in the FeatureTracker status.
+const Reapply = "opendatahub.io/reapply"
+
+// ExportRedacted set to "true" on a Secret in the platform configuration export marks that its values have been left out.
+const ExportRedacted = "opendatahub.io/export-redacted"
+
+// OnDelete declares in the manifest what happens with the resource it defines when the feature applying it is deleted.
+// It is one of "remove", "revert-patch" or "orphan" (see resource.OnDeletePolicy).
+const OnDelete = "opendatahub.io/on-delete"
+
+// MeshEnrollment set to "true" in the manifest marks the resource as enrolling its namespace in the Service Mesh,
+// e.g. a ServiceMeshMember or a patch setting sidecar injection labels. Such resources are skipped for namespaces
+// labeled as opted out of the mesh (see labels.ServiceMeshDisabled).
+const MeshEnrollment = "opendatahub.io/mesh-enrollment"
+
+// GeneratedFrom holds the full name a derived, possibly shortened, name of the resource stands for (see naming.Namespace),
+// so that resources derived from different names, but shortened alike, are told apart.
+const GeneratedFrom = "opendatahub.io/generated-from"
+
+// AdoptionPolicy declares what happens when the resource rendered by the feature already exists, but has not been created by it.
+// It is one of "skip", "adopt" or "fail" (see resource.AdoptionPolicy).
+const AdoptionPolicy = "opendatahub.io/adoption-policy"
+
+// MetadataRepair declares how labels and annotations of the resource are reconciled when it already exists and has been created
+// by the same feature. It is one of "missing", "overwrite" or "none" (see resource.MetadataRepairPolicy).
+const MetadataRepair = "opendatahub.io/metadata-repair"
+
+// Authorization policies generated for a Service by the authorization-policies feature, see servicemesh.AuthorizationPolicies.
+const (
+	// AuthorizationPolicy lists comma-separated patterns protecting the Service, e.g. "allow-authenticated,anonymous-metrics".
+	AuthorizationPolicy = "security.opendatahub.io/authorization-policy"
+	// AllowedServiceAccounts lists comma-separated service accounts, as "namespace/name", allowed by the allow-serviceaccount pattern.
+	AllowedServiceAccounts = "security.opendatahub.io/allowed-service-accounts"
+	// AllowedGroups lists comma-separated groups allowed by the allow-group pattern.
+	AllowedGroups = "security.opendatahub.io/allowed-groups"
+)
+
+// Authorization set to "true" on a Route or a VirtualService protects the workloads it exposes with the authorization provider
+// configured in DSCInitialization, requiring requests to its hosts to carry a token of an authenticated user.
+const Authorization = "security.opendatahub.io/authorization"
+
+// ServingCertSecretName set on a Service makes the OpenShift service CA issue its serving certificate into the named secret.
+const ServingCertSecretName = "service.beta.openshift.io/serving-cert-secret-name"
+
+// ServingCertOriginatingService is set by the OpenShift service CA on secrets it issued, to the name of the Service.
+const ServingCertOriginatingService = "service.beta.openshift.io/originating-service-name"
+
+// ServingCertSecretConfigured records the secret of serving certificates the operator configured a serving component with,
+// from the serving-cert-refs ConfigMap, so that it is distinguished from the one configured by users.
+const ServingCertSecretConfigured = "opendatahub.io/serving-cert-secret"
+
+// CertManagerCertificateName is set by cert-manager on secrets it issued, to the name of the Certificate.
+const CertManagerCertificateName = "cert-manager.io/certificate-name"
+
+// RenderedFields holds, as JSON, the values of the ServiceMeshControlPlane fields managed by the operator, as it last configured them.
+// Changes made to these fields outside the operator are detected by comparing them with the recorded values (see smcp.DetectDrift).
+const RenderedFields = "opendatahub.io/rendered-fields"
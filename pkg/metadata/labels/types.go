@@ -5,24 +5,49 @@ const (
 	InjectTrustCA     = "config.openshift.io/inject-trusted-cabundle"
 	SecurityEnforce   = "pod-security.kubernetes.io/enforce"
 	ClusterMonitoring = "openshift.io/cluster-monitoring"
+	// MeshMemberOf is set by Service Mesh on namespaces enrolled in the mesh, to the namespace of the control plane.
+	MeshMemberOf = "maistra.io/member-of"
 )
 
 // K8SCommon keeps common kubernetes labels [1]
 // used across the project.
 // [1] (https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/#labels)
 var K8SCommon = struct {
-	PartOf string
+	PartOf    string
+	ManagedBy string
 }{
-	PartOf: "app.kubernetes.io/part-of",
+	PartOf:    "app.kubernetes.io/part-of",
+	ManagedBy: "app.kubernetes.io/managed-by",
 }
 
 // ODH holds Open Data Hub specific labels grouped by types.
 var ODH = struct {
-	OwnedNamespace string
-	Component      func(string) string
+	OwnedNamespace    string
+	SharedResource    string
+	Feature           string
+	FeatureSourceType string
+	FeatureSourceName string
+	ServiceMesh       string
+	Component         func(string) string
 }{
-	OwnedNamespace: "opendatahub.io/generated-namespace",
+	OwnedNamespace:    "opendatahub.io/generated-namespace",
+	SharedResource:    "opendatahub.io/shared-resource",
+	Feature:           "opendatahub.io/feature",
+	FeatureSourceType: "opendatahub.io/feature-source-type",
+	FeatureSourceName: "opendatahub.io/feature-source-name",
+	ServiceMesh:       "opendatahub.io/servicemesh",
 	Component: func(name string) string {
 		return ODHAppPrefix + "/" + name
 	},
 }
+
+// ServiceMeshDisabled set as the value of ODH.ServiceMesh label on a namespace exempts it from being enrolled
+// in the Service Mesh by the operator, e.g. for workloads which are incompatible with sidecars.
+const ServiceMeshDisabled = "disabled"
+
+// ServingCertRequested is set on Services whose serving certificate has been requested from the OpenShift service CA
+// by the operator, so that requests of Services no longer listed in spec.servingCertificates of DSCInitialization are withdrawn.
+const ServingCertRequested = "opendatahub.io/serving-cert-requested"
+
+// CanaryNamespace is set on the canary namespace created for canary rollout, so that it is removed once no canary is applied to it.
+const CanaryNamespace = "opendatahub.io/canary-namespace"
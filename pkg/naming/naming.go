@@ -0,0 +1,122 @@
+// Package naming derives names of resources generated by the operator from names chosen by users, e.g. "<appNs>-auth-provider"
+// from the applications namespace. Derived names are deterministic, so that the same resource is found on every reconciliation,
+// and fit the limits of the API server, so that long user-defined names do not make the operator fail.
+package naming
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// MaxNamespaceLength is the limit of namespace names, which have to be DNS-1123 labels.
+	MaxNamespaceLength = validation.DNS1123LabelMaxLength
+	// MaxResourceLength is the limit of names of most other resources, which have to be DNS-1123 subdomains.
+	MaxResourceLength = validation.DNS1123SubdomainMaxLength
+
+	hashLength = 8
+)
+
+// Join concatenates the parts using "-", without shortening the result. It is the full name a derived name stands for.
+func Join(parts ...string) string {
+	return strings.Join(parts, "-")
+}
+
+// Namespace derives the namespace name from the base, e.g. the applications namespace, and the suffix describing its purpose.
+func Namespace(base, suffix string) string {
+	return derive(MaxNamespaceLength, base, suffix)
+}
+
+// Resource derives the resource name from the base and the suffix describing its purpose.
+func Resource(base, suffix string) string {
+	return derive(MaxResourceLength, base, suffix)
+}
+
+// derive keeps the full name when it fits the limit. Otherwise the base is truncated and the hash of the full name is inserted
+// before the suffix, e.g. "<truncated base>-1a2b3c4d-auth-provider", so that different long bases still lead to different names.
+func derive(limit int, base, suffix string) string {
+	full := Join(base, suffix)
+	if len(full) <= limit {
+		return full
+	}
+
+	keep := max(limit-len(suffix)-hashLength-2, 0)
+	truncated := strings.TrimRight(base[:min(keep, len(base))], "-.")
+	if truncated == "" {
+		return Join(hashOf(full), suffix)
+	}
+
+	return Join(truncated, hashOf(full), suffix)
+}
+
+func hashOf(name string) string {
+	sum := sha256.Sum256([]byte(name))
+
+	return hex.EncodeToString(sum[:])[:hashLength]
+}
+
+// ValidateNamespace checks that the name can be used for a namespace.
+func ValidateNamespace(name string) error {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("invalid namespace name %q: %s", name, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// ValidateResource checks that the name can be used for resources requiring DNS-1123 subdomain names.
+func ValidateResource(name string) error {
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("invalid resource name %q: %s", name, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// Claim records that the name is used for the purpose described by Owner, e.g. "applications namespace".
+type Claim struct {
+	Name  string
+	Owner string
+	// Derived claims hold names generated by this package, which their owner has not chosen explicitly.
+	Derived bool
+}
+
+// Collision lists owners claiming the same name.
+type Collision struct {
+	Name   string
+	Owners []string
+}
+
+func (c Collision) String() string {
+	return fmt.Sprintf("%s is claimed by %s", c.Name, strings.Join(c.Owners, " and "))
+}
+
+// Collisions returns names claimed by more than one owner, where at least one of the claims is derived. Names chosen explicitly
+// can be shared on purpose, e.g. applications and monitoring namespace, while a derived name colliding with another one would
+// make two resources silently overwrite each other. Collisions are sorted by name.
+func Collisions(claims ...Claim) []Collision {
+	owners := map[string][]string{}
+	derived := map[string]bool{}
+	for _, claim := range claims {
+		owners[claim.Name] = append(owners[claim.Name], claim.Owner)
+		derived[claim.Name] = derived[claim.Name] || claim.Derived
+	}
+
+	var collisions []Collision
+	for name, nameOwners := range owners {
+		if len(nameOwners) > 1 && derived[name] {
+			collisions = append(collisions, Collision{Name: name, Owners: nameOwners})
+		}
+	}
+
+	sort.Slice(collisions, func(i, j int) bool {
+		return collisions[i].Name < collisions[j].Name
+	})
+
+	return collisions
+}
@@ -0,0 +1,90 @@
+package naming_test
+
+import (
+	"strings"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/naming"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Derived names", func() {
+
+	It("should keep names fitting the limit intact", func() {
+		Expect(naming.Namespace("opendatahub", "auth-provider")).To(Equal("opendatahub-auth-provider"))
+	})
+
+	It("should shorten long names deterministically keeping the suffix", func() {
+		// given
+		base := "team-" + strings.Repeat("a", 55)
+
+		// when
+		name := naming.Namespace(base, "auth-provider")
+
+		// then
+		Expect(name).To(HaveLen(naming.MaxNamespaceLength))
+		Expect(name).To(HaveSuffix("-auth-provider"))
+		Expect(name).To(HavePrefix("team-aaa"))
+		Expect(naming.ValidateNamespace(name)).To(Succeed())
+		Expect(naming.Namespace(base, "auth-provider")).To(Equal(name))
+	})
+
+	It("should derive different names for long bases sharing the prefix", func() {
+		// given
+		prefix := strings.Repeat("a", 55)
+
+		// when
+		first := naming.Namespace(prefix+"-first", "auth-provider")
+		second := naming.Namespace(prefix+"-second", "auth-provider")
+
+		// then
+		Expect(first).ToNot(Equal(second))
+	})
+
+	It("should not end the truncated base with a separator", func() {
+		// given
+		base := strings.Repeat("a", 39) + "-" + strings.Repeat("b", 30)
+
+		// when
+		name := naming.Namespace(base, "auth-provider")
+
+		// then
+		Expect(name).ToNot(ContainSubstring("--"))
+		Expect(naming.ValidateNamespace(name)).To(Succeed())
+	})
+
+	It("should reject invalid names", func() {
+		Expect(naming.ValidateNamespace("Opendatahub")).To(MatchError(ContainSubstring(`invalid namespace name "Opendatahub"`)))
+		Expect(naming.ValidateResource("odh.auth")).To(Succeed())
+	})
+})
+
+var _ = Describe("Name collisions", func() {
+
+	It("should report derived names claimed by other owners", func() {
+		// when
+		collisions := naming.Collisions(
+			naming.Claim{Name: "opendatahub", Owner: "applications namespace"},
+			naming.Claim{Name: "opendatahub-auth-provider", Owner: "monitoring namespace"},
+			naming.Claim{Name: "opendatahub-auth-provider", Owner: "authorization namespace", Derived: true},
+		)
+
+		// then
+		Expect(collisions).To(ConsistOf(naming.Collision{
+			Name:   "opendatahub-auth-provider",
+			Owners: []string{"monitoring namespace", "authorization namespace"},
+		}))
+	})
+
+	It("should allow explicitly chosen names to be shared", func() {
+		// when
+		collisions := naming.Collisions(
+			naming.Claim{Name: "opendatahub", Owner: "applications namespace"},
+			naming.Claim{Name: "opendatahub", Owner: "monitoring namespace"},
+		)
+
+		// then
+		Expect(collisions).To(BeEmpty())
+	})
+})
@@ -0,0 +1,144 @@
+// Package export renders the platform configuration, i.e. DSCInitialization, DataScienceCluster, FeatureTrackers and ConfigMaps
+// and Secrets managed by the operator, as a single YAML bundle, which disaster recovery runbooks can keep and apply to restore it.
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// Options define what the bundle contains.
+type Options struct {
+	// IncludeSecrets keeps data of the Secrets managed by the operator. Otherwise their values are emptied
+	// and the Secrets are annotated with annotations.ExportRedacted.
+	IncludeSecrets bool
+}
+
+// Bundle lists the platform configuration and renders it as multi-document YAML. Fields set by the API server, such as UIDs
+// and resource versions, and owner references, which would not match restored owners, are left out.
+func Bundle(ctx context.Context, cli client.Client, opts Options) ([]byte, error) {
+	managedByOperator := client.MatchingLabels{labels.K8SCommon.ManagedBy: cluster.OperatorName}
+
+	lists := []struct {
+		list    client.ObjectList
+		options []client.ListOption
+	}{
+		{list: &dsciv1.DSCInitializationList{}},
+		{list: &dscv1.DataScienceClusterList{}},
+		{list: &featurev1.FeatureTrackerList{}},
+		{list: &corev1.ConfigMapList{}, options: []client.ListOption{managedByOperator}},
+		{list: &corev1.SecretList{}, options: []client.ListOption{managedByOperator}},
+	}
+
+	var bundle bytes.Buffer
+	for _, l := range lists {
+		if err := cli.List(ctx, l.list, l.options...); err != nil {
+			return nil, fmt.Errorf("failed listing %T: %w", l.list, err)
+		}
+
+		objects, err := exportedObjects(cli.Scheme(), l.list, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objects {
+			document, errMarshal := yaml.Marshal(obj.Object)
+			if errMarshal != nil {
+				return nil, fmt.Errorf("failed rendering %s %s: %w", obj.GetKind(), obj.GetName(), errMarshal)
+			}
+
+			bundle.WriteString("---\n")
+			bundle.Write(document)
+		}
+	}
+
+	return bundle.Bytes(), nil
+}
+
+// exportedObjects converts items of the list to objects sorted by namespace and name, with their kind set and server-side fields removed.
+func exportedObjects(scheme *runtime.Scheme, list client.ObjectList, opts Options) ([]*unstructured.Unstructured, error) {
+	items, err := apimeta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*unstructured.Unstructured, 0, len(items))
+	for _, item := range items {
+		gvk, errGVK := apiutil.GVKForObject(item, scheme)
+		if errGVK != nil {
+			return nil, errGVK
+		}
+
+		content, errConvert := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		obj := &unstructured.Unstructured{Object: content}
+		obj.SetGroupVersionKind(gvk)
+		withoutServerFields(obj)
+		if gvk.Kind == "Secret" && !opts.IncludeSecrets {
+			redact(obj)
+		}
+
+		objects = append(objects, obj)
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].GetNamespace() != objects[j].GetNamespace() {
+			return objects[i].GetNamespace() < objects[j].GetNamespace()
+		}
+
+		return objects[i].GetName() < objects[j].GetName()
+	})
+
+	return objects, nil
+}
+
+func withoutServerFields(obj *unstructured.Unstructured) {
+	obj.SetUID("")
+	obj.SetResourceVersion("")
+	obj.SetGeneration(0)
+	obj.SetManagedFields(nil)
+	obj.SetOwnerReferences(nil)
+	unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+}
+
+// redact keeps the keys of the Secret, so that runbooks know which values to provide, but not the values.
+func redact(obj *unstructured.Unstructured) {
+	for _, field := range []string{"data", "stringData"} {
+		values, found, _ := unstructured.NestedMap(obj.Object, field)
+		if !found {
+			continue
+		}
+
+		for key := range values {
+			values[key] = ""
+		}
+		_ = unstructured.SetNestedMap(obj.Object, values, field)
+	}
+
+	objAnnotations := obj.GetAnnotations()
+	if objAnnotations == nil {
+		objAnnotations = map[string]string{}
+	}
+	objAnnotations[annotations.ExportRedacted] = "true"
+	obj.SetAnnotations(objAnnotations)
+}
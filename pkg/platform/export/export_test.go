@@ -0,0 +1,153 @@
+package export_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/export"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Platform configuration export", func() {
+
+	var cli client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		utilruntime.Must(dsciv1.AddToScheme(scheme))
+		utilruntime.Must(dscv1.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+
+		managedByOperator := map[string]string{labels.K8SCommon.ManagedBy: cluster.OperatorName}
+		tracker := featurev1.NewFeatureTracker("mesh-shared-configmap", "opendatahub")
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				&dsciv1.DSCInitialization{
+					ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+					Spec:       dsciv1.DSCInitializationSpec{ApplicationsNamespace: "opendatahub"},
+				},
+				&dscv1.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"}},
+				tracker,
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "service-mesh-refs", Namespace: "opendatahub", Labels: managedByOperator,
+						OwnerReferences: []metav1.OwnerReference{tracker.ToOwnerReference()},
+					},
+					Data: map[string]string{"CONTROL_PLANE_NAME": "data-science-smcp"},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "user-config", Namespace: "opendatahub"},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "dashboard-oauth-client", Namespace: "opendatahub", Labels: managedByOperator},
+					Data:       map[string][]byte{"secret": []byte("s3cr3t")},
+				},
+			).
+			Build()
+	})
+
+	documentsOf := func(bundle []byte) []*unstructured.Unstructured {
+		var documents []*unstructured.Unstructured
+		decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(string(bundle)), 4096)
+		for {
+			obj := &unstructured.Unstructured{}
+			if err := decoder.Decode(&obj.Object); err != nil {
+				break
+			}
+			if len(obj.Object) > 0 {
+				documents = append(documents, obj)
+			}
+		}
+
+		return documents
+	}
+
+	find := func(documents []*unstructured.Unstructured, kind, name string) *unstructured.Unstructured {
+		for _, document := range documents {
+			if document.GetKind() == kind && document.GetName() == name {
+				return document
+			}
+		}
+
+		return nil
+	}
+
+	It("should bundle platform resources and resources managed by the operator", func(ctx context.Context) {
+		// when
+		bundle, err := export.Bundle(ctx, cli, export.Options{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		documents := documentsOf(bundle)
+		Expect(documents).To(HaveLen(5))
+		Expect(find(documents, "DSCInitialization", "default-dsci")).ToNot(BeNil())
+		Expect(find(documents, "DataScienceCluster", "default-dsc")).ToNot(BeNil())
+		Expect(find(documents, "FeatureTracker", "opendatahub-mesh-shared-configmap")).ToNot(BeNil())
+		Expect(find(documents, "ConfigMap", "user-config")).To(BeNil())
+
+		configMap := find(documents, "ConfigMap", "service-mesh-refs")
+		Expect(configMap).ToNot(BeNil())
+		Expect(configMap.GetResourceVersion()).To(BeEmpty())
+		Expect(configMap.GetOwnerReferences()).To(BeEmpty())
+		Expect(configMap.Object).To(HaveKeyWithValue("data", HaveKeyWithValue("CONTROL_PLANE_NAME", "data-science-smcp")))
+	})
+
+	It("should redact values of secrets unless they are included", func(ctx context.Context) {
+		// when
+		redacted, errRedacted := export.Bundle(ctx, cli, export.Options{})
+		included, errIncluded := export.Bundle(ctx, cli, export.Options{IncludeSecrets: true})
+
+		// then
+		Expect(errRedacted).ToNot(HaveOccurred())
+		Expect(errIncluded).ToNot(HaveOccurred())
+
+		redactedSecret := find(documentsOf(redacted), "Secret", "dashboard-oauth-client")
+		Expect(redactedSecret.Object).To(HaveKeyWithValue("data", HaveKeyWithValue("secret", "")))
+		Expect(redactedSecret.GetAnnotations()).To(HaveKeyWithValue(annotations.ExportRedacted, "true"))
+
+		includedSecret := find(documentsOf(included), "Secret", "dashboard-oauth-client")
+		Expect(includedSecret.Object).To(HaveKeyWithValue("data", HaveKeyWithValue("secret", "czNjcjN0")))
+		Expect(includedSecret.GetAnnotations()).ToNot(HaveKey(annotations.ExportRedacted))
+	})
+
+	It("should write the bundle to the directory", func(ctx context.Context) {
+		// given
+		dir := GinkgoT().TempDir()
+		writerCtx, cancel := context.WithCancel(ctx)
+		writer := &export.Writer{Client: cli, Log: GinkgoLogr, Dir: dir, Period: time.Hour}
+
+		// when
+		go func() {
+			defer GinkgoRecover()
+			Expect(writer.Start(writerCtx)).To(Succeed())
+		}()
+		DeferCleanup(cancel)
+
+		// then
+		Eventually(func() ([]byte, error) {
+			return os.ReadFile(filepath.Join(dir, export.FileName))
+		}).Should(ContainSubstring("name: default-dsci"))
+	})
+})
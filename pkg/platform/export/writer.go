@@ -0,0 +1,67 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FileName of the bundle written by Writer.
+const FileName = "platform-config.yaml"
+
+// Writer periodically writes the bundle to a directory, e.g. on a PersistentVolume mounted in the operator pod,
+// so that the last known configuration survives loss of the cluster. It is meant to be added to the manager.
+type Writer struct {
+	Client  client.Client
+	Log     logr.Logger
+	Dir     string
+	Period  time.Duration
+	Options Options
+}
+
+// Start writes the bundle right away and then every Period, until the context is done. Failures are logged and retried in the next period.
+func (w *Writer) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := w.write(ctx); err != nil {
+			w.Log.Error(err, "failed writing platform configuration export", "dir", w.Dir)
+		}
+	}, w.Period)
+
+	return nil
+}
+
+// NeedLeaderElection makes only the leader write the bundle, as replicas would write the same file.
+func (w *Writer) NeedLeaderElection() bool {
+	return true
+}
+
+// write replaces the file atomically, so that a failure never leaves a partial bundle behind.
+func (w *Writer) write(ctx context.Context) error {
+	bundle, err := Bundle(ctx, w.Client, w.Options)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(w.Dir, FileName+".*")
+	if err != nil {
+		return fmt.Errorf("failed creating temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(bundle); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("failed writing temporary file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed writing temporary file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), filepath.Join(w.Dir, FileName))
+}
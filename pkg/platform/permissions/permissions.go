@@ -0,0 +1,270 @@
+// Package permissions derives the least-privilege ClusterRole the platform controllers need from the resources they actually manage,
+// and reports permissions granted to the operator beyond it, so that security-conscious clusters can trim the operator's broad defaults.
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// ManagingVerbs are granted on every resource of the inventory, as features create, update and garbage collect them.
+var ManagingVerbs = []string{"create", "delete", "get", "list", "patch", "update", "watch"} //nolint:gochecknoglobals // Reason: read-only list of verbs
+
+// baseline lists resources managed by the platform controllers regardless of the applied features: FeatureTrackers recording
+// the inventory and ConfigMaps through which platform references, such as the Service Mesh control plane, are published.
+var baseline = []schema.GroupResource{ //nolint:gochecknoglobals // Reason: read-only list of resources
+	{Group: featurev1.GroupVersion.Group, Resource: "featuretrackers"},
+	{Group: "", Resource: "configmaps"},
+}
+
+// Inventory holds the resources managed by the platform controllers, and the rules they grant to controllers extending the platform.
+type Inventory struct {
+	resources sets.Set[schema.GroupResource]
+	rules     []rbacv1.PolicyRule
+}
+
+// NewInventory creates inventory of the given resources and the ones managed regardless of the applied features.
+func NewInventory(resources ...schema.GroupResource) Inventory {
+	return Inventory{resources: sets.New(baseline...).Insert(resources...)}
+}
+
+// Resources returns resources of the inventory sorted by group and resource.
+func (i Inventory) Resources() []schema.GroupResource {
+	return sorted(i.resources.UnsortedList())
+}
+
+func sorted(resources []schema.GroupResource) []schema.GroupResource {
+	sort.Slice(resources, func(a, b int) bool {
+		if resources[a].Group != resources[b].Group {
+			return resources[a].Group < resources[b].Group
+		}
+
+		return resources[a].Resource < resources[b].Resource
+	})
+
+	return resources
+}
+
+// CollectInventory finds kinds of resources created by features. Cluster-scoped ones are read from the FeatureTrackers recording them,
+// while namespaced ones are looked up using the feature label only in the namespaces recorded by the FeatureTrackers, so that
+// the cluster is not listed as a whole. Rules of ClusterRoles granted to controllers extending the platform are collected as well,
+// as the operator cannot grant permissions it does not hold. Resources which cannot be listed, e.g. because the caller is
+// not allowed to, are skipped.
+func CollectInventory(ctx context.Context, cli client.Client, discoveryClient discovery.ServerResourcesInterface) (Inventory, error) {
+	trackers := &featurev1.FeatureTrackerList{}
+	if err := cli.List(ctx, trackers); err != nil {
+		return Inventory{}, fmt.Errorf("failed listing FeatureTrackers: %w", err)
+	}
+
+	clusterKinds := sets.New[schema.GroupVersionKind]()
+	namespaces := sets.New[string]()
+	for i := range trackers.Items {
+		for _, tracked := range trackers.Items[i].Status.ClusterResources {
+			clusterKinds.Insert(schema.FromAPIVersionAndKind(tracked.APIVersion, tracked.Kind))
+		}
+		for _, tracked := range trackers.Items[i].Status.Namespaces {
+			namespaces.Insert(tracked.Name)
+		}
+	}
+
+	_, resourceLists, err := discoveryClient.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return Inventory{}, fmt.Errorf("failed discovering resources: %w", err)
+	}
+
+	inventory := NewInventory()
+	for _, resourceList := range resourceLists {
+		gv, errParse := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if errParse != nil {
+			return Inventory{}, errParse
+		}
+
+		for _, apiResource := range resourceList.APIResources {
+			groupResource := gv.WithResource(apiResource.Name).GroupResource()
+			if inventory.resources.Has(groupResource) || strings.Contains(apiResource.Name, "/") {
+				continue
+			}
+
+			if !apiResource.Namespaced {
+				if clusterKinds.Has(gv.WithKind(apiResource.Kind)) {
+					inventory.resources.Insert(groupResource)
+				}
+
+				continue
+			}
+
+			if !sets.New(apiResource.Verbs...).Has("list") {
+				continue
+			}
+
+			found, errList := hasFeatureResources(ctx, cli, gv.WithKind(apiResource.Kind), sets.List(namespaces))
+			if errList != nil {
+				return Inventory{}, errList
+			}
+			if found {
+				inventory.resources.Insert(groupResource)
+			}
+		}
+	}
+
+	rules, errRules := grantedPlatformRules(ctx, cli)
+	if errRules != nil {
+		return Inventory{}, errRules
+	}
+	if len(rules) > 0 {
+		inventory.rules = rules
+		inventory.resources.Insert(
+			schema.GroupResource{Group: rbacv1.GroupName, Resource: "clusterroles"},
+			schema.GroupResource{Group: rbacv1.GroupName, Resource: "clusterrolebindings"},
+		)
+	}
+
+	return inventory, nil
+}
+
+func hasFeatureResources(ctx context.Context, cli client.Client, gvk schema.GroupVersionKind, namespaces []string) (bool, error) {
+	for _, namespace := range namespaces {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+		if err := cli.List(ctx, list, client.InNamespace(namespace), client.HasLabels{labels.ODH.Feature}, client.Limit(1)); err != nil {
+			if k8serr.IsForbidden(err) || k8serr.IsNotFound(err) || k8serr.IsMethodNotSupported(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("failed listing %s resources in namespace %s: %w", gvk.Kind, namespace, err)
+		}
+
+		if len(list.Items) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// grantedPlatformRules reads rules of the ClusterRoles granted to controllers extending the platform, as reported
+// by PlatformCapability resources.
+func grantedPlatformRules(ctx context.Context, cli client.Client) ([]rbacv1.PolicyRule, error) {
+	capabilities := &featurev1.PlatformCapabilityList{}
+	if err := cli.List(ctx, capabilities); err != nil {
+		if k8serr.IsForbidden(err) || meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed listing PlatformCapabilities: %w", err)
+	}
+
+	var rules []rbacv1.PolicyRule
+	for i := range capabilities.Items {
+		name := capabilities.Items[i].Status.ClusterRole
+		if name == "" {
+			continue
+		}
+
+		role := &rbacv1.ClusterRole{}
+		if err := cli.Get(ctx, client.ObjectKey{Name: name}, role); err != nil {
+			if k8serr.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed getting ClusterRole %s: %w", name, err)
+		}
+		rules = append(rules, role.Rules...)
+	}
+
+	return rules, nil
+}
+
+// LeastPrivilegeRole renders ClusterRole granting ManagingVerbs on resources of the inventory, with a single rule per API group,
+// followed by the rules granted to controllers extending the platform.
+func LeastPrivilegeRole(name string, inventory Inventory) *rbacv1.ClusterRole {
+	role := &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+
+	for _, groupResource := range inventory.Resources() {
+		last := len(role.Rules) - 1
+		if last >= 0 && role.Rules[last].APIGroups[0] == groupResource.Group {
+			role.Rules[last].Resources = append(role.Rules[last].Resources, groupResource.Resource)
+
+			continue
+		}
+
+		role.Rules = append(role.Rules, rbacv1.PolicyRule{
+			APIGroups: []string{groupResource.Group},
+			Resources: []string{groupResource.Resource},
+			Verbs:     ManagingVerbs,
+		})
+	}
+
+	role.Rules = append(role.Rules, inventory.rules...)
+
+	return role
+}
+
+// ExcessPermissions returns the parts of the granted rules which are not required, one rule for each API group and resource,
+// with the verbs not required on it. Wildcards are never required, therefore rules granting them are reported as they are.
+// Rules granting non-resource URLs are not reported, as they are not derived from the inventory.
+func ExcessPermissions(granted, required []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	requiredVerbs := map[schema.GroupResource]sets.Set[string]{}
+	for _, rule := range required {
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				groupResource := schema.GroupResource{Group: group, Resource: resource}
+				if requiredVerbs[groupResource] == nil {
+					requiredVerbs[groupResource] = sets.New[string]()
+				}
+				requiredVerbs[groupResource].Insert(rule.Verbs...)
+			}
+		}
+	}
+
+	excessVerbs := map[schema.GroupResource]sets.Set[string]{}
+	for _, rule := range granted {
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				groupResource := schema.GroupResource{Group: group, Resource: resource}
+				excess := sets.New(rule.Verbs...).Difference(requiredVerbs[groupResource])
+				if excess.Len() == 0 {
+					continue
+				}
+				if excessVerbs[groupResource] == nil {
+					excessVerbs[groupResource] = sets.New[string]()
+				}
+				excessVerbs[groupResource].Insert(excess.UnsortedList()...)
+			}
+		}
+	}
+
+	excessResources := make([]schema.GroupResource, 0, len(excessVerbs))
+	for groupResource := range excessVerbs {
+		excessResources = append(excessResources, groupResource)
+	}
+
+	excess := make([]rbacv1.PolicyRule, 0, len(excessVerbs))
+	for _, groupResource := range sorted(excessResources) {
+		excess = append(excess, rbacv1.PolicyRule{
+			APIGroups: []string{groupResource.Group},
+			Resources: []string{groupResource.Resource},
+			Verbs:     sets.List(excessVerbs[groupResource]),
+		})
+	}
+
+	return excess
+}
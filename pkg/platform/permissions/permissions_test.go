@@ -0,0 +1,193 @@
+package permissions_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/permissions"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Least-privilege permissions", func() {
+
+	var (
+		cli       client.Client
+		discovery *fakediscovery.FakeDiscovery
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		utilruntime.Must(networkingv1.AddToScheme(scheme))
+		utilruntime.Must(rbacv1.AddToScheme(scheme))
+		utilruntime.Must(featurev1.AddToScheme(scheme))
+
+		featureLabels := map[string]string{labels.ODH.Feature: "mesh-control-plane-creation"}
+		cli = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "oauth2-secret", Namespace: "istio-system", Labels: featureLabels}},
+				&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "mesh-policy", Namespace: "opendatahub", Labels: featureLabels}},
+				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "not-created-by-feature", Namespace: "opendatahub"}},
+				&featurev1.FeatureTracker{
+					ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-mesh-control-plane-creation"},
+					Status: featurev1.FeatureTrackerStatus{
+						Namespaces:       []featurev1.TrackedNamespace{{Name: "istio-system"}, {Name: "opendatahub"}},
+						ClusterResources: []featurev1.TrackedResource{{APIVersion: "networking.k8s.io/v1", Kind: "IngressClass", Name: "mesh"}},
+					},
+				},
+				&rbacv1.ClusterRole{
+					ObjectMeta: metav1.ObjectMeta{Name: "controller-manager-role"},
+					Rules: []rbacv1.PolicyRule{
+						{APIGroups: []string{""}, Resources: []string{"secrets", "services"}, Verbs: []string{"get", "list", "watch", "create", "delete"}},
+						{APIGroups: []string{"networking.k8s.io"}, Resources: []string{"networkpolicies"}, Verbs: []string{"*"}},
+						{APIGroups: []string{"features.opendatahub.io"}, Resources: []string{"featuretrackers"}, Verbs: []string{"get", "list"}},
+						{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}},
+					},
+				},
+				&rbacv1.ClusterRoleBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "controller-manager-rolebinding"},
+					Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "opendatahub-operator-system", Name: "controller-manager"}},
+					RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "controller-manager-role"},
+				},
+			).
+			Build()
+
+		discovery = &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{
+			Resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"get", "list"}},
+						{Name: "services", Kind: "Service", Namespaced: true, Verbs: []string{"get", "list"}},
+						{Name: "services/status", Kind: "Service", Namespaced: true, Verbs: []string{"get", "list"}},
+						{Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: []string{"create"}},
+					},
+				},
+				{
+					GroupVersion: "networking.k8s.io/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "networkpolicies", Kind: "NetworkPolicy", Namespaced: true, Verbs: []string{"get", "list"}},
+						{Name: "ingressclasses", Kind: "IngressClass", Namespaced: false, Verbs: []string{"get", "list"}},
+						{Name: "ingresses", Kind: "Ingress", Namespaced: true, Verbs: []string{"get", "list"}},
+					},
+				},
+			},
+		}}
+	})
+
+	It("should collect resources recorded by FeatureTrackers and the ones managed regardless of them", func(ctx context.Context) {
+		// when
+		inventory, err := permissions.CollectInventory(ctx, cli, discovery)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inventory.Resources()).To(Equal([]schema.GroupResource{
+			{Group: "", Resource: "configmaps"},
+			{Group: "", Resource: "secrets"},
+			{Group: "features.opendatahub.io", Resource: "featuretrackers"},
+			{Group: "networking.k8s.io", Resource: "ingressclasses"},
+			{Group: "networking.k8s.io", Resource: "networkpolicies"},
+		}))
+	})
+
+	It("should only look up namespaced resources in namespaces recorded by FeatureTrackers", func(ctx context.Context) {
+		// given
+		Expect(cli.Create(ctx, &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{
+			Name: "outside-of-tracked-namespaces", Namespace: "other", Labels: map[string]string{labels.ODH.Feature: "mesh-control-plane-creation"},
+		}})).To(Succeed())
+
+		// when
+		inventory, err := permissions.CollectInventory(ctx, cli, discovery)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inventory.Resources()).ToNot(ContainElement(schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"}))
+	})
+
+	It("should require rules granted to controllers extending the platform", func(ctx context.Context) {
+		// given
+		routes := rbacv1.PolicyRule{APIGroups: []string{"route.openshift.io"}, Resources: []string{"routes"}, Verbs: permissions.ManagingVerbs}
+		Expect(cli.Create(ctx, &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: "odh-platform-capability-odh-platform"},
+			Rules:      []rbacv1.PolicyRule{routes},
+		})).To(Succeed())
+		Expect(cli.Create(ctx, &featurev1.PlatformCapability{
+			ObjectMeta: metav1.ObjectMeta{Name: "odh-platform"},
+			Status:     featurev1.PlatformCapabilityStatus{ClusterRole: "odh-platform-capability-odh-platform"},
+		})).To(Succeed())
+
+		// when
+		inventory, err := permissions.CollectInventory(ctx, cli, discovery)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inventory.Resources()).To(ContainElements(
+			schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"},
+			schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"},
+		))
+		Expect(permissions.LeastPrivilegeRole("least-privilege", inventory).Rules).To(ContainElement(routes))
+	})
+
+	It("should render a single rule for each API group", func() {
+		// given
+		inventory := permissions.NewInventory(
+			schema.GroupResource{Group: "", Resource: "secrets"},
+			schema.GroupResource{Group: "maistra.io", Resource: "servicemeshcontrolplanes"},
+		)
+
+		// when
+		role := permissions.LeastPrivilegeRole("least-privilege", inventory)
+
+		// then
+		Expect(role.Name).To(Equal("least-privilege"))
+		Expect(role.Rules).To(Equal([]rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"configmaps", "secrets"}, Verbs: permissions.ManagingVerbs},
+			{APIGroups: []string{"features.opendatahub.io"}, Resources: []string{"featuretrackers"}, Verbs: permissions.ManagingVerbs},
+			{APIGroups: []string{"maistra.io"}, Resources: []string{"servicemeshcontrolplanes"}, Verbs: permissions.ManagingVerbs},
+		}))
+	})
+
+	It("should report permissions granted to the service account which are not required", func(ctx context.Context) {
+		// given
+		serviceAccount := types.NamespacedName{Namespace: "opendatahub-operator-system", Name: "controller-manager"}
+
+		// when
+		report, err := permissions.GenerateReport(ctx, cli, discovery, serviceAccount)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Granted).To(Equal("opendatahub-operator-system/controller-manager"))
+		Expect(report.Excess).To(Equal([]rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"services"}, Verbs: []string{"create", "delete", "get", "list", "watch"}},
+			{APIGroups: []string{"networking.k8s.io"}, Resources: []string{"networkpolicies"}, Verbs: []string{"*"}},
+		}))
+	})
+
+	It("should only render the role when no service account is given", func(ctx context.Context) {
+		// when
+		report, err := permissions.GenerateReport(ctx, cli, discovery, types.NamespacedName{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Role.Name).To(Equal(permissions.RoleName))
+		Expect(report.Granted).To(BeEmpty())
+		Expect(report.Excess).To(BeEmpty())
+	})
+})
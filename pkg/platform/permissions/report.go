@@ -0,0 +1,88 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RoleName is the name of the ClusterRole rendered in the Report.
+const RoleName = "opendatahub-platform-least-privilege"
+
+// Report holds the least-privilege role derived from the inventory and permissions granted beyond it.
+type Report struct {
+	// Role is the least-privilege ClusterRole required by the platform controllers.
+	Role *rbacv1.ClusterRole `json:"role"`
+	// Granted is the service account whose permissions are compared with Role, empty when no comparison has been requested.
+	Granted string `json:"granted,omitempty"`
+	// Excess lists permissions granted to the service account not required by the platform controllers.
+	Excess []rbacv1.PolicyRule `json:"excess,omitempty"`
+}
+
+// GenerateReport collects the inventory and derives the least-privilege role from it. When serviceAccount is not empty, rules of
+// the ClusterRoles bound to it, e.g. to the operator service account, are compared with the derived role.
+func GenerateReport(ctx context.Context, cli client.Client, discoveryClient discovery.ServerResourcesInterface,
+	serviceAccount types.NamespacedName) (Report, error) {
+	inventory, err := CollectInventory(ctx, cli, discoveryClient)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := Report{Role: LeastPrivilegeRole(RoleName, inventory)}
+	if serviceAccount.Name == "" {
+		return report, nil
+	}
+
+	granted, err := grantedRules(ctx, cli, serviceAccount)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report.Granted = serviceAccount.String()
+	report.Excess = ExcessPermissions(granted, report.Role.Rules)
+
+	return report, nil
+}
+
+// grantedRules reads rules of the ClusterRoles bound to the service account using ClusterRoleBindings.
+func grantedRules(ctx context.Context, cli client.Client, serviceAccount types.NamespacedName) ([]rbacv1.PolicyRule, error) {
+	bindings := &rbacv1.ClusterRoleBindingList{}
+	if err := cli.List(ctx, bindings); err != nil {
+		return nil, fmt.Errorf("failed listing ClusterRoleBindings: %w", err)
+	}
+
+	var rules []rbacv1.PolicyRule
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		if binding.RoleRef.Kind != "ClusterRole" || !boundTo(binding.Subjects, serviceAccount) {
+			continue
+		}
+
+		role := &rbacv1.ClusterRole{}
+		if err := cli.Get(ctx, client.ObjectKey{Name: binding.RoleRef.Name}, role); err != nil {
+			if k8serr.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed getting ClusterRole %s: %w", binding.RoleRef.Name, err)
+		}
+		rules = append(rules, role.Rules...)
+	}
+
+	return rules, nil
+}
+
+func boundTo(subjects []rbacv1.Subject, serviceAccount types.NamespacedName) bool {
+	for _, subject := range subjects {
+		if subject.Kind == rbacv1.ServiceAccountKind && subject.Namespace == serviceAccount.Namespace && subject.Name == serviceAccount.Name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+// Package refs provides typed access to the ConfigMaps in which DSCInitialization publishes platform configuration,
+// such as the Service Mesh control plane and the authorization provider, for components relying on it.
+// Consumers should use it instead of reading the ConfigMap keys directly, so they keep working when the keys change.
+package refs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MeshRefsName is the name of the ConfigMap in the applications namespace referencing the Service Mesh control plane.
+	MeshRefsName = "service-mesh-refs"
+	// AuthRefsName is the name of the ConfigMap in the applications namespace referencing the authorization provider.
+	AuthRefsName = "auth-refs"
+)
+
+// Keys of the MeshRefsName ConfigMap.
+const (
+	ControlPlaneNameKey = "CONTROL_PLANE_NAME"
+	MeshNamespaceKey    = "MESH_NAMESPACE"
+)
+
+// Keys of the AuthRefsName ConfigMap.
+const (
+	// AuthAudienceKey holds comma-separated audiences defined for all components.
+	AuthAudienceKey = "AUTH_AUDIENCE"
+	// AuthAudiencesKey holds JSON object of audiences resolved for each of the components.
+	AuthAudiencesKey  = "AUTH_AUDIENCES"
+	AuthProviderKey   = "AUTH_PROVIDER"
+	AuthNamespaceKey  = "AUTH_NAMESPACE"
+	AuthorinoLabelKey = "AUTHORINO_LABEL"
+)
+
+// MeshRefs references the Service Mesh control plane configured in DSCInitialization.
+type MeshRefs struct {
+	ControlPlaneName string
+	Namespace        string
+}
+
+// AuthRefs references the authorization provider configured in DSCInitialization.
+type AuthRefs struct {
+	// Audiences defined for all components, empty when the default audience of the cluster is used.
+	Audiences []string
+	// ComponentAudiences are audiences resolved for each of the components, see AudiencesOf.
+	ComponentAudiences map[string][]string
+	// Provider is the name of the authorization provider, e.g. "authorino".
+	Provider string
+	// Namespace in which the authorization provider is deployed.
+	Namespace string
+	// AuthorinoLabel is the label, in the "key=value" form, which AuthConfigs have to carry to be handled by the provider.
+	AuthorinoLabel string
+}
+
+// AudiencesOf returns the audiences the component accepts in tokens, falling back to the ones defined for all components.
+func (a AuthRefs) AudiencesOf(component string) []string {
+	if audiences, found := a.ComponentAudiences[component]; found {
+		return audiences
+	}
+
+	return a.Audiences
+}
+
+// GetMeshRefs reads MeshRefs published in the namespace. Not found error is returned as is, so callers can check it
+// using k8serr.IsNotFound, e.g. when Service Mesh is not managed.
+func GetMeshRefs(ctx context.Context, cli client.Reader, namespace string) (MeshRefs, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: MeshRefsName, Namespace: namespace}, configMap); err != nil {
+		return MeshRefs{}, fmt.Errorf("failed getting %s/%s: %w", namespace, MeshRefsName, err)
+	}
+
+	return meshRefsFrom(configMap)
+}
+
+// GetAuthRefs reads AuthRefs published in the namespace. Not found error is returned as is, so callers can check it
+// using k8serr.IsNotFound, e.g. when Service Mesh is not managed.
+func GetAuthRefs(ctx context.Context, cli client.Reader, namespace string) (AuthRefs, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: AuthRefsName, Namespace: namespace}, configMap); err != nil {
+		return AuthRefs{}, fmt.Errorf("failed getting %s/%s: %w", namespace, AuthRefsName, err)
+	}
+
+	return authRefsFrom(configMap)
+}
+
+func meshRefsFrom(configMap *corev1.ConfigMap) (MeshRefs, error) {
+	if err := requireKeys(configMap, ControlPlaneNameKey, MeshNamespaceKey); err != nil {
+		return MeshRefs{}, err
+	}
+
+	return MeshRefs{
+		ControlPlaneName: configMap.Data[ControlPlaneNameKey],
+		Namespace:        configMap.Data[MeshNamespaceKey],
+	}, nil
+}
+
+func authRefsFrom(configMap *corev1.ConfigMap) (AuthRefs, error) {
+	if err := requireKeys(configMap, AuthProviderKey, AuthNamespaceKey); err != nil {
+		return AuthRefs{}, err
+	}
+
+	refs := AuthRefs{
+		Provider:       configMap.Data[AuthProviderKey],
+		Namespace:      configMap.Data[AuthNamespaceKey],
+		AuthorinoLabel: configMap.Data[AuthorinoLabelKey],
+	}
+
+	for _, audience := range strings.Split(configMap.Data[AuthAudienceKey], ",") {
+		if trimmed := strings.TrimSpace(audience); trimmed != "" {
+			refs.Audiences = append(refs.Audiences, trimmed)
+		}
+	}
+
+	if componentAudiences := configMap.Data[AuthAudiencesKey]; componentAudiences != "" {
+		if err := json.Unmarshal([]byte(componentAudiences), &refs.ComponentAudiences); err != nil {
+			return AuthRefs{}, fmt.Errorf("invalid %s in %s/%s: %w", AuthAudiencesKey, configMap.Namespace, configMap.Name, err)
+		}
+	}
+
+	return refs, nil
+}
+
+func requireKeys(configMap *corev1.ConfigMap, keys ...string) error {
+	var missing []string
+	for _, key := range keys {
+		if configMap.Data[key] == "" {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s/%s is missing keys %v", configMap.Namespace, configMap.Name, missing)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package refs_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/platform/refs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Platform refs", func() {
+
+	const namespace = "opendatahub"
+
+	configMap := func(name string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       data,
+		}
+	}
+
+	authRefsData := map[string]string{
+		"AUTH_AUDIENCE":   "odh, https://kubernetes.default.svc",
+		"AUTH_AUDIENCES":  `{"kserve":["kserve-api"],"dashboard":["odh","https://kubernetes.default.svc"]}`,
+		"AUTH_PROVIDER":   "authorino",
+		"AUTH_NAMESPACE":  "opendatahub-auth-provider",
+		"AUTHORINO_LABEL": "security.opendatahub.io/authorization-group=default",
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	}
+
+	Context("getting", func() {
+
+		It("should read mesh refs", func(ctx context.Context) {
+			// given
+			cli := newClient(configMap(refs.MeshRefsName, map[string]string{"CONTROL_PLANE_NAME": "data-science-smcp", "MESH_NAMESPACE": "istio-system"}))
+
+			// when
+			meshRefs, err := refs.GetMeshRefs(ctx, cli, namespace)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(meshRefs).To(Equal(refs.MeshRefs{ControlPlaneName: "data-science-smcp", Namespace: "istio-system"}))
+		})
+
+		It("should read auth refs with audiences of components", func(ctx context.Context) {
+			// given
+			cli := newClient(configMap(refs.AuthRefsName, authRefsData))
+
+			// when
+			authRefs, err := refs.GetAuthRefs(ctx, cli, namespace)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authRefs.Provider).To(Equal("authorino"))
+			Expect(authRefs.Namespace).To(Equal("opendatahub-auth-provider"))
+			Expect(authRefs.AuthorinoLabel).To(Equal("security.opendatahub.io/authorization-group=default"))
+			Expect(authRefs.AudiencesOf("kserve")).To(ConsistOf("kserve-api"))
+			Expect(authRefs.AudiencesOf("model-registry")).To(ConsistOf("odh", "https://kubernetes.default.svc"))
+		})
+
+		It("should report missing refs as not found", func(ctx context.Context) {
+			// when
+			_, err := refs.GetMeshRefs(ctx, newClient(), namespace)
+
+			// then
+			Expect(k8serr.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should refuse refs missing keys", func(ctx context.Context) {
+			// given
+			cli := newClient(configMap(refs.MeshRefsName, map[string]string{"CONTROL_PLANE_NAME": "data-science-smcp"}))
+
+			// when
+			_, err := refs.GetMeshRefs(ctx, cli, namespace)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("opendatahub/service-mesh-refs is missing keys [MESH_NAMESPACE]")))
+		})
+	})
+
+	Context("watching", func() {
+
+		It("should call back on changes of refs in the namespace", func(ctx context.Context) {
+			// given
+			informers := &informertest.FakeInformers{}
+			informer, err := informers.FakeInformerFor(ctx, &corev1.ConfigMap{})
+			Expect(err).ToNot(HaveOccurred())
+
+			var observed []*refs.MeshRefs
+			watcher := &refs.Watcher{
+				Namespace: namespace,
+				OnMeshRefs: func(meshRefs *refs.MeshRefs, err error) {
+					Expect(err).ToNot(HaveOccurred())
+					observed = append(observed, meshRefs)
+				},
+			}
+			Expect(watcher.Start(ctx, informers)).To(Succeed())
+
+			created := configMap(refs.MeshRefsName, map[string]string{"CONTROL_PLANE_NAME": "data-science-smcp", "MESH_NAMESPACE": "istio-system"})
+			relabeled := created.DeepCopy()
+			relabeled.Labels = map[string]string{"app": "odh"}
+			replaced := relabeled.DeepCopy()
+			replaced.Data["CONTROL_PLANE_NAME"] = "basic"
+			otherNamespace := created.DeepCopy()
+			otherNamespace.Namespace = "other"
+
+			// when
+			informer.Add(created)
+			informer.Add(otherNamespace)
+			informer.Update(created, relabeled)
+			informer.Update(relabeled, replaced)
+			informer.Delete(replaced)
+
+			// then
+			Expect(observed).To(Equal([]*refs.MeshRefs{
+				{ControlPlaneName: "data-science-smcp", Namespace: "istio-system"},
+				{ControlPlaneName: "basic", Namespace: "istio-system"},
+				nil,
+			}))
+		})
+	})
+})
@@ -0,0 +1,111 @@
+package refs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+// Watcher calls consumers back whenever refs published in the namespace change, e.g. to reconfigure a component
+// when the control plane is replaced. Callbacks get nil refs when the ConfigMap is removed, e.g. when Service Mesh
+// is no longer managed, and an error when the ConfigMap cannot be parsed.
+type Watcher struct {
+	Namespace  string
+	OnMeshRefs func(refs *MeshRefs, err error)
+	OnAuthRefs func(refs *AuthRefs, err error)
+}
+
+// Start registers the watcher with the ConfigMap informer, e.g. of the manager cache, until the context is done.
+// Callbacks are called for the existing ConfigMaps once the informer is synced and for each change of their data afterwards.
+func (w *Watcher) Start(ctx context.Context, informers cache.Informers) error {
+	informer, err := informers.GetInformer(ctx, &corev1.ConfigMap{})
+	if err != nil {
+		return fmt.Errorf("failed getting ConfigMap informer: %w", err)
+	}
+
+	registration, err := informer.AddEventHandler(toolscache.FilteringResourceEventHandler{
+		FilterFunc: func(obj any) bool {
+			configMap, ok := asConfigMap(obj)
+
+			return ok && configMap.Namespace == w.Namespace && (configMap.Name == MeshRefsName || configMap.Name == AuthRefsName)
+		},
+		Handler: toolscache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj any) {
+				configMap, _ := asConfigMap(obj)
+				w.changed(configMap)
+			},
+			UpdateFunc: func(oldObj, newObj any) {
+				oldConfigMap, _ := asConfigMap(oldObj)
+				newConfigMap, _ := asConfigMap(newObj)
+				if !reflect.DeepEqual(oldConfigMap.Data, newConfigMap.Data) {
+					w.changed(newConfigMap)
+				}
+			},
+			DeleteFunc: func(obj any) {
+				configMap, _ := asConfigMap(obj)
+				w.removed(configMap)
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed watching refs in %s: %w", w.Namespace, err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = informer.RemoveEventHandler(registration)
+	}()
+
+	return nil
+}
+
+func (w *Watcher) changed(configMap *corev1.ConfigMap) {
+	switch configMap.Name {
+	case MeshRefsName:
+		if w.OnMeshRefs != nil {
+			refs, err := meshRefsFrom(configMap)
+			if err != nil {
+				w.OnMeshRefs(nil, err)
+				return
+			}
+			w.OnMeshRefs(&refs, nil)
+		}
+	case AuthRefsName:
+		if w.OnAuthRefs != nil {
+			refs, err := authRefsFrom(configMap)
+			if err != nil {
+				w.OnAuthRefs(nil, err)
+				return
+			}
+			w.OnAuthRefs(&refs, nil)
+		}
+	}
+}
+
+func (w *Watcher) removed(configMap *corev1.ConfigMap) {
+	switch configMap.Name {
+	case MeshRefsName:
+		if w.OnMeshRefs != nil {
+			w.OnMeshRefs(nil, nil)
+		}
+	case AuthRefsName:
+		if w.OnAuthRefs != nil {
+			w.OnAuthRefs(nil, nil)
+		}
+	}
+}
+
+// asConfigMap also unwraps objects whose deletion has been missed by the informer.
+func asConfigMap(obj any) (*corev1.ConfigMap, bool) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	configMap, ok := obj.(*corev1.ConfigMap)
+
+	return configMap, ok
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -25,8 +26,31 @@ import (
 const (
 	CAConfigMapName = "odh-trusted-ca-bundle"
 	CADataFieldName = "odh-ca-bundle.crt"
+	// ClusterCADataFieldName is the key of CAConfigMapName under which the Cluster Network Operator injects the cluster trusted CA bundle.
+	ClusterCADataFieldName = "ca-bundle.crt"
+
+	// MeshCASecretName is the Secret holding the trusted CA bundle in namespaces of the mesh, as proxies read CA certificates
+	// referenced by DestinationRules originating TLS from Secrets only.
+	MeshCASecretName = "odh-trusted-ca-bundle-mesh"
+	// MeshCADataFieldName is the key of the CA certificates in MeshCASecretName understood by the proxies.
+	MeshCADataFieldName = "cacert"
 )
 
+// CombinedCABundle returns the cluster trusted CA bundle followed by the custom one, as held by the CAConfigMapName ConfigMap.
+func CombinedCABundle(configMap *corev1.ConfigMap) string {
+	bundles := make([]string, 0, 2)
+	for _, key := range []string{ClusterCADataFieldName, CADataFieldName} {
+		if bundle := strings.TrimSpace(configMap.Data[key]); bundle != "" {
+			bundles = append(bundles, bundle)
+		}
+	}
+	if len(bundles) == 0 {
+		return ""
+	}
+
+	return strings.Join(bundles, "\n") + "\n"
+}
+
 func ShouldInjectTrustedBundle(ns *corev1.Namespace) bool {
 	isActive := ns.Status.Phase == corev1.NamespaceActive
 	return isActive && cluster.IsNotReservedNamespace(ns) && !HasCABundleAnnotationDisabled(ns)
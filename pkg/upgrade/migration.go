@@ -0,0 +1,181 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// MigrationLedgerName is the name of the ConfigMap in the operator namespace recording completed migrations.
+// Keys are IDs of the migrations, values are versions of the operator which completed them.
+const MigrationLedgerName = "opendatahub-operator-migrations"
+
+// Migration changes resources left behind by previous versions of the operator, e.g. renames a ConfigMap or moves a Secret.
+// It is run once, on the first start of an operator version shipping it, and recorded in the ledger afterwards.
+// Migrations have to be idempotent, as they are run again when recording them fails.
+type Migration struct {
+	// ID identifies the migration in the ledger. It must not change once released.
+	ID string
+	// Version of the operator introducing the migration. Migrations are run ordered by it.
+	Version semver.Version
+	// Scope identifies the inputs the migration is run for, e.g. the namespaces it changes resources in. It is recorded
+	// in the ledger along with ID, so that the migration is run again when its scope changes, e.g. the namespaces are reconfigured.
+	Scope string
+	Run   func(ctx context.Context, cli client.Client) error
+}
+
+// ledgerKey is the key recording the migration in the ledger. Scope is hashed, as it may contain characters not allowed in keys.
+func (m Migration) ledgerKey() string {
+	if m.Scope == "" {
+		return m.ID
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(m.Scope))
+
+	return fmt.Sprintf("%s.%08x", m.ID, hash.Sum32())
+}
+
+// MigrationRunner runs pending migrations on operator startup.
+type MigrationRunner struct {
+	Client client.Client
+	Log    logr.Logger
+	// Namespace holding the ledger. When empty, e.g. when running outside the cluster, all migrations
+	// are run on every start, as there is nowhere to record them.
+	Namespace string
+	// OperatorVersion is recorded in the ledger along with completed migrations.
+	OperatorVersion string
+	Migrations      []Migration
+}
+
+// Run checks the migrations are well-defined and runs the ones missing in the ledger, ordered by version.
+// It stops on the first failing migration, as the following ones may rely on it.
+func (r *MigrationRunner) Run(ctx context.Context) error {
+	if err := validateMigrations(r.Migrations); err != nil {
+		return fmt.Errorf("invalid migrations: %w", err)
+	}
+
+	completed, err := r.completedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+	r.reportUnknownMigrations(completed)
+
+	migrations := append([]Migration{}, r.Migrations...)
+	sort.SliceStable(migrations, func(i, j int) bool {
+		return migrations[i].Version.LT(migrations[j].Version)
+	})
+
+	for _, migration := range migrations {
+		if _, done := completed[migration.ledgerKey()]; done {
+			continue
+		}
+
+		r.Log.Info("running migration", "migration", migration.ID, "version", migration.Version.String(), "scope", migration.Scope)
+		if errRun := migration.Run(ctx, r.Client); errRun != nil {
+			return fmt.Errorf("migration %s failed: %w", migration.ID, errRun)
+		}
+
+		if errRecord := r.record(ctx, migration.ledgerKey()); errRecord != nil {
+			return fmt.Errorf("failed recording migration %s: %w", migration.ID, errRecord)
+		}
+	}
+
+	return nil
+}
+
+func validateMigrations(migrations []Migration) error {
+	var multiErr *multierror.Error
+	ids := make(map[string]struct{}, len(migrations))
+	for _, migration := range migrations {
+		if msgs := validation.IsConfigMapKey(migration.ID); len(msgs) > 0 {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("id %q cannot be recorded in the ledger: %v", migration.ID, msgs))
+		}
+		if _, duplicate := ids[migration.ID]; duplicate {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("id %q is used by more than one migration", migration.ID))
+		}
+		ids[migration.ID] = struct{}{}
+
+		if migration.Run == nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("migration %q has nothing to run", migration.ID))
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+func (r *MigrationRunner) completedMigrations(ctx context.Context) (map[string]string, error) {
+	if r.Namespace == "" {
+		r.Log.Info("operator namespace is unknown, migrations are not recorded and run on every start")
+
+		return map[string]string{}, nil
+	}
+
+	ledger := &corev1.ConfigMap{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: MigrationLedgerName, Namespace: r.Namespace}, ledger); err != nil {
+		if k8serr.IsNotFound(err) {
+			return map[string]string{}, nil
+		}
+
+		return nil, fmt.Errorf("failed reading migration ledger %s/%s: %w", r.Namespace, MigrationLedgerName, err)
+	}
+
+	if ledger.Data == nil {
+		return map[string]string{}, nil
+	}
+
+	return ledger.Data, nil
+}
+
+// reportUnknownMigrations logs migrations recorded in the ledger which the running operator does not know,
+// i.e. completed by a newer version of the operator before it has been downgraded. They are not reverted.
+// Records of known migrations run for another scope are not reported.
+func (r *MigrationRunner) reportUnknownMigrations(completed map[string]string) {
+	for key, version := range completed {
+		if !r.knowsLedgerKey(key) {
+			r.Log.Info("ledger records migration unknown to this operator version, it is not reverted", "migration", key, "completedBy", version)
+		}
+	}
+}
+
+func (r *MigrationRunner) knowsLedgerKey(key string) bool {
+	for _, migration := range r.Migrations {
+		if key == migration.ID || strings.HasPrefix(key, migration.ID+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *MigrationRunner) record(ctx context.Context, id string) error {
+	if r.Namespace == "" {
+		return nil
+	}
+
+	version := r.OperatorVersion
+	if version == "" {
+		version = "unknown"
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return cluster.CreateOrUpdateConfigMap(ctx, r.Client, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: MigrationLedgerName, Namespace: r.Namespace},
+			Data:       map[string]string{id: version},
+		})
+	})
+}
@@ -0,0 +1,221 @@
+package upgrade_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/blang/semver/v4"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Migrations", func() {
+
+	const operatorNamespace = "migrations-operator-ns"
+
+	var (
+		cli client.Client
+		ran []string
+	)
+
+	migration := func(id, version string, err error) upgrade.Migration {
+		return upgrade.Migration{
+			ID:      id,
+			Version: semver.MustParse(version),
+			Run: func(_ context.Context, _ client.Client) error {
+				ran = append(ran, id)
+				return err
+			},
+		}
+	}
+
+	runner := func(migrations ...upgrade.Migration) *upgrade.MigrationRunner {
+		return &upgrade.MigrationRunner{
+			Client:          cli,
+			Log:             ctrl.Log.WithName("migrations"),
+			Namespace:       operatorNamespace,
+			OperatorVersion: "2.20.0",
+			Migrations:      migrations,
+		}
+	}
+
+	ledger := func(ctx context.Context) map[string]string {
+		ledgerCM := &corev1.ConfigMap{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: upgrade.MigrationLedgerName, Namespace: operatorNamespace}, ledgerCM)).To(Succeed())
+
+		return ledgerCM.Data
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		cli = fake.NewClientBuilder().WithScheme(scheme).Build()
+		ran = nil
+	})
+
+	Context("running", func() {
+
+		It("should run pending migrations ordered by version and record them", func(ctx context.Context) {
+			// given
+			migrations := runner(
+				migration("relabel-namespaces", "2.19.0", nil),
+				migration("rename-auth-refs", "2.11.0", nil),
+			)
+
+			// when
+			err := migrations.Run(ctx)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ran).To(Equal([]string{"rename-auth-refs", "relabel-namespaces"}))
+			Expect(ledger(ctx)).To(Equal(map[string]string{"rename-auth-refs": "2.20.0", "relabel-namespaces": "2.20.0"}))
+		})
+
+		It("should run each migration only once", func(ctx context.Context) {
+			// given
+			Expect(runner(migration("rename-auth-refs", "2.11.0", nil)).Run(ctx)).To(Succeed())
+
+			// when
+			err := runner(
+				migration("rename-auth-refs", "2.11.0", nil),
+				migration("relabel-namespaces", "2.19.0", nil),
+			).Run(ctx)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ran).To(Equal([]string{"rename-auth-refs", "relabel-namespaces"}))
+		})
+
+		It("should run migration again when its scope changes", func(ctx context.Context) {
+			// given
+			scoped := func(scope string) upgrade.Migration {
+				m := migration("cleanup-deprecated-resources", "2.0.0", nil)
+				m.Scope = "applications=" + scope
+
+				return m
+			}
+			Expect(runner(scoped("opendatahub")).Run(ctx)).To(Succeed())
+			Expect(runner(scoped("opendatahub")).Run(ctx)).To(Succeed())
+
+			// when
+			err := runner(scoped("odh-applications")).Run(ctx)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ran).To(Equal([]string{"cleanup-deprecated-resources", "cleanup-deprecated-resources"}))
+			Expect(ledger(ctx)).To(HaveLen(2))
+			Expect(ledger(ctx)).To(HaveEach(Equal("2.20.0")))
+		})
+
+		It("should stop on the first failing migration without recording it", func(ctx context.Context) {
+			// given
+			migrations := runner(
+				migration("rename-auth-refs", "2.11.0", nil),
+				migration("relocate-secret", "2.12.0", errors.New("secret is locked")),
+				migration("relabel-namespaces", "2.19.0", nil),
+			)
+
+			// when
+			err := migrations.Run(ctx)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("migration relocate-secret failed: secret is locked")))
+			Expect(ran).To(Equal([]string{"rename-auth-refs", "relocate-secret"}))
+			Expect(ledger(ctx)).To(Equal(map[string]string{"rename-auth-refs": "2.20.0"}))
+		})
+
+		It("should refuse ill-defined migrations before running any of them", func(ctx context.Context) {
+			// given
+			migrations := runner(
+				migration("rename-auth-refs", "2.11.0", nil),
+				migration("rename-auth-refs", "2.12.0", nil),
+				upgrade.Migration{ID: "relabel namespaces", Version: semver.MustParse("2.19.0")},
+			)
+
+			// when
+			err := migrations.Run(ctx)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring(`id "rename-auth-refs" is used by more than one migration`)))
+			Expect(err).To(MatchError(ContainSubstring(`id "relabel namespaces" cannot be recorded in the ledger`)))
+			Expect(err).To(MatchError(ContainSubstring(`migration "relabel namespaces" has nothing to run`)))
+			Expect(ran).To(BeEmpty())
+		})
+	})
+
+	Context("steps", func() {
+
+		It("should rename configmap", func(ctx context.Context) {
+			// given
+			Expect(cli.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "auth-config", Namespace: "opendatahub", Labels: map[string]string{"app": "odh"}},
+				Data:       map[string]string{"AUTH_AUDIENCE": "odh"},
+			})).To(Succeed())
+
+			// when
+			err := upgrade.RenameConfigMap("opendatahub", "auth-config", "auth-refs")(ctx, cli)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			renamed := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "auth-refs", Namespace: "opendatahub"}, renamed)).To(Succeed())
+			Expect(renamed.Data).To(HaveKeyWithValue("AUTH_AUDIENCE", "odh"))
+			Expect(renamed.Labels).To(HaveKeyWithValue("app", "odh"))
+			Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: "auth-config", Namespace: "opendatahub"}, &corev1.ConfigMap{}))).To(BeTrue())
+		})
+
+		It("should relocate secret", func(ctx context.Context) {
+			// given
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "oauth-client", Namespace: "opendatahub"},
+				Type:       corev1.SecretTypeOpaque,
+				Data:       map[string][]byte{"secret": []byte("s3cr3t")},
+			})).To(Succeed())
+
+			// when
+			err := upgrade.RelocateSecret("oauth-client", "opendatahub", "opendatahub-auth-provider")(ctx, cli)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			relocated := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "oauth-client", Namespace: "opendatahub-auth-provider"}, relocated)).To(Succeed())
+			Expect(relocated.Data).To(HaveKeyWithValue("secret", []byte("s3cr3t")))
+			Expect(k8serr.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: "oauth-client", Namespace: "opendatahub"}, &corev1.Secret{}))).To(BeTrue())
+		})
+
+		It("should not fail when resource to move is gone", func(ctx context.Context) {
+			// when
+			err := upgrade.RenameConfigMap("opendatahub", "auth-config", "auth-refs")(ctx, cli)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should rename label keeping its value", func(ctx context.Context) {
+			// given
+			Expect(cli.Create(ctx, &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "opendatahub", Labels: map[string]string{"opendatahub.io/generated-namespace": "true"}},
+			})).To(Succeed())
+
+			// when
+			err := upgrade.RenameLabel(corev1.SchemeGroupVersion.WithKind("Namespace"), "opendatahub.io/generated-namespace", "opendatahub.io/owned-namespace")(ctx, cli)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			namespace := &corev1.Namespace{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "opendatahub"}, namespace)).To(Succeed())
+			Expect(namespace.Labels).To(Equal(map[string]string{"opendatahub.io/owned-namespace": "true"}))
+		})
+	})
+})
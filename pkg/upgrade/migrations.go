@@ -0,0 +1,126 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// Migrations lists the migrations shipped with the operator. New ones are appended with the version introducing them.
+// Namespaces of the active DSCInitialization take precedence over the given ones, as resources of previous releases
+// have been deployed to the namespaces it configures.
+func Migrations(ctx context.Context, cli client.Client, platform cluster.Platform, applicationsNamespace, monitoringNamespace string) []Migration {
+	dsciList := &dsciv1.DSCInitializationList{}
+	if err := cli.List(ctx, dsciList); err == nil {
+		if dsci := dsciList.ActiveInstance(); dsci != nil {
+			applicationsNamespace = dsci.Spec.ApplicationsNamespace
+			if dsci.Spec.Monitoring.Namespace != "" {
+				monitoringNamespace = dsci.Spec.Monitoring.Namespace
+			}
+		}
+	}
+
+	return []Migration{
+		{
+			ID:      "cleanup-deprecated-resources",
+			Version: semver.MustParse("2.0.0"),
+			Scope:   "applications=" + applicationsNamespace + ",monitoring=" + monitoringNamespace,
+			Run: func(ctx context.Context, cli client.Client) error {
+				return CleanupExistingResource(ctx, cli, platform, applicationsNamespace, monitoringNamespace)
+			},
+		},
+	}
+}
+
+// RenameConfigMap moves the content of the ConfigMap to a new one with the given name in the same namespace.
+// The ConfigMap already existing under the new name is kept as is, as it has been created by the operator or the user since.
+func RenameConfigMap(namespace, oldName, newName string) func(ctx context.Context, cli client.Client) error {
+	return func(ctx context.Context, cli client.Client) error {
+		old := &corev1.ConfigMap{}
+		if err := cli.Get(ctx, client.ObjectKey{Name: oldName, Namespace: namespace}, old); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		renamed := &corev1.ConfigMap{
+			ObjectMeta: copiedMeta(old.ObjectMeta, newName, namespace),
+			Data:       old.Data,
+			BinaryData: old.BinaryData,
+		}
+		if err := cli.Create(ctx, renamed); err != nil && !k8serr.IsAlreadyExists(err) {
+			return fmt.Errorf("failed creating configmap %s/%s: %w", namespace, newName, err)
+		}
+
+		return client.IgnoreNotFound(cli.Delete(ctx, old))
+	}
+}
+
+// RelocateSecret moves the Secret to another namespace, keeping its name.
+// The Secret already existing in the target namespace is kept as is.
+func RelocateSecret(name, fromNamespace, toNamespace string) func(ctx context.Context, cli client.Client) error {
+	return func(ctx context.Context, cli client.Client) error {
+		old := &corev1.Secret{}
+		if err := cli.Get(ctx, client.ObjectKey{Name: name, Namespace: fromNamespace}, old); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		relocated := &corev1.Secret{
+			ObjectMeta: copiedMeta(old.ObjectMeta, name, toNamespace),
+			Type:       old.Type,
+			Data:       old.Data,
+		}
+		if err := cli.Create(ctx, relocated); err != nil && !k8serr.IsAlreadyExists(err) {
+			return fmt.Errorf("failed creating secret %s/%s: %w", toNamespace, name, err)
+		}
+
+		return client.IgnoreNotFound(cli.Delete(ctx, old))
+	}
+}
+
+// RenameLabel replaces the label key on all the resources of the given kind carrying it, keeping its value.
+// Resources already carrying the new label keep its value.
+func RenameLabel(kind schema.GroupVersionKind, oldKey, newKey string) func(ctx context.Context, cli client.Client) error {
+	return func(ctx context.Context, cli client.Client) error {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+		if err := cli.List(ctx, list, client.HasLabels{oldKey}); err != nil {
+			return fmt.Errorf("failed listing %s labeled with %s: %w", kind.Kind, oldKey, err)
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			objLabels := obj.GetLabels()
+			if _, found := objLabels[newKey]; !found {
+				objLabels[newKey] = objLabels[oldKey]
+			}
+			delete(objLabels, oldKey)
+			obj.SetLabels(objLabels)
+
+			if err := cli.Update(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed relabeling %s %s: %w", kind.Kind, client.ObjectKeyFromObject(obj), err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// copiedMeta carries labels and annotations of the moved resource over. Owner references are not copied,
+// as they are not valid across namespaces, the owner re-adopts the resource when reconciled.
+func copiedMeta(meta metav1.ObjectMeta, name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
@@ -0,0 +1,100 @@
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// DSCIPresetsConfigMapName is the name of the ConfigMap in the operator namespace holding install-time defaults
+// of the DSCInitialization created by the operator. It allows distributions embedding the operator to ship their
+// own defaults, e.g. as part of the OLM bundle, without changing the defaulting code.
+const DSCIPresetsConfigMapName = "dsci-presets"
+
+// Keys of the DSCIPresetsConfigMapName ConfigMap. All of them are optional.
+const (
+	PresetApplicationsNamespace = "applications-namespace"
+	PresetMonitoringState       = "monitoring-management-state"
+	PresetMonitoringNamespace   = "monitoring-namespace"
+	PresetServiceMeshNamespace  = "service-mesh-namespace"
+)
+
+// ApplyDSCIPresets overrides values of the default DSCInitialization spec with the ones defined in the presets
+// ConfigMap of the operator namespace. It has no effect when the ConfigMap does not exist.
+func ApplyDSCIPresets(ctx context.Context, cli client.Client, spec *dsciv1.DSCInitializationSpec) error {
+	operatorNamespace, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return nil //nolint:nilerr // Reason: presets are only read when running in the cluster, not e.g. during local development
+	}
+
+	presets := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: DSCIPresetsConfigMapName, Namespace: operatorNamespace}, presets); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed getting DSCInitialization presets %s/%s: %w", operatorNamespace, DSCIPresetsConfigMapName, err)
+	}
+
+	if err := applyPresets(presets.Data, spec); err != nil {
+		return fmt.Errorf("invalid DSCInitialization presets %s/%s: %w", operatorNamespace, DSCIPresetsConfigMapName, err)
+	}
+
+	ctrl.Log.Info("applied DSCInitialization presets", "configmap", DSCIPresetsConfigMapName, "namespace", operatorNamespace)
+
+	return nil
+}
+
+func applyPresets(presets map[string]string, spec *dsciv1.DSCInitializationSpec) error {
+	var multiErr *multierror.Error
+
+	if ns, found := presets[PresetApplicationsNamespace]; found {
+		multiErr = multierror.Append(multiErr, presetNamespace(PresetApplicationsNamespace, ns, &spec.ApplicationsNamespace))
+	}
+
+	if ns, found := presets[PresetMonitoringNamespace]; found {
+		multiErr = multierror.Append(multiErr, presetNamespace(PresetMonitoringNamespace, ns, &spec.Monitoring.Namespace))
+	}
+
+	if state, found := presets[PresetMonitoringState]; found {
+		switch managementState := operatorv1.ManagementState(strings.TrimSpace(state)); managementState {
+		case operatorv1.Managed, operatorv1.Removed:
+			spec.Monitoring.ManagementState = managementState
+		default:
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%s has to be either %s or %s, got %q", PresetMonitoringState, operatorv1.Managed, operatorv1.Removed, state))
+		}
+	}
+
+	if ns, found := presets[PresetServiceMeshNamespace]; found {
+		if spec.ServiceMesh == nil {
+			multiErr = multierror.Append(multiErr, errors.New(PresetServiceMeshNamespace+" is set, but Service Mesh is not configured by default"))
+		} else {
+			multiErr = multierror.Append(multiErr, presetNamespace(PresetServiceMeshNamespace, ns, &spec.ServiceMesh.ControlPlane.Namespace))
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+func presetNamespace(key, value string, target *string) error {
+	ns := strings.TrimSpace(value)
+	if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+		return fmt.Errorf("%s is not a valid namespace name %q: %s", key, value, strings.Join(errs, ", "))
+	}
+
+	*target = ns
+
+	return nil
+}
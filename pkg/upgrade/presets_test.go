@@ -0,0 +1,94 @@
+package upgrade_test
+
+import (
+	"context"
+	"os"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DSCInitialization presets", func() {
+
+	const operatorNamespace = "presets-operator-ns"
+
+	var spec *dsciv1.DSCInitializationSpec
+
+	presets := func(data map[string]string) client.Object {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: upgrade.DSCIPresetsConfigMapName, Namespace: operatorNamespace},
+			Data:       data,
+		}
+	}
+
+	BeforeEach(func() {
+		Expect(os.Setenv("OPERATOR_NAMESPACE", operatorNamespace)).To(Succeed())
+		DeferCleanup(os.Unsetenv, "OPERATOR_NAMESPACE")
+
+		spec = &dsciv1.DSCInitializationSpec{
+			ApplicationsNamespace: "opendatahub",
+			Monitoring:            dsciv1.Monitoring{ManagementState: operatorv1.Managed, Namespace: "opendatahub"},
+			ServiceMesh: &infrav1.ServiceMeshSpec{
+				ControlPlane: infrav1.ControlPlaneSpec{Namespace: "istio-system"},
+			},
+		}
+	})
+
+	It("should keep defaults when presets do not exist", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().Build()
+		expected := spec.DeepCopy()
+
+		// when
+		err := upgrade.ApplyDSCIPresets(ctx, cli, spec)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec).To(Equal(expected))
+	})
+
+	It("should override defaults with presets", func(ctx context.Context) {
+		// given
+		cli := fake.NewClientBuilder().WithObjects(presets(map[string]string{
+			upgrade.PresetApplicationsNamespace: "vendor-applications",
+			upgrade.PresetMonitoringState:       "Removed",
+			upgrade.PresetMonitoringNamespace:   "vendor-monitoring",
+			upgrade.PresetServiceMeshNamespace:  "vendor-mesh",
+		})).Build()
+
+		// when
+		err := upgrade.ApplyDSCIPresets(ctx, cli, spec)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.ApplicationsNamespace).To(Equal("vendor-applications"))
+		Expect(spec.Monitoring.ManagementState).To(Equal(operatorv1.Removed))
+		Expect(spec.Monitoring.Namespace).To(Equal("vendor-monitoring"))
+		Expect(spec.ServiceMesh.ControlPlane.Namespace).To(Equal("vendor-mesh"))
+	})
+
+	DescribeTable("should reject invalid presets",
+		func(ctx context.Context, data map[string]string, expectedErr string) {
+			// given
+			cli := fake.NewClientBuilder().WithObjects(presets(data)).Build()
+
+			// when
+			err := upgrade.ApplyDSCIPresets(ctx, cli, spec)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("with invalid namespace name", map[string]string{upgrade.PresetApplicationsNamespace: "Not_A_Namespace"}, upgrade.PresetApplicationsNamespace),
+		Entry("with unsupported management state", map[string]string{upgrade.PresetMonitoringState: "Unmanaged"}, upgrade.PresetMonitoringState),
+	)
+})
@@ -3,15 +3,18 @@ package upgrade
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
@@ -35,29 +38,8 @@ func OperatorUninstall(ctx context.Context, cli client.Client) error {
 	}
 
 	// Delete generated namespaces by the operator
-	generatedNamespaces := &corev1.NamespaceList{}
-	nsOptions := []client.ListOption{
-		client.MatchingLabels{labels.ODH.OwnedNamespace: "true"},
-	}
-	if err := cli.List(ctx, generatedNamespaces, nsOptions...); err != nil {
-		return fmt.Errorf("error getting generated namespaces : %w", err)
-	}
-
-	// Return if any one of the namespaces is Terminating due to resources that are in process of deletion. (e.g. CRDs)
-	for _, namespace := range generatedNamespaces.Items {
-		if namespace.Status.Phase == corev1.NamespaceTerminating {
-			return fmt.Errorf("waiting for namespace %v to be deleted", namespace.Name)
-		}
-	}
-
-	for _, namespace := range generatedNamespaces.Items {
-		namespace := namespace
-		if namespace.Status.Phase == corev1.NamespaceActive {
-			if err := cli.Delete(ctx, &namespace); err != nil {
-				return fmt.Errorf("error deleting namespace %v: %w", namespace.Name, err)
-			}
-			ctrl.Log.Info("Namespace " + namespace.Name + " deleted as a part of uninstallation.")
-		}
+	if err := removeGeneratedNamespaces(ctx, cli); err != nil {
+		return err
 	}
 
 	// give enough time for namespace deletion before proceed
@@ -107,6 +89,102 @@ func removeDSCInitialization(ctx context.Context, cli client.Client) error {
 	return multiErr.ErrorOrNil()
 }
 
+func removeGeneratedNamespaces(ctx context.Context, cli client.Client) error {
+	generatedNamespaces := &corev1.NamespaceList{}
+	nsOptions := []client.ListOption{
+		client.MatchingLabels{labels.ODH.OwnedNamespace: "true"},
+	}
+	if err := cli.List(ctx, generatedNamespaces, nsOptions...); err != nil {
+		return fmt.Errorf("error getting generated namespaces : %w", err)
+	}
+
+	// Return if any one of the namespaces is Terminating due to resources that are in process of deletion. (e.g. CRDs)
+	for _, namespace := range generatedNamespaces.Items {
+		if namespace.Status.Phase == corev1.NamespaceTerminating {
+			return fmt.Errorf("waiting for namespace %v to be deleted", namespace.Name)
+		}
+	}
+
+	for _, namespace := range generatedNamespaces.Items {
+		namespace := namespace
+		if namespace.Status.Phase == corev1.NamespaceActive {
+			if err := cli.Delete(ctx, &namespace); err != nil {
+				return fmt.Errorf("error deleting namespace %v: %w", namespace.Name, err)
+			}
+			ctrl.Log.Info("Namespace " + namespace.Name + " deleted as a part of uninstallation.")
+		}
+	}
+
+	return nil
+}
+
+// RemoveClusterScopedResources deletes cluster-scoped resources created by the operator, which are not garbage collected
+// when the operator is uninstalled through OLM:
+//   - FeatureTrackers, together with the resources they own,
+//   - ClusterRoles and ClusterRoleBindings created by features or deployed from component manifests,
+//   - namespaces generated by the operator.
+//
+// It returns an error while generated namespaces are still terminating, so that it can be retried.
+func RemoveClusterScopedResources(ctx context.Context, cli client.Client) error {
+	var multiErr *multierror.Error
+
+	trackers := &featurev1.FeatureTrackerList{}
+	if err := cli.List(ctx, trackers); err != nil {
+		return fmt.Errorf("error getting feature trackers: %w", err)
+	}
+	for i := range trackers.Items {
+		multiErr = multierror.Append(multiErr, deleteClusterResource(ctx, cli, "FeatureTracker", &trackers.Items[i]))
+	}
+
+	clusterRoles := &rbacv1.ClusterRoleList{}
+	if err := cli.List(ctx, clusterRoles); err != nil {
+		return fmt.Errorf("error getting cluster roles: %w", err)
+	}
+	for i := range clusterRoles.Items {
+		if isCreatedByOperator(clusterRoles.Items[i].GetLabels()) {
+			multiErr = multierror.Append(multiErr, deleteClusterResource(ctx, cli, "ClusterRole", &clusterRoles.Items[i]))
+		}
+	}
+
+	clusterRoleBindings := &rbacv1.ClusterRoleBindingList{}
+	if err := cli.List(ctx, clusterRoleBindings); err != nil {
+		return fmt.Errorf("error getting cluster role bindings: %w", err)
+	}
+	for i := range clusterRoleBindings.Items {
+		if isCreatedByOperator(clusterRoleBindings.Items[i].GetLabels()) {
+			multiErr = multierror.Append(multiErr, deleteClusterResource(ctx, cli, "ClusterRoleBinding", &clusterRoleBindings.Items[i]))
+		}
+	}
+
+	multiErr = multierror.Append(multiErr, removeGeneratedNamespaces(ctx, cli))
+
+	return multiErr.ErrorOrNil()
+}
+
+func deleteClusterResource(ctx context.Context, cli client.Client, kind string, obj client.Object) error {
+	if err := cli.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("error deleting %s %s: %w", kind, obj.GetName(), err)
+	}
+	ctrl.Log.Info(kind + " " + obj.GetName() + " deleted as a part of uninstallation.")
+
+	return nil
+}
+
+// isCreatedByOperator checks if the resource is labeled as created by a feature or deployed from component manifests.
+func isCreatedByOperator(objLabels map[string]string) bool {
+	if objLabels[labels.K8SCommon.ManagedBy] == cluster.OperatorName {
+		return true
+	}
+
+	for key := range objLabels {
+		if strings.HasPrefix(key, labels.ODHAppPrefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
 // HasDeleteConfigMap returns true if delete configMap is added to the operator namespace by managed-tenants repo.
 // It returns false in all other cases.
 func HasDeleteConfigMap(ctx context.Context, c client.Client) bool {
@@ -111,6 +111,7 @@ func CreateDefaultDSC(ctx context.Context, cli client.Client) error {
 }
 
 // CreateDefaultDSCI creates a default instance of DSCI
+// Defaults can be overridden by the presets ConfigMap shipped with the operator, see ApplyDSCIPresets.
 // If there exists default-dsci instance already, it will not update DSCISpec on it.
 // Note: DSCI CR modifcations are not supported, as it is the initial prereq setting for the components.
 func CreateDefaultDSCI(ctx context.Context, cli client.Client, _ cluster.Platform, appNamespace, monNamespace string) error {
@@ -133,6 +134,10 @@ func CreateDefaultDSCI(ctx context.Context, cli client.Client, _ cluster.Platfor
 		},
 	}
 
+	if err := ApplyDSCIPresets(ctx, cli, defaultDsciSpec); err != nil {
+		return err
+	}
+
 	defaultDsci := &dsciv1.DSCInitialization{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DSCInitialization",
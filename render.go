@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/conversion"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+)
+
+// renderCommand is the first argument of the operator binary rendering manifests of a capability instead of running the manager.
+const renderCommand = "render"
+
+// runRender renders manifests of the features of the capability configured by the DSCInitialization read from a file and
+// prints them to the output, so that they can be reviewed before anything is applied to the cluster. Data providers of the
+// features read the cluster pointed at by the kubeconfig, or the objects read from fixtures files when rendering offline.
+func runRender(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet(renderCommand, flag.ContinueOnError)
+	dsciFile := flags.String("dsci", "", "File holding the DSCInitialization, fields defaulted by the API server have to be set")
+	capability := flags.String("capability", "", "Capability whose manifests are rendered, one of: "+strings.Join(dscictrl.RenderTargets(), ", "))
+	kubeconfig := flags.String("kubeconfig", "", "Kubeconfig of the cluster the features read their data from, defaults to the current context")
+	fixtures := flags.String("fixtures", "", "Directory with YAML files of objects the features read their data from instead of the cluster")
+	logmode := flags.String("log-mode", "", "Log mode ('', prod, devel), logs are written to stderr")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *dsciFile == "" || *capability == "" {
+		return fmt.Errorf("both --dsci and --capability flags are required")
+	}
+
+	ctrl.SetLogger(logger.NewDynamic(*logmode, os.Stderr).Logger())
+
+	instance, err := readDSCInitialization(*dsciFile)
+	if err != nil {
+		return err
+	}
+
+	var cli client.Client
+	if *fixtures != "" {
+		cli, err = loadFixtures(*fixtures)
+	} else {
+		cli, err = clusterClient(*kubeconfig)
+	}
+	if err != nil {
+		return err
+	}
+
+	reconciler := &dscictrl.DSCInitializationReconciler{
+		Client:                cli,
+		Scheme:                scheme,
+		Log:                   ctrl.Log.WithName("render"),
+		ApplicationsNamespace: instance.Spec.ApplicationsNamespace,
+	}
+
+	rendered, err := reconciler.RenderCapability(ctx, instance, *capability)
+	if err != nil {
+		return err
+	}
+
+	return printRendered(out, rendered)
+}
+
+func readDSCInitialization(path string) (*dsciv1.DSCInitialization, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading DSCInitialization: %w", err)
+	}
+
+	instance := &dsciv1.DSCInitialization{}
+	if err := yaml.UnmarshalStrict(content, instance); err != nil {
+		return nil, fmt.Errorf("failed parsing DSCInitialization %s: %w", path, err)
+	}
+
+	return instance, nil
+}
+
+func loadConfig(kubeconfig string) (*rest.Config, error) {
+	restCfg, err := config.GetConfig()
+	if kubeconfig != "" {
+		restCfg, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed loading kubeconfig: %w", err)
+	}
+
+	return restCfg, nil
+}
+
+func clusterClient(kubeconfig string) (client.Client, error) {
+	restCfg, err := loadConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(restCfg, client.Options{Scheme: scheme})
+}
+
+// loadFixtures reads objects from YAML files of the directory, see fixturesClient.
+func loadFixtures(dir string) (client.Client, error) {
+	var objects []*unstructured.Unstructured
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, errWalk error) error {
+		if errWalk != nil || entry.IsDir() || (filepath.Ext(path) != ".yaml" && filepath.Ext(path) != ".yml") {
+			return errWalk
+		}
+
+		content, errRead := os.ReadFile(path)
+		if errRead != nil {
+			return errRead
+		}
+
+		fileObjects, errConvert := conversion.StrToUnstructured(string(content))
+		if errConvert != nil {
+			return fmt.Errorf("failed parsing fixtures %s: %w", path, errConvert)
+		}
+		for _, obj := range fileObjects {
+			if len(obj.Object) > 0 {
+				objects = append(objects, obj)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed reading fixtures: %w", err)
+	}
+
+	return &fixturesClient{scheme: scheme, objects: objects}, nil
+}
+
+func printRendered(out io.Writer, rendered []feature.RenderedFeature) error {
+	for _, renderedFeature := range rendered {
+		for _, obj := range renderedFeature.Resources {
+			content, err := yaml.Marshal(obj.Object)
+			if err != nil {
+				return fmt.Errorf("failed printing %s %s of feature %s: %w", obj.GetKind(), obj.GetName(), renderedFeature.Name, err)
+			}
+			if _, err := fmt.Fprintf(out, "---\n# Feature: %s\n%s", renderedFeature.Name, content); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
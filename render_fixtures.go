@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+var (
+	errFixturesReadOnly    = errors.New("fixtures cannot be changed, rendering does not apply anything to the cluster")
+	errFixturesScopeOfKind = errors.New("scope of kinds is not known when rendering from fixtures")
+	errFixturesSubResource = errors.New("subresources are not part of fixtures")
+)
+
+// fixturesClient serves objects read from fixtures files to the features rendered offline. Features only read the cluster when
+// they are rendered, so all the other operations fail. Objects are converted to the types of the operator scheme when read as such,
+// kinds the scheme does not know, such as ServiceMeshControlPlane, are read as unstructured objects. The scheme is not changed.
+type fixturesClient struct {
+	scheme  *runtime.Scheme
+	objects []*unstructured.Unstructured
+}
+
+var _ client.Client = (*fixturesClient)(nil)
+
+func (c *fixturesClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return err
+	}
+
+	for _, fixture := range c.objects {
+		if fixture.GroupVersionKind() == gvk && fixture.GetNamespace() == key.Namespace && fixture.GetName() == key.Name {
+			return c.convert(fixture, obj)
+		}
+	}
+
+	return k8serr.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: strings.ToLower(gvk.Kind)}, key.Name)
+}
+
+func (c *fixturesClient) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listGVK, err := c.GroupVersionKindFor(list)
+	if err != nil {
+		return err
+	}
+	gvk := listGVK.GroupVersion().WithKind(strings.TrimSuffix(listGVK.Kind, "List"))
+
+	listOptions := (&client.ListOptions{}).ApplyOptions(opts)
+	if listOptions.FieldSelector != nil && !listOptions.FieldSelector.Empty() {
+		return errors.New("field selectors are not supported when rendering from fixtures")
+	}
+
+	var items []runtime.Object
+	for _, fixture := range c.objects {
+		if fixture.GroupVersionKind() != gvk || (listOptions.Namespace != "" && fixture.GetNamespace() != listOptions.Namespace) {
+			continue
+		}
+		if listOptions.LabelSelector != nil && !listOptions.LabelSelector.Matches(labels.Set(fixture.GetLabels())) {
+			continue
+		}
+
+		if _, isUnstructured := list.(*unstructured.UnstructuredList); isUnstructured {
+			items = append(items, fixture.DeepCopy())
+
+			continue
+		}
+
+		item, errNew := c.scheme.New(gvk)
+		if errNew != nil {
+			return errNew
+		}
+		if errConvert := runtime.DefaultUnstructuredConverter.FromUnstructured(fixture.DeepCopy().Object, item); errConvert != nil {
+			return errConvert
+		}
+		items = append(items, item)
+	}
+
+	return meta.SetList(list, items)
+}
+
+func (c *fixturesClient) convert(fixture *unstructured.Unstructured, obj client.Object) error {
+	if u, isUnstructured := obj.(*unstructured.Unstructured); isUnstructured {
+		fixture.DeepCopyInto(u)
+
+		return nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(fixture.DeepCopy().Object, obj)
+}
+
+func (c *fixturesClient) Create(context.Context, client.Object, ...client.CreateOption) error {
+	return errFixturesReadOnly
+}
+
+func (c *fixturesClient) Delete(context.Context, client.Object, ...client.DeleteOption) error {
+	return errFixturesReadOnly
+}
+
+func (c *fixturesClient) Update(context.Context, client.Object, ...client.UpdateOption) error {
+	return errFixturesReadOnly
+}
+
+func (c *fixturesClient) Patch(context.Context, client.Object, client.Patch, ...client.PatchOption) error {
+	return errFixturesReadOnly
+}
+
+func (c *fixturesClient) DeleteAllOf(context.Context, client.Object, ...client.DeleteAllOfOption) error {
+	return errFixturesReadOnly
+}
+
+func (c *fixturesClient) Status() client.SubResourceWriter {
+	return fixturesSubResource{}
+}
+
+func (c *fixturesClient) SubResource(string) client.SubResourceClient {
+	return fixturesSubResource{}
+}
+
+func (c *fixturesClient) Scheme() *runtime.Scheme {
+	return c.scheme
+}
+
+func (c *fixturesClient) RESTMapper() meta.RESTMapper {
+	return meta.NewDefaultRESTMapper(nil)
+}
+
+func (c *fixturesClient) GroupVersionKindFor(obj runtime.Object) (schema.GroupVersionKind, error) {
+	return apiutil.GVKForObject(obj, c.scheme)
+}
+
+func (c *fixturesClient) IsObjectNamespaced(runtime.Object) (bool, error) {
+	return false, errFixturesScopeOfKind
+}
+
+// fixturesSubResource rejects access to subresources, which are not part of the fixtures.
+type fixturesSubResource struct{}
+
+func (fixturesSubResource) Get(context.Context, client.Object, client.Object, ...client.SubResourceGetOption) error {
+	return errFixturesSubResource
+}
+
+func (fixturesSubResource) Create(context.Context, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+	return errFixturesReadOnly
+}
+
+func (fixturesSubResource) Update(context.Context, client.Object, ...client.SubResourceUpdateOption) error {
+	return errFixturesReadOnly
+}
+
+func (fixturesSubResource) Patch(context.Context, client.Object, client.Patch, ...client.SubResourcePatchOption) error {
+	return errFixturesReadOnly
+}
@@ -0,0 +1,107 @@
+package features_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/blang/semver/v4"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/integration/features/fixtures"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Canary rollout", func() {
+
+	const (
+		appNamespace    = "default"
+		canaryNamespace = "opendatahub-canary"
+	)
+
+	var (
+		dsci    *dsciv1.DSCInitialization
+		applied []string
+	)
+
+	BeforeEach(func() {
+		dsci = fixtures.NewDSCInitialization(appNamespace)
+		dsci.Spec.CanaryRollout = &dsciv1.CanaryRollout{Namespace: canaryNamespace}
+		applied = []string{}
+		feature.SetOperatorVersion(semver.MustParse("2.9.0"))
+	})
+
+	gatewayFeature := func(probe feature.Action) feature.FeaturesProvider {
+		return func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("canary-gateway").
+					UsingConfig(envTest.Config).
+					WithResources(func(_ context.Context, f *feature.Feature) error {
+						applied = append(applied, f.TargetNamespace)
+
+						return nil
+					}).
+					CanaryProbes(probe),
+			)
+		}
+	}
+
+	passingProbe := func(_ context.Context, _ *feature.Feature) error {
+		return nil
+	}
+
+	AfterEach(func(ctx context.Context) {
+		feature.SetOperatorVersion(semver.Version{})
+		Expect(feature.ClusterFeaturesHandler(dsci, gatewayFeature(passingProbe)).Delete(ctx)).To(Succeed())
+	})
+
+	It("should apply changes directly when feature has not been applied before", func(ctx context.Context) {
+		// when
+		Expect(feature.ClusterFeaturesHandler(dsci, gatewayFeature(passingProbe)).Apply(ctx)).To(Succeed())
+
+		// then
+		Expect(applied).To(Equal([]string{appNamespace}))
+	})
+
+	It("should roll out changes of upgraded operator after they succeed in the canary namespace", func(ctx context.Context) {
+		// given
+		Expect(feature.ClusterFeaturesHandler(dsci, gatewayFeature(passingProbe)).Apply(ctx)).To(Succeed())
+		feature.SetOperatorVersion(semver.MustParse("2.10.0"))
+		applied = []string{}
+
+		// when
+		Expect(feature.ClusterFeaturesHandler(dsci, gatewayFeature(passingProbe)).Apply(ctx)).To(Succeed())
+
+		// then
+		Expect(applied).To(Equal([]string{canaryNamespace, appNamespace}))
+		featureTracker, err := fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "canary-gateway")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(featureTracker.Status.OperatorVersion).To(Equal("2.10.0"))
+		_, err = fixtures.GetFeatureTracker(ctx, envTestClient, canaryNamespace, feature.CanaryName("canary-gateway"))
+		Expect(k8serr.IsNotFound(err)).To(BeTrue(), "canary should be removed after rollout")
+	})
+
+	It("should keep previous version of the feature when the canary probe fails", func(ctx context.Context) {
+		// given
+		Expect(feature.ClusterFeaturesHandler(dsci, gatewayFeature(passingProbe)).Apply(ctx)).To(Succeed())
+		feature.SetOperatorVersion(semver.MustParse("2.10.0"))
+		applied = []string{}
+		failingProbe := func(_ context.Context, _ *feature.Feature) error {
+			return errors.New("gateway not reachable")
+		}
+
+		// when
+		err := feature.ClusterFeaturesHandler(dsci, gatewayFeature(failingProbe)).Apply(ctx)
+
+		// then
+		Expect(feature.IsCanaryFailed(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("gateway not reachable")))
+		Expect(applied).To(Equal([]string{canaryNamespace}))
+		featureTracker, errGet := fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "canary-gateway")
+		Expect(errGet).ToNot(HaveOccurred())
+		Expect(featureTracker.Status.OperatorVersion).To(Equal("2.9.0"))
+	})
+})
@@ -0,0 +1,45 @@
+package features_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/featuretesting"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/envtestutil"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/integration/features/fixtures"
+)
+
+var _ = featuretesting.ConformanceSuite("resources created programmatically", func() featuretesting.Subject {
+	namespace := envtestutil.AppendRandomNameTo("test-conformance")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "conformance-secret",
+			Namespace: namespace,
+		},
+	}
+
+	createSecret := func(ctx context.Context, f *feature.Feature) error {
+		toCreate := secret.DeepCopy()
+		toCreate.SetOwnerReferences([]metav1.OwnerReference{f.AsOwnerReference()})
+
+		return client.IgnoreAlreadyExists(f.Client.Create(ctx, toCreate))
+	}
+
+	return featuretesting.Subject{
+		Config: envTest.Config,
+		Client: envTestClient,
+		DSCI:   fixtures.NewDSCInitialization(namespace),
+		Provider: func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("conformance-secret").
+					PreConditions(feature.CreateNamespaceIfNotExists(namespace)).
+					WithResources(createSecret),
+			)
+		},
+		Resources: []client.Object{secret},
+	}
+})
@@ -0,0 +1,97 @@
+package features_test
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/integration/features/fixtures"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature dependencies", func() {
+
+	var (
+		dsci     *dsciv1.DSCInitialization
+		executed []string
+	)
+
+	BeforeEach(func() {
+		dsci = fixtures.NewDSCInitialization("default")
+		executed = []string{}
+	})
+
+	record := func(name string) feature.Action {
+		return func(_ context.Context, _ *feature.Feature) error {
+			executed = append(executed, name)
+
+			return nil
+		}
+	}
+
+	recordCleanup := func(name string) feature.CleanupFunc {
+		return func(_ context.Context, _ client.Client) error {
+			executed = append(executed, name)
+
+			return nil
+		}
+	}
+
+	dependentFeatures := func(registry feature.FeaturesRegistry) error {
+		return registry.Add(
+			feature.Define("extension-provider").
+				UsingConfig(envTest.Config).
+				DependsOn("auth-namespace").
+				PreConditions(record("extension-provider")).
+				OnDelete(recordCleanup("extension-provider")),
+			feature.Define("auth-namespace").
+				UsingConfig(envTest.Config).
+				PreConditions(record("auth-namespace")).
+				OnDelete(recordCleanup("auth-namespace")),
+		)
+	}
+
+	It("should apply features after their dependencies", func(ctx context.Context) {
+		// given
+		featuresHandler := feature.ClusterFeaturesHandler(dsci, dependentFeatures)
+
+		// when
+		Expect(featuresHandler.Apply(ctx)).To(Succeed())
+
+		// then
+		Expect(executed).To(Equal([]string{"auth-namespace", "extension-provider"}))
+	})
+
+	It("should delete features before their dependencies", func(ctx context.Context) {
+		// given
+		featuresHandler := feature.ClusterFeaturesHandler(dsci, dependentFeatures)
+		Expect(featuresHandler.Apply(ctx)).To(Succeed())
+		executed = []string{}
+
+		// when
+		Expect(featuresHandler.Delete(ctx)).To(Succeed())
+
+		// then
+		Expect(executed).To(Equal([]string{"extension-provider", "auth-namespace"}))
+	})
+
+	It("should fail when features depend on each other", func(ctx context.Context) {
+		// given
+		featuresHandler := feature.ClusterFeaturesHandler(dsci, func(registry feature.FeaturesRegistry) error {
+			return registry.Add(
+				feature.Define("chicken").UsingConfig(envTest.Config).DependsOn("egg"),
+				feature.Define("egg").UsingConfig(envTest.Config).DependsOn("chicken"),
+			)
+		})
+
+		// when
+		err := featuresHandler.Apply(ctx)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("circular dependency")))
+	})
+})
@@ -0,0 +1,53 @@
+package fixtures
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/featuretesting"
+)
+
+// InstantPoller returns the default poller of features driven by featuretesting.InstantClock, so that features waiting
+// for the cluster, e.g. for the control plane to become ready, run through their intervals and timeout instantly.
+func InstantPoller() (*feature.Poller, *featuretesting.InstantClock) {
+	return featuretesting.NewInstantPoller(feature.DefaultPoller())
+}
+
+// ControlPlaneReadiness lists components of ServiceMeshControlPlane by their readiness, as reported by Service Mesh operator.
+type ControlPlaneReadiness struct {
+	Ready, Pending, Unready []string
+}
+
+// NewServiceMeshControlPlane creates ServiceMeshControlPlane with status reporting readiness of its components. As there is
+// no Service Mesh operator in the test environment, the status has to be written after the control plane is created.
+func NewServiceMeshControlPlane(name, namespace string, readiness ControlPlaneReadiness) *unstructured.Unstructured {
+	smcp := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{},
+			"status": map[string]interface{}{
+				"readiness": map[string]interface{}{
+					"components": map[string]interface{}{
+						"ready":   toSlice(readiness.Ready),
+						"pending": toSlice(readiness.Pending),
+						"unready": toSlice(readiness.Unready),
+					},
+				},
+			},
+		},
+	}
+	smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+	smcp.SetName(name)
+	smcp.SetNamespace(namespace)
+
+	return smcp
+}
+
+func toSlice(values []string) []interface{} {
+	slice := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		slice = append(slice, value)
+	}
+
+	return slice
+}
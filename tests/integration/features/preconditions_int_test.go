@@ -5,7 +5,9 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
@@ -93,6 +95,49 @@ var _ = Describe("feature preconditions", func() {
 
 		})
 
+		It("should apply namespace defaults to created namespace", func(ctx context.Context) {
+			// given
+			defer objectCleaner.DeleteAll(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})
+			dsci.Spec.NamespaceDefaults = &dsciv1.NamespaceDefaults{
+				ResourceQuota: &corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+				},
+				LimitRange: &corev1.LimitRangeSpec{
+					Limits: []corev1.LimitRangeItem{{
+						Type:           corev1.LimitTypeContainer,
+						DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+					}},
+				},
+			}
+
+			// when
+			featuresHandler := feature.ClusterFeaturesHandler(dsci, func(registry feature.FeaturesRegistry) error {
+				errFeatureAdd := registry.Add(feature.Define("create-new-ns-with-guardrails").
+					UsingConfig(envTest.Config).
+					PreConditions(
+						feature.CreateNamespaceIfNotExists(namespace),
+						feature.ApplyNamespaceDefaults(namespace, dsci.Spec.NamespaceDefaults),
+					),
+				)
+
+				Expect(errFeatureAdd).ToNot(HaveOccurred())
+
+				return nil
+			})
+
+			// then
+			Expect(featuresHandler.Apply(ctx)).To(Succeed())
+
+			// and
+			quota := &corev1.ResourceQuota{}
+			Expect(envTestClient.Get(ctx, client.ObjectKey{Name: feature.NamespaceQuotaName, Namespace: namespace}, quota)).To(Succeed())
+			Expect(quota.Spec.Hard).To(HaveKey(corev1.ResourcePods))
+
+			limitRange := &corev1.LimitRange{}
+			Expect(envTestClient.Get(ctx, client.ObjectKey{Name: feature.NamespaceLimitRangeName, Namespace: namespace}, limitRange)).To(Succeed())
+			Expect(limitRange.Spec.Limits).To(HaveLen(1))
+		})
+
 	})
 
 })
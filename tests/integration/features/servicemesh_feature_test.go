@@ -4,6 +4,7 @@ import (
 	"context"
 	"path"
 
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -273,6 +274,75 @@ var _ = Describe("Service Mesh setup", func() {
 		})
 
 	})
+
+	Describe("postconditions", func() {
+
+		When("waiting for control plane to become ready", func() {
+
+			var (
+				smcpCrdObj *apiextensionsv1.CustomResourceDefinition
+				namespace  *corev1.Namespace
+			)
+
+			BeforeEach(func(ctx context.Context) {
+				smcpCrdObj = installServiceMeshCRD(ctx)
+				namespace = fixtures.NewNamespace(envtestutil.AppendRandomNameTo("control-plane"))
+				Expect(envTestClient.Create(ctx, namespace)).To(Succeed())
+
+				dsci.Spec.ServiceMesh.ControlPlane.Name = "data-science-smcp"
+				dsci.Spec.ServiceMesh.ControlPlane.Namespace = namespace.Name
+			})
+
+			AfterEach(func(ctx context.Context) {
+				objectCleaner.DeleteAll(ctx, namespace, smcpCrdObj)
+			})
+
+			createControlPlane := func(ctx context.Context, readiness fixtures.ControlPlaneReadiness) {
+				smcp := fixtures.NewServiceMeshControlPlane(dsci.Spec.ServiceMesh.ControlPlane.Name, namespace.Name, readiness)
+				status := smcp.Object["status"]
+				Expect(envTestClient.Create(ctx, smcp)).To(Succeed())
+				smcp.Object["status"] = status
+				Expect(envTestClient.Status().Update(ctx, smcp)).To(Succeed())
+			}
+
+			waitForControlPlaneHandler := func(poller *feature.Poller) *feature.FeaturesHandler {
+				return feature.ClusterFeaturesHandler(dsci, func(registry feature.FeaturesRegistry) error {
+					return registry.Add(feature.Define("control-plane-readiness").
+						UsingConfig(envTest.Config).
+						UsingPoller(poller).
+						WithData(servicemesh.FeatureData.ControlPlane.Define(&dsci.Spec).AsAction()).
+						PostConditions(servicemesh.WaitForControlPlaneToBeReady),
+					)
+				})
+			}
+
+			It("should time out when components do not become ready", func(ctx context.Context) {
+				// given
+				createControlPlane(ctx, fixtures.ControlPlaneReadiness{Ready: []string{"istiod"}, Pending: []string{"ingress-gateway"}})
+				poller, clock := fixtures.InstantPoller()
+				start := clock.Now()
+
+				// when
+				err := waitForControlPlaneHandler(poller).Apply(ctx)
+
+				// then
+				Expect(err).To(MatchError(context.DeadlineExceeded))
+				Expect(clock.Since(start)).To(Equal(poller.Timeout()))
+			})
+
+			It("should succeed when all components are ready", func(ctx context.Context) {
+				// given
+				createControlPlane(ctx, fixtures.ControlPlaneReadiness{Ready: []string{"istiod", "ingress-gateway"}})
+				poller, _ := fixtures.InstantPoller()
+
+				// when
+				err := waitForControlPlaneHandler(poller).Apply(ctx)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
 })
 
 func installServiceMeshCRD(ctx context.Context) *apiextensionsv1.CustomResourceDefinition {
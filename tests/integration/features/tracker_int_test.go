@@ -3,9 +3,14 @@ package features_test
 import (
 	"context"
 	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
@@ -173,4 +178,141 @@ var _ = Describe("Feature tracking capability", func() {
 		})
 
 	})
+
+	Context("re-applying single feature", func() {
+
+		It("should apply only the requested feature", func(ctx context.Context) {
+			// given
+			featuresHandler := feature.ClusterFeaturesHandler(dsci, func(registry feature.FeaturesRegistry) error {
+				return registry.Add(
+					feature.Define("not-reapplied-feature").UsingConfig(envTest.Config),
+					feature.Define("reapplied-feature").UsingConfig(envTest.Config),
+				)
+			})
+
+			// when
+			Expect(featuresHandler.ApplyOnly(ctx, "reapplied-feature")).To(Succeed())
+
+			// then
+			featureTracker, err := fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "reapplied-feature")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(featureTracker.Status.Phase).To(Equal(status.PhaseReady))
+			_, err = fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "not-reapplied-feature")
+			Expect(k8serr.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should fail when the requested feature is not defined", func(ctx context.Context) {
+			// given
+			featuresHandler := feature.ClusterFeaturesHandler(dsci, func(registry feature.FeaturesRegistry) error {
+				return registry.Add(feature.Define("defined-feature").UsingConfig(envTest.Config))
+			})
+
+			// when
+			err := featuresHandler.ApplyOnly(ctx, "undefined-feature")
+
+			// then
+			Expect(err).To(MatchError(feature.ErrFeatureNotDefined))
+		})
+	})
+
+	Context("applying the same feature concurrently", func() {
+
+		trackerWithLease := func(ctx context.Context, featureName string, expiresAt time.Time) {
+			tracker := featurev1.NewFeatureTracker(featureName, appNamespace)
+			tracker.Spec = featurev1.FeatureTrackerSpec{
+				Source:       featurev1.Source{Type: featurev1.DSCIType, Name: dsci.Name},
+				AppNamespace: appNamespace,
+			}
+			Expect(envTestClient.Create(ctx, tracker)).To(Succeed())
+			tracker.Status.ApplyLease = &featurev1.ApplyLease{
+				Holder:    "another-operator-instance",
+				ExpiresAt: metav1.NewTime(expiresAt.UTC().Truncate(time.Second)),
+			}
+			Expect(envTestClient.Status().Update(ctx, tracker)).To(Succeed())
+		}
+
+		It("should not apply the feature while another apply pass holds the lease", func(ctx context.Context) {
+			// given
+			trackerWithLease(ctx, "leased-feature", time.Now().Add(time.Minute))
+			applied := false
+			featuresHandler := feature.ClusterFeaturesHandler(dsci, func(registry feature.FeaturesRegistry) error {
+				return registry.Add(feature.Define("leased-feature").
+					UsingConfig(envTest.Config).
+					PreConditions(func(_ context.Context, _ *feature.Feature) error {
+						applied = true
+
+						return nil
+					}),
+				)
+			})
+
+			// when
+			err := featuresHandler.Apply(ctx)
+
+			// then
+			Expect(feature.IsApplyInProgress(err)).To(BeTrue())
+			Expect(applied).To(BeFalse())
+			featureTracker, err := fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "leased-feature")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(featureTracker.Status.Phase).To(BeEmpty())
+		})
+
+		It("should take over expired lease", func(ctx context.Context) {
+			// given
+			trackerWithLease(ctx, "expired-lease-feature", time.Now().Add(-time.Minute))
+			featuresHandler := feature.ClusterFeaturesHandler(dsci, func(registry feature.FeaturesRegistry) error {
+				return registry.Add(feature.Define("expired-lease-feature").
+					UsingConfig(envTest.Config),
+				)
+			})
+
+			// when
+			Expect(featuresHandler.Apply(ctx)).To(Succeed())
+
+			// then
+			featureTracker, err := fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "expired-lease-feature")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(featureTracker.Status.Phase).To(Equal(status.PhaseReady))
+			Expect(featureTracker.Status.ApplyLease).ToNot(BeNil())
+			Expect(featureTracker.Status.ApplyLease.Holder).ToNot(Equal("another-operator-instance"))
+			Expect(featureTracker.Status.ApplyLease.ExpiresAt.Time).To(BeTemporally(">", time.Now()))
+		})
+
+		It("should serialize overlapping applies within the operator", func(ctx context.Context) {
+			// given
+			var running, maxRunning atomic.Int32
+			featuresHandler := func() *feature.FeaturesHandler {
+				return feature.ClusterFeaturesHandler(dsci, func(registry feature.FeaturesRegistry) error {
+					return registry.Add(feature.Define("overlapping-feature").
+						UsingConfig(envTest.Config).
+						PreConditions(func(_ context.Context, _ *feature.Feature) error {
+							current := running.Add(1)
+							defer running.Add(-1)
+							if current > maxRunning.Load() {
+								maxRunning.Store(current)
+							}
+							time.Sleep(100 * time.Millisecond)
+
+							return nil
+						}),
+					)
+				})
+			}
+
+			// when
+			var wg sync.WaitGroup
+			for i := 0; i < 3; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(featuresHandler().Apply(ctx)).To(Succeed())
+				}()
+			}
+			wg.Wait()
+
+			// then
+			Expect(maxRunning.Load()).To(Equal(int32(1)))
+		})
+	})
 })